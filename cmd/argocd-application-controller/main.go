@@ -18,6 +18,7 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	"github.com/argoproj/argo-cd/errors"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
@@ -37,18 +38,22 @@ const (
 
 func newCommand() *cobra.Command {
 	var (
-		clientConfig             clientcmd.ClientConfig
-		appResyncPeriod          int64
-		repoServerAddress        string
-		repoServerTimeoutSeconds int
-		selfHealTimeoutSeconds   int
-		statusProcessors         int
-		operationProcessors      int
-		logLevel                 string
-		glogLevel                int
-		metricsPort              int
-		kubectlParallelismLimit  int64
-		cacheSrc                 func() (*appstatecache.Cache, error)
+		clientConfig                clientcmd.ClientConfig
+		appResyncPeriod             int64
+		repoServerAddress           string
+		repoServerTimeoutSeconds    int
+		selfHealTimeoutSeconds      int
+		statusProcessors            int
+		operationProcessors         int
+		logLevel                    string
+		glogLevel                   int
+		metricsPort                 int
+		kubectlParallelismLimit     int64
+		comparisonSchedulerConfig   controller.ComparisonSchedulerConfig
+		comparisonBackoffSeconds    int
+		comparisonBackoffMaxSeconds int
+		diffParallelism             int
+		cacheSrc                    func() (*appstatecache.Cache, error)
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -76,6 +81,9 @@ func newCommand() *cobra.Command {
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
+			clusterSharding, err := sharding.GetSharding()
+			errors.CheckError(err)
+
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 			kubectl := kube.KubectlCmd{}
 			appController, err := controller.NewApplicationController(
@@ -89,10 +97,18 @@ func newCommand() *cobra.Command {
 				resyncDuration,
 				time.Duration(selfHealTimeoutSeconds)*time.Second,
 				metricsPort,
-				kubectlParallelismLimit)
+				kubectlParallelismLimit,
+				clusterSharding,
+				comparisonSchedulerConfig,
+				controller.ComparisonBackoffConfig{
+					InitialDelay: time.Duration(comparisonBackoffSeconds) * time.Second,
+					MaxDelay:     time.Duration(comparisonBackoffMaxSeconds) * time.Second,
+				},
+				diffParallelism,
+				nil)
 			errors.CheckError(err)
 
-			log.Infof("Application Controller (version: %s) starting (namespace: %s)", common.GetVersion(), namespace)
+			log.Infof("Application Controller (version: %s) starting (namespace: %s, shard: %d of %d)", common.GetVersion(), namespace, clusterSharding.Shard, clusterSharding.Replicas)
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
@@ -115,6 +131,13 @@ func newCommand() *cobra.Command {
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDMetrics, "Start metrics server on given port")
 	command.Flags().IntVar(&selfHealTimeoutSeconds, "self-heal-timeout-seconds", 5, "Specifies timeout between application self heal attempts")
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", 20, "Number of allowed concurrent kubectl fork/execs. Any value less the 1 means no limit.")
+	command.Flags().IntVar(&comparisonSchedulerConfig.HeavyAppResources, "heavy-app-resources", 500, "Number of resources from which the comparison of an application is considered heavy")
+	command.Flags().IntVar(&comparisonSchedulerConfig.HeavyComparisonLimit, "heavy-comparison-limit", 0, "Number of allowed concurrent comparisons of heavy applications, at least one status processor is reserved for other applications. Any value less than 1 means no limit.")
+	command.Flags().IntVar(&comparisonSchedulerConfig.ClusterComparisonLimit, "cluster-comparison-limit", 0, "Number of allowed concurrent comparisons of applications deployed to the same cluster. Any value less than 1 means no limit.")
+
+	command.Flags().IntVar(&comparisonBackoffSeconds, "comparison-error-backoff-seconds", 10, "Delay in seconds before retrying to generate the manifests of an application after the first failure, which doubles with every consecutive failure. 0 disables the backoff.")
+	command.Flags().IntVar(&comparisonBackoffMaxSeconds, "comparison-error-backoff-max-seconds", 300, "Maximum delay in seconds between the retries to generate the manifests of an application.")
+	command.Flags().IntVar(&diffParallelism, "diff-parallelism", 0, "Number of goroutines which diff the resources of an application. Any value less than 1 means the number of CPUs.")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 	return &command
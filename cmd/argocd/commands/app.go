@@ -46,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/resource/ignore"
 	"github.com/argoproj/argo-cd/util/templates"
+	"github.com/argoproj/argo-cd/util/text"
 	"github.com/argoproj/argo-cd/util/text/label"
 )
 
@@ -802,8 +803,8 @@ func liveObjects(resources []*argoappv1.ResourceDiff) ([]*unstructured.Unstructu
 	return objs, nil
 }
 
-func getLocalObjects(app *argoappv1.Application, local, appLabelKey, kubeVersion string) []*unstructured.Unstructured {
-	manifestStrings := getLocalObjectsString(app, local, appLabelKey, kubeVersion, nil)
+func getLocalObjects(app *argoappv1.Application, local, appLabelKey, trackingMethod, trackingValuePrefix, kubeVersion string) []*unstructured.Unstructured {
+	manifestStrings := getLocalObjectsString(app, local, appLabelKey, trackingMethod, trackingValuePrefix, kubeVersion, nil)
 	objs := make([]*unstructured.Unstructured, len(manifestStrings))
 	for i := range manifestStrings {
 		obj := unstructured.Unstructured{}
@@ -814,11 +815,13 @@ func getLocalObjects(app *argoappv1.Application, local, appLabelKey, kubeVersion
 	return objs
 }
 
-func getLocalObjectsString(app *argoappv1.Application, local, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions) []string {
-	res, err := repository.GenerateManifests(local, &repoapiclient.ManifestRequest{
+func getLocalObjectsString(app *argoappv1.Application, local, appLabelKey, trackingMethod, trackingValuePrefix, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions) []string {
+	res, err := repository.GenerateManifests(local, "", &repoapiclient.ManifestRequest{
 		ApplicationSource: &app.Spec.Source,
 		AppLabelKey:       appLabelKey,
-		AppLabelValue:     app.Name,
+		AppLabelValue:     kube.TrackingValue{Prefix: trackingValuePrefix}.Format(app.Name),
+		AppName:           app.Name,
+		TrackingMethod:    trackingMethod,
 		Namespace:         app.Spec.Destination.Namespace,
 		KustomizeOptions:  kustomizeOptions,
 		KubeVersion:       kubeVersion,
@@ -838,7 +841,12 @@ func (p *resourceInfoProvider) IsNamespaced(server string, gk schema.GroupKind)
 	return p.namespacedByGk[gk], nil
 }
 
-func groupLocalObjs(localObs []*unstructured.Unstructured, liveObjs []*unstructured.Unstructured, appNamespace string) map[kube.ResourceKey]*unstructured.Unstructured {
+// IsKnownGroupKind returns true for all kinds: if the live object is missing then it does not matter if target is namespaced or not.
+func (p *resourceInfoProvider) IsKnownGroupKind(server string, gk schema.GroupKind) (bool, error) {
+	return true, nil
+}
+
+func groupLocalObjs(localObs []*unstructured.Unstructured, liveObjs []*unstructured.Unstructured, appNamespace string, strategy controller.DeduplicationStrategy) map[kube.ResourceKey]*unstructured.Unstructured {
 	namespacedByGk := make(map[schema.GroupKind]bool)
 	for i := range liveObjs {
 		if liveObjs[i] != nil {
@@ -846,8 +854,7 @@ func groupLocalObjs(localObs []*unstructured.Unstructured, liveObjs []*unstructu
 			namespacedByGk[schema.GroupKind{Group: key.Group, Kind: key.Kind}] = key.Namespace != ""
 		}
 	}
-	localObs, _, err := controller.DeduplicateTargetObjects("", appNamespace, localObs, &resourceInfoProvider{namespacedByGk: namespacedByGk})
-	errors.CheckError(err)
+	localObs, _, _ = controller.DeduplicateTargetObjects("", appNamespace, localObs, &resourceInfoProvider{namespacedByGk: namespacedByGk}, strategy)
 	objByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
 	for i := range localObs {
 		obj := localObs[i]
@@ -903,7 +910,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Server: app.Spec.Destination.Server})
 				errors.CheckError(err)
 				util.Close(conn)
-				localObjs := groupLocalObjs(getLocalObjects(app, local, argoSettings.AppLabelKey, cluster.ServerVersion), liveObjs, app.Spec.Destination.Namespace)
+				localObjs := groupLocalObjs(getLocalObjects(app, local, argoSettings.AppLabelKey, argoSettings.TrackingMethod, argoSettings.TrackingValuePrefix, cluster.ServerVersion), liveObjs, app.Spec.Destination.Namespace, controller.GetDeduplicationStrategy(app))
 				for _, res := range resources.Items {
 					var live = &unstructured.Unstructured{}
 					err := json.Unmarshal([]byte(res.LiveState), &live)
@@ -925,7 +932,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					}
 					if local, ok := localObjs[key]; ok || live != nil {
 						if local != nil && !kube.IsCRD(local) {
-							err = kube.SetAppInstanceLabel(local, argoSettings.AppLabelKey, appName)
+							err = kube.SetAppInstance(local, kube.TrackingMethod(argoSettings.TrackingMethod), argoSettings.AppLabelKey, kube.TrackingValue{Prefix: argoSettings.TrackingValuePrefix}.Format(appName))
 							errors.CheckError(err)
 						}
 
@@ -1189,6 +1196,9 @@ func parseSelectedResources(resources []string) []argoappv1.SyncOperationResourc
 				Kind:  fields[1],
 				Name:  fields[2],
 			}
+			if parts := strings.SplitN(rsrc.Name, "/", 2); len(parts) == 2 {
+				rsrc.Namespace, rsrc.Name = parts[0], parts[1]
+			}
 			selectedResources = append(selectedResources, rsrc)
 		}
 	}
@@ -1267,17 +1277,22 @@ func printAppResources(w io.Writer, app *argoappv1.Application) {
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		revision  string
-		resources []string
-		labels    []string
-		selector  string
-		prune     bool
-		dryRun    bool
-		timeout   uint
-		strategy  string
-		force     bool
-		async     bool
-		local     string
+		revision                string
+		resources               []string
+		labels                  []string
+		selector                string
+		prune                   bool
+		dryRun                  bool
+		timeout                 uint
+		strategy                string
+		force                   bool
+		async                   bool
+		includeHooks            bool
+		local                   string
+		retryLimit              int64
+		retryBackoffDuration    string
+		retryBackoffMaxDuration string
+		retryBackoffFactor      int64
 	)
 	var command = &cobra.Command{
 		Use:   "sync [APPNAME... | -l selector]",
@@ -1294,7 +1309,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   # Sync a specific resource
   # Resource should be formatted as GROUP:KIND:NAME. If no GROUP is specified then :KIND:NAME
   argocd app sync my-app --resource :Service:my-service
-  argocd app sync my-app --resource argoproj.io:Rollout:my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-rollout
+
+  # Sync all deployments whose name starts with "frontend-" in the namespace "web"
+  argocd app sync my-app --resource apps:Deployment:web/frontend-*`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 && selector == "" {
 				c.HelpFunc()(c, args)
@@ -1375,16 +1393,17 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Server: app.Spec.Destination.Server})
 					errors.CheckError(err)
 					util.Close(conn)
-					localObjsStrings = getLocalObjectsString(app, local, cluster.ServerVersion, argoSettings.AppLabelKey, argoSettings.KustomizeOptions)
+					localObjsStrings = getLocalObjectsString(app, local, argoSettings.AppLabelKey, argoSettings.TrackingMethod, argoSettings.TrackingValuePrefix, cluster.ServerVersion, argoSettings.KustomizeOptions)
 				}
 
 				syncReq := applicationpkg.ApplicationSyncRequest{
-					Name:      &appName,
-					DryRun:    dryRun,
-					Revision:  revision,
-					Resources: selectedResources,
-					Prune:     prune,
-					Manifests: localObjsStrings,
+					Name:         &appName,
+					DryRun:       dryRun,
+					Revision:     revision,
+					Resources:    selectedResources,
+					Prune:        prune,
+					Manifests:    localObjsStrings,
+					IncludeHooks: includeHooks,
 				}
 				switch strategy {
 				case "apply":
@@ -1396,6 +1415,16 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				default:
 					log.Fatalf("Unknown sync strategy: '%s'", strategy)
 				}
+				if retryLimit != 0 {
+					syncReq.RetryStrategy = &argoappv1.RetryStrategy{
+						Limit: retryLimit,
+						Backoff: &argoappv1.Backoff{
+							Duration:    retryBackoffDuration,
+							MaxDuration: retryBackoffMaxDuration,
+							Factor:      &retryBackoffFactor,
+						},
+					}
+				}
 				ctx := context.Background()
 				_, err := appIf.Sync(ctx, &syncReq)
 				errors.CheckError(err)
@@ -1419,10 +1448,11 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			}
 		},
 	}
-	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview the sync using a server-side dry-run without affecting cluster")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
-	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%s[NAMESPACE/]NAME. Fields may be blank and NAME may be a glob pattern. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
+	command.Flags().BoolVar(&includeHooks, "include-hooks", false, "Run all hooks of the application when syncing specific resources. Otherwise only selected hooks are run")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Sync apps that match this label")
 	command.Flags().StringArrayVar(&labels, "label", []string{}, fmt.Sprintf("Sync only specific resources with a label. This option may be specified repeatedly."))
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
@@ -1430,6 +1460,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
+	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries (a negative value means unlimited)")
+	command.Flags().StringVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration.String(), "Retry backoff base duration. Default unit is seconds, but could also be a duration (e.g. 2m, 1h)")
+	command.Flags().StringVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration.String(), "Max retry backoff duration. Default unit is seconds, but could also be a duration (e.g. 2m, 1h)")
+	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
 	return command
 }
 
@@ -1520,7 +1554,7 @@ func getResourceStates(app *argoappv1.Application, selectedResources []argoappv1
 	if len(selectedResources) > 0 {
 		for i := len(states) - 1; i >= 0; i-- {
 			res := states[i]
-			if !argo.ContainsSyncResource(res.Name, schema.GroupVersionKind{Group: res.Group, Kind: res.Kind}, selectedResources) {
+			if !argo.ContainsSyncResource(res.Name, res.Namespace, schema.GroupVersionKind{Group: res.Group, Kind: res.Kind}, selectedResources) {
 				states = append(states[:i], states[i+1:]...)
 			}
 		}
@@ -1741,17 +1775,50 @@ func printApplicationHistoryIds(revHistory []argoappv1.RevisionHistory) {
 // Print a history table for an application.
 func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+	_, _ = fmt.Fprintf(w, "ID\tDATE\tDURATION\tINITIATED BY\tREVISION\tDETAILS\n")
 	for _, depInfo := range revHistory {
 		rev := depInfo.Source.TargetRevision
 		if len(depInfo.Revision) >= 7 {
 			rev = fmt.Sprintf("%s (%s)", rev, depInfo.Revision[0:7])
 		}
-		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev)
+		// deployments recorded by older versions have neither a start time nor an initiator
+		duration := ""
+		if depInfo.DeployStartedAt != nil {
+			duration = depInfo.DeployedAt.Sub(depInfo.DeployStartedAt.Time).Round(time.Second).String()
+		}
+		initiator := depInfo.InitiatedBy.Username
+		if depInfo.InitiatedBy.Automated {
+			initiator = "automated"
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, duration, initiator, rev, formatRevisionMetadata(depInfo.RevisionMetadata))
 	}
 	_ = w.Flush()
 }
 
+// formatRevisionMetadata summarizes the metadata of a deployed revision in a single line: the commit message, author and
+// tags of Git revisions, or the version and description of Helm charts
+func formatRevisionMetadata(metadata *argoappv1.ResolvedRevisionMetadata) string {
+	if metadata == nil {
+		return ""
+	}
+	var parts []string
+	if metadata.ChartVersion != "" {
+		parts = append(parts, fmt.Sprintf("%s %s", metadata.ChartName, metadata.ChartVersion))
+	}
+	if metadata.Message != "" {
+		parts = append(parts, metadata.Message)
+	} else if metadata.ChartDescription != "" {
+		parts = append(parts, text.Trunc(metadata.ChartDescription, 64))
+	}
+	if metadata.Author != "" {
+		parts = append(parts, fmt.Sprintf("by %s", metadata.Author))
+	}
+	if len(metadata.Tags) > 0 {
+		parts = append(parts, fmt.Sprintf("[%s]", strings.Join(metadata.Tags, ", ")))
+	}
+	return strings.Join(parts, " ")
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
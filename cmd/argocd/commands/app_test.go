@@ -35,3 +35,17 @@ func Test_setHelmOpt(t *testing.T) {
 		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "foo", Value: "bar", ForceString: true}}, src.Helm.Parameters)
 	})
 }
+
+func Test_formatRevisionMetadata(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		assert.Equal(t, "", formatRevisionMetadata(nil))
+	})
+	t.Run("Git", func(t *testing.T) {
+		metadata := &v1alpha1.ResolvedRevisionMetadata{Author: "joe", Message: "fix probes", Tags: []string{"v1.0.0", "stable"}}
+		assert.Equal(t, "fix probes by joe [v1.0.0, stable]", formatRevisionMetadata(metadata))
+	})
+	t.Run("Helm", func(t *testing.T) {
+		metadata := &v1alpha1.ResolvedRevisionMetadata{ChartName: "redis", ChartVersion: "3.6.5", ChartDescription: "Open source key-value store"}
+		assert.Equal(t, "redis 3.6.5 Open source key-value store", formatRevisionMetadata(metadata))
+	})
+}
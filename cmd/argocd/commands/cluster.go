@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
@@ -23,6 +24,7 @@ import (
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/clusterauth"
+	"github.com/argoproj/argo-cd/util/kube"
 )
 
 // NewClusterCommand returns a new instance of an `argocd cluster` command
@@ -52,6 +54,7 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 		awsRoleArn      string
 		awsClusterName  string
 		systemNamespace string
+		namespaces      []string
 	)
 	var command = &cobra.Command{
 		Use:   "add",
@@ -97,6 +100,10 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if inCluster {
 				clst.Server = common.KubernetesInternalAPIServerAddr
 			}
+			clst.Namespaces = namespaces
+			if !inCluster {
+				warnMissingPermissions(clst)
+			}
 			clstCreateReq := clusterpkg.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  upsert,
@@ -112,9 +119,27 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	command.Flags().StringVar(&awsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws-iam-authenticator will be used to access cluster")
 	command.Flags().StringVar(&awsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringVar(&systemNamespace, "system-namespace", common.DefaultSystemNamespace, "Use different system namespace")
+	command.Flags().StringArrayVar(&namespaces, "namespace", []string{}, "List of namespaces which are allowed to manage. Argo CD watches the whole cluster if omitted")
 	return command
 }
 
+// clusterPreflightTimeout is the maximum duration of the permissions check of an added cluster
+const clusterPreflightTimeout = 30 * time.Second
+
+// warnMissingPermissions warns about the permissions required by Argo CD which are not granted to the cluster credentials
+func warnMissingPermissions(clst *argoappv1.Cluster) {
+	ctx, cancel := context.WithTimeout(context.Background(), clusterPreflightTimeout)
+	defer cancel()
+	report, err := kube.TestConfigWithContext(ctx, clst.RESTConfig(), &kube.PreflightOptions{Namespaces: clst.Namespaces})
+	if err != nil {
+		log.Warnf("Failed to verify permissions of the cluster credentials: %v", err)
+		return
+	}
+	for _, missing := range report.Missing {
+		log.Warnf("Argo CD might fail to manage the cluster: %s", missing)
+	}
+}
+
 func printKubeContexts(ca clientcmd.ConfigAccess) {
 	config, err := ca.GetStartingConfig()
 	errors.CheckError(err)
@@ -94,6 +94,9 @@ const (
 	LabelKeyAppInstance = "app.kubernetes.io/instance"
 	// LegacyLabelApplicationName is the legacy label (v0.10 and below) and is superceded by 'app.kubernetes.io/instance'
 	LabelKeyLegacyApplicationName = "applications.argoproj.io/app-name"
+	// LabelKeyHookRunID identifies the sync operation which created a hook, so the instances of previous operations are
+	// never mistaken for the instance of the current operation
+	LabelKeyHookRunID = "argocd.argoproj.io/hook-run-id"
 	// LabelKeySecretType contains the type of argocd secret (currently: 'cluster')
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
@@ -105,6 +108,15 @@ const (
 	AnnotationSyncOptions = "argocd.argoproj.io/sync-options"
 	// AnnotationSyncWave indicates which wave of the sync the resource or hook should be in
 	AnnotationSyncWave = "argocd.argoproj.io/sync-wave"
+	// AnnotationIgnoreHealthCheck excludes the resource from the application health and from sync wave health gating when set to "true"
+	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
+	// AnnotationHealthOptions is a comma-separated list of options for the health assessment of a resource
+	AnnotationHealthOptions = "argocd.argoproj.io/health-options"
+	// AnnotationDefaultNamespace is the namespace of a namespaced resource which has no namespace in its manifest, instead of the destination namespace of the application
+	AnnotationDefaultNamespace = "argocd.argoproj.io/default-namespace"
+	// AnnotationManifestOrigin is an internal annotation which records the origin of a target object among the generated
+	// manifests. It is removed from the target objects before they are compared or applied.
+	AnnotationManifestOrigin = "argocd.argoproj.io/manifest-origin"
 	// AnnotationKeyHook contains the hook type of a resource
 	AnnotationKeyHook = "argocd.argoproj.io/hook"
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
@@ -112,6 +124,19 @@ const (
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh = "argocd.argoproj.io/refresh"
+	// AnnotationKeyRefreshInterval is the annotation key which holds the interval between the comparisons of an application
+	// with the target state, e.g. `10m`. Overrides the application resync period of the controller.
+	AnnotationKeyRefreshInterval = "argocd.argoproj.io/refresh-interval"
+	// AnnotationKeyReconcile is the annotation key which pauses the reconciliation of an application if it has the value 'paused'.
+	// Paused applications are neither compared nor synced and keep their last known status.
+	AnnotationKeyReconcile = "argocd.argoproj.io/reconcile"
+	// AnnotationValueReconcilePaused is the value of the reconcile annotation which pauses the reconciliation of an application
+	AnnotationValueReconcilePaused = "paused"
+	// AnnotationKeyReconcilePausedBy is the annotation key which optionally records who paused the reconciliation of an application
+	AnnotationKeyReconcilePausedBy = "argocd.argoproj.io/reconcile-paused-by"
+	// AnnotationKeyAppInstance is the annotation key which identifies the application of a resource when resources are
+	// tracked by annotation. The Argo CD application name is used as the value
+	AnnotationKeyAppInstance = "argocd.argoproj.io/tracking-id"
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
@@ -135,6 +160,30 @@ const (
 	EnvVarTLSDataPath = "ARGOCD_TLS_DATA_PATH"
 	// Specifies number of git remote operations attempts count
 	EnvGitAttemptsCount = "ARGOCD_GIT_ATTEMPTS_COUNT"
+	// Specifies the number of generated manifest sets cached by the application controller, 0 disables the cache
+	EnvControllerManifestCacheSize = "ARGOCD_CONTROLLER_MANIFEST_CACHE_SIZE"
+	// Specifies the number of application controller replicas which share the managed clusters
+	EnvControllerReplicas = "ARGOCD_CONTROLLER_REPLICAS"
+	// Specifies the ordinal of the application controller replica, defaults to the ordinal suffix of the hostname
+	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
+)
+
+// Environment variables available to config management plugins
+const (
+	// EnvAppName is the name of the application
+	EnvAppName = "ARGOCD_APP_NAME"
+	// EnvAppNamespace is the destination namespace of the application
+	EnvAppNamespace = "ARGOCD_APP_NAMESPACE"
+	// EnvAppSourceRepoURL is the repository URL of the application source
+	EnvAppSourceRepoURL = "ARGOCD_APP_SOURCE_REPO_URL"
+	// EnvAppSourceTargetRevision is the target revision of the application source
+	EnvAppSourceTargetRevision = "ARGOCD_APP_SOURCE_TARGET_REVISION"
+	// EnvAppRevision is the resolved revision the manifests are generated from
+	EnvAppRevision = "ARGOCD_APP_REVISION"
+	// EnvKubeVersion is the Kubernetes version of the destination cluster, e.g. 1.14.0
+	EnvKubeVersion = "KUBE_VERSION"
+	// EnvKubeAPIVersions is the comma-separated list of group/versions served by the destination cluster
+	EnvKubeAPIVersions = "KUBE_API_VERSIONS"
 )
 
 const (
@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	statecache "github.com/argoproj/argo-cd/controller/cache"
 	"github.com/argoproj/argo-cd/controller/metrics"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -46,8 +47,12 @@ import (
 
 const (
 	updateOperationStateTimeout = 1 * time.Second
+	// eventAggregationWindow is the period in which identical application events are aggregated, so flapping apps don't flood etcd
+	eventAggregationWindow = 10 * time.Minute
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// operationProgressInterval is the minimum interval between two patches of the progress of a running operation
+	operationProgressInterval = 5 * time.Second
 )
 
 type CompareWith int
@@ -89,6 +94,21 @@ type ApplicationController struct {
 	refreshRequestedAppsMutex *sync.Mutex
 	metricsServer             *metrics.MetricsServer
 	kubectlSemaphore          *semaphore.Weighted
+	clusterSharding           *sharding.Sharding
+	comparisonScheduler       *comparisonScheduler
+	// driftGraceDeadlines holds the time at which the drift grace period of a resource of an app elapses, the app is
+	// refreshed once the deadline has passed
+	driftGraceDeadlines      map[string]time.Time
+	driftGraceDeadlinesMutex *sync.Mutex
+	// operationProgressPatchedAt holds the time at which the progress of the running operation of an app was last
+	// patched, it is used to throttle the progress updates
+	operationProgressPatchedAt map[string]time.Time
+	operationProgressInterval  time.Duration
+	operationProgressLock      sync.Mutex
+	// inflightOperations holds the cancel functions of the running operations by app name, an operation is cancelled
+	// if its app is deleted
+	inflightOperations     map[string]context.CancelFunc
+	inflightOperationsLock sync.Mutex
 }
 
 type ApplicationControllerConfig struct {
@@ -109,25 +129,36 @@ func NewApplicationController(
 	selfHealTimeout time.Duration,
 	metricsPort int,
 	kubectlParallelismLimit int64,
+	clusterSharding *sharding.Sharding,
+	comparisonSchedulerConfig ComparisonSchedulerConfig,
+	comparisonBackoffConfig ComparisonBackoffConfig,
+	diffParallelism int,
+	tracer Tracer,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v", appResyncPeriod)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
 	ctrl := ApplicationController{
-		cache:                     argoCache,
-		namespace:                 namespace,
-		kubeClientset:             kubeClientset,
-		kubectl:                   kubectl,
-		applicationClientset:      applicationClientset,
-		repoClientset:             repoClientset,
-		appRefreshQueue:           workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		appOperationQueue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
-		db:                        db,
-		statusRefreshTimeout:      appResyncPeriod,
-		refreshRequestedApps:      make(map[string]CompareWith),
-		refreshRequestedAppsMutex: &sync.Mutex{},
-		auditLogger:               argo.NewAuditLogger(namespace, kubeClientset, "argocd-application-controller"),
-		settingsMgr:               settingsMgr,
-		selfHealTimeout:           selfHealTimeout,
+		cache:                      argoCache,
+		namespace:                  namespace,
+		kubeClientset:              kubeClientset,
+		kubectl:                    kubectl,
+		applicationClientset:       applicationClientset,
+		repoClientset:              repoClientset,
+		appRefreshQueue:            workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		appOperationQueue:          workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		db:                         db,
+		statusRefreshTimeout:       appResyncPeriod,
+		refreshRequestedApps:       make(map[string]CompareWith),
+		refreshRequestedAppsMutex:  &sync.Mutex{},
+		driftGraceDeadlines:        make(map[string]time.Time),
+		driftGraceDeadlinesMutex:   &sync.Mutex{},
+		auditLogger:                argo.NewAggregatingAuditLogger(namespace, kubeClientset, "argocd-application-controller", eventAggregationWindow),
+		settingsMgr:                settingsMgr,
+		selfHealTimeout:            selfHealTimeout,
+		clusterSharding:            clusterSharding,
+		operationProgressPatchedAt: make(map[string]time.Time),
+		operationProgressInterval:  operationProgressInterval,
+		inflightOperations:         make(map[string]context.CancelFunc),
 	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
@@ -139,17 +170,20 @@ func NewApplicationController(
 	}
 	projInformer := v1alpha1.NewAppProjectInformer(applicationClientset, namespace, appResyncPeriod, cache.Indexers{})
 	metricsAddr := fmt.Sprintf("0.0.0.0:%d", metricsPort)
-	ctrl.metricsServer = metrics.NewMetricsServer(metricsAddr, appLister, func() error {
+	ctrl.metricsServer = metrics.NewMetricsServer(metricsAddr, appLister, clusterSharding, func() error {
 		_, err := kubeClientset.Discovery().ServerVersion()
 		return err
 	})
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated)
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer)
+	ctrl.comparisonScheduler = newComparisonScheduler(comparisonSchedulerConfig, ctrl.metricsServer, ctrl.getAppServer)
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, clusterSharding, ctrl.handleObjectUpdated, ctrl.handleClusterConnectionStateUpdated)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, comparisonBackoffConfig, diffParallelism, tracer)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
 	ctrl.appStateManager = appStateManager
 	ctrl.stateCache = stateCache
+	// apps which destination only has a name are owned by the replica which owns the named cluster
+	clusterSharding.SetServerResolver(appStateManager.ResolveDestinationServer)
 
 	return &ctrl, nil
 }
@@ -223,12 +257,29 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 	}
 }
 
+// handleClusterConnectionStateUpdated publishes the connection state of a cluster, so that the API server doesn't have
+// to connect to the cluster to report it
+func (ctrl *ApplicationController) handleClusterConnectionStateUpdated(server string, state appv1.ConnectionState) {
+	if err := ctrl.cache.SetClusterConnectionState(server, &state); err != nil {
+		log.Warnf("Failed to publish connection state of cluster %s: %v", server, err)
+	}
+}
+
 func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application, comparisonResult *comparisonResult) (*appv1.ApplicationTree, error) {
 	managedResources, err := ctrl.managedResources(comparisonResult)
 	if err != nil {
 		return nil, err
 	}
-	tree, err := ctrl.getResourceTree(a, managedResources)
+	if comparisonResult.syncStatus != nil {
+		a = withComparedDestination(a, comparisonResult.syncStatus.ComparedTo.Destination)
+	}
+	var tree *appv1.ApplicationTree
+	if comparisonResult.resourceNodes != nil {
+		// nodes collected during the comparison keep the tree consistent with the resources statuses
+		tree, err = ctrl.buildResourceTree(a, managedResources, comparisonResult.resourceNodes)
+	} else {
+		tree, err = ctrl.getResourceTree(a, managedResources)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -251,25 +302,18 @@ func isKnownOrphanedResourceExclusion(key kube.ResourceKey) bool {
 }
 
 func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managedResources []*appv1.ResourceDiff) (*appv1.ApplicationTree, error) {
-	nodes := make([]appv1.ResourceNode, 0)
-
-	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	nodes, err := ctrl.getManagedResourcesNodes(a, managedResources)
 	if err != nil {
 		return nil, err
 	}
-	orphanedNodesMap := make(map[kube.ResourceKey]appv1.ResourceNode)
-	warnOrphaned := true
-	if proj.Spec.OrphanedResources != nil {
-		orphanedNodesMap, err = ctrl.stateCache.GetNamespaceTopLevelResources(a.Spec.Destination.Server, a.Spec.Destination.Namespace)
-		if err != nil {
-			return nil, err
-		}
-		warnOrphaned = proj.Spec.OrphanedResources.IsWarn()
-	}
+	return ctrl.buildResourceTree(a, managedResources, nodes)
+}
 
+// getManagedResourcesNodes returns nodes of the given managed resources and all their children
+func (ctrl *ApplicationController) getManagedResourcesNodes(a *appv1.Application, managedResources []*appv1.ResourceDiff) ([]appv1.ResourceNode, error) {
+	nodes := make([]appv1.ResourceNode, 0)
 	for i := range managedResources {
 		managedResource := managedResources[i]
-		delete(orphanedNodesMap, kube.NewResourceKey(managedResource.Group, managedResource.Kind, managedResource.Namespace, managedResource.Name))
 		var live = &unstructured.Unstructured{}
 		err := json.Unmarshal([]byte(managedResource.LiveState), &live)
 		if err != nil {
@@ -300,6 +344,29 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 			}
 		}
 	}
+	return nodes, nil
+}
+
+// buildResourceTree builds the application tree from the nodes of the managed resources and the orphaned resources of the application namespace
+func (ctrl *ApplicationController) buildResourceTree(a *appv1.Application, managedResources []*appv1.ResourceDiff, nodes []appv1.ResourceNode) (*appv1.ApplicationTree, error) {
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	if err != nil {
+		return nil, err
+	}
+	orphanedNodesMap := make(map[kube.ResourceKey]appv1.ResourceNode)
+	warnOrphaned := true
+	if isOrphanedResourcesMonitoringEnabled(a, proj) {
+		orphanedNodesMap, err = ctrl.stateCache.GetNamespaceTopLevelResources(a.Spec.Destination.Server, a.Spec.Destination.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		warnOrphaned = proj.Spec.OrphanedResources.IsWarn()
+	}
+
+	for i := range managedResources {
+		managedResource := managedResources[i]
+		delete(orphanedNodesMap, kube.NewResourceKey(managedResource.Group, managedResource.Kind, managedResource.Namespace, managedResource.Name))
+	}
 	orphanedNodes := make([]appv1.ResourceNode, 0)
 	for k := range orphanedNodesMap {
 		if k.Namespace != "" && proj.IsResourcePermitted(metav1.GroupKind{Group: k.Group, Kind: k.Kind}, true) && !isKnownOrphanedResourceExclusion(k) {
@@ -331,6 +398,9 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 }
 
 func (ctrl *ApplicationController) managedResources(comparisonResult *comparisonResult) ([]*appv1.ResourceDiff, error) {
+	// a failure to load the settings doesn't disable the redaction
+	redactionDisabled, _ := ctrl.settingsMgr.GetSecretRedactionDisabled()
+	redactSecrets := !redactionDisabled
 	items := make([]*appv1.ResourceDiff, len(comparisonResult.managedResources))
 	for i := range comparisonResult.managedResources {
 		res := comparisonResult.managedResources[i]
@@ -342,18 +412,16 @@ func (ctrl *ApplicationController) managedResources(comparisonResult *comparison
 			Hook:      res.Hook,
 		}
 
-		target := res.Target
-		live := res.Live
-		resDiff := res.Diff
-		if res.Kind == kube.SecretKind && res.Group == "" {
+		// the secrets are redacted again before they leave the controller, so that results which were not redacted by
+		// the comparison are never exposed
+		target, live, resDiff := res.Target, res.Live, res.Diff
+		if redactSecrets && res.Kind == kube.SecretKind && res.Group == "" {
 			var err error
-			target, live, err = diff.HideSecretData(res.Target, res.Live)
+			target, _, live, resDiff, err = redactSecretData(res.Target, res.Predicted, res.Live, comparisonResult.diffNormalizer)
 			if err != nil {
 				return nil, err
 			}
-			resDiff = *diff.Diff(target, live, comparisonResult.diffNormalizer)
 		}
-
 		if live != nil {
 			data, err := json.Marshal(live)
 			if err != nil {
@@ -398,18 +466,21 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
+	go ctrl.appStateManager.WatchSettings(ctx)
+	go ctrl.appStateManager.WatchClusters(ctx)
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
+	ctrl.comparisonScheduler.reserveLightWorkers(statusProcessors)
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
-			for ctrl.processAppRefreshQueueItem() {
+			for ctrl.processAppRefreshQueueItem(ctx) {
 			}
 		}, time.Second, ctx.Done())
 	}
 
 	for i := 0; i < operationProcessors; i++ {
 		go wait.Until(func() {
-			for ctrl.processAppOperationQueueItem() {
+			for ctrl.processAppOperationQueueItem(ctx) {
 			}
 		}, time.Second, ctx.Done())
 	}
@@ -433,7 +504,29 @@ func (ctrl *ApplicationController) isRefreshRequested(appName string) (bool, Com
 	return ok, level
 }
 
-func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext bool) {
+// setDriftGraceDeadline records the drift grace deadline of the given app, a zero deadline clears it
+func (ctrl *ApplicationController) setDriftGraceDeadline(appName string, deadline time.Time) {
+	ctrl.driftGraceDeadlinesMutex.Lock()
+	defer ctrl.driftGraceDeadlinesMutex.Unlock()
+	if deadline.IsZero() {
+		delete(ctrl.driftGraceDeadlines, appName)
+	} else {
+		ctrl.driftGraceDeadlines[appName] = deadline
+	}
+}
+
+func (ctrl *ApplicationController) isDriftGraceElapsed(appName string) bool {
+	ctrl.driftGraceDeadlinesMutex.Lock()
+	defer ctrl.driftGraceDeadlinesMutex.Unlock()
+	deadline, ok := ctrl.driftGraceDeadlines[appName]
+	if !ok || time.Now().Before(deadline) {
+		return false
+	}
+	delete(ctrl.driftGraceDeadlines, appName)
+	return true
+}
+
+func (ctrl *ApplicationController) processAppOperationQueueItem(ctx context.Context) (processNext bool) {
 	appKey, shutdown := ctrl.appOperationQueue.Get()
 	if shutdown {
 		processNext = false
@@ -461,8 +554,11 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if !ctrl.clusterSharding.IsAppOwned(app) {
+		return
+	}
 	if app.Operation != nil {
-		ctrl.processRequestedAppOperation(app)
+		ctrl.processRequestedAppOperation(ctx, app)
 	} else if app.DeletionTimestamp != nil && app.CascadedDeletion() {
 		err = ctrl.finalizeApplicationDeletion(app)
 		if err != nil {
@@ -492,6 +588,8 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		}
 		return nil
 	}
+	// the resources are deleted from the cluster which the application was last compared with
+	app = withComparedDestination(app, app.Status.Sync.ComparedTo.Destination)
 
 	objsMap, err := ctrl.stateCache.GetManagedLiveObjs(app, []*unstructured.Unstructured{})
 	if err != nil {
@@ -572,7 +670,7 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
-func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
+func (ctrl *ApplicationController) processRequestedAppOperation(ctx context.Context, app *appv1.Application) {
 	logCtx := log.WithField("application", app.Name)
 	var state *appv1.OperationState
 	// Recover from any unexpected panics and automatically set the status to be failed
@@ -604,6 +702,18 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		}
 		app = freshApp
 		state = app.Status.OperationState.DeepCopy()
+		if state.Phase == appv1.OperationRunning && state.NextRetryAt != nil {
+			if retryAfter := time.Until(state.NextRetryAt.Time); retryAfter > 0 {
+				logCtx.Infof("Skipping retrying in-progress operation. Attempting again at: %s", state.NextRetryAt.Format(time.RFC3339))
+				ctrl.requestAppOperation(app, retryAfter)
+				return
+			}
+			// Start the retry from scratch, so that it is performed against freshly generated manifests
+			// and does not inherit the results of the failed attempt.
+			state.NextRetryAt = nil
+			state.SyncResult = nil
+			logCtx.Infof("Retrying failed operation. attempt: %d", state.RetryCount)
+		}
 		logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
 	} else {
 		state = &appv1.OperationState{Phase: appv1.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
@@ -611,24 +721,40 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
 
-	ctrl.appStateManager.SyncAppState(app, state)
+	// a terminated operation must never be retried
+	terminating := state.Phase == appv1.OperationTerminating
+	paused := app.IsReconcilePaused()
+	if paused {
+		// operations of paused applications fail right away instead of waiting for the reconciliation to resume
+		state.Phase = appv1.OperationError
+		state.Message = fmt.Sprintf("Cannot sync: %s", app.ReconcilePausedMessage())
+	} else {
+		opCtx, done := ctrl.startOperation(ctx, app.Name)
+		ctrl.appStateManager.SyncAppState(opCtx, app, state, ctrl.newOperationProgressReporter(app))
+		done()
+	}
 
 	if state.Phase == appv1.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
-		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(app.ObjectMeta.Name, metav1.GetOptions{})
-		if err == nil {
-			if freshApp.Status.OperationState != nil && freshApp.Status.OperationState.Phase == appv1.OperationTerminating {
-				state.Phase = appv1.OperationTerminating
-				state.Message = "operation is terminating"
-				// after this, we will get requeued to the workqueue, but next time the
-				// SyncAppState will operate in a Terminating phase, allowing the worker to perform
-				// cleanup (e.g. delete jobs, workflows, etc...)
-			}
+		if ctrl.isOperationTerminationRequested(app.Name) {
+			state.Phase = appv1.OperationTerminating
+			state.Message = "operation is terminating"
+			// after this, we will get requeued to the workqueue, but next time the
+			// SyncAppState will operate in a Terminating phase, allowing the worker to perform
+			// cleanup (e.g. delete jobs, workflows, etc...)
 		}
+	} else if state.Phase == appv1.OperationFailed && !terminating && !paused && !ctrl.isOperationTerminationRequested(app.Name) {
+		// only failed attempts are retried, errors (e.g. an invalid spec or a denied resource) would fail again. The
+		// operation might have been terminated during the failed attempt, in which case the retry would overwrite the
+		// termination with Running.
+		ctrl.scheduleOperationRetry(state)
 	}
 
 	ctrl.setOperationState(app, state)
+	if state.Phase == appv1.OperationRunning && state.NextRetryAt != nil {
+		ctrl.requestAppOperation(app, time.Until(state.NextRetryAt.Time))
+	}
 	if state.Phase.Completed() {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -642,6 +768,119 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 }
 
+// isOperationTerminationRequested returns true if the latest state of the application, which is retrieved from the API
+// server rather than the informer, has a terminating operation. A failure to get the application is not a request.
+func (ctrl *ApplicationController) isOperationTerminationRequested(appName string) bool {
+	freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(appName, metav1.GetOptions{})
+	if err != nil {
+		log.WithField("application", appName).Warnf("Failed to retrieve latest application state: %v", err)
+		return false
+	}
+	return freshApp.Status.OperationState != nil && freshApp.Status.OperationState.Phase == appv1.OperationTerminating
+}
+
+// scheduleOperationRetry moves a failed operation back to the Running phase and schedules the next attempt, if the
+// operation retry strategy allows it.
+func (ctrl *ApplicationController) scheduleOperationRetry(state *appv1.OperationState) {
+	retry := state.Operation.Retry
+	if !retry.IsRetryAllowed(state.RetryCount) {
+		if state.RetryCount > 0 {
+			state.Message = fmt.Sprintf("%s (retried %d times)", state.Message, state.RetryCount)
+		}
+		return
+	}
+	delay, err := retry.NextRetryDelay(state.RetryCount)
+	if err != nil {
+		state.Message = fmt.Sprintf("%s (failed to retry: %v)", state.Message, err)
+		return
+	}
+	state.RetryCount++
+	nextRetryAt := metav1.NewTime(time.Now().Add(delay))
+	state.NextRetryAt = &nextRetryAt
+	state.Phase = appv1.OperationRunning
+	attempt := fmt.Sprintf("%d", state.RetryCount)
+	if retry.Limit > 0 {
+		attempt = fmt.Sprintf("%d/%d", state.RetryCount, retry.Limit)
+	}
+	state.Message = fmt.Sprintf("%s (retrying attempt %s in %s)", state.Message, attempt, delay)
+}
+
+// requestAppOperation schedules processing of the application operation after the given delay
+func (ctrl *ApplicationController) requestAppOperation(app *appv1.Application, after time.Duration) {
+	key, err := cache.MetaNamespaceKeyFunc(app)
+	if err != nil {
+		log.WithField("application", app.Name).Warnf("Fails to requeue application: %v", err)
+		return
+	}
+	ctrl.appOperationQueue.AddAfter(key, after)
+}
+
+// newOperationProgressReporter returns a reporter which patches the progress of the running operation of the app at
+// most once per progress interval and checks the informer for a termination request
+func (ctrl *ApplicationController) newOperationProgressReporter(app *appv1.Application) OperationProgressReporter {
+	logCtx := log.WithField("application", app.Name)
+	return func(progress *appv1.OperationProgress) bool {
+		if !ctrl.isOperationProgressThrottled(app.Name) {
+			// only the progress is patched, so that a concurrent termination request is not overwritten
+			patch, err := json.Marshal(map[string]interface{}{
+				"status": map[string]interface{}{
+					"operationState": map[string]interface{}{
+						"progress": progress,
+					},
+				},
+			})
+			if err == nil {
+				_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Patch(app.Name, types.MergePatchType, patch)
+			}
+			if err != nil {
+				logCtx.Warnf("Failed to patch operation progress: %v", err)
+			} else {
+				ctrl.recordOperationProgressPatch(app.Name, false)
+			}
+		}
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err != nil {
+			return false
+		}
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+		if err != nil || !exists {
+			return false
+		}
+		latest, ok := obj.(*appv1.Application)
+		return ok && latest.Status.OperationState != nil && latest.Status.OperationState.Phase == appv1.OperationTerminating
+	}
+}
+
+// isOperationProgressThrottled returns true if the progress of the running operation of the app was patched less than
+// the progress interval ago
+func (ctrl *ApplicationController) isOperationProgressThrottled(appName string) bool {
+	ctrl.operationProgressLock.Lock()
+	defer ctrl.operationProgressLock.Unlock()
+	patchedAt, ok := ctrl.operationProgressPatchedAt[appName]
+	return ok && time.Since(patchedAt) < ctrl.operationProgressInterval
+}
+
+// recordOperationProgressPatch remembers when the operation state of the app was patched
+func (ctrl *ApplicationController) recordOperationProgressPatch(appName string, completed bool) {
+	ctrl.operationProgressLock.Lock()
+	defer ctrl.operationProgressLock.Unlock()
+	if completed {
+		delete(ctrl.operationProgressPatchedAt, appName)
+	} else {
+		ctrl.operationProgressPatchedAt[appName] = time.Now()
+	}
+}
+
+// isOperationProgressUpdate returns true if the operation states only differ in their progress
+func isOperationProgressUpdate(current, updated *appv1.OperationState) bool {
+	if current == nil {
+		return false
+	}
+	current, updated = current.DeepCopy(), updated.DeepCopy()
+	current.Progress, updated.Progress = nil, nil
+	return reflect.DeepEqual(current, updated)
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	util.RetryUntilSucceed(func() error {
 		if state.Phase == "" {
@@ -666,6 +905,10 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			log.Infof("No operation updates necessary to '%s'. Skipping patch", app.Name)
 			return nil
 		}
+		if state.Phase == appv1.OperationRunning && isOperationProgressUpdate(app.Status.OperationState, state) && ctrl.isOperationProgressThrottled(app.Name) {
+			log.Debugf("Operation progress of '%s' was patched recently. Skipping patch", app.Name)
+			return nil
+		}
 		patchJSON, err := json.Marshal(patch)
 		if err != nil {
 			return err
@@ -680,22 +923,30 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			return err
 		}
 		log.Infof("updated '%s' operation (phase: %s)", app.Name, state.Phase)
+		ctrl.recordOperationProgressPatch(app.Name, state.Phase.Completed())
+		if state.Phase == appv1.OperationRunning && (app.Status.OperationState == nil || app.Status.OperationState.Phase != appv1.OperationRunning) {
+			ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationRunning, Type: v1.EventTypeNormal}, "Sync operation started")
+		}
 		if state.Phase.Completed() {
-			eventInfo := argo.EventInfo{Reason: argo.EventReasonOperationCompleted}
+			eventInfo := argo.EventInfo{}
 			var messages []string
 			if state.Operation.Sync != nil && len(state.Operation.Sync.Resources) > 0 {
 				messages = []string{"Partial sync operation"}
 			} else {
 				messages = []string{"Sync operation"}
 			}
-			if state.SyncResult != nil {
+			if state.SyncResult != nil && len(state.SyncResult.Revisions) > 0 {
+				messages = append(messages, "to", strings.Join(state.SyncResult.Revisions, ", "))
+			} else if state.SyncResult != nil {
 				messages = append(messages, "to", state.SyncResult.Revision)
 			}
 			if state.Phase.Successful() {
 				eventInfo.Type = v1.EventTypeNormal
+				eventInfo.Reason = argo.EventReasonOperationSucceeded
 				messages = append(messages, "succeeded")
 			} else {
 				eventInfo.Type = v1.EventTypeWarning
+				eventInfo.Reason = argo.EventReasonOperationFailed
 				messages = append(messages, "failed:", state.Message)
 			}
 			ctrl.auditLogger.LogAppEvent(app, eventInfo, strings.Join(messages, " "))
@@ -705,16 +956,20 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 	}, "Update application operation state", context.Background(), updateOperationStateTimeout)
 }
 
-func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext bool) {
+func (ctrl *ApplicationController) processAppRefreshQueueItem(ctx context.Context) (processNext bool) {
 	appKey, shutdown := ctrl.appRefreshQueue.Get()
 	if shutdown {
 		processNext = false
 		return
 	}
 	processNext = true
+	var origApp *appv1.Application
 	defer func() {
 		if r := recover(); r != nil {
 			log.Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
+			if origApp != nil {
+				ctrl.setAppReconciliationError(origApp, r)
+			}
 		}
 		ctrl.appRefreshQueue.Done(appKey)
 	}()
@@ -726,18 +981,47 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
+		ctrl.comparisonScheduler.forget(appKey.(string))
+		if _, name, err := cache.SplitMetaNamespaceKey(appKey.(string)); err == nil {
+			ctrl.appStateManager.ForgetComparisonBackoff(name)
+			ctrl.setDriftGraceDeadline(name, time.Time{})
+		}
 		return
 	}
-	origApp, ok := obj.(*appv1.Application)
-	if !ok {
+	origApp, _ = obj.(*appv1.Application)
+	if origApp == nil {
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout)
+	if !ctrl.clusterSharding.IsAppOwned(origApp) {
+		return
+	}
+	if origApp.IsReconcilePaused() {
+		ctrl.setAppReconcilePaused(origApp)
+		return
+	}
+	refreshInterval := ctrl.getAppRefreshInterval(origApp)
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, refreshInterval)
 
 	if !needRefresh {
+		ctrl.metricsServer.IncComparison(origApp, false)
 		return
 	}
+	if comparisonLevel != ComparisonWithNothing {
+		release, ok := ctrl.comparisonScheduler.tryAcquire(appKey.(string), origApp)
+		if !ok {
+			// the refresh is postponed, so the status processor can compare other applications meanwhile
+			ctrl.requestAppRefresh(origApp.Name, comparisonLevel)
+			ctrl.appRefreshQueue.AddAfter(appKey, comparisonPostponeDelay)
+			return
+		}
+		defer release()
+	}
+	if _, ok := origApp.IsRefreshRequested(); ok {
+		// explicitly requested refreshes must neither be served from the comparison cache nor be backed off
+		ctrl.appStateManager.InvalidateComparisonCache(origApp.Name)
+		ctrl.appStateManager.ResetComparisonBackoff(origApp.Name)
+	}
 
 	startTime := time.Now()
 	defer func() {
@@ -754,13 +1038,14 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}()
 
 	app := origApp.DeepCopy()
+	app.Status.SetConditions(nil, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionReconcilePausedWarning: true})
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
 	if comparisonLevel == ComparisonWithNothing {
 		managedResources := make([]*appv1.ResourceDiff, 0)
 		if err := ctrl.cache.GetAppManagedResources(app.Name, &managedResources); err != nil {
 			logCtx.Warnf("Failed to get cached managed resources for tree reconciliation, fallback to full reconciliation")
 		} else {
-			if tree, err := ctrl.getResourceTree(app, managedResources); err != nil {
+			if tree, err := ctrl.getResourceTree(withComparedDestination(app, app.Status.Sync.ComparedTo.Destination), managedResources); err != nil {
 				app.Status.SetConditions(
 					[]appv1.ApplicationCondition{
 						{
@@ -786,6 +1071,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		}
 	}
 
+	// the conditions owned by the reconciliation are reported from scratch, the ones which are still true keep their
+	// LastTransitionTime once the status is persisted
+	app.Status.SetConditions(nil, getReconciliationConditionTypes())
 	hasErrors := ctrl.refreshAppConditions(app)
 	if hasErrors {
 		app.Status.Sync.Status = appv1.SyncStatusCodeUnknown
@@ -799,20 +1087,19 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		localManifests = opState.Operation.Sync.Manifests
 	}
 
-	revision := app.Spec.Source.TargetRevision
-	if comparisonLevel == CompareWithRecent {
-		revision = app.Status.Sync.Revision
+	revisions, sources := getComparedSources(app, comparisonLevel)
+	ctrl.metricsServer.IncComparison(app, true)
+	compareResult := ctrl.appStateManager.CompareAppState(ctx, app, revisions, sources, refreshType == appv1.RefreshTypeHard, localManifests)
+	if compareResult.cancelled {
+		// the result is outdated since the application was deleted or its spec changed, or the controller stops
+		return
 	}
 
-	compareResult := ctrl.appStateManager.CompareAppState(app, revision, app.Spec.Source, refreshType == appv1.RefreshTypeHard, localManifests)
-
 	ctrl.normalizeApplication(origApp, app)
 
-	tree, err := ctrl.setAppManagedResources(app, compareResult)
-	if err != nil {
+	app.Status.Summary = compareResult.summary
+	if _, err := ctrl.setAppManagedResources(app, compareResult); err != nil {
 		logCtx.Errorf("Failed to cache app resources: %v", err)
-	} else {
-		app.Status.Summary = tree.GetSummary()
 	}
 
 	project, err := ctrl.getAppProj(app)
@@ -839,14 +1126,71 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 
 	app.Status.ObservedAt = &compareResult.reconciledAt
 	app.Status.ReconciledAt = &compareResult.reconciledAt
+	nextRefreshAt := metav1.NewTime(compareResult.reconciledAt.Add(refreshInterval))
+	app.Status.NextRefreshAt = &nextRefreshAt
+	if refreshInterval < ctrl.statusRefreshTimeout {
+		// apps are requeued by the informer resync only once per resync period
+		ctrl.appRefreshQueue.AddAfter(appKey, refreshInterval)
+	}
+	// the real sync status of resources with a pending drift is reported once their grace period elapses, the
+	// refresh is due only then so that earlier events don't trigger it. The queue keeps a single delayed item per app.
+	// The deadline of an app without pending drift, e.g. an app which is back in sync, is cleared.
+	ctrl.setDriftGraceDeadline(app.Name, compareResult.driftGraceDeadline)
+	if !compareResult.driftGraceDeadline.IsZero() {
+		ctrl.appRefreshQueue.AddAfter(appKey, time.Until(compareResult.driftGraceDeadline))
+	}
 	app.Status.Sync = *compareResult.syncStatus
 	app.Status.Health = *compareResult.healthStatus
+	app.Status.HealthRollupPolicy = app.Spec.HealthRollupPolicy.Effective()
 	app.Status.Resources = compareResult.resources
+	app.Status.OrphanedResources = compareResult.orphanedResources
 	app.Status.SourceType = compareResult.appSourceType
 	ctrl.persistAppStatus(origApp, &app.Status)
 	return
 }
 
+// setAppReconcilePaused reports that the reconciliation of the application is paused. The last known status is kept, and
+// the time of the condition is the time at which the pause was first observed.
+func (ctrl *ApplicationController) setAppReconcilePaused(app *appv1.Application) {
+	status := app.Status.DeepCopy()
+	status.SetConditions(
+		[]appv1.ApplicationCondition{{Type: appv1.ApplicationConditionReconcilePausedWarning, Message: app.ReconcilePausedMessage()}},
+		map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionReconcilePausedWarning: true},
+	)
+	if reflect.DeepEqual(app.Status.Conditions, status.Conditions) {
+		return
+	}
+	log.WithField("application", app.Name).Info(app.ReconcilePausedMessage())
+	ctrl.persistAppStatus(app, status)
+}
+
+// getAppRefreshInterval returns the interval between the comparisons of the application. The interval configured in the
+// application annotation is limited by the settings. The application resync period is used if no interval is configured.
+func (ctrl *ApplicationController) getAppRefreshInterval(app *appv1.Application) time.Duration {
+	value, ok := app.Annotations[common.AnnotationKeyRefreshInterval]
+	if !ok {
+		return ctrl.statusRefreshTimeout
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		logCtx.Warnf("Invalid refresh interval '%s', falling back to %v", value, ctrl.statusRefreshTimeout)
+		return ctrl.statusRefreshTimeout
+	}
+	minInterval, maxInterval, err := ctrl.settingsMgr.GetAppRefreshIntervalLimits()
+	if err != nil {
+		logCtx.Warnf("Failed to get refresh interval limits: %v", err)
+		return interval
+	}
+	if minInterval > 0 && interval < minInterval {
+		interval = minInterval
+	}
+	if maxInterval > 0 && interval > maxInterval {
+		interval = maxInterval
+	}
+	return interval
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
 // Additionally returns whether full refresh was requested or not.
@@ -867,11 +1211,18 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	} else if requested, level := ctrl.isRefreshRequested(app.Name); requested {
 		compareWith = level
 		reason = fmt.Sprintf("controller refresh requested")
+	} else if ctrl.isDriftGraceElapsed(app.Name) {
+		reason = "drift grace period elapsed"
 	} else if app.Status.Sync.Status == appv1.SyncStatusCodeUnknown && expired {
 		reason = "comparison status unknown"
-	} else if !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
+	} else if rollback := getRollbackSyncResult(app); rollback != nil && (!rollback.Source.Equals(app.Status.Sync.ComparedTo.Source) ||
+		!sourcesEqual(rollback.Sources, app.Status.Sync.ComparedTo.Sources)) {
+		reason = "rollback source differs"
+	} else if rollback == nil && !app.Spec.HasMultipleSources() && !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
 		reason = "spec.source differs"
-	} else if !app.Spec.Destination.Equals(app.Status.Sync.ComparedTo.Destination) {
+	} else if rollback == nil && app.Spec.HasMultipleSources() && !sourcesEqual(app.Spec.Sources, app.Status.Sync.ComparedTo.Sources) {
+		reason = "spec.sources differs"
+	} else if !isComparedToDestination(app.Spec.Destination, app.Status.Sync.ComparedTo.Destination) {
 		reason = "spec.destination differs"
 	}
 	if reason != "" {
@@ -881,6 +1232,29 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	return false, refreshType, compareWith
 }
 
+// setAppReconciliationError persists an unknown error condition for the app which reconciliation panicked, so the
+// failure is visible to the user instead of leaving the app with a stale status
+func (ctrl *ApplicationController) setAppReconciliationError(origApp *appv1.Application, reason interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Failed to persist reconciliation error of application '%s': %+v", origApp.Name, r)
+		}
+	}()
+	app := origApp.DeepCopy()
+	now := metav1.Now()
+	app.Status.SetConditions(
+		[]appv1.ApplicationCondition{{
+			Type:               appv1.ApplicationConditionUnknownError,
+			Message:            fmt.Sprintf("Failed to reconcile application: %v", reason),
+			LastTransitionTime: &now,
+		}},
+		map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionUnknownError: true},
+	)
+	app.Status.Sync.Status = appv1.SyncStatusCodeUnknown
+	app.Status.Health.Status = appv1.HealthStatusUnknown
+	ctrl.persistAppStatus(origApp, &app.Status)
+}
+
 func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application) bool {
 	errorConditions := make([]appv1.ApplicationCondition, 0)
 	proj, err := ctrl.getAppProj(app)
@@ -914,6 +1288,71 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 	return len(errorConditions) > 0
 }
 
+// withComparedDestination returns a copy of the application with the given compared destination if the application
+// references the destination cluster by name only, since the comparisons resolve the name to the server of the cluster
+func withComparedDestination(app *appv1.Application, compared appv1.ApplicationDestination) *appv1.Application {
+	if app.Spec.Destination.Server != "" || app.Spec.Destination.Name == "" {
+		return app
+	}
+	app = app.DeepCopy()
+	app.Spec.Destination = compared
+	return app
+}
+
+// getComparedSources returns the revisions and sources the application is compared with. A rolled back application is
+// compared with the revisions and sources it was rolled back to, otherwise the sources of the spec are compared with
+// their target revisions, or with the recently compared revisions if a comparison with the recent revisions is requested.
+// Applications with multiple sources have a revision per source.
+func getComparedSources(app *appv1.Application, comparisonLevel CompareWith) ([]string, []appv1.ApplicationSource) {
+	rollback := getRollbackSyncResult(app)
+	if !app.Spec.HasMultipleSources() {
+		revision := app.Spec.Source.TargetRevision
+		source := app.Spec.Source
+		if rollback != nil {
+			revision = rollback.Revision
+			source = rollback.Source
+		} else if comparisonLevel == CompareWithRecent && !isLocalManifestsRevision(app.Status.Sync.Revision) {
+			revision = app.Status.Sync.Revision
+		}
+		return []string{revision}, []appv1.ApplicationSource{source}
+	}
+	if rollback != nil && len(rollback.Sources) > 0 && len(rollback.Revisions) == len(rollback.Sources) {
+		return rollback.Revisions, rollback.Sources
+	}
+	sources := app.Spec.Sources
+	revisions := make([]string, len(sources))
+	if comparisonLevel == CompareWithRecent && len(app.Status.Sync.Revisions) == len(sources) {
+		copy(revisions, app.Status.Sync.Revisions)
+	} else {
+		for i := range sources {
+			revisions[i] = sources[i].TargetRevision
+		}
+	}
+	return revisions, sources
+}
+
+// sourcesEqual returns true if both lists hold equal sources in the same order
+func sourcesEqual(a, b []appv1.ApplicationSource) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isComparedToDestination returns true if the application was compared with the given spec destination. The compared
+// destination holds the server which the name of the spec destination was resolved to.
+func isComparedToDestination(spec, comparedTo appv1.ApplicationDestination) bool {
+	if spec.Name != "" && spec.Server == "" {
+		spec.Server = comparedTo.Server
+	}
+	return spec.Equals(comparedTo)
+}
+
 // normalizeApplication normalizes an application.spec and additionally persists updates if it changed
 func (ctrl *ApplicationController) normalizeApplication(orig, app *appv1.Application) {
 	logCtx := log.WithFields(log.Fields{"application": app.Name})
@@ -932,12 +1371,43 @@ func (ctrl *ApplicationController) normalizeApplication(orig, app *appv1.Applica
 	}
 }
 
+// getSyncStatusEventInfo returns the info of the event which is recorded when the application's sync status changes
+func getSyncStatusEventInfo(status appv1.SyncStatusCode) argo.EventInfo {
+	switch status {
+	case appv1.SyncStatusCodeSynced:
+		return argo.EventInfo{Reason: argo.EventReasonSynced, Type: v1.EventTypeNormal}
+	case appv1.SyncStatusCodeOutOfSync:
+		return argo.EventInfo{Reason: argo.EventReasonOutOfSync, Type: v1.EventTypeWarning}
+	}
+	return argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeNormal}
+}
+
+// getAddedConditions returns the conditions whose type is not present in the original conditions
+func getAddedConditions(orig []appv1.ApplicationCondition, updated []appv1.ApplicationCondition) []appv1.ApplicationCondition {
+	origTypes := make(map[appv1.ApplicationConditionType]bool)
+	for _, condition := range orig {
+		origTypes[condition.Type] = true
+	}
+	var added []appv1.ApplicationCondition
+	for _, condition := range updated {
+		if !origTypes[condition.Type] {
+			origTypes[condition.Type] = true
+			added = append(added, condition)
+		}
+	}
+	return added
+}
+
 // persistAppStatus persists updates to application status. If no changes were made, it is a no-op
 func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus) {
 	logCtx := log.WithFields(log.Fields{"application": orig.Name})
+	newStatus.Conditions = preserveConditionTransitionTimes(orig.Status.Conditions, newStatus.Conditions)
 	if orig.Status.Sync.Status != newStatus.Sync.Status {
 		message := fmt.Sprintf("Updated sync status: %s -> %s", orig.Status.Sync.Status, newStatus.Sync.Status)
-		ctrl.auditLogger.LogAppEvent(orig, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeNormal}, message)
+		ctrl.auditLogger.LogAppEvent(orig, getSyncStatusEventInfo(newStatus.Sync.Status), message)
+	}
+	for _, condition := range getAddedConditions(orig.Status.Conditions, newStatus.Conditions) {
+		ctrl.auditLogger.LogAppEvent(orig, argo.EventInfo{Reason: condition.Type, Type: v1.EventTypeWarning}, condition.Message)
 	}
 	if orig.Status.Health.Status != newStatus.Health.Status {
 		message := fmt.Sprintf("Updated health status: %s -> %s", orig.Status.Health.Status, newStatus.Health.Status)
@@ -986,6 +1456,12 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil
 	}
+	// automated sync is suspended until the next manual sync after a rollback, otherwise the rolled back application
+	// would be upgraded again immediately
+	if opState := app.Status.OperationState; opState != nil && opState.Operation.Sync != nil && opState.Operation.Sync.RollbackID != nil && !opState.Operation.Sync.DryRun {
+		logCtx.Infof("Skipping auto-sync: application was rolled back to history id %d", *opState.Operation.Sync.RollbackID)
+		return nil
+	}
 
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
@@ -996,12 +1472,22 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 
 	desiredCommitSHA := syncStatus.Revision
 	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA)
+	if app.Spec.HasMultipleSources() {
+		// the sources of applications with multiple sources are synced to their target revisions, the compared
+		// revisions of all sources are reported
+		desiredCommitSHA = strings.Join(syncStatus.Revisions, ", ")
+		alreadyAttempted, attemptPhase = alreadyAttemptedSyncOfSources(app, syncStatus.Revisions)
+	}
 	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision: desiredCommitSHA,
+			Revision: syncStatus.Revision,
 			Prune:    app.Spec.SyncPolicy.Automated.Prune,
 		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
 	}
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
@@ -1020,9 +1506,10 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 			for _, resource := range resources {
 				if resource.Status != appv1.SyncStatusCodeSynced {
 					op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
-						Kind:  resource.Kind,
-						Group: resource.Group,
-						Name:  resource.Name,
+						Kind:      resource.Kind,
+						Group:     resource.Group,
+						Name:      resource.Name,
+						Namespace: resource.Namespace,
 					})
 				}
 			}
@@ -1051,6 +1538,20 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil
 }
 
+// getRollbackSyncResult returns the result of the most recent sync operation if it successfully rolled the application
+// back to an entry of its revision history, nil otherwise. Until the next sync, the application is compared with the
+// revision and source it was rolled back to instead of its spec.
+func getRollbackSyncResult(app *appv1.Application) *appv1.SyncOperationResult {
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.Operation.Sync.RollbackID == nil || opState.Operation.Sync.DryRun {
+		return nil
+	}
+	if opState.SyncResult == nil || !opState.Phase.Successful() {
+		return nil
+	}
+	return opState.SyncResult
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
 // commitSHA and with the same app source config which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, appv1.OperationPhase) {
@@ -1069,6 +1570,29 @@ func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, appv1
 	return reflect.DeepEqual(app.Spec.Source, app.Status.OperationState.SyncResult.Source), app.Status.OperationState.Phase
 }
 
+// alreadyAttemptedSyncOfSources returns whether or not the most recent sync of an application with multiple sources was
+// performed against the given commitSHAs of its sources and with the sources which are currently set in the app
+func alreadyAttemptedSyncOfSources(app *appv1.Application, commitSHAs []string) (bool, appv1.OperationPhase) {
+	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil || app.Status.OperationState.SyncResult == nil {
+		return false, ""
+	}
+	syncRes := app.Status.OperationState.SyncResult
+	if !reflect.DeepEqual(syncRes.Revisions, commitSHAs) || len(syncRes.Sources) != len(app.Spec.Sources) {
+		return false, ""
+	}
+	// Ignore differences in target revisions, since we already just verified commitSHAs are equal
+	for i := range app.Spec.Sources {
+		specSource := app.Spec.Sources[i].DeepCopy()
+		specSource.TargetRevision = ""
+		syncResSource := syncRes.Sources[i].DeepCopy()
+		syncResSource.TargetRevision = ""
+		if !reflect.DeepEqual(specSource, syncResSource) {
+			return false, ""
+		}
+	}
+	return true, app.Status.OperationState.Phase
+}
+
 func (ctrl *ApplicationController) shouldSelfHeal(app *appv1.Application) (bool, time.Duration) {
 	if app.Status.OperationState == nil {
 		return true, time.Duration(0)
@@ -1095,6 +1619,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
+				if !ctrl.isOwnedApp(obj) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
 					ctrl.appRefreshQueue.Add(key)
@@ -1102,6 +1629,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
+				if !ctrl.isOwnedApp(new) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(new)
 				if err != nil {
 					return
@@ -1113,6 +1643,11 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						log.WithField("application", newApp.Name).Info("Enabled automated sync")
 						ctrl.requestAppRefresh(newApp.Name, CompareWithLatest)
 					}
+					if oldApp.IsReconcilePaused() && !newApp.IsReconcilePaused() {
+						log.WithField("application", newApp.Name).Info("Resumed reconciliation")
+						ctrl.requestAppRefresh(newApp.Name, CompareWithLatest)
+					}
+					ctrl.cancelSupersededComparisons(oldApp, newApp)
 				}
 				ctrl.appRefreshQueue.Add(key)
 				ctrl.appOperationQueue.Add(key)
@@ -1122,6 +1657,10 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				// key function.
 				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 				if err == nil {
+					if _, name, err := cache.SplitMetaNamespaceKey(key); err == nil {
+						ctrl.appStateManager.CancelComparisons(name)
+						ctrl.cancelOperation(name)
+					}
 					ctrl.appRefreshQueue.Add(key)
 				}
 			},
@@ -1147,6 +1686,64 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	return informer, lister, err
 }
 
+// cancelSupersededComparisons cancels the in-flight comparisons of an application which is being deleted or which spec
+// changed, since their results would resurrect the status of a deleted application or be outdated. The application is
+// compared again with the new spec.
+func (ctrl *ApplicationController) cancelSupersededComparisons(oldApp, newApp *appv1.Application) {
+	deleting := oldApp.DeletionTimestamp == nil && newApp.DeletionTimestamp != nil
+	if !deleting && reflect.DeepEqual(oldApp.Spec, newApp.Spec) {
+		return
+	}
+	if ctrl.appStateManager.CancelComparisons(newApp.Name) {
+		log.WithField("application", newApp.Name).Info("Cancelled superseded comparison")
+		if !deleting {
+			ctrl.requestAppRefresh(newApp.Name, CompareWithLatest)
+		}
+	}
+}
+
+// startOperation registers the running operation of the application and returns its context, which is derived from the
+// given context and also cancelled if the application is deleted. The returned function must be called once the
+// operation is done.
+func (ctrl *ApplicationController) startOperation(ctx context.Context, appName string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	ctrl.inflightOperationsLock.Lock()
+	ctrl.inflightOperations[appName] = cancel
+	ctrl.inflightOperationsLock.Unlock()
+	return ctx, func() {
+		ctrl.inflightOperationsLock.Lock()
+		delete(ctrl.inflightOperations, appName)
+		ctrl.inflightOperationsLock.Unlock()
+		cancel()
+	}
+}
+
+// cancelOperation cancels the running operation of the application, if any
+func (ctrl *ApplicationController) cancelOperation(appName string) {
+	ctrl.inflightOperationsLock.Lock()
+	defer ctrl.inflightOperationsLock.Unlock()
+	if cancel, ok := ctrl.inflightOperations[appName]; ok {
+		log.WithField("application", appName).Info("Cancelled operation of deleted application")
+		cancel()
+		delete(ctrl.inflightOperations, appName)
+	}
+}
+
+// getAppServer returns the server of the destination cluster of the given application. Destination names which fail
+// to resolve are left unresolved, the comparison of the application reports the failure.
+func (ctrl *ApplicationController) getAppServer(app *appv1.Application) string {
+	if server, err := ctrl.appStateManager.ResolveDestinationServer(app.Spec.Destination); err == nil {
+		return server
+	}
+	return app.Spec.Destination.Server
+}
+
+// isOwnedApp returns true if the given application is deployed to a cluster processed by the controller shard
+func (ctrl *ApplicationController) isOwnedApp(obj interface{}) bool {
+	app, ok := obj.(*appv1.Application)
+	return ok && ctrl.clusterSharding.IsAppOwned(app)
+}
+
 func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
@@ -2,12 +2,18 @@ package controller
 
 import (
 	"context"
+	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,12 +26,14 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	mockstatecache "github.com/argoproj/argo-cd/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	mockrepoclient "github.com/argoproj/argo-cd/reposerver/apiclient/mocks"
 	mockreposerver "github.com/argoproj/argo-cd/reposerver/mocks"
 	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/util/cache/appstate"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -44,6 +52,82 @@ type fakeData struct {
 	managedLiveObjs     map[kube.ResourceKey]*unstructured.Unstructured
 	namespacedResources map[kube.ResourceKey]namespacedResource
 	configMapData       map[string]string
+	// clusterModificationCount is the modification count reported by the live state cache for any cluster
+	clusterModificationCount int64
+	// resourcesModificationCount is the modification count reported by the live state cache for any resources
+	resourcesModificationCount int64
+	// comparisonSchedulerConfig holds the comparison concurrency limits of the controller
+	comparisonSchedulerConfig ComparisonSchedulerConfig
+	// manifestStreamUnsupported simulates a repo server which doesn't implement GenerateManifestStream
+	manifestStreamUnsupported bool
+	// apiVersions holds the group/versions served by the destination cluster
+	apiVersions []string
+	// unknownGroupKinds holds the group/kinds which are not registered in the destination cluster
+	unknownGroupKinds []schema.GroupKind
+	// clusterScopedGroupKinds holds the group/kinds which are cluster-scoped in the destination cluster
+	clusterScopedGroupKinds []schema.GroupKind
+	// failedGroupVersions holds the errors of the group/versions of the destination cluster which could not be discovered
+	failedGroupVersions map[schema.GroupVersion]string
+	// managedLiveObjsErr is returned by the live state cache instead of the managed live objects
+	managedLiveObjsErr error
+	// clusterConnectionState is the connection state reported by the live state cache for any cluster
+	clusterConnectionState *argoappv1.ConnectionState
+	// openAPISchema is the OpenAPI schema reported by the live state cache for any cluster
+	openAPISchema *kube.OpenAPISchema
+	// comparisonBackoffConfig configures the backoff of comparisons which failed to generate manifests
+	comparisonBackoffConfig ComparisonBackoffConfig
+	// manifestGenerationHook is called with the context of every manifest stream request before it is served
+	manifestGenerationHook func(ctx context.Context)
+	// clusterSecrets holds the secrets of the clusters which are configured in addition to the fake cluster
+	clusterSecrets []runtime.Object
+	// kubectl replaces the default mock kubectl of the controller
+	kubectl *kubetest.MockKubectlCmd
+	// manifestResponses holds the manifest responses of the source repositories by URL, the manifestResponse is returned
+	// for any other repository
+	manifestResponses map[string]*apiclient.ManifestResponse
+}
+
+// fakeManifestStream streams the manifests of a manifest response in batches of the given size
+type fakeManifestStream struct {
+	grpc.ClientStream
+	chunks []*apiclient.ManifestResponseChunk
+	// err is returned once all chunks are received, instead of io.EOF
+	err     error
+	trailer metadata.MD
+}
+
+func newFakeManifestStream(res *apiclient.ManifestResponse, batchSize int) *fakeManifestStream {
+	stream := &fakeManifestStream{}
+	if res == nil {
+		return stream
+	}
+	for i := 0; i < len(res.Manifests); i += batchSize {
+		end := i + batchSize
+		if end > len(res.Manifests) {
+			end = len(res.Manifests)
+		}
+		stream.chunks = append(stream.chunks, &apiclient.ManifestResponseChunk{Manifests: res.Manifests[i:end]})
+	}
+	metadata := *res
+	metadata.Manifests = nil
+	stream.chunks = append(stream.chunks, &apiclient.ManifestResponseChunk{Metadata: &metadata})
+	return stream
+}
+
+func (s *fakeManifestStream) Recv() (*apiclient.ManifestResponseChunk, error) {
+	if len(s.chunks) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *fakeManifestStream) Trailer() metadata.MD {
+	return s.trailer
 }
 
 func newFakeController(data *fakeData) *ApplicationController {
@@ -56,6 +140,22 @@ func newFakeController(data *fakeData) *ApplicationController {
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
 	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, nil)
+	if data.manifestStreamUnsupported {
+		mockRepoClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unimplemented, "unknown method GenerateManifestStream"))
+	} else {
+		mockRepoClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(
+			func(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) apiclient.RepoServerService_GenerateManifestStreamClient {
+				if data.manifestGenerationHook != nil {
+					data.manifestGenerationHook(ctx)
+				}
+				if res, ok := data.manifestResponses[in.ApplicationSource.RepoURL]; ok {
+					return newFakeManifestStream(res, 100)
+				}
+				return newFakeManifestStream(data.manifestResponse, 100)
+			}, func(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) error {
+				return ctx.Err()
+			})
+	}
 	mockRepoClientset := mockreposerver.Clientset{}
 	mockRepoClientset.On("NewRepoServerClient").Return(&fakeCloser{}, &mockRepoClient, nil)
 
@@ -79,9 +179,12 @@ func newFakeController(data *fakeData) *ApplicationController {
 		},
 		Data: data.configMapData,
 	}
-	kubeClient := fake.NewSimpleClientset(&clust, &cm, &secret)
+	kubeClient := fake.NewSimpleClientset(append([]runtime.Object{&clust, &cm, &secret}, data.clusterSecrets...)...)
 	settingsMgr := settings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace)
-	kubectl := &kubetest.MockKubectlCmd{}
+	kubectl := data.kubectl
+	if kubectl == nil {
+		kubectl = &kubetest.MockKubectlCmd{}
+	}
 	ctrl, err := NewApplicationController(
 		test.FakeArgoCDNamespace,
 		settingsMgr,
@@ -97,6 +200,11 @@ func newFakeController(data *fakeData) *ApplicationController {
 		time.Minute,
 		common.DefaultPortArgoCDMetrics,
 		0,
+		nil,
+		data.comparisonSchedulerConfig,
+		data.comparisonBackoffConfig,
+		0,
+		nil,
 	)
 	if err != nil {
 		panic(err)
@@ -108,21 +216,73 @@ func newFakeController(data *fakeData) *ApplicationController {
 	mockStateCache := mockstatecache.LiveStateCache{}
 	ctrl.appStateManager.(*appStateManager).liveStateCache = &mockStateCache
 	ctrl.stateCache = &mockStateCache
-	mockStateCache.On("IsNamespaced", mock.Anything, mock.Anything).Return(true, nil)
-	mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything).Return(data.managedLiveObjs, nil)
+	mockStateCache.On("IsNamespaced", mock.Anything, mock.Anything).Return(func(server string, gk schema.GroupKind) bool {
+		for _, clusterScoped := range data.clusterScopedGroupKinds {
+			if clusterScoped == gk {
+				return false
+			}
+		}
+		return true
+	}, nil)
+	// the comparison removes the matched objects from the returned map, so every call returns a copy
+	mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything).Return(func(_ *argoappv1.Application, _ []*unstructured.Unstructured) map[kube.ResourceKey]*unstructured.Unstructured {
+		if data.managedLiveObjs == nil {
+			return nil
+		}
+		liveObjs := make(map[kube.ResourceKey]*unstructured.Unstructured, len(data.managedLiveObjs))
+		for key, obj := range data.managedLiveObjs {
+			liveObjs[key] = obj
+		}
+		return liveObjs
+	}, data.managedLiveObjsErr)
+	clusterConnectionState := argoappv1.ConnectionState{Status: argoappv1.ConnectionStatusSuccessful}
+	if data.clusterConnectionState != nil {
+		clusterConnectionState = *data.clusterConnectionState
+	}
+	mockStateCache.On("GetClusterConnectionState", mock.Anything).Return(clusterConnectionState)
+	mockStateCache.On("GetOpenAPISchema", mock.Anything).Return(data.openAPISchema, nil)
+	mockStateCache.On("GetServerVersion", mock.Anything).Return("v1.14.0", nil)
+	mockStateCache.On("GetAPIVersions", mock.Anything).Return(data.apiVersions, nil)
+	mockStateCache.On("IsKnownGroupKind", mock.Anything, mock.Anything).Return(func(server string, gk schema.GroupKind) bool {
+		for _, unknown := range data.unknownGroupKinds {
+			if unknown == gk {
+				return false
+			}
+		}
+		return true
+	}, nil)
+	mockStateCache.On("RefreshAPIResources", mock.Anything).Return(nil)
+	mockStateCache.On("GetFailedGroupVersions", mock.Anything).Return(data.failedGroupVersions, nil)
+	mockStateCache.On("GetClusterModificationCount", mock.Anything).Return(func(server string) int64 {
+		return data.clusterModificationCount
+	}, nil)
+	mockStateCache.On("GetResourcesModificationCount", mock.Anything, mock.Anything, mock.Anything).Return(func(_ string, _ string, _ []kube.ResourceKey) int64 {
+		return data.resourcesModificationCount
+	}, nil)
 	response := make(map[kube.ResourceKey]argoappv1.ResourceNode)
 	for k, v := range data.namespacedResources {
 		response[k] = v.ResourceNode
 	}
 	mockStateCache.On("GetNamespaceTopLevelResources", mock.Anything, mock.Anything).Return(response, nil)
+	mockStateCache.On("GetNamespaceUnmanagedResources", mock.Anything, mock.Anything).Return(func(_ string, namespace string) map[kube.ResourceKey]argoappv1.ResourceNode {
+		unmanaged := make(map[kube.ResourceKey]argoappv1.ResourceNode)
+		for k, v := range data.namespacedResources {
+			if k.Namespace == namespace && v.AppName == "" {
+				unmanaged[k] = v.ResourceNode
+			}
+		}
+		return unmanaged
+	}, nil)
 	mockStateCache.On("IterateHierarchy", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		key := args[1].(kube.ResourceKey)
 		action := args[2].(func(child argoappv1.ResourceNode, appName string))
 		appName := ""
+		var parentRefs []argoappv1.ResourceRef
 		if res, ok := data.namespacedResources[key]; ok {
 			appName = res.AppName
+			parentRefs = res.ParentRefs
 		}
-		action(argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Group: key.Group, Namespace: key.Namespace, Name: key.Name}}, appName)
+		action(argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Group: key.Group, Namespace: key.Namespace, Name: key.Name}, ParentRefs: parentRefs}, appName)
 	}).Return(nil)
 	return ctrl
 }
@@ -149,6 +309,21 @@ metadata:
 type: Opaque
 `
 
+func newFakeClusterSecret(name, server string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-" + name,
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{
+			"name":   []byte(name),
+			"server": []byte(server),
+			"config": []byte(`{"bearerToken":"fake","tlsClientConfig":{"insecure":true}}`),
+		},
+	}
+}
+
 var fakeApp = `
 apiVersion: argoproj.io/v1alpha1
 kind: Application
@@ -213,6 +388,7 @@ func TestAutoSync(t *testing.T) {
 	assert.NotNil(t, app.Operation)
 	assert.NotNil(t, app.Operation.Sync)
 	assert.False(t, app.Operation.Sync.Prune)
+	assert.Equal(t, argoappv1.OperationInitiator{Automated: true}, app.Operation.InitiatedBy)
 }
 
 func TestSkipAutoSync(t *testing.T) {
@@ -307,6 +483,31 @@ func TestSkipAutoSync(t *testing.T) {
 	}
 }
 
+func TestSkipAutoSyncAfterRollback(t *testing.T) {
+	app := newFakeApp()
+	rollbackID := int64(1)
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync: &argoappv1.SyncOperation{RollbackID: &rollbackID},
+		},
+		Phase: argoappv1.OperationSucceeded,
+		SyncResult: &argoappv1.SyncOperationResult{
+			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Source:   *app.Spec.Source.DeepCopy(),
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
 // TestAutoSyncIndicateError verifies we skip auto-sync and return error condition if previous sync failed
 func TestAutoSyncIndicateError(t *testing.T) {
 	app := newFakeApp()
@@ -457,7 +658,7 @@ func TestNormalizeApplication(t *testing.T) {
 			}
 			return true, nil, nil
 		})
-		ctrl.processAppRefreshQueueItem()
+		ctrl.processAppRefreshQueueItem(context.Background())
 		assert.True(t, normalized)
 	}
 
@@ -479,11 +680,239 @@ func TestNormalizeApplication(t *testing.T) {
 			}
 			return true, nil, nil
 		})
-		ctrl.processAppRefreshQueueItem()
+		ctrl.processAppRefreshQueueItem(context.Background())
 		assert.False(t, normalized)
 	}
 }
 
+func TestProcessAppRefreshPausedApp(t *testing.T) {
+	newPausedApp := func() *argoappv1.Application {
+		app := newFakeApp()
+		app.Annotations = map[string]string{
+			common.AnnotationKeyReconcile:         common.AnnotationValueReconcilePaused,
+			common.AnnotationKeyReconcilePausedBy: "alice",
+		}
+		return app
+	}
+	processPausedApp := func(app *argoappv1.Application) ([]string, *ApplicationController) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+		key, _ := cache.MetaNamespaceKeyFunc(app)
+		ctrl.appRefreshQueue.Add(key)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		var patches []string
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+			return true, nil, nil
+		})
+		ctrl.processAppRefreshQueueItem(context.Background())
+		return patches, ctrl
+	}
+
+	// the pause is reported without comparing the application
+	app := newPausedApp()
+	patches, ctrl := processPausedApp(app)
+	if assert.Len(t, patches, 1) {
+		assert.Contains(t, patches[0], argoappv1.ApplicationConditionReconcilePausedWarning)
+		assert.Contains(t, patches[0], "Reconciliation is paused by the argocd.argoproj.io/reconcile annotation, which was set by alice")
+	}
+	_, ok := ctrl.appStateManager.(*appStateManager).comparisonResults[app.Name]
+	assert.False(t, ok)
+
+	// the status is not patched again once the pause was reported
+	app = newPausedApp()
+	app.Status.SetConditions([]argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionReconcilePausedWarning, Message: app.ReconcilePausedMessage()}}, nil)
+	patches, _ = processPausedApp(app)
+	assert.Empty(t, patches)
+}
+
+func TestProcessRequestedAppOperationPausedApp(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationKeyReconcile: common.AnnotationValueReconcilePaused}
+	app.Operation = &argoappv1.Operation{
+		Sync:  &argoappv1.SyncOperation{},
+		Retry: argoappv1.RetryStrategy{Limit: 5},
+	}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patches []string
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+		return true, nil, nil
+	})
+
+	// the operation fails right away and is not retried
+	ctrl.processRequestedAppOperation(context.Background(), app)
+	if assert.NotEmpty(t, patches) {
+		lastPatch := patches[len(patches)-1]
+		assert.Contains(t, lastPatch, `"phase":"Error"`)
+		assert.Contains(t, lastPatch, "Cannot sync: Reconciliation is paused by the argocd.argoproj.io/reconcile annotation")
+		assert.NotContains(t, lastPatch, "retrying")
+	}
+}
+
+// syncStubStateManager ends the sync operations with the given phase
+type syncStubStateManager struct {
+	AppStateManager
+	phase  argoappv1.OperationPhase
+	onSync func()
+}
+
+func (m *syncStubStateManager) SyncAppState(_ context.Context, _ *argoappv1.Application, state *argoappv1.OperationState, _ OperationProgressReporter) {
+	if m.onSync != nil {
+		m.onSync()
+	}
+	state.Phase = m.phase
+	state.Message = "one or more objects failed to apply"
+}
+
+func TestProcessRequestedAppOperationRetry(t *testing.T) {
+	processApp := func(phase argoappv1.OperationPhase, terminate bool) string {
+		app := newFakeApp()
+		app.Operation = &argoappv1.Operation{
+			Sync:  &argoappv1.SyncOperation{},
+			Retry: argoappv1.RetryStrategy{Limit: 5},
+		}
+		app.Status.OperationState = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		var patches []string
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+			return true, nil, nil
+		})
+		terminated := false
+		fakeAppCs.PrependReactor("get", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if !terminated {
+				return false, nil, nil
+			}
+			latest := app.DeepCopy()
+			latest.Status.OperationState = &argoappv1.OperationState{Operation: *app.Operation, Phase: argoappv1.OperationTerminating}
+			return true, latest, nil
+		})
+		ctrl.appStateManager = &syncStubStateManager{AppStateManager: ctrl.appStateManager, phase: phase, onSync: func() {
+			// the user terminates the operation while the attempt is running
+			terminated = terminate
+		}}
+
+		ctrl.processRequestedAppOperation(context.Background(), app)
+		if !assert.NotEmpty(t, patches) {
+			return ""
+		}
+		return patches[len(patches)-1]
+	}
+
+	t.Run("Failed", func(t *testing.T) {
+		lastPatch := processApp(argoappv1.OperationFailed, false)
+		assert.Contains(t, lastPatch, `"phase":"Running"`)
+		assert.Contains(t, lastPatch, "retrying attempt 1/5")
+	})
+	t.Run("TerminatedDuringFailedAttempt", func(t *testing.T) {
+		lastPatch := processApp(argoappv1.OperationFailed, true)
+		assert.Contains(t, lastPatch, `"phase":"Failed"`)
+		assert.NotContains(t, lastPatch, "retrying")
+	})
+	t.Run("Error", func(t *testing.T) {
+		lastPatch := processApp(argoappv1.OperationError, false)
+		assert.Contains(t, lastPatch, `"phase":"Error"`)
+		assert.NotContains(t, lastPatch, "retrying")
+	})
+}
+
+func TestCancelComparisonOfDeletedApp(t *testing.T) {
+	app := newFakeApp()
+	started := make(chan bool)
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		manifestGenerationHook: func(ctx context.Context) {
+			// the manifest generation is stuck until the comparison is cancelled
+			close(started)
+			<-ctx.Done()
+		},
+	}
+	ctrl := newFakeController(&data)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.Add(key)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patches int32
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		atomic.AddInt32(&patches, 1)
+		return true, nil, nil
+	})
+
+	done := make(chan bool)
+	go func() {
+		ctrl.processAppRefreshQueueItem(context.Background())
+		close(done)
+	}()
+	<-started
+
+	deletedApp := app.DeepCopy()
+	now := metav1.Now()
+	deletedApp.DeletionTimestamp = &now
+	ctrl.cancelSupersededComparisons(app, deletedApp)
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("comparison was not cancelled")
+	}
+
+	// neither the status nor the history of the application is patched
+	assert.Equal(t, int32(0), atomic.LoadInt32(&patches))
+	_, ok := ctrl.appStateManager.(*appStateManager).comparisonResults[app.Name]
+	assert.False(t, ok)
+}
+
+func TestCancelSupersededComparisons(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	appStateManager := ctrl.appStateManager.(*appStateManager)
+
+	// comparisons are not cancelled by changes of the status
+	ctx, done := appStateManager.startComparison(context.Background(), app.Name)
+	defer done()
+	updatedApp := app.DeepCopy()
+	updatedApp.Status.Sync.Status = argoappv1.SyncStatusCodeSynced
+	ctrl.cancelSupersededComparisons(app, updatedApp)
+	assert.NoError(t, ctx.Err())
+
+	// a spec change cancels the comparison and compares the application again
+	updatedApp.Spec.Source.Path = "other-path"
+	ctrl.cancelSupersededComparisons(app, updatedApp)
+	assert.Error(t, ctx.Err())
+	requested, level := ctrl.isRefreshRequested(app.Name)
+	assert.True(t, requested)
+	assert.Equal(t, CompareWithLatest, level)
+	assert.False(t, appStateManager.CancelComparisons(app.Name))
+}
+
+func TestCancelOperation(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+
+	ctx, done := ctrl.startOperation(context.Background(), app.Name)
+	ctrl.cancelOperation("other-app")
+	assert.NoError(t, ctx.Err())
+	ctrl.cancelOperation(app.Name)
+	assert.Error(t, ctx.Err())
+	done()
+	assert.Len(t, ctrl.inflightOperations, 0)
+
+	// the operation is cancelled if the controller stops
+	parent, cancel := context.WithCancel(context.Background())
+	ctx, done = ctrl.startOperation(parent, app.Name)
+	defer done()
+	cancel()
+	assert.Error(t, ctx.Err())
+}
+
 func TestHandleAppUpdated(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
@@ -501,6 +930,61 @@ func TestHandleAppUpdated(t *testing.T) {
 	assert.Equal(t, CompareWithRecent, level)
 }
 
+func TestSkipAppsOfOtherShards(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	clusterSharding, err := sharding.NewSharding(2, 1-sharding.GetShardByServer(app.Spec.Destination.Server, 2))
+	assert.NoError(t, err)
+	ctrl.clusterSharding = clusterSharding
+	assert.False(t, ctrl.isOwnedApp(app))
+
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.Add(key)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	fakeAppCs.ReactionChain = nil
+	patched := false
+	fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patched = true
+		return true, nil, nil
+	})
+	ctrl.processAppRefreshQueueItem(context.Background())
+	assert.False(t, patched)
+}
+
+func TestAppsWithDestinationNameOwnedByShardOfCluster(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination = argoappv1.ApplicationDestination{Name: "prod", Namespace: test.FakeDestNamespace}
+	ctrl := newFakeController(&fakeData{
+		apps:           []runtime.Object{app, &defaultProj},
+		clusterSecrets: []runtime.Object{newFakeClusterSecret("prod", "https://prod.example.com")},
+	})
+	assert.Equal(t, "https://prod.example.com", ctrl.getAppServer(app))
+
+	shard := sharding.GetShardByServer("https://prod.example.com", 2)
+	for _, tc := range []struct {
+		shard int
+		owned bool
+	}{{shard, true}, {1 - shard, false}} {
+		clusterSharding, err := sharding.NewSharding(2, tc.shard)
+		assert.NoError(t, err)
+		clusterSharding.SetServerResolver(ctrl.appStateManager.ResolveDestinationServer)
+		ctrl.clusterSharding = clusterSharding
+		assert.Equal(t, tc.owned, ctrl.isOwnedApp(app), "shard %d", tc.shard)
+	}
+
+	// the comparisons are limited by the resolved cluster rather than by the apps without a server
+	ctrl.comparisonScheduler = newComparisonScheduler(ComparisonSchedulerConfig{ClusterComparisonLimit: 1}, nil, ctrl.getAppServer)
+	otherApp := newFakeApp()
+	otherApp.Name = "other-app"
+	otherApp.Spec.Destination = argoappv1.ApplicationDestination{Name: "unknown", Namespace: test.FakeDestNamespace}
+	release, ok := ctrl.comparisonScheduler.tryAcquire("argocd/my-app", app)
+	assert.True(t, ok)
+	defer release()
+	assert.Contains(t, ctrl.comparisonScheduler.clusterRunning, "https://prod.example.com")
+	_, ok = ctrl.comparisonScheduler.tryAcquire("argocd/other-app", otherApp)
+	assert.True(t, ok)
+}
+
 func TestHandleOrphanedResourceUpdated(t *testing.T) {
 	app1 := newFakeApp()
 	app1.Name = "app1"
@@ -541,6 +1025,219 @@ func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	assert.True(t, patched)
 }
 
+func TestSetOperationStateThrottlesProgress(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = &argoappv1.OperationState{Phase: argoappv1.OperationRunning, Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.operationProgressInterval = time.Hour
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patches := 0
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patches++
+		return false, nil, nil
+	})
+	newState := func(progress argoappv1.OperationProgress) *argoappv1.OperationState {
+		state := app.Status.OperationState.DeepCopy()
+		state.Progress = &progress
+		return state
+	}
+
+	ctrl.setOperationState(app, newState(argoappv1.OperationProgress{Pending: 2}))
+	assert.Equal(t, 1, patches)
+
+	// progress updates are throttled
+	ctrl.setOperationState(app, newState(argoappv1.OperationProgress{Pending: 1, Running: 1}))
+	assert.Equal(t, 1, patches)
+	ctrl.newOperationProgressReporter(app)(&argoappv1.OperationProgress{Running: 2})
+	assert.Equal(t, 1, patches)
+
+	// other updates are not
+	state := newState(argoappv1.OperationProgress{Running: 2})
+	state.Message = "one or more tasks are running"
+	ctrl.setOperationState(app, state)
+	assert.Equal(t, 2, patches)
+
+	// the final state is always patched and contains the complete results
+	state = newState(argoappv1.OperationProgress{Succeeded: 2})
+	state.Phase = argoappv1.OperationSucceeded
+	state.SyncResult = &argoappv1.SyncOperationResult{Resources: argoappv1.ResourceResults{
+		{Kind: "Pod", Name: "my-pod", Status: argoappv1.ResultCodeSynced},
+		{Kind: "Service", Name: "my-service", Status: argoappv1.ResultCodeSynced},
+	}}
+	ctrl.setOperationState(app, state)
+	assert.Equal(t, 3, patches)
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.OperationSucceeded, updated.Status.OperationState.Phase)
+	assert.Equal(t, int64(2), updated.Status.OperationState.Progress.Succeeded)
+	assert.Len(t, updated.Status.OperationState.SyncResult.Resources, 2)
+}
+
+func TestOperationProgressReporter(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = &argoappv1.OperationState{Phase: argoappv1.OperationRunning, Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	report := ctrl.newOperationProgressReporter(app)
+
+	assert.False(t, report(&argoappv1.OperationProgress{Pending: 1, Running: 1, WaitingOn: "Pod/my-pod"}))
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.OperationRunning, updated.Status.OperationState.Phase)
+	assert.Equal(t, "Pod/my-pod", updated.Status.OperationState.Progress.WaitingOn)
+
+	terminating := app.DeepCopy()
+	terminating.Status.OperationState.Phase = argoappv1.OperationTerminating
+	assert.NoError(t, ctrl.appInformer.GetIndexer().Update(terminating))
+	assert.True(t, report(&argoappv1.OperationProgress{Pending: 1, Running: 1}))
+}
+
+func TestSetAppReconciliationError(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	ctrl.setAppReconciliationError(app, "boom")
+
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, updated.Status.Sync.Status)
+	assert.Equal(t, argoappv1.HealthStatusUnknown, updated.Status.Health.Status)
+	assert.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionUnknownError, updated.Status.Conditions[0].Type)
+	assert.Equal(t, "Failed to reconcile application: boom", updated.Status.Conditions[0].Message)
+}
+
+func TestNeedRefreshAppStatusAfterRollback(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+
+	app := newFakeApp()
+	now := metav1.Now()
+	app.Status.ReconciledAt = &now
+	rollbackSource := app.Spec.Source.DeepCopy()
+	rollbackSource.TargetRevision = "v1.0.0"
+	rollbackID := int64(1)
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync: &argoappv1.SyncOperation{RollbackID: &rollbackID},
+		},
+		Phase:      argoappv1.OperationSucceeded,
+		SyncResult: &argoappv1.SyncOperationResult{Revision: "abc123", Source: *rollbackSource},
+	}
+	app.Status.Sync = argoappv1.SyncStatus{
+		Status: argoappv1.SyncStatusCodeSynced,
+		ComparedTo: argoappv1.ComparedTo{
+			Source:      *rollbackSource,
+			Destination: app.Spec.Destination,
+		},
+	}
+
+	// the rolled back application is compared with the source it was rolled back to rather than its spec
+	needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.False(t, needRefresh)
+
+	app.Status.Sync.ComparedTo.Source = app.Spec.Source
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.True(t, needRefresh)
+}
+
+func TestNeedRefreshAppStatusMultipleSources(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+
+	app := newFakeApp()
+	now := metav1.Now()
+	app.Status.ReconciledAt = &now
+	app.Spec.Sources = []argoappv1.ApplicationSource{
+		{RepoURL: "https://example.com/frontend.git", Path: "manifests"},
+		{RepoURL: "https://example.com/backend.git", Path: "manifests"},
+	}
+	app.Status.Sync = argoappv1.SyncStatus{
+		Status: argoappv1.SyncStatusCodeSynced,
+		ComparedTo: argoappv1.ComparedTo{
+			Sources:     app.Spec.Sources,
+			Destination: app.Spec.Destination,
+		},
+	}
+
+	// the ignored single source does not cause refreshes
+	needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.False(t, needRefresh)
+
+	app.Status.Sync.ComparedTo.Sources = app.Spec.Sources[:1]
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.True(t, needRefresh)
+}
+
+func TestGetComparedSources(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Sources = []argoappv1.ApplicationSource{
+		{RepoURL: "https://example.com/frontend.git", Path: "manifests", TargetRevision: "master"},
+		{RepoURL: "https://example.com/backend.git", Path: "manifests", TargetRevision: "v1.0.0"},
+	}
+	app.Status.OperationState = nil
+	app.Status.Sync.Revisions = []string{"aaa111", "bbb222"}
+
+	revisions, sources := getComparedSources(app, CompareWithLatest)
+	assert.Equal(t, []string{"master", "v1.0.0"}, revisions)
+	assert.Equal(t, app.Spec.Sources, sources)
+
+	revisions, sources = getComparedSources(app, CompareWithRecent)
+	assert.Equal(t, []string{"aaa111", "bbb222"}, revisions)
+	assert.Equal(t, app.Spec.Sources, sources)
+
+	app.Spec.Sources = nil
+	revisions, sources = getComparedSources(app, CompareWithLatest)
+	assert.Equal(t, []string{""}, revisions)
+	assert.Equal(t, []argoappv1.ApplicationSource{app.Spec.Source}, sources)
+}
+
+func TestNeedRefreshAppStatusDriftGraceElapsed(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+
+	app := newFakeApp()
+	now := metav1.Now()
+	app.Status.ReconciledAt = &now
+	app.Status.Sync = argoappv1.SyncStatus{
+		Status: argoappv1.SyncStatusCodeSynced,
+		ComparedTo: argoappv1.ComparedTo{
+			Source:      app.Spec.Source,
+			Destination: app.Spec.Destination,
+		},
+	}
+
+	// events before the deadline don't refresh the app
+	ctrl.setDriftGraceDeadline(app.Name, time.Now().Add(time.Hour))
+	needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.False(t, needRefresh)
+
+	// a later reconciliation replaces the deadline
+	ctrl.setDriftGraceDeadline(app.Name, time.Now().Add(-time.Second))
+	needRefresh, _, compareWith := ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.True(t, needRefresh)
+	assert.Equal(t, CompareWithLatest, compareWith)
+
+	// the elapsed deadline triggers a single refresh
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.False(t, needRefresh)
+
+	// a reconciliation without pending drift clears the deadline
+	ctrl.setDriftGraceDeadline(app.Name, time.Now().Add(-time.Second))
+	ctrl.setDriftGraceDeadline(app.Name, time.Time{})
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.False(t, needRefresh)
+	assert.Empty(t, ctrl.driftGraceDeadlines)
+}
+
+func TestForgetDriftGraceDeadlineOfDeletedApp(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{&defaultProj}})
+	ctrl.setDriftGraceDeadline(app.Name, time.Now().Add(time.Hour))
+
+	// the queue still has an entry of the deleted app
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.Add(key)
+	ctrl.processAppRefreshQueueItem(context.Background())
+	assert.Empty(t, ctrl.driftGraceDeadlines)
+}
+
 func TestNeedRefreshAppStatus(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
 
@@ -603,6 +1300,84 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 	}
 }
 
+func TestNeedRefreshAppStatusDestinationName(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+
+	app := newFakeApp()
+	app.Spec.Destination = argoappv1.ApplicationDestination{Name: "prod", Namespace: test.FakeDestNamespace}
+	now := metav1.Now()
+	app.Status.ReconciledAt = &now
+	app.Status.Sync = argoappv1.SyncStatus{
+		Status: argoappv1.SyncStatusCodeSynced,
+		ComparedTo: argoappv1.ComparedTo{
+			Source:      app.Spec.Source,
+			Destination: argoappv1.ApplicationDestination{Name: "prod", Server: "https://prod.example.com", Namespace: test.FakeDestNamespace},
+		},
+	}
+
+	// the compared destination holds the server which the name was resolved to
+	needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.False(t, needRefresh)
+
+	app.Spec.Destination.Name = "staging"
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, 1*time.Hour)
+	assert.True(t, needRefresh)
+}
+
+func TestGetAppRefreshInterval(t *testing.T) {
+	newApp := func(interval string) *argoappv1.Application {
+		app := newFakeApp()
+		app.Annotations = map[string]string{common.AnnotationKeyRefreshInterval: interval}
+		return app
+	}
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+	assert.Equal(t, ctrl.statusRefreshTimeout, ctrl.getAppRefreshInterval(newFakeApp()))
+	assert.Equal(t, 10*time.Second, ctrl.getAppRefreshInterval(newApp("10s")))
+	assert.Equal(t, ctrl.statusRefreshTimeout, ctrl.getAppRefreshInterval(newApp("invalid")))
+	assert.Equal(t, ctrl.statusRefreshTimeout, ctrl.getAppRefreshInterval(newApp("-1m")))
+
+	ctrl = newFakeController(&fakeData{apps: []runtime.Object{}, configMapData: map[string]string{
+		"application.refreshInterval.min": "30s",
+		"application.refreshInterval.max": "1h",
+	}})
+	assert.Equal(t, 30*time.Second, ctrl.getAppRefreshInterval(newApp("10s")))
+	assert.Equal(t, 5*time.Minute, ctrl.getAppRefreshInterval(newApp("5m")))
+	assert.Equal(t, time.Hour, ctrl.getAppRefreshInterval(newApp("24h")))
+}
+
+func TestNeedRefreshAppStatusRefreshInterval(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationKeyRefreshInterval: "1h"}
+	reconciledAt := metav1.NewTime(time.Now().UTC().Add(-10 * time.Minute))
+	app.Status.ReconciledAt = &reconciledAt
+	app.Status.Sync = argoappv1.SyncStatus{
+		Status: argoappv1.SyncStatusCodeSynced,
+		ComparedTo: argoappv1.ComparedTo{
+			Source:      app.Spec.Source,
+			Destination: app.Spec.Destination,
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	// the resync period has elapsed, but the refresh interval of the app has not
+	assert.Equal(t, time.Minute, ctrl.statusRefreshTimeout)
+	needRefresh, _, _ := ctrl.needRefreshAppStatus(app, ctrl.getAppRefreshInterval(app))
+	assert.False(t, needRefresh)
+
+	app.Annotations[common.AnnotationKeyRefreshInterval] = "5m"
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, ctrl.getAppRefreshInterval(app))
+	assert.True(t, needRefresh)
+
+	// explicit refresh requests bypass the refresh interval
+	app.Annotations = map[string]string{
+		common.AnnotationKeyRefreshInterval: "1h",
+		common.AnnotationKeyRefresh:         string(argoappv1.RefreshTypeNormal),
+	}
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, ctrl.getAppRefreshInterval(app))
+	assert.True(t, needRefresh)
+}
+
 func TestRefreshAppConditions(t *testing.T) {
 	defaultProj := argoappv1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{
@@ -655,3 +1430,200 @@ func TestRefreshAppConditions(t *testing.T) {
 		assert.Equal(t, "Application referencing project wrong project which does not exist", app.Status.Conditions[0].Message)
 	})
 }
+
+func TestPreserveConditionTransitionTimes(t *testing.T) {
+	t1 := metav1.NewTime(time.Now().Add(-3 * time.Hour))
+	t2 := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	t3 := metav1.NewTime(time.Now().Add(-1 * time.Hour))
+	condition := func(message string, at metav1.Time) argoappv1.ApplicationCondition {
+		return argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionComparisonError, Message: message, LastTransitionTime: &at}
+	}
+
+	t.Run("IdenticalCondition", func(t *testing.T) {
+		conditions := preserveConditionTransitionTimes([]argoappv1.ApplicationCondition{condition("a", t1)}, []argoappv1.ApplicationCondition{condition("a", t2)})
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, t1, *conditions[0].LastTransitionTime)
+		}
+	})
+
+	t.Run("FlappingMessage", func(t *testing.T) {
+		// an error which alternates between two messages is reported as a new condition on every change
+		conditions := []argoappv1.ApplicationCondition{condition("a", t1)}
+		for _, next := range []argoappv1.ApplicationCondition{condition("b", t2), condition("a", t3)} {
+			conditions = preserveConditionTransitionTimes(conditions, []argoappv1.ApplicationCondition{next})
+			if assert.Len(t, conditions, 1) {
+				assert.Equal(t, next.Message, conditions[0].Message)
+				assert.Equal(t, *next.LastTransitionTime, *conditions[0].LastTransitionTime)
+			}
+		}
+	})
+
+	t.Run("SameMessageOfOtherType", func(t *testing.T) {
+		previous := condition("a", t1)
+		previous.Type = argoappv1.ApplicationConditionSyncError
+		conditions := preserveConditionTransitionTimes([]argoappv1.ApplicationCondition{previous}, []argoappv1.ApplicationCondition{condition("a", t2)})
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, t2, *conditions[0].LastTransitionTime)
+		}
+	})
+}
+
+func TestProcessAppRefreshClearsStaleConditions(t *testing.T) {
+	since := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	app := newFakeApp()
+	app.Spec.Project = "wrong project"
+	app.Status.Conditions = []argoappv1.ApplicationCondition{
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Application referencing project wrong project which does not exist", LastTransitionTime: &since},
+		{Type: argoappv1.ApplicationConditionComparisonError, Message: "rpc error: repository not accessible", LastTransitionTime: &since},
+		{Type: argoappv1.ApplicationConditionSyncError, Message: "auto-sync failed", LastTransitionTime: &since},
+		{Type: argoappv1.ApplicationConditionExcludedResourceWarning, Message: "excluded", LastTransitionTime: &since},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.Add(key)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patches []string
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+		return true, nil, nil
+	})
+
+	ctrl.processAppRefreshQueueItem(context.Background())
+
+	if !assert.Len(t, patches, 1) {
+		return
+	}
+	var patched argoappv1.Application
+	assert.NoError(t, yaml.Unmarshal([]byte(patches[0]), &patched))
+	// the comparison and the sync were skipped because the spec is invalid, so their conditions are stale
+	if assert.Len(t, patched.Status.Conditions, 2) {
+		assert.Equal(t, argoappv1.ApplicationConditionExcludedResourceWarning, patched.Status.Conditions[0].Type)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, patched.Status.Conditions[1].Type)
+		assert.True(t, since.Equal(patched.Status.Conditions[1].LastTransitionTime))
+	}
+}
+
+func TestScheduleOperationRetry(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
+	factor := int64(2)
+	retry := argoappv1.RetryStrategy{Limit: 5, Backoff: &argoappv1.Backoff{Duration: "10s", Factor: &factor, MaxDuration: "1h"}}
+
+	t.Run("Retry", func(t *testing.T) {
+		state := &argoappv1.OperationState{
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}, Retry: retry},
+			Phase:      argoappv1.OperationFailed,
+			Message:    "one or more objects failed to apply",
+			RetryCount: 2,
+		}
+		ctrl.scheduleOperationRetry(state)
+		assert.Equal(t, argoappv1.OperationRunning, state.Phase)
+		assert.Equal(t, int64(3), state.RetryCount)
+		assert.Equal(t, "one or more objects failed to apply (retrying attempt 3/5 in 40s)", state.Message)
+		if assert.NotNil(t, state.NextRetryAt) {
+			assert.True(t, state.NextRetryAt.Time.After(time.Now()))
+		}
+	})
+	t.Run("LimitExhausted", func(t *testing.T) {
+		state := &argoappv1.OperationState{
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}, Retry: retry},
+			Phase:      argoappv1.OperationFailed,
+			Message:    "one or more objects failed to apply",
+			RetryCount: 5,
+		}
+		ctrl.scheduleOperationRetry(state)
+		assert.Equal(t, argoappv1.OperationFailed, state.Phase)
+		assert.Nil(t, state.NextRetryAt)
+		assert.Equal(t, "one or more objects failed to apply (retried 5 times)", state.Message)
+	})
+	t.Run("NoRetryStrategy", func(t *testing.T) {
+		state := &argoappv1.OperationState{
+			Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+			Phase:     argoappv1.OperationError,
+			Message:   "error",
+		}
+		ctrl.scheduleOperationRetry(state)
+		assert.Equal(t, argoappv1.OperationError, state.Phase)
+		assert.Equal(t, "error", state.Message)
+	})
+}
+
+func TestAutoSyncRetryStrategy(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Retry = &argoappv1.RetryStrategy{Limit: 3}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Equal(t, int64(3), app.Operation.Retry.Limit)
+}
+
+func getAppEventReasons(t *testing.T, ctrl *ApplicationController) map[string]int {
+	list, err := ctrl.kubeClientset.CoreV1().Events(test.FakeArgoCDNamespace).List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	reasons := make(map[string]int)
+	for _, event := range list.Items {
+		reasons[event.Reason] += int(event.Count)
+	}
+	return reasons
+}
+
+func TestAppStatusTransitionEvents(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Sync.Status = argoappv1.SyncStatusCodeSynced
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	warning := argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionSharedResourceWarning, Message: "shared"}
+	reconciles := []argoappv1.ApplicationStatus{
+		{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync}, Conditions: []argoappv1.ApplicationCondition{warning}},
+		{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync}, Conditions: []argoappv1.ApplicationCondition{warning}},
+		{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync}, Conditions: []argoappv1.ApplicationCondition{warning}},
+		{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced}},
+		{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced}},
+	}
+	orig := app.DeepCopy()
+	for i := range reconciles {
+		ctrl.persistAppStatus(orig, &reconciles[i])
+		orig.Status = reconciles[i]
+	}
+	assert.Equal(t, map[string]int{
+		argo.EventReasonOutOfSync:                           1,
+		argo.EventReasonSynced:                              1,
+		argoappv1.ApplicationConditionSharedResourceWarning: 1,
+	}, getAppEventReasons(t, ctrl))
+
+	// flapping between the same statuses increments the count of the existing events
+	for i := 0; i < 3; i++ {
+		ctrl.persistAppStatus(orig, &reconciles[0])
+		ctrl.persistAppStatus(&argoappv1.Application{ObjectMeta: orig.ObjectMeta, Status: reconciles[0]}, &reconciles[3])
+	}
+	list, err := ctrl.kubeClientset.CoreV1().Events(test.FakeArgoCDNamespace).List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 3)
+	assert.Equal(t, 4, getAppEventReasons(t, ctrl)[argo.EventReasonOutOfSync])
+}
+
+func TestOperationPhaseEvents(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	state := &argoappv1.OperationState{Phase: argoappv1.OperationRunning, Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}}
+	ctrl.setOperationState(app, state)
+	app.Status.OperationState = state.DeepCopy()
+	state = state.DeepCopy()
+	state.Message = "retrying"
+	ctrl.setOperationState(app, state)
+	app.Status.OperationState = state.DeepCopy()
+	assert.Equal(t, map[string]int{argo.EventReasonOperationRunning: 1}, getAppEventReasons(t, ctrl))
+
+	state = state.DeepCopy()
+	state.Phase = argoappv1.OperationFailed
+	ctrl.setOperationState(app, state)
+	assert.Equal(t, map[string]int{argo.EventReasonOperationRunning: 1, argo.EventReasonOperationFailed: 1}, getAppEventReasons(t, ctrl))
+}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sync"
 
@@ -13,6 +14,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/controller/metrics"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/db"
@@ -21,9 +23,14 @@ import (
 )
 
 type cacheSettings struct {
-	ResourceOverrides   map[string]appv1.ResourceOverride
-	AppInstanceLabelKey string
-	ResourcesFilter     *settings.ResourcesFilter
+	ResourceOverrides map[string]appv1.ResourceOverride
+	// AppInstanceLabelKeys holds the primary and legacy app instance label keys in order of precedence
+	AppInstanceLabelKeys []string
+	// TrackingMethod is the method by which resources are associated with applications
+	TrackingMethod kube.TrackingMethod
+	// TrackingValue is the format of the value by which resources are associated with applications
+	TrackingValue   kube.TrackingValue
+	ResourcesFilter *settings.ResourcesFilter
 }
 
 type LiveStateCache interface {
@@ -34,6 +41,30 @@ type LiveStateCache interface {
 	GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
 	// Returns all top level resources (resources without owner references) of a specified namespace
 	GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
+	// Returns the top level resources of a specified namespace which are not referenced by any application
+	GetNamespaceUnmanagedResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
+	// Returns the Kubernetes version of the specified cluster, which is retrieved every time the cluster cache is synced
+	GetServerVersion(server string) (string, error)
+	// Returns the sorted group/versions served by the specified cluster, which are retrieved on first use and cached until
+	// the cluster is resynced or a CRD changes
+	GetAPIVersions(server string) ([]string, error)
+	// Returns a number which increases every time a resource of the specified cluster changes or the cluster cache is resynced
+	GetClusterModificationCount(server string) (int64, error)
+	// Returns the modification count of the specified cluster when the specified resources, or any resource which belongs
+	// to the specified app, were last added, updated or removed
+	GetResourcesModificationCount(server string, appName string, keys []kube.ResourceKey) (int64, error)
+	// Returns true if the API of the specified GroupKind is served by the specified cluster
+	IsKnownGroupKind(server string, gk schema.GroupKind) (bool, error)
+	// Returns the OpenAPI schema published by the specified cluster, which is retrieved on first use and cached until the
+	// cluster is resynced or a CRD changes
+	GetOpenAPISchema(server string) (*kube.OpenAPISchema, error)
+	// Discovers the APIs which were added to the specified cluster since it was synced, e.g. by an applied CRD
+	RefreshAPIResources(server string) error
+	// Returns the errors of the group/versions of the specified cluster which could not be discovered, e.g. because the
+	// server of an aggregated APIService is down
+	GetFailedGroupVersions(server string) (map[schema.GroupVersion]string, error)
+	// Returns the result of the last attempt to sync or watch the specified cluster without connecting to the cluster
+	GetClusterConnectionState(server string) appv1.ConnectionState
 	// Starts watching resources of each controlled cluster.
 	Run(ctx context.Context) error
 	// Invalidate invalidates the entire cluster state cache
@@ -42,6 +73,9 @@ type LiveStateCache interface {
 
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference)
 
+// ConnectionStateUpdatedHandler is called when the connection state of a cluster changes
+type ConnectionStateUpdatedHandler = func(server string, state appv1.ConnectionState)
+
 func GetTargetObjKey(a *appv1.Application, un *unstructured.Unstructured, isNamespaced bool) kube.ResourceKey {
 	key := kube.GetResourceKey(un)
 	if !isNamespaced {
@@ -59,36 +93,52 @@ func NewLiveStateCache(
 	settingsMgr *settings.SettingsManager,
 	kubectl kube.Kubectl,
 	metricsServer *metrics.MetricsServer,
-	onObjectUpdated ObjectUpdatedHandler) LiveStateCache {
+	clusterSharding *sharding.Sharding,
+	onObjectUpdated ObjectUpdatedHandler,
+	onConnectionStateUpdated ConnectionStateUpdatedHandler) LiveStateCache {
 
 	return &liveStateCache{
-		appInformer:       appInformer,
-		db:                db,
-		clusters:          make(map[string]*clusterInfo),
-		lock:              &sync.Mutex{},
-		onObjectUpdated:   onObjectUpdated,
-		kubectl:           kubectl,
-		settingsMgr:       settingsMgr,
-		metricsServer:     metricsServer,
-		cacheSettingsLock: &sync.Mutex{},
+		appInformer:              appInformer,
+		db:                       db,
+		clusters:                 make(map[string]*clusterInfo),
+		ownedClusters:            make(map[string]bool),
+		lock:                     &sync.Mutex{},
+		onObjectUpdated:          onObjectUpdated,
+		onConnectionStateUpdated: onConnectionStateUpdated,
+		kubectl:                  kubectl,
+		settingsMgr:              settingsMgr,
+		metricsServer:            metricsServer,
+		clusterSharding:          clusterSharding,
+		cacheSettingsLock:        &sync.Mutex{},
 	}
 }
 
 type liveStateCache struct {
-	db                db.ArgoDB
-	clusters          map[string]*clusterInfo
-	lock              *sync.Mutex
-	appInformer       cache.SharedIndexInformer
-	onObjectUpdated   ObjectUpdatedHandler
-	kubectl           kube.Kubectl
-	settingsMgr       *settings.SettingsManager
-	metricsServer     *metrics.MetricsServer
-	cacheSettingsLock *sync.Mutex
-	cacheSettings     *cacheSettings
+	db                       db.ArgoDB
+	clusters                 map[string]*clusterInfo
+	ownedClusters            map[string]bool
+	lock                     *sync.Mutex
+	appInformer              cache.SharedIndexInformer
+	onObjectUpdated          ObjectUpdatedHandler
+	onConnectionStateUpdated ConnectionStateUpdatedHandler
+	kubectl                  kube.Kubectl
+	settingsMgr              *settings.SettingsManager
+	metricsServer            *metrics.MetricsServer
+	clusterSharding          *sharding.Sharding
+	cacheSettingsLock        *sync.Mutex
+	cacheSettings            *cacheSettings
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
-	appInstanceLabelKey, err := c.settingsMgr.GetAppInstanceLabelKey()
+	appInstanceLabelKeys, err := c.settingsMgr.GetAppInstanceLabelKeys()
+	if err != nil {
+		return nil, err
+	}
+	trackingMethod, err := c.settingsMgr.GetAppResourceTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	trackingValue, err := c.settingsMgr.GetAppResourceTrackingValue()
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +150,7 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &cacheSettings{AppInstanceLabelKey: appInstanceLabelKey, ResourceOverrides: resourceOverrides, ResourcesFilter: resourcesFilter}, nil
+	return &cacheSettings{AppInstanceLabelKeys: appInstanceLabelKeys, TrackingMethod: trackingMethod, TrackingValue: trackingValue, ResourceOverrides: resourceOverrides, ResourcesFilter: resourcesFilter}, nil
 }
 
 func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
@@ -108,29 +158,73 @@ func (c *liveStateCache) getCluster(server string) (*clusterInfo, error) {
 	defer c.lock.Unlock()
 	info, ok := c.clusters[server]
 	if !ok {
+		if !c.clusterSharding.IsClusterOwned(server) {
+			return nil, fmt.Errorf("cluster %s is not processed by controller shard %d", server, c.clusterSharding.GetShard())
+		}
 		cluster, err := c.db.GetCluster(context.Background(), server)
 		if err != nil {
 			return nil, err
 		}
-		info = &clusterInfo{
-			apisMeta:         make(map[schema.GroupKind]*apiMeta),
-			lock:             &sync.Mutex{},
-			nodes:            make(map[kube.ResourceKey]*node),
-			nsIndex:          make(map[string]map[kube.ResourceKey]*node),
-			onObjectUpdated:  c.onObjectUpdated,
-			kubectl:          c.kubectl,
-			cluster:          cluster,
-			syncTime:         nil,
-			syncLock:         &sync.Mutex{},
-			log:              log.WithField("server", cluster.Server),
-			cacheSettingsSrc: c.getCacheSettings,
-		}
-
+		info = c.newClusterInfo(cluster)
 		c.clusters[cluster.Server] = info
 	}
 	return info, nil
 }
 
+func (c *liveStateCache) newClusterInfo(cluster *appv1.Cluster) *clusterInfo {
+	return &clusterInfo{
+		apisMeta:                 make(map[schema.GroupKind]*apiMeta),
+		lock:                     &sync.Mutex{},
+		nodes:                    make(map[kube.ResourceKey]*node),
+		nsIndex:                  make(map[string]map[kube.ResourceKey]*node),
+		onObjectUpdated:          c.onObjectUpdated,
+		kubectl:                  c.kubectl,
+		cluster:                  cluster,
+		syncTime:                 nil,
+		syncLock:                 &sync.Mutex{},
+		log:                      log.WithField("server", cluster.Server),
+		cacheSettingsSrc:         c.getCacheSettings,
+		namespacedLock:           &sync.RWMutex{},
+		connectionLock:           &sync.Mutex{},
+		discoveryLock:            &sync.Mutex{},
+		onConnectionStateUpdated: c.handleConnectionStateUpdated,
+	}
+}
+
+// handleConnectionStateUpdated exports the updated connection state of a cluster
+func (c *liveStateCache) handleConnectionStateUpdated(server string, state appv1.ConnectionState) {
+	if c.metricsServer != nil {
+		c.metricsServer.SetClusterConnectionStatus(server, state.Status)
+	}
+	if c.onConnectionStateUpdated != nil {
+		c.onConnectionStateUpdated(server, state)
+	}
+}
+
+// rebuildCluster replaces the cache of the given cluster with a new one which uses the updated cluster settings.
+// The stale cache is stopped in background so that in-flight comparisons fail fast instead of waiting for it.
+func (c *liveStateCache) rebuildCluster(info *clusterInfo, cluster *appv1.Cluster) {
+	log.Infof("Rebuilding cache of cluster %s since cluster settings have changed", cluster.Server)
+	c.clusters[cluster.Server] = c.newClusterInfo(cluster)
+	go info.stop()
+	if c.metricsServer != nil {
+		c.metricsServer.IncClusterCacheRebuild(cluster.Server)
+	}
+}
+
+// refreshClusterApps requests refresh of all applications deployed to the given cluster
+func (c *liveStateCache) refreshClusterApps(server string) {
+	managedByApp := make(map[string]bool)
+	for _, obj := range c.appInformer.GetStore().List() {
+		if app, ok := obj.(*appv1.Application); ok && app.Spec.Destination.Server == server {
+			managedByApp[app.Name] = true
+		}
+	}
+	if len(managedByApp) > 0 {
+		c.onObjectUpdated(managedByApp, v1.ObjectReference{})
+	}
+}
+
 func (c *liveStateCache) getSyncedCluster(server string) (*clusterInfo, error) {
 	info, err := c.getCluster(server)
 	if err != nil {
@@ -155,11 +249,19 @@ func (c *liveStateCache) Invalidate() {
 	log.Info("live state cache invalidated")
 }
 
+// IsNamespaced returns true if the given GroupKind is namespaced. The scope is memoized per cluster, so most lookups
+// don't have to wait for the cluster sync lock.
 func (c *liveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool, error) {
-	clusterInfo, err := c.getSyncedCluster(server)
+	clusterInfo, err := c.getCluster(server)
 	if err != nil {
 		return false, err
 	}
+	if namespaced, ok := clusterInfo.getMemoizedNamespaced(gk); ok {
+		return namespaced, nil
+	}
+	if err = clusterInfo.ensureSynced(); err != nil {
+		return false, err
+	}
 	return clusterInfo.isNamespaced(gk), nil
 }
 
@@ -180,6 +282,88 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace
 	return clusterInfo.getNamespaceTopLevelResources(namespace), nil
 }
 
+func (c *liveStateCache) GetNamespaceUnmanagedResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getNamespaceUnmanagedResources(namespace), nil
+}
+
+func (c *liveStateCache) GetServerVersion(server string) (string, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return "", err
+	}
+	return clusterInfo.serverVersion, nil
+}
+
+func (c *liveStateCache) GetAPIVersions(server string) ([]string, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getAPIVersions()
+}
+
+func (c *liveStateCache) IsKnownGroupKind(server string, gk schema.GroupKind) (bool, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return false, err
+	}
+	return clusterInfo.isKnownGroupKind(gk), nil
+}
+
+func (c *liveStateCache) GetOpenAPISchema(server string) (*kube.OpenAPISchema, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getOpenAPISchema()
+}
+
+func (c *liveStateCache) RefreshAPIResources(server string) error {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return err
+	}
+	return clusterInfo.refreshAPIResources()
+}
+
+func (c *liveStateCache) GetFailedGroupVersions(server string) (map[schema.GroupVersion]string, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getFailedGroupVersions(), nil
+}
+
+func (c *liveStateCache) GetClusterConnectionState(server string) appv1.ConnectionState {
+	c.lock.Lock()
+	info, ok := c.clusters[server]
+	c.lock.Unlock()
+	if !ok {
+		return appv1.ConnectionState{Status: appv1.ConnectionStatusUnknown, Message: "Cluster has not been synced yet"}
+	}
+	return info.getConnectionState()
+}
+
+func (c *liveStateCache) GetClusterModificationCount(server string) (int64, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return 0, err
+	}
+	return clusterInfo.getModificationCount(), nil
+}
+
+func (c *liveStateCache) GetResourcesModificationCount(server string, appName string, keys []kube.ResourceKey) (int64, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return 0, err
+	}
+	return clusterInfo.getResourcesModificationCount(appName, keys), nil
+}
+
 func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	clusterInfo, err := c.getSyncedCluster(a.Spec.Destination.Server)
 	if err != nil {
@@ -236,6 +420,50 @@ func (c *liveStateCache) watchSettings(ctx context.Context) {
 	close(updateCh)
 }
 
+// handleClusterEvent updates the cluster caches when a cluster is added, modified or removed
+func (c *liveStateCache) handleClusterEvent(event *db.ClusterEvent) {
+	if !c.clusterSharding.IsClusterOwned(event.Cluster.Server) {
+		return
+	}
+	c.lock.Lock()
+	c.updateOwnedClusters(event)
+	rebuilt := false
+	if cluster, ok := c.clusters[event.Cluster.Server]; ok {
+		if event.Type == watch.Deleted {
+			go cluster.stop()
+			delete(c.clusters, event.Cluster.Server)
+			if c.metricsServer != nil {
+				c.metricsServer.DeleteClusterConnectionStatus(event.Cluster.Server)
+			}
+		} else if event.Type == watch.Modified && !reflect.DeepEqual(cluster.cluster, event.Cluster) {
+			c.rebuildCluster(cluster, event.Cluster)
+			rebuilt = true
+		}
+	} else if event.Type == watch.Added && isClusterHasApps(c.appInformer.GetStore().List(), event.Cluster) {
+		go func() {
+			// warm up cache for cluster with apps
+			_, _ = c.getSyncedCluster(event.Cluster.Server)
+		}()
+	}
+	c.lock.Unlock()
+	if rebuilt {
+		// comparisons which failed using stale cluster settings should be retried
+		c.refreshClusterApps(event.Cluster.Server)
+	}
+}
+
+// updateOwnedClusters keeps track of the clusters processed by the controller shard
+func (c *liveStateCache) updateOwnedClusters(event *db.ClusterEvent) {
+	if event.Type == watch.Deleted {
+		delete(c.ownedClusters, event.Cluster.Server)
+	} else {
+		c.ownedClusters[event.Cluster.Server] = true
+	}
+	if c.metricsServer != nil {
+		c.metricsServer.SetShardClusters(len(c.ownedClusters))
+	}
+}
+
 // Run watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
 func (c *liveStateCache) Run(ctx context.Context) error {
 	cacheSettings, err := c.loadCacheSettings()
@@ -247,27 +475,7 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
 
 	util.RetryUntilSucceed(func() error {
-		clusterEventCallback := func(event *db.ClusterEvent) {
-			c.lock.Lock()
-			defer c.lock.Unlock()
-			if cluster, ok := c.clusters[event.Cluster.Server]; ok {
-				if event.Type == watch.Deleted {
-					cluster.invalidate()
-					delete(c.clusters, event.Cluster.Server)
-				} else if event.Type == watch.Modified {
-					cluster.cluster = event.Cluster
-					cluster.invalidate()
-				}
-			} else if event.Type == watch.Added && isClusterHasApps(c.appInformer.GetStore().List(), event.Cluster) {
-				go func() {
-					// warm up cache for cluster with apps
-					_, _ = c.getSyncedCluster(event.Cluster.Server)
-				}()
-			}
-		}
-
-		return c.db.WatchClusters(ctx, clusterEventCallback)
-
+		return c.db.WatchClusters(ctx, c.handleClusterEvent)
 	}, "watch clusters", ctx, clusterRetryTimeout)
 
 	<-ctx.Done()
@@ -0,0 +1,152 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/kube/kubetest"
+)
+
+func newTestLiveStateCache(onObjectUpdated ObjectUpdatedHandler, apps ...*appv1.Application) *liveStateCache {
+	appInformer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &appv1.Application{}, 0, cache.Indexers{})
+	for i := range apps {
+		_ = appInformer.GetStore().Add(apps[i])
+	}
+	return &liveStateCache{
+		appInformer:       appInformer,
+		clusters:          make(map[string]*clusterInfo),
+		ownedClusters:     make(map[string]bool),
+		lock:              &sync.Mutex{},
+		onObjectUpdated:   onObjectUpdated,
+		kubectl:           &kubetest.MockKubectlCmd{},
+		cacheSettingsLock: &sync.Mutex{},
+		cacheSettings:     &cacheSettings{AppInstanceLabelKeys: []string{common.LabelKeyAppInstance}},
+	}
+}
+
+func TestRebuildClusterOnSettingsChange(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://mycluster", Config: appv1.ClusterConfig{BearerToken: "old-token"}}
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "argocd"},
+		Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: cluster.Server}},
+	}
+	var refreshed map[string]bool
+	liveStateCache := newTestLiveStateCache(func(managedByApp map[string]bool, ref corev1.ObjectReference) {
+		refreshed = managedByApp
+	}, app)
+	staleInfo := liveStateCache.newClusterInfo(cluster)
+	liveStateCache.clusters[cluster.Server] = staleInfo
+	assert.Nil(t, staleInfo.ensureSynced())
+
+	// unchanged cluster settings should not cause a rebuild
+	liveStateCache.handleClusterEvent(&db.ClusterEvent{Type: watch.Modified, Cluster: cluster.DeepCopy()})
+	assert.Equal(t, staleInfo, liveStateCache.clusters[cluster.Server])
+	assert.Nil(t, refreshed)
+
+	updated := cluster.DeepCopy()
+	updated.Config.BearerToken = "new-token"
+	liveStateCache.handleClusterEvent(&db.ClusterEvent{Type: watch.Modified, Cluster: updated})
+
+	info := liveStateCache.clusters[cluster.Server]
+	assert.NotEqual(t, staleInfo, info)
+	assert.Equal(t, "new-token", info.cluster.Config.BearerToken)
+	assert.Equal(t, map[string]bool{"my-app": true}, refreshed)
+	assert.Nil(t, info.ensureSynced())
+
+	// stale cache is stopped in background
+	var err error
+	for i := 0; i < 100 && err == nil; i++ {
+		if err = staleInfo.ensureSynced(); err == nil {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	assert.NotNil(t, err)
+}
+
+func TestStopClusterOnDelete(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://mycluster"}
+	liveStateCache := newTestLiveStateCache(func(managedByApp map[string]bool, ref corev1.ObjectReference) {})
+	info := liveStateCache.newClusterInfo(cluster)
+	liveStateCache.clusters[cluster.Server] = info
+
+	liveStateCache.handleClusterEvent(&db.ClusterEvent{Type: watch.Deleted, Cluster: cluster})
+
+	assert.NotContains(t, liveStateCache.clusters, cluster.Server)
+}
+
+func TestIgnoreClustersOfOtherShards(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://mycluster"}
+	liveStateCache := newTestLiveStateCache(func(managedByApp map[string]bool, ref corev1.ObjectReference) {})
+	clusterSharding, err := sharding.NewSharding(2, 1-sharding.GetShardByServer(cluster.Server, 2))
+	assert.NoError(t, err)
+	liveStateCache.clusterSharding = clusterSharding
+
+	liveStateCache.handleClusterEvent(&db.ClusterEvent{Type: watch.Added, Cluster: cluster})
+	assert.Empty(t, liveStateCache.ownedClusters)
+
+	_, err = liveStateCache.getCluster(cluster.Server)
+	assert.Error(t, err)
+	assert.NotContains(t, liveStateCache.clusters, cluster.Server)
+}
+
+func TestTrackOwnedClusters(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://mycluster"}
+	liveStateCache := newTestLiveStateCache(func(managedByApp map[string]bool, ref corev1.ObjectReference) {})
+
+	liveStateCache.handleClusterEvent(&db.ClusterEvent{Type: watch.Added, Cluster: cluster})
+	assert.Equal(t, map[string]bool{cluster.Server: true}, liveStateCache.ownedClusters)
+
+	liveStateCache.handleClusterEvent(&db.ClusterEvent{Type: watch.Deleted, Cluster: cluster})
+	assert.Empty(t, liveStateCache.ownedClusters)
+}
+
+func BenchmarkIsNamespaced(b *testing.B) {
+	liveStateCache := newTestLiveStateCache(func(managedByApp map[string]bool, ref corev1.ObjectReference) {})
+	info := newCluster()
+	liveStateCache.clusters["https://mycluster"] = info
+	if err := info.ensureSynced(); err != nil {
+		b.Fatal(err)
+	}
+	gk := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+
+	// simulate watches which hold the cluster sync lock while listing resources
+	done := make(chan bool)
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				_ = runSynced(info.syncLock, func() error {
+					time.Sleep(100 * time.Microsecond)
+					return nil
+				})
+			}
+		}
+	}()
+
+	b.Run("Memoized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = liveStateCache.IsNamespaced("https://mycluster", gk)
+		}
+	})
+	b.Run("NotMemoized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			info, _ := liveStateCache.getSyncedCluster("https://mycluster")
+			_ = info.apisMeta[gk]
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -19,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
@@ -30,12 +32,38 @@ const (
 	clusterSyncTimeout         = 24 * time.Hour
 	clusterRetryTimeout        = 10 * time.Second
 	watchResourcesRetryTimeout = 1 * time.Second
+	// maxAPIVersions limits the number of API versions passed to manifest generation
+	maxAPIVersions = 1000
 )
 
-type apiMeta struct {
-	namespaced      bool
+// unknownGroupKindCacheTimeout is the duration for which the scope of an unknown GroupKind is memoized, so that freshly
+// created CRDs are detected shortly even if the CRD watch event is missed
+var unknownGroupKindCacheTimeout = 5 * time.Second
+
+// modificationSeq is the source of the modification counts of all cluster caches. The sequence is shared by the caches,
+// so that the count of a cluster keeps increasing if its cache is rebuilt.
+var modificationSeq int64
+
+// maxRemovedModifications is the maximum number of removed resources of a cluster whose modification counts are tracked
+const maxRemovedModifications = 10000
+
+// watchMeta holds the state of a single list/watch loop of an API. The namespace is empty if the API is watched in all namespaces.
+type watchMeta struct {
+	namespace       string
 	resourceVersion string
-	watchCancel     context.CancelFunc
+}
+
+// namespacedInfo holds the memoized scope of a GroupKind
+type namespacedInfo struct {
+	namespaced bool
+	// expiresAt is set if the GroupKind is unknown and has to be re-checked after the given time
+	expiresAt *time.Time
+}
+
+type apiMeta struct {
+	namespaced  bool
+	watches     []*watchMeta
+	watchCancel context.CancelFunc
 }
 
 type clusterInfo struct {
@@ -43,6 +71,23 @@ type clusterInfo struct {
 	syncTime  *time.Time
 	syncError error
 	apisMeta  map[schema.GroupKind]*apiMeta
+	// serverVersion is the Kubernetes version of the cluster retrieved during the last sync
+	serverVersion string
+	// stopped is true if the cache has been replaced or the cluster has been removed
+	stopped bool
+	// modificationCount increases every time the cached resources change and must be accessed atomically
+	modificationCount int64
+	// allModifiedCount is the modification count of the last change which potentially modified any cached resource,
+	// e.g. a resync of the cluster or a relist of an API, and must be accessed atomically
+	allModifiedCount int64
+	// appModifications holds the modification counts of the last changes of the resources which belong to an app
+	appModifications map[string]int64
+	// removedModifications holds the modification counts of the removals of resources which are no longer cached
+	removedModifications map[kube.ResourceKey]int64
+	// connectionState holds the result of the last attempt to sync or watch the cluster
+	connectionState          appv1.ConnectionState
+	connectionLock           *sync.Mutex
+	onConnectionStateUpdated ConnectionStateUpdatedHandler
 
 	lock    *sync.Mutex
 	nodes   map[kube.ResourceKey]*node
@@ -53,13 +98,26 @@ type clusterInfo struct {
 	cluster          *appv1.Cluster
 	log              *log.Entry
 	cacheSettingsSrc func() *cacheSettings
+
+	namespacedLock  *sync.RWMutex
+	namespacedCache map[schema.GroupKind]namespacedInfo
+
+	// openAPISchema and apiVersions are retrieved on first use and dropped if the cluster is resynced or a CRD changes
+	discoveryLock *sync.Mutex
+	openAPISchema *kube.OpenAPISchema
+	apiVersions   []string
+	// failedGroupVersions holds the errors of the group/versions which could not be discovered when the APIs were last
+	// discovered, e.g. because the server of an aggregated APIService is down
+	failedGroupVersions map[schema.GroupVersion]string
 }
 
-func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion string, objs []unstructured.Unstructured) {
+// replaceResourceCache replaces cached resources of the given API in the given namespace (or in all namespaces if namespace is empty)
+func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, namespace string, resourceVersion string, objs []unstructured.Unstructured) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	info, ok := c.apisMeta[gk]
 	if ok {
+		c.markAllModified()
 		objByKind := make(map[kube.ResourceKey]*unstructured.Unstructured)
 		for i := range objs {
 			objByKind[kube.GetResourceKey(&objs[i])] = &objs[i]
@@ -73,7 +131,7 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 		}
 
 		for key, existingNode := range c.nodes {
-			if key.Kind != gk.Kind || key.Group != gk.Group {
+			if key.Kind != gk.Kind || key.Group != gk.Group || namespace != "" && key.Namespace != namespace {
 				continue
 			}
 
@@ -81,7 +139,11 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 				c.onNodeRemoved(key, existingNode)
 			}
 		}
-		info.resourceVersion = resourceVersion
+		for _, w := range info.watches {
+			if w.namespace == namespace {
+				w.resourceVersion = resourceVersion
+			}
+		}
 	}
 }
 
@@ -112,7 +174,7 @@ func isServiceAccountTokenSecret(un *unstructured.Unstructured) (bool, metav1.Ow
 	return ref.Name != "" && ref.UID != "", ref
 }
 
-func (c *clusterInfo) createObjInfo(un *unstructured.Unstructured, appInstanceLabel string) *node {
+func (c *clusterInfo) createObjInfo(un *unstructured.Unstructured, appInstanceLabelKeys ...string) *node {
 	ownerRefs := un.GetOwnerReferences()
 	// Special case for endpoint. Remove after https://github.com/kubernetes/kubernetes/issues/28483 is fixed
 	if un.GroupVersionKind().Group == "" && un.GetKind() == kube.EndpointsKind && len(un.GetOwnerReferences()) == 0 {
@@ -133,9 +195,14 @@ func (c *clusterInfo) createObjInfo(un *unstructured.Unstructured, appInstanceLa
 		ref:             kube.GetObjectRef(un),
 		ownerRefs:       ownerRefs,
 	}
+	if createdAt := un.GetCreationTimestamp(); !createdAt.IsZero() {
+		nodeInfo.createdAt = &createdAt
+	}
 
 	populateNodeInfo(un, nodeInfo)
-	appName := kube.GetAppInstanceLabel(un, appInstanceLabel)
+	cacheSettings := c.cacheSettingsSrc()
+	// resources of applications of other installations are not associated with any application
+	appName, _ := cacheSettings.TrackingValue.Parse(kube.GetAppInstanceName(un, cacheSettings.TrackingMethod, appInstanceLabelKeys...))
 	if len(ownerRefs) == 0 && appName != "" {
 		nodeInfo.appName = appName
 		nodeInfo.resource = un
@@ -165,14 +232,82 @@ func (c *clusterInfo) removeNode(key kube.ResourceKey) {
 	}
 }
 
+// markModified increases the modification count of the cluster and returns it
+func (c *clusterInfo) markModified() int64 {
+	count := atomic.AddInt64(&modificationSeq, 1)
+	atomic.StoreInt64(&c.modificationCount, count)
+	return count
+}
+
+// markAllModified increases the modification count of the cluster and considers every resource modified, since the
+// cached resources were replaced without tracking the individual changes
+func (c *clusterInfo) markAllModified() {
+	atomic.StoreInt64(&c.allModifiedCount, c.markModified())
+}
+
+// markAppModified records that a resource which belongs to the given app was added, updated or removed
+func (c *clusterInfo) markAppModified(appName string) {
+	if c.appModifications == nil {
+		c.appModifications = make(map[string]int64)
+	}
+	c.appModifications[appName] = c.getModificationCount()
+}
+
+// markRemoved records the removal of the given resource. The oldest removals are not tracked individually, once there
+// are too many of them all resources are considered modified instead.
+func (c *clusterInfo) markRemoved(key kube.ResourceKey) {
+	if c.removedModifications == nil || len(c.removedModifications) >= maxRemovedModifications {
+		c.removedModifications = make(map[kube.ResourceKey]int64)
+		c.markAllModified()
+	}
+	c.removedModifications[key] = c.getModificationCount()
+}
+
+// getModificationCount returns a number which increases every time a resource is added, updated or removed, or the
+// cache is resynced
+func (c *clusterInfo) getModificationCount() int64 {
+	return atomic.LoadInt64(&c.modificationCount)
+}
+
+// getResourcesModificationCount returns the modification count of the last change of the given resources, including
+// their removal, or of any resource which belongs to the given app, e.g. a newly created resource with the app label
+func (c *clusterInfo) getResourcesModificationCount(appName string, keys []kube.ResourceKey) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	count := atomic.LoadInt64(&c.allModifiedCount)
+	if appCount := c.appModifications[appName]; appCount > count {
+		count = appCount
+	}
+	for _, key := range keys {
+		keyCount := c.removedModifications[key]
+		if n, ok := c.nodes[key]; ok {
+			keyCount = n.modificationCount
+		}
+		if keyCount > count {
+			count = keyCount
+		}
+	}
+	return count
+}
+
 func (c *clusterInfo) invalidate() {
 	c.syncLock.Lock()
 	defer c.syncLock.Unlock()
+	c.markAllModified()
 	c.syncTime = nil
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
 	c.apisMeta = nil
+	c.invalidateNamespaced()
+}
+
+// stop stops watching cluster resources. Any following attempt to use the cache fails.
+func (c *clusterInfo) stop() {
+	c.syncLock.Lock()
+	c.stopped = true
+	c.syncLock.Unlock()
+	c.invalidate()
 }
 
 func (c *clusterInfo) synced() bool {
@@ -191,15 +326,67 @@ func (c *clusterInfo) stopWatching(gk schema.GroupKind) {
 	if info, ok := c.apisMeta[gk]; ok {
 		info.watchCancel()
 		delete(c.apisMeta, gk)
-		c.replaceResourceCache(gk, "", []unstructured.Unstructured{})
+		c.invalidateNamespaced(gk)
+		c.replaceResourceCache(gk, "", "", []unstructured.Unstructured{})
 		log.Warnf("Stop watching %s not found on %s.", gk, c.cluster.Server)
 	}
 }
 
+// watchNamespaces returns the namespaces in which the given API is listed and watched. An empty namespace stands for all namespaces.
+func (c *clusterInfo) watchNamespaces(api kube.APIResourceInfo) []string {
+	if !c.cluster.IsNamespaceScoped() {
+		return []string{""}
+	}
+	if !api.Meta.Namespaced {
+		// cluster-scoped APIs are not accessible if Argo CD is restricted to a list of namespaces
+		return nil
+	}
+	return c.cluster.Namespaces
+}
+
+func resourceInterface(api kube.APIResourceInfo, namespace string) dynamic.ResourceInterface {
+	if nsIf, ok := api.Interface.(dynamic.NamespaceableResourceInterface); ok && namespace != "" {
+		return nsIf.Namespace(namespace)
+	}
+	return api.Interface
+}
+
+// getAPIResources discovers the APIs of the cluster and records the group/versions which could not be discovered. The
+// APIs of the other group/versions are returned, so a broken APIService does not prevent the cluster from being cached.
+func (c *clusterInfo) getAPIResources() ([]kube.APIResourceInfo, error) {
+	apis, err := c.kubectl.GetAPIResources(c.cluster.RESTConfig(), c.cacheSettingsSrc().ResourcesFilter)
+	failedGroupVersions := make(map[schema.GroupVersion]string)
+	if partialErr, ok := err.(*kube.PartialDiscoveryError); ok {
+		for gv, gvErr := range partialErr.FailedGroupVersions {
+			failedGroupVersions[gv] = gvErr.Error()
+		}
+		c.log.Warnf("Failed to discover some APIs, the resources of the failed group/versions are not cached: %v", err)
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.discoveryLock.Lock()
+	c.failedGroupVersions = failedGroupVersions
+	c.discoveryLock.Unlock()
+	return apis, nil
+}
+
+// getFailedGroupVersions returns the errors of the group/versions which could not be discovered
+func (c *clusterInfo) getFailedGroupVersions() map[schema.GroupVersion]string {
+	c.discoveryLock.Lock()
+	defer c.discoveryLock.Unlock()
+	res := make(map[schema.GroupVersion]string, len(c.failedGroupVersions))
+	for gv, err := range c.failedGroupVersions {
+		res[gv] = err
+	}
+	return res
+}
+
 // startMissingWatches lists supported cluster resources and start watching for changes unless watch is already running
 func (c *clusterInfo) startMissingWatches() error {
 
-	apis, err := c.kubectl.GetAPIResources(c.cluster.RESTConfig(), c.cacheSettingsSrc().ResourcesFilter)
+	apis, err := c.getAPIResources()
 	if err != nil {
 		return err
 	}
@@ -209,8 +396,13 @@ func (c *clusterInfo) startMissingWatches() error {
 		if _, ok := c.apisMeta[api.GroupKind]; !ok {
 			ctx, cancel := context.WithCancel(context.Background())
 			info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+			for _, ns := range c.watchNamespaces(api) {
+				w := &watchMeta{namespace: ns}
+				info.watches = append(info.watches, w)
+				go c.watchEvents(ctx, api, w)
+			}
 			c.apisMeta[api.GroupKind] = info
-			go c.watchEvents(ctx, api, info)
+			c.invalidateNamespaced(api.GroupKind)
 		}
 	}
 	return nil
@@ -222,7 +414,8 @@ func runSynced(lock *sync.Mutex, action func() error) error {
 	return action()
 }
 
-func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *apiMeta) {
+func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *watchMeta) {
+	resourceIf := resourceInterface(api, info.namespace)
 	util.RetryUntilSucceed(func() (err error) {
 		defer func() {
 			if r := recover(); r != nil {
@@ -232,24 +425,26 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 
 		err = runSynced(c.syncLock, func() error {
 			if info.resourceVersion == "" {
-				list, err := api.Interface.List(metav1.ListOptions{})
+				list, err := resourceIf.List(metav1.ListOptions{})
 				if err != nil {
 					return err
 				}
-				c.replaceResourceCache(api.GroupKind, list.GetResourceVersion(), list.Items)
+				c.replaceResourceCache(api.GroupKind, info.namespace, list.GetResourceVersion(), list.Items)
 			}
 			return nil
 		})
 
 		if err != nil {
+			c.recordWatchError(err)
 			return err
 		}
 
-		w, err := api.Interface.Watch(metav1.ListOptions{ResourceVersion: info.resourceVersion})
+		w, err := resourceIf.Watch(metav1.ListOptions{ResourceVersion: info.resourceVersion})
 		if errors.IsNotFound(err) {
 			c.stopWatching(api.GroupKind)
 			return nil
 		}
+		c.recordWatchError(err)
 
 		err = runSynced(c.syncLock, func() error {
 			if errors.IsGone(err) {
@@ -272,8 +467,12 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 					obj := event.Object.(*unstructured.Unstructured)
 					info.resourceVersion = obj.GetResourceVersion()
 					c.processEvent(event.Type, obj)
-					if kube.IsCRD(obj) {
-						if event.Type == watch.Deleted {
+					// the APIs change with CRDs, and the APIs of an APIService appear once its server becomes available
+					if kube.IsCRD(obj) || kube.IsAPIService(obj) {
+						c.invalidateDiscovery()
+						// resources of the changed APIs might be known or unknown now
+						c.markAllModified()
+						if event.Type == watch.Deleted && kube.IsCRD(obj) {
 							group, groupOk, groupErr := unstructured.NestedString(obj.Object, "spec", "group")
 							kind, kindOk, kindErr := unstructured.NestedString(obj.Object, "spec", "names", "kind")
 
@@ -309,22 +508,38 @@ func (c *clusterInfo) sync() (err error) {
 	}
 	c.apisMeta = make(map[schema.GroupKind]*apiMeta)
 	c.nodes = make(map[kube.ResourceKey]*node)
+	c.appModifications = make(map[string]int64)
+	c.removedModifications = make(map[kube.ResourceKey]int64)
+	c.invalidateNamespaced()
+	c.invalidateDiscovery()
+	defer c.markAllModified()
+
+	// retrieving the server version verifies that the cluster is accessible using the current cluster settings
+	c.serverVersion, err = c.kubectl.GetServerVersion(c.cluster.RESTConfig())
+	if err != nil {
+		return err
+	}
 
-	apis, err := c.kubectl.GetAPIResources(c.cluster.RESTConfig(), c.cacheSettingsSrc().ResourcesFilter)
+	apis, err := c.getAPIResources()
 	if err != nil {
 		return err
 	}
+	var resourceIfs []dynamic.ResourceInterface
+	for i := range apis {
+		for _, ns := range c.watchNamespaces(apis[i]) {
+			resourceIfs = append(resourceIfs, resourceInterface(apis[i], ns))
+		}
+	}
 	lock := sync.Mutex{}
-	err = util.RunAllAsync(len(apis), func(i int) error {
-		api := apis[i]
-		list, err := api.Interface.List(metav1.ListOptions{})
+	err = util.RunAllAsync(len(resourceIfs), func(i int) error {
+		list, err := resourceIfs[i].List(metav1.ListOptions{})
 		if err != nil {
 			return err
 		}
 
 		lock.Lock()
 		for i := range list.Items {
-			c.setNode(c.createObjInfo(&list.Items[i], c.cacheSettingsSrc().AppInstanceLabelKey))
+			c.setNode(c.createObjInfo(&list.Items[i], c.cacheSettingsSrc().AppInstanceLabelKeys...))
 		}
 		lock.Unlock()
 		return nil
@@ -346,6 +561,9 @@ func (c *clusterInfo) sync() (err error) {
 func (c *clusterInfo) ensureSynced() error {
 	c.syncLock.Lock()
 	defer c.syncLock.Unlock()
+	if c.stopped {
+		return fmt.Errorf("cache of cluster %s has been stopped", c.cluster.Server)
+	}
 	if c.synced() {
 		return c.syncError
 	}
@@ -354,9 +572,45 @@ func (c *clusterInfo) ensureSynced() error {
 	syncTime := time.Now()
 	c.syncTime = &syncTime
 	c.syncError = err
+	c.setConnectionState(err)
 	return c.syncError
 }
 
+// recordWatchError updates the connection state using the result of an attempt to list or watch resources. Errors
+// returned by the API server, e.g. if listing a resource is forbidden, don't affect the connection state.
+func (c *clusterInfo) recordWatchError(err error) {
+	if _, isAPIError := err.(errors.APIStatus); isAPIError {
+		return
+	}
+	c.setConnectionState(err)
+}
+
+func (c *clusterInfo) setConnectionState(err error) {
+	now := metav1.Now()
+	state := appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful, ModifiedAt: &now}
+	if err != nil {
+		state.Status = appv1.ConnectionStatusFailed
+		state.Message = fmt.Sprintf("Unable to connect to cluster: %v", err)
+	}
+	c.connectionLock.Lock()
+	changed := c.connectionState.Status != state.Status || c.connectionState.Message != state.Message
+	c.connectionState = state
+	c.connectionLock.Unlock()
+	if changed && c.onConnectionStateUpdated != nil {
+		c.onConnectionStateUpdated(c.cluster.Server, state)
+	}
+}
+
+// getConnectionState returns the result of the last attempt to sync or watch the cluster
+func (c *clusterInfo) getConnectionState() appv1.ConnectionState {
+	c.connectionLock.Lock()
+	defer c.connectionLock.Unlock()
+	if c.connectionState.Status == "" {
+		return appv1.ConnectionState{Status: appv1.ConnectionStatusUnknown, Message: "Cluster has not been synced yet"}
+	}
+	return c.connectionState
+}
+
 func (c *clusterInfo) getNamespaceTopLevelResources(namespace string) map[kube.ResourceKey]appv1.ResourceNode {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -369,6 +623,20 @@ func (c *clusterInfo) getNamespaceTopLevelResources(namespace string) map[kube.R
 	return nodes
 }
 
+// getNamespaceUnmanagedResources returns the top level resources of the given namespace which are not referenced by any
+// application. Endpoints are not returned, since they are considered children of the Service with the same name.
+func (c *clusterInfo) getNamespaceUnmanagedResources(namespace string) map[kube.ResourceKey]appv1.ResourceNode {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	nodes := make(map[kube.ResourceKey]appv1.ResourceNode)
+	for key, node := range c.nsIndex[namespace] {
+		if len(node.ownerRefs) == 0 && node.appName == "" {
+			nodes[key] = node.asResourceNode()
+		}
+	}
+	return nodes
+}
+
 func (c *clusterInfo) iterateHierarchy(key kube.ResourceKey, action func(child appv1.ResourceNode, appName string)) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -399,11 +667,106 @@ func (c *clusterInfo) iterateHierarchy(key kube.ResourceKey, action func(child a
 	}
 }
 
-func (c *clusterInfo) isNamespaced(gk schema.GroupKind) bool {
-	if api, ok := c.apisMeta[gk]; ok && !api.namespaced {
-		return false
+// isKnownGroupKind returns true if the API of the given GroupKind is served by the cluster
+func (c *clusterInfo) isKnownGroupKind(gk schema.GroupKind) bool {
+	c.syncLock.Lock()
+	defer c.syncLock.Unlock()
+	_, ok := c.apisMeta[gk]
+	return ok
+}
+
+// refreshAPIResources starts watching the APIs which were added since the cluster was synced, so that resources of a
+// CRD applied during a sync are found without waiting for the CRD watch event
+func (c *clusterInfo) refreshAPIResources() error {
+	c.invalidateDiscovery()
+	return runSynced(c.syncLock, c.startMissingWatches)
+}
+
+// getOpenAPISchema returns the OpenAPI schema of the cluster, which is retrieved if it is not cached yet
+func (c *clusterInfo) getOpenAPISchema() (*kube.OpenAPISchema, error) {
+	c.discoveryLock.Lock()
+	defer c.discoveryLock.Unlock()
+	if c.openAPISchema == nil {
+		openAPISchema, err := c.kubectl.GetOpenAPISchema(c.cluster.RESTConfig())
+		if err != nil {
+			return nil, err
+		}
+		c.openAPISchema = openAPISchema
+	}
+	return c.openAPISchema, nil
+}
+
+// getAPIVersions returns the sorted group/versions served by the cluster, which are retrieved if they are not cached
+// yet. The list is capped to maxAPIVersions entries.
+func (c *clusterInfo) getAPIVersions() ([]string, error) {
+	c.discoveryLock.Lock()
+	defer c.discoveryLock.Unlock()
+	if c.apiVersions == nil {
+		apiVersions, err := c.kubectl.GetAPIVersions(c.cluster.RESTConfig())
+		if err != nil {
+			return nil, err
+		}
+		if len(apiVersions) > maxAPIVersions {
+			c.log.Warnf("Cluster serves %d API versions, only the first %d are passed to manifest generation", len(apiVersions), maxAPIVersions)
+			apiVersions = apiVersions[:maxAPIVersions]
+		}
+		if apiVersions == nil {
+			apiVersions = []string{}
+		}
+		c.apiVersions = apiVersions
+	}
+	return c.apiVersions, nil
+}
+
+func (c *clusterInfo) invalidateDiscovery() {
+	c.discoveryLock.Lock()
+	defer c.discoveryLock.Unlock()
+	c.openAPISchema = nil
+	c.apiVersions = nil
+}
+
+// getMemoizedNamespaced returns the memoized scope of the given GroupKind. The second return value is false if the scope
+// is not memoized or the memoized scope of an unknown GroupKind has expired.
+func (c *clusterInfo) getMemoizedNamespaced(gk schema.GroupKind) (bool, bool) {
+	c.namespacedLock.RLock()
+	defer c.namespacedLock.RUnlock()
+	info, ok := c.namespacedCache[gk]
+	if !ok || info.expiresAt != nil && time.Now().After(*info.expiresAt) {
+		return false, false
+	}
+	return info.namespaced, true
+}
+
+// invalidateNamespaced drops the memoized scope of the given GroupKinds or of all GroupKinds if none is specified
+func (c *clusterInfo) invalidateNamespaced(gks ...schema.GroupKind) {
+	c.namespacedLock.Lock()
+	defer c.namespacedLock.Unlock()
+	if len(gks) == 0 {
+		c.namespacedCache = nil
+		return
+	}
+	for _, gk := range gks {
+		delete(c.namespacedCache, gk)
 	}
-	return true
+}
+
+func (c *clusterInfo) isNamespaced(gk schema.GroupKind) bool {
+	if namespaced, ok := c.getMemoizedNamespaced(gk); ok {
+		return namespaced
+	}
+	api, known := c.apisMeta[gk]
+	info := namespacedInfo{namespaced: !known || api.namespaced}
+	if !known {
+		expiresAt := time.Now().Add(unknownGroupKindCacheTimeout)
+		info.expiresAt = &expiresAt
+	}
+	c.namespacedLock.Lock()
+	defer c.namespacedLock.Unlock()
+	if c.namespacedCache == nil {
+		c.namespacedCache = make(map[schema.GroupKind]namespacedInfo)
+	}
+	c.namespacedCache[gk] = info
+	return info.namespaced
 }
 
 func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured, metricsServer *metrics.MetricsServer) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
@@ -442,7 +805,9 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 						return err
 					}
 				}
-			} else if _, watched := c.apisMeta[key.GroupKind()]; !watched {
+			} else if _, known := c.apisMeta[key.GroupKind()]; !known {
+				// load resources of unknown APIs directly. Known but not watched APIs (e.g. cluster-scoped APIs of a namespace
+				// scoped cluster) are skipped since they are not accessible
 				var err error
 				managedObj, err = c.kubectl.GetResource(config, targetObj.GroupVersionKind(), targetObj.GetName(), targetObj.GetNamespace())
 				if err != nil {
@@ -485,6 +850,7 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 func (c *clusterInfo) processEvent(event watch.EventType, un *unstructured.Unstructured) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	c.markModified()
 	key := kube.GetResourceKey(un)
 	existingNode, exists := c.nodes[key]
 	if event == watch.Deleted {
@@ -501,14 +867,19 @@ func (c *clusterInfo) onNodeUpdated(exists bool, existingNode *node, un *unstruc
 	if exists {
 		nodes = append(nodes, existingNode)
 	}
-	newObj := c.createObjInfo(un, c.cacheSettingsSrc().AppInstanceLabelKey)
+	newObj := c.createObjInfo(un, c.cacheSettingsSrc().AppInstanceLabelKeys...)
+	newObj.modificationCount = c.getModificationCount()
 	c.setNode(newObj)
+	delete(c.removedModifications, key)
 	nodes = append(nodes, newObj)
 	toNotify := make(map[string]bool)
 	for i := range nodes {
 		n := nodes[i]
 		if ns, ok := c.nsIndex[n.ref.Namespace]; ok {
 			app := n.getApp(ns)
+			if app != "" {
+				c.markAppModified(app)
+			}
 			if app == "" || skipAppRequeing(key) {
 				continue
 			}
@@ -525,8 +896,10 @@ func (c *clusterInfo) onNodeRemoved(key kube.ResourceKey, n *node) {
 	}
 
 	c.removeNode(key)
+	c.markRemoved(key)
 	managedByApp := make(map[string]bool)
 	if appName != "" {
+		c.markAppModified(appName)
 		managedByApp[appName] = n.isRootAppNode()
 	}
 	c.onObjectUpdated(managedByApp, n.ref)
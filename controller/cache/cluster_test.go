@@ -6,11 +6,13 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -161,10 +163,13 @@ func newClusterExt(kubectl kube.Kubectl) *clusterInfo {
 		cluster:         &appv1.Cluster{},
 		syncTime:        nil,
 		syncLock:        &sync.Mutex{},
+		namespacedLock:  &sync.RWMutex{},
+		connectionLock:  &sync.Mutex{},
+		discoveryLock:   &sync.Mutex{},
 		apisMeta:        make(map[schema.GroupKind]*apiMeta),
 		log:             log.WithField("cluster", "test"),
 		cacheSettingsSrc: func() *cacheSettings {
-			return &cacheSettings{AppInstanceLabelKey: common.LabelKeyAppInstance}
+			return &cacheSettings{AppInstanceLabelKeys: []string{common.LabelKeyAppInstance}}
 		},
 	}
 }
@@ -208,6 +213,30 @@ func TestGetNamespaceResources(t *testing.T) {
 	assert.Equal(t, resources[kube.GetResourceKey(kubesystemNamespaceTopLevel2)].Name, "helm-guestbook3")
 }
 
+func TestGetNamespaceUnmanagedResources(t *testing.T) {
+	unmanagedDeploy := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata: {"name": "unmanaged", "namespace": "default"}
+`)
+	endpoints := strToUnstructured(`
+  apiVersion: v1
+  kind: Endpoints
+  metadata: {"name": "helm-guestbook", "namespace": "default"}
+`)
+
+	cluster := newCluster(testPod, testRS, testDeploy, unmanagedDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	cluster.setNode(cluster.createObjInfo(endpoints, common.LabelKeyAppInstance))
+
+	resources := cluster.getNamespaceUnmanagedResources("default")
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "unmanaged", resources[kube.GetResourceKey(unmanagedDeploy)].Name)
+
+	assert.Empty(t, cluster.getNamespaceUnmanagedResources("kube-system"))
+}
+
 func TestGetChildren(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -281,6 +310,129 @@ metadata:
 	})
 }
 
+func TestCreateObjInfoLegacyAppInstanceLabel(t *testing.T) {
+	cluster := newCluster()
+	legacyDeploy := testDeploy.DeepCopy()
+	legacyDeploy.SetLabels(map[string]string{common.LabelKeyAppInstance: "legacy-app"})
+	relabeledDeploy := testDeploy.DeepCopy()
+	relabeledDeploy.SetLabels(map[string]string{common.LabelKeyAppInstance: "legacy-app", "mycompany.com/appname": "my-app"})
+
+	assert.Equal(t, "legacy-app", cluster.createObjInfo(legacyDeploy, "mycompany.com/appname", common.LabelKeyAppInstance).appName)
+	assert.Equal(t, "my-app", cluster.createObjInfo(relabeledDeploy, "mycompany.com/appname", common.LabelKeyAppInstance).appName)
+	// without the legacy key only resources with the primary key are managed
+	assert.Equal(t, "", cluster.createObjInfo(legacyDeploy, "mycompany.com/appname").appName)
+	assert.Nil(t, cluster.createObjInfo(legacyDeploy, "mycompany.com/appname").resource)
+}
+
+func TestCreateObjInfoTrackingAnnotation(t *testing.T) {
+	cluster := newCluster()
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{AppInstanceLabelKeys: []string{common.LabelKeyAppInstance}, TrackingMethod: kube.TrackingMethodAnnotation}
+	}
+	annotatedDeploy := testDeploy.DeepCopy()
+	annotatedDeploy.SetLabels(map[string]string{common.LabelKeyAppInstance: "my-release"})
+	annotatedDeploy.SetAnnotations(map[string]string{common.AnnotationKeyAppInstance: "my-app"})
+	labeledDeploy := testDeploy.DeepCopy()
+	labeledDeploy.SetLabels(map[string]string{common.LabelKeyAppInstance: "legacy-app"})
+
+	assert.Equal(t, "my-app", cluster.createObjInfo(annotatedDeploy, common.LabelKeyAppInstance).appName)
+	// resources which were synced before switching to the annotation are still managed
+	assert.Equal(t, "legacy-app", cluster.createObjInfo(labeledDeploy, common.LabelKeyAppInstance).appName)
+}
+
+func TestCreateObjInfoTrackingValue(t *testing.T) {
+	cluster := newCluster()
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{AppInstanceLabelKeys: []string{common.LabelKeyAppInstance}, TrackingValue: kube.NamespaceTrackingValue("argocd")}
+	}
+	newDeploy := func(val string) *unstructured.Unstructured {
+		deploy := testDeploy.DeepCopy()
+		deploy.SetLabels(map[string]string{common.LabelKeyAppInstance: val})
+		return deploy
+	}
+
+	assert.Equal(t, "my-app", cluster.createObjInfo(newDeploy("argocd_my-app"), common.LabelKeyAppInstance).appName)
+	// resources which were synced before the tracking value format was configured are still managed
+	assert.Equal(t, "my-app", cluster.createObjInfo(newDeploy("my-app"), common.LabelKeyAppInstance).appName)
+	// resources of another installation are not
+	otherDeploy := cluster.createObjInfo(newDeploy("other_my-app"), common.LabelKeyAppInstance)
+	assert.Equal(t, "", otherDeploy.appName)
+	assert.Nil(t, otherDeploy.resource)
+}
+
+func TestNamespaceScopedCluster(t *testing.T) {
+	otherNamespaceDeploy := testDeploy.DeepCopy()
+	otherNamespaceDeploy.SetNamespace("kube-system")
+	clusterRole := strToUnstructured(`
+  apiVersion: rbac.authorization.k8s.io/v1
+  kind: ClusterRole
+  metadata:
+    labels:
+      app.kubernetes.io/instance: helm-guestbook
+    name: helm-guestbook
+    uid: "5"`)
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), testDeploy, otherNamespaceDeploy, clusterRole)
+	clusterRoleGroupKind := schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}
+	kubectl := &kubetest.MockKubectlCmd{
+		APIResources: []kube.APIResourceInfo{{
+			GroupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			Interface: client.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}),
+			Meta:      metav1.APIResource{Namespaced: true},
+		}, {
+			GroupKind: clusterRoleGroupKind,
+			Interface: client.Resource(schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}),
+			Meta:      metav1.APIResource{Namespaced: false},
+		}},
+		// cluster-scoped resources must not be loaded even if they are accessible
+		Resources: []*unstructured.Unstructured{clusterRole},
+	}
+	cluster := newClusterExt(kubectl)
+	cluster.cluster = &appv1.Cluster{Namespaces: []string{"default"}}
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	assert.Len(t, cluster.nodes, 1)
+	assert.Contains(t, cluster.nodes, kube.GetResourceKey(testDeploy))
+	assert.False(t, cluster.isNamespaced(clusterRoleGroupKind))
+	assert.Empty(t, cluster.apisMeta[clusterRoleGroupKind].watches)
+
+	targetDeploy := strToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: helm-guestbook`)
+	targetClusterRole := strToUnstructured(`
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: helm-guestbook`)
+
+	managedObjs, err := cluster.getManagedLiveObjs(&appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook"},
+		Spec: appv1.ApplicationSpec{
+			Destination: appv1.ApplicationDestination{
+				Namespace: "default",
+			},
+		},
+	}, []*unstructured.Unstructured{targetDeploy, targetClusterRole}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, managedObjs, map[kube.ResourceKey]*unstructured.Unstructured{
+		kube.NewResourceKey("apps", "Deployment", "default", "helm-guestbook"): testDeploy,
+	})
+}
+
+func TestResourceNodeCreatedAt(t *testing.T) {
+	pod := testPod.DeepCopy()
+	createdAt := metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+	pod.SetCreationTimestamp(createdAt)
+	cluster := newCluster(pod)
+
+	actual := cluster.createObjInfo(pod, common.LabelKeyAppInstance).asResourceNode().CreatedAt
+	assert.NotNil(t, actual)
+	assert.True(t, createdAt.Equal(actual))
+	assert.Nil(t, cluster.createObjInfo(testPod, common.LabelKeyAppInstance).asResourceNode().CreatedAt)
+}
+
 func TestChildDeletedEvent(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -292,6 +444,62 @@ func TestChildDeletedEvent(t *testing.T) {
 	assert.Equal(t, []appv1.ResourceNode{}, rsChildren)
 }
 
+func TestModificationCount(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	assert.NoError(t, cluster.ensureSynced())
+	synced := cluster.getModificationCount()
+	assert.True(t, synced > 0)
+
+	cluster.processEvent(watch.Modified, testPod)
+	modified := cluster.getModificationCount()
+	assert.True(t, modified > synced)
+
+	cluster.replaceResourceCache(testPod.GroupVersionKind().GroupKind(), "", "updated-list-version", []unstructured.Unstructured{*testPod})
+	relisted := cluster.getModificationCount()
+	assert.True(t, relisted > modified)
+
+	// the count keeps increasing if the cache is replaced
+	rebuilt := newCluster(testPod, testRS, testDeploy)
+	assert.NoError(t, rebuilt.ensureSynced())
+	assert.True(t, rebuilt.getModificationCount() > relisted)
+
+	rebuilt.invalidate()
+	assert.NoError(t, rebuilt.ensureSynced())
+	assert.True(t, rebuilt.getModificationCount() > relisted)
+}
+
+func TestResourcesModificationCount(t *testing.T) {
+	cluster := newCluster(testPod, testRS, testDeploy)
+	assert.NoError(t, cluster.ensureSynced())
+	synced := cluster.getModificationCount()
+	serviceKey := kube.GetResourceKey(testService)
+	assert.Equal(t, synced, cluster.getResourcesModificationCount("helm-guestbook", []kube.ResourceKey{serviceKey}))
+
+	// unrelated resource
+	cluster.processEvent(watch.Modified, testIngress)
+	assert.Equal(t, synced, cluster.getResourcesModificationCount("helm-guestbook", []kube.ResourceKey{serviceKey}))
+
+	// compared resource
+	cluster.processEvent(watch.Modified, testService)
+	serviceModified := cluster.getModificationCount()
+	assert.Equal(t, serviceModified, cluster.getResourcesModificationCount("helm-guestbook", []kube.ResourceKey{serviceKey}))
+	assert.Equal(t, synced, cluster.getResourcesModificationCount("helm-guestbook", nil))
+
+	// resource which belongs to the app
+	cluster.processEvent(watch.Modified, testPod)
+	podModified := cluster.getModificationCount()
+	assert.Equal(t, podModified, cluster.getResourcesModificationCount("helm-guestbook", nil))
+	assert.Equal(t, synced, cluster.getResourcesModificationCount("other-app", nil))
+
+	// removed resource
+	cluster.processEvent(watch.Deleted, testService)
+	assert.Equal(t, cluster.getModificationCount(), cluster.getResourcesModificationCount("other-app", []kube.ResourceKey{serviceKey}))
+
+	// relisted resources
+	cluster.replaceResourceCache(testPod.GroupVersionKind().GroupKind(), "", "updated-list-version", []unstructured.Unstructured{*testPod})
+	assert.Equal(t, cluster.getModificationCount(), cluster.getResourcesModificationCount("other-app", nil))
+}
+
 func TestProcessNewChildEvent(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -456,7 +664,7 @@ func TestWatchCacheUpdated(t *testing.T) {
 
 	podGroupKind := testPod.GroupVersionKind().GroupKind()
 
-	cluster.replaceResourceCache(podGroupKind, "updated-list-version", []unstructured.Unstructured{*updated, *added})
+	cluster.replaceResourceCache(podGroupKind, "", "updated-list-version", []unstructured.Unstructured{*updated, *added})
 
 	_, ok := cluster.nodes[kube.GetResourceKey(removed)]
 	assert.False(t, ok)
@@ -486,3 +694,183 @@ func TestGetDuplicatedChildren(t *testing.T) {
 		assert.Equal(t, testRS.GetName(), children[0].Name)
 	}
 }
+
+func TestIsNamespacedMemoized(t *testing.T) {
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	podGK := schema.GroupKind{Kind: "Pod"}
+	_, ok := cluster.getMemoizedNamespaced(podGK)
+	assert.False(t, ok)
+	assert.True(t, cluster.isNamespaced(podGK))
+	namespaced, ok := cluster.getMemoizedNamespaced(podGK)
+	assert.True(t, ok)
+	assert.True(t, namespaced)
+
+	// unknown GroupKind is assumed to be namespaced until the CRD is watched
+	crdGK := schema.GroupKind{Group: "example.com", Kind: "ClusterThing"}
+	assert.True(t, cluster.isNamespaced(crdGK))
+	_, ok = cluster.getMemoizedNamespaced(crdGK)
+	assert.True(t, ok)
+
+	kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+	kubectl.APIResources = append(kubectl.APIResources, kube.APIResourceInfo{
+		GroupKind: crdGK,
+		Interface: fake.NewSimpleDynamicClient(runtime.NewScheme()).Resource(schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "clusterthings"}),
+		Meta:      metav1.APIResource{Namespaced: false},
+	})
+	err = runSynced(cluster.syncLock, cluster.startMissingWatches)
+	assert.Nil(t, err)
+	assert.False(t, cluster.isNamespaced(crdGK))
+
+	cluster.stopWatching(crdGK)
+	_, ok = cluster.getMemoizedNamespaced(crdGK)
+	assert.False(t, ok)
+	assert.True(t, cluster.isNamespaced(crdGK))
+}
+
+func TestIsNamespacedUnknownGroupKindExpires(t *testing.T) {
+	defer func(timeout time.Duration) { unknownGroupKindCacheTimeout = timeout }(unknownGroupKindCacheTimeout)
+	unknownGroupKindCacheTimeout = 0
+
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	crdGK := schema.GroupKind{Group: "example.com", Kind: "ClusterThing"}
+	assert.True(t, cluster.isNamespaced(crdGK))
+	time.Sleep(time.Millisecond)
+	_, ok := cluster.getMemoizedNamespaced(crdGK)
+	assert.False(t, ok)
+
+	cluster.apisMeta[crdGK] = &apiMeta{namespaced: false, watchCancel: func() {}}
+	assert.False(t, cluster.isNamespaced(crdGK))
+}
+
+func TestRefreshAPIResources(t *testing.T) {
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	crdGK := schema.GroupKind{Group: "example.com", Kind: "Widget"}
+	assert.True(t, cluster.isKnownGroupKind(schema.GroupKind{Kind: "Pod"}))
+	assert.False(t, cluster.isKnownGroupKind(crdGK))
+	assert.True(t, cluster.isNamespaced(crdGK))
+
+	kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+	kubectl.APIResources = append(kubectl.APIResources, kube.APIResourceInfo{
+		GroupKind: crdGK,
+		Interface: fake.NewSimpleDynamicClient(runtime.NewScheme()).Resource(schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}),
+		Meta:      metav1.APIResource{Namespaced: false},
+	})
+	err = cluster.refreshAPIResources()
+	assert.Nil(t, err)
+	assert.True(t, cluster.isKnownGroupKind(crdGK))
+	assert.False(t, cluster.isNamespaced(crdGK))
+}
+
+func TestPartialDiscoveryFailure(t *testing.T) {
+	cluster := newCluster()
+	kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+	metricsGV := schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}
+	kubectl.APIResourcesError = &kube.PartialDiscoveryError{FailedGroupVersions: map[schema.GroupVersion]error{
+		metricsGV: fmt.Errorf("the server is currently unable to handle the request"),
+	}}
+
+	// the APIs which were discovered are cached
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	assert.True(t, cluster.isKnownGroupKind(schema.GroupKind{Kind: "Pod"}))
+	assert.Equal(t, map[schema.GroupVersion]string{metricsGV: "the server is currently unable to handle the request"}, cluster.getFailedGroupVersions())
+
+	// the failures are forgotten once the APIs are discovered successfully
+	kubectl.APIResourcesError = nil
+	err = cluster.refreshAPIResources()
+	assert.Nil(t, err)
+	assert.Empty(t, cluster.getFailedGroupVersions())
+}
+
+func TestGetOpenAPISchema(t *testing.T) {
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+	kubectl.OpenAPISchema = &kube.OpenAPISchema{}
+	openAPISchema, err := cluster.getOpenAPISchema()
+	assert.Nil(t, err)
+	assert.True(t, kubectl.OpenAPISchema == openAPISchema)
+
+	// the schema is cached until the APIs of the cluster are refreshed
+	cachedSchema := kubectl.OpenAPISchema
+	kubectl.OpenAPISchema = &kube.OpenAPISchema{}
+	openAPISchema, err = cluster.getOpenAPISchema()
+	assert.Nil(t, err)
+	assert.True(t, cachedSchema == openAPISchema)
+
+	err = cluster.refreshAPIResources()
+	assert.Nil(t, err)
+	openAPISchema, err = cluster.getOpenAPISchema()
+	assert.Nil(t, err)
+	assert.True(t, kubectl.OpenAPISchema == openAPISchema)
+}
+
+func TestGetAPIVersions(t *testing.T) {
+	cluster := newCluster()
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	kubectl := cluster.kubectl.(*kubetest.MockKubectlCmd)
+	kubectl.APIVersions = []string{"apps/v1", "v1"}
+	apiVersions, err := cluster.getAPIVersions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"apps/v1", "v1"}, apiVersions)
+
+	// the versions are cached until the APIs of the cluster are refreshed
+	kubectl.APIVersions = []string{"apps/v1", "networking.k8s.io/v1beta1", "v1"}
+	apiVersions, err = cluster.getAPIVersions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"apps/v1", "v1"}, apiVersions)
+
+	err = cluster.refreshAPIResources()
+	assert.Nil(t, err)
+	apiVersions, err = cluster.getAPIVersions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"apps/v1", "networking.k8s.io/v1beta1", "v1"}, apiVersions)
+}
+
+func TestClusterConnectionState(t *testing.T) {
+	cluster := newCluster()
+	var updates []appv1.ConnectionState
+	cluster.onConnectionStateUpdated = func(server string, state appv1.ConnectionState) {
+		updates = append(updates, state)
+	}
+	assert.Equal(t, appv1.ConnectionStatusUnknown, cluster.getConnectionState().Status)
+
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+	state := cluster.getConnectionState()
+	assert.Equal(t, appv1.ConnectionStatusSuccessful, state.Status)
+	assert.NotNil(t, state.ModifiedAt)
+
+	// errors returned by the API server don't affect the connection state
+	cluster.recordWatchError(apierr.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", fmt.Errorf("forbidden")))
+	assert.Equal(t, appv1.ConnectionStatusSuccessful, cluster.getConnectionState().Status)
+
+	cluster.recordWatchError(fmt.Errorf("dial tcp: i/o timeout"))
+	state = cluster.getConnectionState()
+	assert.Equal(t, appv1.ConnectionStatusFailed, state.Status)
+	assert.Equal(t, "Unable to connect to cluster: dial tcp: i/o timeout", state.Message)
+
+	cluster.recordWatchError(fmt.Errorf("dial tcp: i/o timeout"))
+	cluster.recordWatchError(nil)
+	assert.Equal(t, appv1.ConnectionStatusSuccessful, cluster.getConnectionState().Status)
+
+	// the handler is only called if the state changes
+	if assert.Len(t, updates, 3) {
+		assert.Equal(t, appv1.ConnectionStatusSuccessful, updates[0].Status)
+		assert.Equal(t, appv1.ConnectionStatusFailed, updates[1].Status)
+		assert.Equal(t, appv1.ConnectionStatusSuccessful, updates[2].Status)
+	}
+}
@@ -31,15 +31,33 @@ func populateNodeInfo(un *unstructured.Unstructured, node *node) {
 			populateServiceInfo(un, node)
 			return
 		}
+	case "apps":
+		switch gvk.Kind {
+		case kube.ReplicaSetKind:
+			populateReplicaSetInfo(un, node)
+			return
+		}
 	case "extensions", "networking.k8s.io":
 		switch gvk.Kind {
 		case kube.IngressKind:
 			populateIngressInfo(un, node)
 			return
+		case kube.ReplicaSetKind:
+			populateReplicaSetInfo(un, node)
+			return
 		}
 	}
 }
 
+// populateReplicaSetInfo marks replica sets which are scaled down to zero replicas, e.g. old revisions of a deployment, as inactive
+func populateReplicaSetInfo(un *unstructured.Unstructured, node *node) {
+	counts, err := kube.GetReplicaCounts(un)
+	if err != nil {
+		return
+	}
+	node.inactive = counts.Desired == 0 && counts.Current == 0
+}
+
 func getIngress(un *unstructured.Unstructured) []v1.LoadBalancerIngress {
 	ingress, ok, err := unstructured.NestedSlice(un.Object, "status", "loadBalancer", "ingress")
 	if !ok || err != nil {
@@ -173,18 +191,7 @@ func populatePodInfo(un *unstructured.Unstructured, node *node) {
 		reason = pod.Status.Reason
 	}
 
-	imagesSet := make(map[string]bool)
-	for _, container := range pod.Spec.InitContainers {
-		imagesSet[container.Image] = true
-	}
-	for _, container := range pod.Spec.Containers {
-		imagesSet[container.Image] = true
-	}
-
-	node.images = nil
-	for image := range imagesSet {
-		node.images = append(node.images, image)
-	}
+	node.images = kube.GetImages(un)
 
 	initializing := false
 	for i := range pod.Status.InitContainerStatuses {
@@ -5,7 +5,10 @@ import (
 	"strings"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -38,6 +41,27 @@ func TestGetPodInfo(t *testing.T) {
 	assert.Equal(t, &v1alpha1.ResourceNetworkingInfo{Labels: map[string]string{"app": "guestbook"}}, node.networkingInfo)
 }
 
+func TestGetReplicaSetInfo(t *testing.T) {
+	rs := &appsv1.ReplicaSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: kube.ReplicaSetKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook-rs", Namespace: "default"},
+		Spec:       appsv1.ReplicaSetSpec{Replicas: pointer.Int32Ptr(0)},
+		Status:     appsv1.ReplicaSetStatus{Replicas: 1},
+	}
+	isInactive := func() bool {
+		n := &node{}
+		populateNodeInfo(mustToUnstructured(rs), n)
+		return n.inactive
+	}
+	assert.False(t, isInactive())
+
+	rs.Status.Replicas = 0
+	assert.True(t, isInactive())
+
+	rs.Spec.Replicas = nil
+	assert.False(t, isInactive())
+}
+
 func TestGetServiceInfo(t *testing.T) {
 	node := &node{}
 	populateNodeInfo(testService, node)
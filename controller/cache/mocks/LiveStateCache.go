@@ -21,6 +21,87 @@ type LiveStateCache struct {
 	mock.Mock
 }
 
+// GetAPIVersions provides a mock function with given fields: server
+func (_m *LiveStateCache) GetAPIVersions(server string) ([]string, error) {
+	ret := _m.Called(server)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterConnectionState provides a mock function with given fields: server
+func (_m *LiveStateCache) GetClusterConnectionState(server string) v1alpha1.ConnectionState {
+	ret := _m.Called(server)
+
+	var r0 v1alpha1.ConnectionState
+	if rf, ok := ret.Get(0).(func(string) v1alpha1.ConnectionState); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(v1alpha1.ConnectionState)
+	}
+
+	return r0
+}
+
+// GetClusterModificationCount provides a mock function with given fields: server
+func (_m *LiveStateCache) GetClusterModificationCount(server string) (int64, error) {
+	ret := _m.Called(server)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFailedGroupVersions provides a mock function with given fields: server
+func (_m *LiveStateCache) GetFailedGroupVersions(server string) (map[schema.GroupVersion]string, error) {
+	ret := _m.Called(server)
+
+	var r0 map[schema.GroupVersion]string
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupVersion]string); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupVersion]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetManagedLiveObjs provides a mock function with given fields: a, targetObjs
 func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	ret := _m.Called(a, targetObjs)
@@ -67,11 +148,120 @@ func (_m *LiveStateCache) GetNamespaceTopLevelResources(server string, namespace
 	return r0, r1
 }
 
+// GetNamespaceUnmanagedResources provides a mock function with given fields: server, namespace
+func (_m *LiveStateCache) GetNamespaceUnmanagedResources(server string, namespace string) (map[kube.ResourceKey]v1alpha1.ResourceNode, error) {
+	ret := _m.Called(server, namespace)
+
+	var r0 map[kube.ResourceKey]v1alpha1.ResourceNode
+	if rf, ok := ret.Get(0).(func(string, string) map[kube.ResourceKey]v1alpha1.ResourceNode); ok {
+		r0 = rf(server, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[kube.ResourceKey]v1alpha1.ResourceNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(server, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenAPISchema provides a mock function with given fields: server
+func (_m *LiveStateCache) GetOpenAPISchema(server string) (*kube.OpenAPISchema, error) {
+	ret := _m.Called(server)
+
+	var r0 *kube.OpenAPISchema
+	if rf, ok := ret.Get(0).(func(string) *kube.OpenAPISchema); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kube.OpenAPISchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcesModificationCount provides a mock function with given fields: server, appName, keys
+func (_m *LiveStateCache) GetResourcesModificationCount(server string, appName string, keys []kube.ResourceKey) (int64, error) {
+	ret := _m.Called(server, appName, keys)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, string, []kube.ResourceKey) int64); ok {
+		r0 = rf(server, appName, keys)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []kube.ResourceKey) error); ok {
+		r1 = rf(server, appName, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerVersion provides a mock function with given fields: server
+func (_m *LiveStateCache) GetServerVersion(server string) (string, error) {
+	ret := _m.Called(server)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Invalidate provides a mock function with given fields:
 func (_m *LiveStateCache) Invalidate() {
 	_m.Called()
 }
 
+// IsKnownGroupKind provides a mock function with given fields: server, gk
+func (_m *LiveStateCache) IsKnownGroupKind(server string, gk schema.GroupKind) (bool, error) {
+	ret := _m.Called(server, gk)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, schema.GroupKind) bool); ok {
+		r0 = rf(server, gk)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, schema.GroupKind) error); ok {
+		r1 = rf(server, gk)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsNamespaced provides a mock function with given fields: server, gk
 func (_m *LiveStateCache) IsNamespaced(server string, gk schema.GroupKind) (bool, error) {
 	ret := _m.Called(server, gk)
@@ -107,6 +297,20 @@ func (_m *LiveStateCache) IterateHierarchy(server string, key kube.ResourceKey,
 	return r0
 }
 
+// RefreshAPIResources provides a mock function with given fields: server
+func (_m *LiveStateCache) RefreshAPIResources(server string) error {
+	ret := _m.Called(server)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(server)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields: ctx
 func (_m *LiveStateCache) Run(ctx context.Context) error {
 	ret := _m.Called(ctx)
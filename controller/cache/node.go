@@ -24,6 +24,11 @@ type node struct {
 	networkingInfo *appv1.ResourceNetworkingInfo
 	images         []string
 	health         *appv1.HealthStatus
+	createdAt      *metav1.Time
+	// inactive is true for superseded rollout revisions
+	inactive bool
+	// modificationCount is the modification count of the cluster when the resource was last added or updated
+	modificationCount int64
 }
 
 func (n *node) isRootAppNode() bool {
@@ -124,6 +129,8 @@ func (n *node) asResourceNode() appv1.ResourceNode {
 		NetworkingInfo:  n.networkingInfo,
 		Images:          n.images,
 		Health:          n.health,
+		CreatedAt:       n.createdAt,
+		Inactive:        n.inactive,
 	}
 }
 
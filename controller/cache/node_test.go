@@ -9,7 +9,7 @@ import (
 )
 
 var c = &clusterInfo{cacheSettingsSrc: func() *cacheSettings {
-	return &cacheSettings{AppInstanceLabelKey: common.LabelKeyAppInstance}
+	return &cacheSettings{AppInstanceLabelKeys: []string{common.LabelKeyAppInstance}}
 }}
 
 func TestIsParentOf(t *testing.T) {
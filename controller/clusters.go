@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/db"
+)
+
+const clusterWatchRetryTimeout = 10 * time.Second
+
+// getClusters returns the snapshot of the clusters which is used to resolve destination names. The snapshot is
+// reloaded after a cluster was added, modified or removed.
+func (m *appStateManager) getClusters(ctx context.Context) ([]v1alpha1.Cluster, error) {
+	m.clustersLock.Lock()
+	defer m.clustersLock.Unlock()
+	if m.clusters != nil && !m.clustersOutdated {
+		return m.clusters, nil
+	}
+	start := time.Now()
+	clusters, err := m.db.ListClusters(ctx)
+	m.observeDBRequest("ListClusters", start)
+	if err != nil {
+		return nil, err
+	}
+	m.clusters = clusters.Items
+	m.clustersOutdated = false
+	return m.clusters, nil
+}
+
+// invalidateClusters makes the next destination resolution reload the clusters
+func (m *appStateManager) invalidateClusters() {
+	m.clustersLock.Lock()
+	defer m.clustersLock.Unlock()
+	m.clustersOutdated = true
+}
+
+// resolveDestination returns the given destination with the server which its name resolves to. Destinations without
+// a name are returned unchanged.
+func (m *appStateManager) resolveDestination(ctx context.Context, dest v1alpha1.ApplicationDestination) (v1alpha1.ApplicationDestination, error) {
+	if dest.Name == "" {
+		return dest, nil
+	}
+	clusters, err := m.getClusters(ctx)
+	if err != nil {
+		return dest, err
+	}
+	dest.Server, err = argo.ResolveDestinationServer(dest, clusters)
+	return dest, err
+}
+
+// ResolveDestinationServer returns the server of the given destination, the name of destinations without a server is
+// resolved using the snapshot of the clusters
+func (m *appStateManager) ResolveDestinationServer(dest v1alpha1.ApplicationDestination) (string, error) {
+	dest, err := m.resolveDestination(context.Background(), dest)
+	return dest.Server, err
+}
+
+// WatchClusters invalidates the clusters used to resolve destination names whenever a cluster changes, until the
+// context is done
+func (m *appStateManager) WatchClusters(ctx context.Context) {
+	util.RetryUntilSucceed(func() error {
+		return m.db.WatchClusters(ctx, func(event *db.ClusterEvent) {
+			log.Debugf("Cluster %s changed, reloading clusters", event.Cluster.Server)
+			m.invalidateClusters()
+		})
+	}, "watch clusters", ctx, clusterWatchRetryTimeout)
+}
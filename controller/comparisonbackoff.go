@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+)
+
+// ComparisonBackoffConfig holds the delays between the attempts to generate the manifests of an application which
+// failed to generate them
+type ComparisonBackoffConfig struct {
+	// InitialDelay is the delay after the first failure, which doubles with every consecutive failure. 0 disables the
+	// backoff.
+	InitialDelay time.Duration
+	// MaxDelay is the maximum delay between two attempts
+	MaxDelay time.Duration
+}
+
+// delay returns the backoff delay after the given number of consecutive failures
+func (c ComparisonBackoffConfig) delay(attempts int) time.Duration {
+	maxDelay := c.MaxDelay
+	if maxDelay < c.InitialDelay {
+		maxDelay = c.InitialDelay
+	}
+	delay := c.InitialDelay
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// comparisonBackoff holds the consecutive failures to generate the manifests of an application source and revision
+type comparisonBackoff struct {
+	source   v1alpha1.ApplicationSource
+	revision string
+	attempts int
+	delay    time.Duration
+	retryAt  time.Time
+	// attemptedAt is the time of the last failed attempt to generate the manifests
+	attemptedAt time.Time
+	err         error
+}
+
+// message returns the message of the comparison error condition, which includes the attempt count and the delay
+func (b *comparisonBackoff) message() string {
+	return fmt.Sprintf("%v (attempt %d, retrying in %v)", b.err, b.attempts, b.delay)
+}
+
+// knownGoodManifests holds the manifests which were last generated successfully for an application source and
+// revision. They are compared with the live state while the manifest generation is backed off.
+type knownGoodManifests struct {
+	source       v1alpha1.ApplicationSource
+	revision     string
+	targetObjs   []*unstructured.Unstructured
+	hooks        []*unstructured.Unstructured
+	manifestInfo *apiclient.ManifestResponse
+}
+
+// getComparisonBackoff returns the backoff of the application if the manifests of the given source and revision
+// failed to generate and the next attempt is not due yet
+func (m *appStateManager) getComparisonBackoff(appName string, source v1alpha1.ApplicationSource, revision string) (*comparisonBackoff, bool) {
+	m.comparisonBackoffLock.Lock()
+	defer m.comparisonBackoffLock.Unlock()
+	backoff, ok := m.comparisonBackoffs[appName]
+	if !ok || backoff.revision != revision || !reflect.DeepEqual(backoff.source, source) || !time.Now().Before(backoff.retryAt) {
+		return nil, false
+	}
+	return backoff, true
+}
+
+// recordComparisonFailure increments the consecutive failures to generate the manifests of the application and
+// returns the updated backoff. Failures of a different source or revision start a new backoff.
+func (m *appStateManager) recordComparisonFailure(appName string, source v1alpha1.ApplicationSource, revision string, err error) *comparisonBackoff {
+	m.comparisonBackoffLock.Lock()
+	defer m.comparisonBackoffLock.Unlock()
+	backoff, ok := m.comparisonBackoffs[appName]
+	if !ok || backoff.revision != revision || !reflect.DeepEqual(backoff.source, source) {
+		backoff = &comparisonBackoff{source: source, revision: revision}
+		m.comparisonBackoffs[appName] = backoff
+	}
+	backoff.attempts++
+	backoff.delay = m.comparisonBackoffConfig.delay(backoff.attempts)
+	backoff.attemptedAt = time.Now()
+	backoff.retryAt = backoff.attemptedAt.Add(backoff.delay)
+	backoff.err = err
+	m.metricsServer.SetComparisonBackoffApps(len(m.comparisonBackoffs))
+	return backoff
+}
+
+// recordComparisonSuccess resets the backoff of the application and remembers the successfully generated manifests
+func (m *appStateManager) recordComparisonSuccess(appName string, source v1alpha1.ApplicationSource, revision string, targetObjs, hooks []*unstructured.Unstructured, manifestInfo *apiclient.ManifestResponse) {
+	m.comparisonBackoffLock.Lock()
+	defer m.comparisonBackoffLock.Unlock()
+	delete(m.comparisonBackoffs, appName)
+	m.metricsServer.SetComparisonBackoffApps(len(m.comparisonBackoffs))
+	m.knownGoodManifests[appName] = &knownGoodManifests{
+		source:       source,
+		revision:     revision,
+		targetObjs:   copyObjs(targetObjs),
+		hooks:        copyObjs(hooks),
+		manifestInfo: manifestInfo,
+	}
+}
+
+// getKnownGoodManifests returns copies of the manifests last generated successfully for the given source and revision
+// of the application
+func (m *appStateManager) getKnownGoodManifests(appName string, source v1alpha1.ApplicationSource, revision string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, *apiclient.ManifestResponse, bool) {
+	m.comparisonBackoffLock.Lock()
+	defer m.comparisonBackoffLock.Unlock()
+	known, ok := m.knownGoodManifests[appName]
+	if !ok || known.revision != revision || !reflect.DeepEqual(known.source, source) {
+		return nil, nil, nil, false
+	}
+	return copyObjs(known.targetObjs), copyObjs(known.hooks), known.manifestInfo, true
+}
+
+// ResetComparisonBackoff resets the backoff of the application, so that its next comparison generates the manifests
+// regardless of previous failures
+func (m *appStateManager) ResetComparisonBackoff(appName string) {
+	m.comparisonBackoffLock.Lock()
+	defer m.comparisonBackoffLock.Unlock()
+	delete(m.comparisonBackoffs, appName)
+	m.metricsServer.SetComparisonBackoffApps(len(m.comparisonBackoffs))
+}
+
+// ForgetComparisonBackoff removes the backoff and the known good manifests of a deleted application
+func (m *appStateManager) ForgetComparisonBackoff(appName string) {
+	m.comparisonBackoffLock.Lock()
+	defer m.comparisonBackoffLock.Unlock()
+	delete(m.comparisonBackoffs, appName)
+	delete(m.knownGoodManifests, appName)
+	m.metricsServer.SetComparisonBackoffApps(len(m.comparisonBackoffs))
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// cachedComparison holds the last comparison result of an application together with the fingerprint of the
+// comparison inputs it was produced from
+type cachedComparison struct {
+	fingerprint string
+	result      *comparisonResult
+	// modificationCount is the modification count of the destination cluster before the live state was loaded, the
+	// result is outdated once any of the compared resources is modified afterwards
+	modificationCount int64
+	// keys are the keys of the target and live resources which were compared
+	keys []kube.ResourceKey
+}
+
+// comparisonInputs are the inputs of a comparison which are hashed into the comparison fingerprint
+type comparisonInputs struct {
+	AppName                 string                               `json:"appName"`
+	Spec                    v1alpha1.ApplicationSpec             `json:"spec"`
+	Sources                 []v1alpha1.ApplicationSource         `json:"sources"`
+	Revisions               []string                             `json:"revisions"`
+	ProjectResourceVersion  string                               `json:"projectResourceVersion"`
+	AppLabelKeys            []string                             `json:"appLabelKeys"`
+	TrackingMethod          kube.TrackingMethod                  `json:"trackingMethod"`
+	TrackingValue           kube.TrackingValue                   `json:"trackingValue"`
+	ResourceOverrides       map[string]v1alpha1.ResourceOverride `json:"resourceOverrides"`
+	SecretRedactionDisabled bool                                 `json:"secretRedactionDisabled"`
+	IgnoredMetadataKeys     []string                             `json:"ignoredMetadataKeys"`
+	MaxResources            int64                                `json:"maxResources"`
+	CompareOptions          string                               `json:"compareOptions"`
+}
+
+// comparisonFingerprint returns a hash of the inputs of the comparison of the given application. The comparison is only
+// cacheable if the revisions of all sources are commit SHAs, since branches and tags can't be resolved without calling
+// the repo server.
+// The live state is not part of the fingerprint, instead the current modification count of the destination cluster
+// cache is returned, which is compared against the modification counts of the compared resources when the result is
+// reused.
+func (m *appStateManager) comparisonFingerprint(app *v1alpha1.Application, proj *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, appLabelKeys []string, trackingMethod kube.TrackingMethod, trackingValue kube.TrackingValue, resourceOverrides map[string]v1alpha1.ResourceOverride, maxResources int64) (string, int64, bool) {
+	resolvedRevisions := make([]string, len(sources))
+	for i, source := range sources {
+		revision := revisions[i]
+		if revision == "" {
+			revision = source.TargetRevision
+		}
+		if source.IsHelm() || !git.IsCommitSHA(revision) {
+			return "", 0, false
+		}
+		resolvedRevisions[i] = revision
+	}
+	modificationCount, err := m.liveStateCache.GetClusterModificationCount(app.Spec.Destination.Server)
+	if err != nil {
+		return "", 0, false
+	}
+	redactionDisabled, err := m.settingsMgr.GetSecretRedactionDisabled()
+	if err != nil {
+		return "", 0, false
+	}
+	ignoredMetadataKeys, err := m.settingsMgr.GetIgnoredMetadataKeys()
+	if err != nil {
+		return "", 0, false
+	}
+	data, err := json.Marshal(&comparisonInputs{
+		AppName:                 app.Name,
+		Spec:                    app.Spec,
+		Sources:                 sources,
+		Revisions:               resolvedRevisions,
+		ProjectResourceVersion:  proj.ResourceVersion,
+		AppLabelKeys:            appLabelKeys,
+		TrackingMethod:          trackingMethod,
+		TrackingValue:           trackingValue,
+		ResourceOverrides:       resourceOverrides,
+		SecretRedactionDisabled: redactionDisabled,
+		IgnoredMetadataKeys:     ignoredMetadataKeys,
+		MaxResources:            maxResources,
+		CompareOptions:          app.Annotations[common.AnnotationCompareOptions],
+	})
+	if err != nil {
+		return "", 0, false
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), modificationCount, true
+}
+
+// getCachedComparison returns the cached comparison result of the application if it was produced from the inputs
+// with the given fingerprint and none of the compared resources, nor any resource of the app, changed since then
+func (m *appStateManager) getCachedComparison(app *v1alpha1.Application, fingerprint string) (*comparisonResult, bool) {
+	m.comparisonResultsLock.RLock()
+	cached, ok := m.comparisonCache[app.Name]
+	m.comparisonResultsLock.RUnlock()
+	if !ok || cached.fingerprint != fingerprint {
+		return nil, false
+	}
+	modificationCount, err := m.liveStateCache.GetResourcesModificationCount(app.Spec.Destination.Server, app.Name, cached.keys)
+	if err != nil || modificationCount > cached.modificationCount {
+		return nil, false
+	}
+	return cached.result, true
+}
+
+func (m *appStateManager) setCachedComparison(appName string, fingerprint string, modificationCount int64, compRes *comparisonResult) {
+	keys := make([]kube.ResourceKey, len(compRes.managedResources))
+	for i, res := range compRes.managedResources {
+		keys[i] = kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+	}
+	m.comparisonResultsLock.Lock()
+	defer m.comparisonResultsLock.Unlock()
+	m.comparisonCache[appName] = &cachedComparison{fingerprint: fingerprint, result: compRes, modificationCount: modificationCount, keys: keys}
+}
+
+// InvalidateComparisonCache removes the cached comparison result of the application, so that the next comparison
+// regenerates the manifests and diffs them against the live state
+func (m *appStateManager) InvalidateComparisonCache(appName string) {
+	m.comparisonResultsLock.Lock()
+	defer m.comparisonResultsLock.Unlock()
+	delete(m.comparisonCache, appName)
+}
@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// maxCachedNormalizers limits the number of normalizers cached per settings snapshot
+const maxCachedNormalizers = 10000
+
+// comparisonSettings holds the settings which are used by every comparison
+type comparisonSettings struct {
+	appLabelKeys        []string
+	trackingMethod      kube.TrackingMethod
+	trackingValue       kube.TrackingValue
+	resourceOverrides   map[string]v1alpha1.ResourceOverride
+	passthroughPatterns []string
+	ignoredMetadataKeys []string
+	resourcesFilter     *settings.ResourcesFilter
+	maxResources        int64
+	loadedAt            time.Time
+	// resolveDestinationServer resolves the destination cluster names of child Application resources when they are
+	// diffed, the clusters are looked up on use, so that clusters added after the settings snapshot are found
+	resolveDestinationServer argo.DestinationServerResolver
+	// normalizers caches the normalizers built from the snapshot by the hash of the application inputs, so they are
+	// dropped together with the snapshot when the settings change
+	normalizers     map[string]*comparisonNormalizers
+	normalizersLock sync.Mutex
+}
+
+// comparisonNormalizers are the normalizers of the resources of an application. None of them is modified after it is
+// created, so they are shared by concurrent comparisons.
+type comparisonNormalizers struct {
+	diffNormalizer         diff.Normalizer
+	passthroughAnnotations *argo.PassthroughAnnotations
+	ignoredChanges         *argo.IgnoredChanges
+}
+
+// normalizerInputs are the inputs of the application which are hashed into the key of its normalizers
+type normalizerInputs struct {
+	IgnoreDifferences      []v1alpha1.ResourceIgnoreDifferences `json:"ignoreDifferences"`
+	PassthroughAnnotations []string                             `json:"passthroughAnnotations"`
+}
+
+// getNormalizers returns the normalizers of the given application, which are built once for each distinct
+// ignoreDifferences and passthroughAnnotations of the applications
+func (cs *comparisonSettings) getNormalizers(app *v1alpha1.Application) (*comparisonNormalizers, error) {
+	data, err := json.Marshal(&normalizerInputs{IgnoreDifferences: app.Spec.IgnoreDifferences, PassthroughAnnotations: app.Spec.PassthroughAnnotations})
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%x", sha256.Sum256(data))
+	cs.normalizersLock.Lock()
+	defer cs.normalizersLock.Unlock()
+	if normalizers, ok := cs.normalizers[key]; ok {
+		return normalizers, nil
+	}
+	normalizers, err := cs.newNormalizers(app)
+	if err != nil {
+		return nil, err
+	}
+	if cs.normalizers == nil || len(cs.normalizers) >= maxCachedNormalizers {
+		cs.normalizers = make(map[string]*comparisonNormalizers)
+	}
+	cs.normalizers[key] = normalizers
+	return normalizers, nil
+}
+
+// newNormalizers creates the normalizers of the application from the snapshot
+func (cs *comparisonSettings) newNormalizers(app *v1alpha1.Application) (*comparisonNormalizers, error) {
+	passthroughAnnotations, err := argo.NewPassthroughAnnotations(append(cs.passthroughPatterns, app.Spec.PassthroughAnnotations...), cs.appLabelKeys...)
+	if err != nil {
+		return nil, err
+	}
+	diffNormalizer, err := argo.NewDiffNormalizerWithResolver(app.Spec.IgnoreDifferences, cs.resourceOverrides, cs.resolveDestinationServer)
+	if err != nil {
+		return nil, err
+	}
+	ignoredChanges, err := argo.NewIgnoredChanges(cs.ignoredMetadataKeys, cs.resourceOverrides, cs.appLabelKeys...)
+	if err != nil {
+		return nil, err
+	}
+	return &comparisonNormalizers{
+		diffNormalizer:         argo.NewCompositeNormalizer(argo.NewKnownTypesNormalizer(), diffNormalizer, passthroughAnnotations),
+		passthroughAnnotations: passthroughAnnotations,
+		ignoredChanges:         ignoredChanges,
+	}, nil
+}
+
+func (m *appStateManager) loadComparisonSettings() (*comparisonSettings, error) {
+	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, err
+	}
+	appLabelKeys, err := m.settingsMgr.GetAppInstanceLabelKeys()
+	if err != nil {
+		return nil, err
+	}
+	trackingMethod, err := m.settingsMgr.GetAppResourceTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	trackingValue, err := m.settingsMgr.GetAppResourceTrackingValue()
+	if err != nil {
+		return nil, err
+	}
+	passthroughPatterns, err := m.settingsMgr.GetPassthroughAnnotations()
+	if err != nil {
+		return nil, err
+	}
+	ignoredMetadataKeys, err := m.settingsMgr.GetIgnoredMetadataKeys()
+	if err != nil {
+		return nil, err
+	}
+	resourcesFilter, err := m.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, err
+	}
+	maxResources, err := m.settingsMgr.GetAppMaxResources()
+	if err != nil {
+		return nil, err
+	}
+	return &comparisonSettings{
+		appLabelKeys:        appLabelKeys,
+		trackingMethod:      trackingMethod,
+		trackingValue:       trackingValue,
+		resourceOverrides:   resourceOverrides,
+		passthroughPatterns: passthroughPatterns,
+		ignoredMetadataKeys: ignoredMetadataKeys,
+		resourcesFilter:     resourcesFilter,
+		maxResources:        maxResources,
+		loadedAt:            time.Now(),
+		// the snapshot of the clusters is only reloaded after a cluster changed, so the resolution of the normalizers of
+		// each comparison doesn't list the clusters
+		resolveDestinationServer: m.ResolveDestinationServer,
+	}, nil
+}
+
+// getComparisonSettings returns the snapshot of the comparison settings, which is reloaded after the settings changed.
+// If the settings fail to load, the last snapshot is returned together with the error, so that a transient failure
+// does not change the comparison results of all applications. The snapshot is nil if it has never been loaded.
+func (m *appStateManager) getComparisonSettings() (*comparisonSettings, error) {
+	m.comparisonSettingsLock.Lock()
+	defer m.comparisonSettingsLock.Unlock()
+	if m.comparisonSettings != nil && !m.comparisonSettingsOutdated {
+		return m.comparisonSettings, nil
+	}
+	loaded, err := m.loadComparisonSettings()
+	if err != nil {
+		return m.comparisonSettings, err
+	}
+	m.comparisonSettings = loaded
+	m.comparisonSettingsOutdated = false
+	return loaded, nil
+}
+
+// invalidateComparisonSettings makes the next comparison reload the settings
+func (m *appStateManager) invalidateComparisonSettings() {
+	m.comparisonSettingsLock.Lock()
+	defer m.comparisonSettingsLock.Unlock()
+	m.comparisonSettingsOutdated = true
+}
+
+// staleSettingsCondition returns the condition of a comparison which used the given snapshot because the settings
+// failed to load
+func staleSettingsCondition(snapshot *comparisonSettings, err error, now metav1.Time) v1alpha1.ApplicationCondition {
+	return v1alpha1.ApplicationCondition{
+		Type:               v1alpha1.ApplicationConditionStaleSettingsWarning,
+		Message:            fmt.Sprintf("Failed to load settings, using the settings loaded %v ago: %v", now.Sub(snapshot.loadedAt).Round(time.Second), err),
+		LastTransitionTime: &now,
+	}
+}
+
+// WatchSettings reloads the comparison settings whenever the settings change, until the context is done
+func (m *appStateManager) WatchSettings(ctx context.Context) {
+	updateCh := make(chan *settings.ArgoCDSettings, 1)
+	m.settingsMgr.Subscribe(updateCh)
+	defer m.settingsMgr.Unsubscribe(updateCh)
+	for {
+		select {
+		case <-updateCh:
+			log.Debug("Settings changed, reloading comparison settings")
+			m.invalidateComparisonSettings()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
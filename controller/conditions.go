@@ -0,0 +1,42 @@
+package controller
+
+import (
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// getReconciliationConditionTypes returns the condition types which are owned by a full reconciliation of an
+// application. The conditions of these types are cleared when the reconciliation starts and reported again by the steps
+// of the reconciliation which still find them to be true, so a condition whose step was skipped (e.g. the comparison
+// of an application with an invalid spec) does not linger.
+func getReconciliationConditionTypes() map[appv1.ApplicationConditionType]bool {
+	types := map[appv1.ApplicationConditionType]bool{
+		appv1.ApplicationConditionReconcilePausedWarning:  true,
+		appv1.ApplicationConditionInvalidSpecError:        true,
+		appv1.ApplicationConditionUnknownError:            true,
+		appv1.ApplicationConditionOrphanedResourceWarning: true,
+		appv1.ApplicationConditionSyncError:               true,
+	}
+	for conditionType := range comparisonConditionTypes {
+		types[conditionType] = true
+	}
+	return types
+}
+
+// preserveConditionTransitionTimes returns the given conditions where every condition which has the same type and
+// message as one of the previous conditions keeps the LastTransitionTime of the previous condition. Conditions are
+// compared with the conditions the application had before it was reconciled, so a condition which is reported again
+// by every reconciliation keeps the time at which it first appeared, even if it was cleared and reported again by
+// different steps of the reconciliation.
+func preserveConditionTransitionTimes(previous []appv1.ApplicationCondition, conditions []appv1.ApplicationCondition) []appv1.ApplicationCondition {
+	result := make([]appv1.ApplicationCondition, len(conditions))
+	for i := range conditions {
+		result[i] = conditions[i]
+		for _, prev := range previous {
+			if prev.Type == conditions[i].Type && prev.Message == conditions[i].Message && prev.LastTransitionTime != nil {
+				result[i].LastTransitionTime = prev.LastTransitionTime.DeepCopy()
+				break
+			}
+		}
+	}
+	return result
+}
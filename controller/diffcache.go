@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// cachedDiffs holds the diff results of the resources of an application from its last comparison
+type cachedDiffs struct {
+	// server and normalizers are the destination cluster and the normalizers the resources were diffed with, the
+	// normalizers are rebuilt whenever the ignored differences or the resource overrides change
+	server      string
+	normalizers *comparisonNormalizers
+	diffs       map[kube.ResourceKey]*cachedDiff
+}
+
+// cachedDiff is the diff result of a resource together with the hash of the target object and the resource version
+// of the live object it was produced from
+type cachedDiff struct {
+	targetHash          string
+	liveResourceVersion string
+	result              diff.DiffResult
+}
+
+func hashTarget(target *unstructured.Unstructured) (string, bool) {
+	data, err := json.Marshal(target)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), true
+}
+
+// diffResources diffs the target and live objects of the application. The diff of a resource is reused from the last
+// comparison if neither the target object nor the resource version of the live object changed and the resource was
+// diffed with the same normalizers. Resources which are missing or not tracked in git are always diffed, since their
+// diff is trivial. The previous diffs are ignored if noCache is set, and they are not replaced by previews.
+func (m *appStateManager) diffResources(appName string, server string, normalizers *comparisonNormalizers, targets []*unstructured.Unstructured, lives []*unstructured.Unstructured, noCache bool, preview bool) (*diff.DiffResultList, error) {
+	if normalizers == nil {
+		return diff.DiffArrayParallel(targets, lives, nil, m.diffParallelism)
+	}
+	var previous *cachedDiffs
+	if !noCache {
+		m.diffCacheLock.Lock()
+		previous = m.diffCache[appName]
+		m.diffCacheLock.Unlock()
+		if previous != nil && (previous.server != server || previous.normalizers != normalizers) {
+			previous = nil
+		}
+	}
+
+	current := &cachedDiffs{server: server, normalizers: normalizers, diffs: make(map[kube.ResourceKey]*cachedDiff)}
+	results := &diff.DiffResultList{Diffs: make([]diff.DiffResult, len(targets))}
+	var diffTargets, diffLives []*unstructured.Unstructured
+	var diffIndexes []int
+	var diffEntries []*cachedDiff
+	var skipped int
+	for i := range targets {
+		target, live := targets[i], lives[i]
+		var key kube.ResourceKey
+		var entry *cachedDiff
+		if target != nil && live != nil && live.GetResourceVersion() != "" {
+			if targetHash, ok := hashTarget(target); ok {
+				key = kube.GetResourceKey(live)
+				entry = &cachedDiff{targetHash: targetHash, liveResourceVersion: live.GetResourceVersion()}
+				current.diffs[key] = entry
+			}
+		}
+		if entry != nil && previous != nil {
+			if prev, ok := previous.diffs[key]; ok && prev.targetHash == entry.targetHash && prev.liveResourceVersion == entry.liveResourceVersion {
+				entry.result = prev.result
+				results.Diffs[i] = prev.result
+				results.Modified = results.Modified || prev.result.Modified
+				skipped++
+				continue
+			}
+		}
+		diffTargets = append(diffTargets, target)
+		diffLives = append(diffLives, live)
+		diffIndexes = append(diffIndexes, i)
+		diffEntries = append(diffEntries, entry)
+	}
+
+	computed, err := diff.DiffArrayParallel(diffTargets, diffLives, normalizers.diffNormalizer, m.diffParallelism)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range diffIndexes {
+		results.Diffs[i] = computed.Diffs[j]
+		results.Modified = results.Modified || computed.Diffs[j].Modified
+		if diffEntries[j] != nil {
+			diffEntries[j].result = computed.Diffs[j]
+		}
+	}
+	m.metricsServer.AddResourceDiffs(len(diffIndexes), skipped)
+
+	if !preview {
+		m.diffCacheLock.Lock()
+		m.diffCache[appName] = current
+		m.diffCacheLock.Unlock()
+	}
+	return results, nil
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/resource"
+)
+
+// driftGracePeriodOption is the compare option which keeps reporting a resource as synced for the given duration after
+// it was synced, e.g. DriftGracePeriod=120s, so that the changes made by operators shortly after the sync don't make
+// the application flap between OutOfSync and Synced
+const driftGracePeriodOption = "DriftGracePeriod"
+
+// getDriftGracePeriod returns the drift grace period of the resource, the option of the target object takes precedence
+func getDriftGracePeriod(targetObj, liveObj *unstructured.Unstructured) time.Duration {
+	for _, obj := range []*unstructured.Unstructured{targetObj, liveObj} {
+		if obj == nil {
+			continue
+		}
+		val, ok := resource.GetAnnotationOptionValue(obj, common.AnnotationCompareOptions, driftGracePeriodOption)
+		if !ok {
+			continue
+		}
+		gracePeriod, err := time.ParseDuration(val)
+		if err != nil || gracePeriod < 0 {
+			log.Warnf("Invalid %s compare option of %s/%s: %s", driftGracePeriodOption, obj.GetKind(), obj.GetName(), val)
+			return 0
+		}
+		return gracePeriod
+	}
+	return 0
+}
+
+// getLastSyncedAt returns the time when the resource was last synced by the operation of the application. Hooks and
+// results of dry-runs are not taken into account.
+func getLastSyncedAt(app *v1alpha1.Application, res v1alpha1.ResourceStatus) (time.Time, bool) {
+	opState := app.Status.OperationState
+	if opState == nil || opState.SyncResult == nil {
+		return time.Time{}, false
+	}
+	for _, r := range opState.SyncResult.Resources {
+		if r.HookType != "" || r.Previewed || r.Status != v1alpha1.ResultCodeSynced ||
+			r.Group != res.Group || r.Kind != res.Kind || r.Namespace != res.Namespace || r.Name != res.Name {
+			continue
+		}
+		if opState.FinishedAt != nil {
+			return opState.FinishedAt.Time, true
+		}
+		return opState.StartedAt.Time, true
+	}
+	return time.Time{}, false
+}
+
+// getDriftGraceDeadline returns the time until which the drift of the resource is reported as pending. A drift is
+// pending only if the resource was synced when the application was last compared and the grace period since the last
+// sync of the resource has not elapsed yet. Missing and extraneous resources never have a pending drift.
+func getDriftGraceDeadline(app *v1alpha1.Application, res v1alpha1.ResourceStatus, targetObj, liveObj *unstructured.Unstructured, now time.Time) (time.Time, bool) {
+	if targetObj == nil || liveObj == nil {
+		return time.Time{}, false
+	}
+	gracePeriod := getDriftGracePeriod(targetObj, liveObj)
+	if gracePeriod == 0 {
+		return time.Time{}, false
+	}
+	wasSynced := false
+	for _, prev := range app.Status.Resources {
+		if prev.Group == res.Group && prev.Kind == res.Kind && prev.Namespace == res.Namespace && prev.Name == res.Name {
+			wasSynced = prev.Status == v1alpha1.SyncStatusCodeSynced
+			break
+		}
+	}
+	if !wasSynced {
+		return time.Time{}, false
+	}
+	syncedAt, ok := getLastSyncedAt(app, res)
+	if !ok {
+		return time.Time{}, false
+	}
+	deadline := syncedAt.Add(gracePeriod)
+	if !now.Before(deadline) {
+		return time.Time{}, false
+	}
+	return deadline, true
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+)
+
+// inflightComparison is a status comparison of an application which is in progress
+type inflightComparison struct {
+	cancel context.CancelFunc
+}
+
+// startComparison registers a status comparison of the application and returns its context, which is derived from the
+// given context and also cancelled if the comparison is superseded. The returned function must be called once the
+// comparison is done.
+func (m *appStateManager) startComparison(ctx context.Context, appName string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	comparison := &inflightComparison{cancel: cancel}
+	m.inflightComparisonsLock.Lock()
+	if m.inflightComparisons[appName] == nil {
+		m.inflightComparisons[appName] = make(map[*inflightComparison]bool)
+	}
+	m.inflightComparisons[appName][comparison] = true
+	m.inflightComparisonsLock.Unlock()
+	return ctx, func() {
+		m.inflightComparisonsLock.Lock()
+		delete(m.inflightComparisons[appName], comparison)
+		if len(m.inflightComparisons[appName]) == 0 {
+			delete(m.inflightComparisons, appName)
+		}
+		m.inflightComparisonsLock.Unlock()
+		cancel()
+	}
+}
+
+// CancelComparisons cancels the in-flight status comparisons of the application, e.g. because the application was
+// deleted or its spec changed. Cancelled comparisons abort without updating the application. Returns true if any
+// comparison was cancelled.
+func (m *appStateManager) CancelComparisons(appName string) bool {
+	m.inflightComparisonsLock.Lock()
+	defer m.inflightComparisonsLock.Unlock()
+	comparisons := m.inflightComparisons[appName]
+	for comparison := range comparisons {
+		comparison.cancel()
+	}
+	delete(m.inflightComparisons, appName)
+	return len(comparisons) > 0
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/util/git"
+)
+
+const defaultManifestCacheSize = 100
+
+var manifestCacheSize = defaultManifestCacheSize
+
+func init() {
+	if sizeStr := os.Getenv(common.EnvControllerManifestCacheSize); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err != nil || size < 0 {
+			log.Warnf("Invalid value in %s env variable: %s, using the default manifest cache size %d", common.EnvControllerManifestCacheSize, sizeStr, defaultManifestCacheSize)
+		} else {
+			manifestCacheSize = size
+		}
+	}
+}
+
+// manifestCache caches the objects generated from immutable revisions (i.e. Git commit SHAs) of application sources,
+// so that comparisons of unchanged revisions don't need to call the repo server
+type manifestCache struct {
+	cache *lru.Cache
+}
+
+type cachedManifests struct {
+	targetObjs   []*unstructured.Unstructured
+	hooks        []*unstructured.Unstructured
+	manifestInfo *apiclient.ManifestResponse
+}
+
+// newManifestCache returns a cache holding up to size manifest sets. The returned cache is disabled if size is 0.
+func newManifestCache(size int) *manifestCache {
+	if size <= 0 {
+		return &manifestCache{}
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &manifestCache{cache: cache}
+}
+
+// manifestCacheKey returns the cache key of the manifests generated for the given request. The key is made of the
+// application name, the repo URL, the revision and a hash of the whole request, which includes the application source.
+// Requests which revision is not a commit SHA, or which source is a Helm chart, are not cacheable.
+func manifestCacheKey(appName string, req *apiclient.ManifestRequest) (string, bool) {
+	if req.ApplicationSource == nil || req.ApplicationSource.IsHelm() || !git.IsCommitSHA(req.Revision) {
+		return "", false
+	}
+	hashed := *req
+	hashed.NoCache = false
+	data, err := json.Marshal(&hashed)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%s|%s|%s|%x", appName, req.ApplicationSource.RepoURL, req.Revision, sha256.Sum256(data)), true
+}
+
+// get returns copies of the cached objects and manifest response, so that callers are free to modify them. The
+// manifests of the cached response are not kept, since the objects generated from them are cached already.
+func (c *manifestCache) get(key string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, *apiclient.ManifestResponse, bool) {
+	if c.cache == nil {
+		return nil, nil, nil, false
+	}
+	val, ok := c.cache.Get(key)
+	if !ok {
+		return nil, nil, nil, false
+	}
+	cached := val.(*cachedManifests)
+	return copyObjs(cached.targetObjs), copyObjs(cached.hooks), cloneManifestInfo(cached.manifestInfo), true
+}
+
+func (c *manifestCache) set(key string, targetObjs, hooks []*unstructured.Unstructured, manifestInfo *apiclient.ManifestResponse) {
+	if c.cache == nil {
+		return
+	}
+	// the manifests are dropped so that an entry doesn't hold them twice, as strings and as objects
+	info := cloneManifestInfo(manifestInfo)
+	info.Manifests = nil
+	c.cache.Add(key, &cachedManifests{targetObjs: copyObjs(targetObjs), hooks: copyObjs(hooks), manifestInfo: info})
+}
+
+func (c *manifestCache) remove(key string) {
+	if c.cache == nil {
+		return
+	}
+	c.cache.Remove(key)
+}
+
+func copyObjs(objs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	res := make([]*unstructured.Unstructured, len(objs))
+	for i := range objs {
+		res[i] = objs[i].DeepCopy()
+	}
+	return res
+}
+
+func cloneManifestInfo(info *apiclient.ManifestResponse) *apiclient.ManifestResponse {
+	if info == nil {
+		return &apiclient.ManifestResponse{}
+	}
+	return proto.Clone(info).(*apiclient.ManifestResponse)
+}
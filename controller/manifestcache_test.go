@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/test"
+)
+
+const fakeCommitSHA = "a8ec0a4a4e0b8a7c7d1b1f3e6a9b1d1b2c3d4e5f"
+
+func TestManifestCacheKey(t *testing.T) {
+	newReq := func(revision string, source v1alpha1.ApplicationSource) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{Revision: revision, ApplicationSource: &source, AppLabelValue: "my-app"}
+	}
+	src := v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"}
+
+	key, ok := manifestCacheKey("my-app", newReq(fakeCommitSHA, src))
+	assert.True(t, ok)
+
+	// hard refresh doesn't change the key
+	noCacheReq := newReq(fakeCommitSHA, src)
+	noCacheReq.NoCache = true
+	noCacheKey, _ := manifestCacheKey("my-app", noCacheReq)
+	assert.Equal(t, key, noCacheKey)
+
+	otherSrc := src
+	otherSrc.Path = "helm-guestbook"
+	otherKey, ok := manifestCacheKey("my-app", newReq(fakeCommitSHA, otherSrc))
+	assert.True(t, ok)
+	assert.NotEqual(t, key, otherKey)
+
+	otherAppKey, ok := manifestCacheKey("other-app", newReq(fakeCommitSHA, src))
+	assert.True(t, ok)
+	assert.NotEqual(t, key, otherAppKey)
+
+	// the manifests are generated again if the APIs of the cluster change, e.g. when a CRD is added
+	apiVersionsReq := newReq(fakeCommitSHA, src)
+	apiVersionsReq.ApiVersions = []string{"networking.k8s.io/v1", "v1"}
+	apiVersionsKey, ok := manifestCacheKey("my-app", apiVersionsReq)
+	assert.True(t, ok)
+	assert.NotEqual(t, key, apiVersionsKey)
+
+	_, ok = manifestCacheKey("my-app", newReq("master", src))
+	assert.False(t, ok)
+	_, ok = manifestCacheKey("my-app", newReq("v1.0.0", src))
+	assert.False(t, ok)
+	_, ok = manifestCacheKey("my-app", newReq("1.2.3", v1alpha1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "my-chart"}))
+	assert.False(t, ok)
+}
+
+func TestManifestCache(t *testing.T) {
+	cache := newManifestCache(1)
+	pod := test.NewPod()
+	manifestInfo := &apiclient.ManifestResponse{Revision: fakeCommitSHA, Manifests: []string{toJSON(t, pod)}}
+
+	cache.set("a", []*unstructured.Unstructured{pod}, nil, manifestInfo)
+	targetObjs, hooks, info, ok := cache.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []*unstructured.Unstructured{pod}, targetObjs)
+	assert.Empty(t, hooks)
+	assert.Equal(t, fakeCommitSHA, info.Revision)
+	// the manifests are cached as objects only
+	assert.Empty(t, info.Manifests)
+	assert.Len(t, manifestInfo.Manifests, 1)
+
+	// cached objects are copies
+	targetObjs[0].SetNamespace("changed")
+	info.Revision = "changed"
+	targetObjs, _, info, _ = cache.get("a")
+	assert.Equal(t, pod.GetNamespace(), targetObjs[0].GetNamespace())
+	assert.Equal(t, fakeCommitSHA, info.Revision)
+
+	// the least recently used entry is evicted
+	cache.set("b", nil, nil, manifestInfo)
+	_, _, _, ok = cache.get("a")
+	assert.False(t, ok)
+
+	cache.remove("b")
+	_, _, _, ok = cache.get("b")
+	assert.False(t, ok)
+
+	disabled := newManifestCache(0)
+	disabled.set("a", []*unstructured.Unstructured{pod}, nil, manifestInfo)
+	_, _, _, ok = disabled.get("a")
+	assert.False(t, ok)
+}
@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"strconv"
 	"time"
@@ -8,8 +10,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/argoproj/argo-cd/controller/sharding"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/git"
@@ -18,16 +22,33 @@ import (
 
 type MetricsServer struct {
 	*http.Server
-	syncCounter             *prometheus.CounterVec
-	k8sRequestCounter       *prometheus.CounterVec
-	kubectlExecCounter      *prometheus.CounterVec
-	kubectlExecPendingGauge *prometheus.GaugeVec
-	reconcileHistogram      *prometheus.HistogramVec
+	syncCounter                *prometheus.CounterVec
+	k8sRequestCounter          *prometheus.CounterVec
+	kubectlExecCounter         *prometheus.CounterVec
+	kubectlExecPendingGauge    *prometheus.GaugeVec
+	reconcileHistogram         *prometheus.HistogramVec
+	manifestCacheCounter       *prometheus.CounterVec
+	comparisonCacheCounter     *prometheus.CounterVec
+	resourceDiffCounter        *prometheus.CounterVec
+	clusterCacheRebuildCounter *prometheus.CounterVec
+	comparisonCounter          *prometheus.CounterVec
+	comparisonQueueDepthGauge  *prometheus.GaugeVec
+	comparisonWaitHistogram    *prometheus.HistogramVec
+	shardClustersGauge         *prometheus.GaugeVec
+	clusterConnectionGauge     *prometheus.GaugeVec
+	comparisonBackoffGauge     prometheus.Gauge
+	manifestRequestHistogram   *prometheus.HistogramVec
+	manifestRequestErrors      *prometheus.CounterVec
+	manifestRequestsInflight   prometheus.Gauge
+	dbRequestHistogram         *prometheus.HistogramVec
+	clusterSharding            *sharding.Sharding
 }
 
 const (
 	// MetricsPath is the endpoint to collect application metrics
 	MetricsPath = "/metrics"
+	// repoLabelLength is the number of hex characters of the repository URL hash used as metric label
+	repoLabelLength = 8
 )
 
 // Follow Prometheus naming practices
@@ -59,12 +80,24 @@ var (
 		append(descAppDefaultLabels, "health_status"),
 		nil,
 	)
+	descShardApps = prometheus.NewDesc(
+		"argocd_controller_shard_apps",
+		"Number of applications processed by the application controller replica.",
+		[]string{"shard"},
+		nil,
+	)
+	descReconcilePausedApps = prometheus.NewDesc(
+		"argocd_app_reconcile_paused_apps",
+		"Number of applications whose reconciliation is paused.",
+		nil,
+		nil,
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
-func NewMetricsServer(addr string, appLister applister.ApplicationLister, healthCheck func() error) *MetricsServer {
+func NewMetricsServer(addr string, appLister applister.ApplicationLister, clusterSharding *sharding.Sharding, healthCheck func() error) *MetricsServer {
 	mux := http.NewServeMux()
-	appRegistry := NewAppRegistry(appLister)
+	appRegistry := NewAppRegistry(appLister, clusterSharding)
 	appRegistry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	appRegistry.MustRegister(prometheus.NewGoCollector())
 	mux.Handle(MetricsPath, promhttp.HandlerFor(appRegistry, promhttp.HandlerOpts{}))
@@ -109,16 +142,121 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, health
 
 	appRegistry.MustRegister(reconcileHistogram)
 
+	manifestCacheCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_app_manifest_cache_total",
+		Help: "Number of lookups of generated manifests in the controller cache.",
+	}, []string{"result"})
+	appRegistry.MustRegister(manifestCacheCounter)
+
+	comparisonCacheCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_app_comparison_cache_total",
+		Help: "Number of lookups of comparison results in the controller cache.",
+	}, []string{"result"})
+	appRegistry.MustRegister(comparisonCacheCounter)
+
+	resourceDiffCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_app_resource_diff_total",
+		Help: "Number of resource diffs which were computed or skipped because neither the target nor the live resource changed.",
+	}, []string{"result"})
+	appRegistry.MustRegister(resourceDiffCounter)
+
+	clusterCacheRebuildCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_cache_rebuild_total",
+		Help: "Number of cluster cache rebuilds caused by cluster settings changes.",
+	}, []string{"server"})
+	appRegistry.MustRegister(clusterCacheRebuildCounter)
+
+	comparisonCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_comparison_total",
+			Help: "Number of application comparisons which were performed or skipped because the refresh interval has not elapsed.",
+		},
+		append(descAppDefaultLabels, "result"),
+	)
+	appRegistry.MustRegister(comparisonCounter)
+
+	comparisonQueueDepthGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_comparison_queue_depth",
+		Help: "Number of application comparisons postponed because of comparison concurrency limits.",
+	}, []string{"bucket"})
+	appRegistry.MustRegister(comparisonQueueDepthGauge)
+
+	comparisonWaitHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_app_comparison_wait_seconds",
+		Help:    "Time application comparisons waited because of comparison concurrency limits.",
+		Buckets: []float64{0.5, 1, 2, 4, 8, 16, 32, 64},
+	}, []string{"bucket"})
+	appRegistry.MustRegister(comparisonWaitHistogram)
+
+	shardClustersGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_controller_shard_clusters",
+		Help: "Number of clusters processed by the application controller replica.",
+	}, []string{"shard"})
+	appRegistry.MustRegister(shardClustersGauge)
+
+	clusterConnectionGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_connection_status",
+		Help: "Connection status of the clusters processed by the application controller replica: 1 if the last attempt to sync or watch the cluster succeeded, 0 otherwise.",
+	}, []string{"server"})
+	appRegistry.MustRegister(clusterConnectionGauge)
+
+	comparisonBackoffGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "argocd_app_comparison_backoff_apps",
+		Help: "Number of applications which manifest generation is backed off after consecutive failures.",
+	})
+	appRegistry.MustRegister(comparisonBackoffGauge)
+
+	manifestRequestHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_repo_server_manifest_request_duration_seconds",
+		Help:    "Duration of the manifest generation requests of the controller to the repo server.",
+		Buckets: []float64{0.1, 0.25, .5, 1, 2, 4, 8, 16, 32, 64},
+	}, []string{"repo"})
+	appRegistry.MustRegister(manifestRequestHistogram)
+
+	manifestRequestErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_repo_server_manifest_request_errors_total",
+		Help: "Number of failed manifest generation requests of the controller to the repo server.",
+	}, []string{"grpc_code"})
+	appRegistry.MustRegister(manifestRequestErrors)
+
+	manifestRequestsInflight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "argocd_repo_server_manifest_requests_inflight",
+		Help: "Number of manifest generation requests of the controller to the repo server which are in progress.",
+	})
+	appRegistry.MustRegister(manifestRequestsInflight)
+
+	dbRequestHistogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "argocd_controller_db_request_duration_seconds",
+		Help:    "Duration of the settings database requests which precede the manifest generation of applications.",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, .5, 1},
+	}, []string{"request"})
+	appRegistry.MustRegister(dbRequestHistogram)
+
 	return &MetricsServer{
 		Server: &http.Server{
 			Addr:    addr,
 			Handler: mux,
 		},
-		syncCounter:             syncCounter,
-		k8sRequestCounter:       k8sRequestCounter,
-		reconcileHistogram:      reconcileHistogram,
-		kubectlExecCounter:      kubectlExecCounter,
-		kubectlExecPendingGauge: kubectlExecPendingGauge,
+		syncCounter:                syncCounter,
+		k8sRequestCounter:          k8sRequestCounter,
+		reconcileHistogram:         reconcileHistogram,
+		kubectlExecCounter:         kubectlExecCounter,
+		kubectlExecPendingGauge:    kubectlExecPendingGauge,
+		manifestCacheCounter:       manifestCacheCounter,
+		comparisonCacheCounter:     comparisonCacheCounter,
+		resourceDiffCounter:        resourceDiffCounter,
+		clusterCacheRebuildCounter: clusterCacheRebuildCounter,
+		comparisonCounter:          comparisonCounter,
+		comparisonQueueDepthGauge:  comparisonQueueDepthGauge,
+		comparisonWaitHistogram:    comparisonWaitHistogram,
+		shardClustersGauge:         shardClustersGauge,
+		clusterConnectionGauge:     clusterConnectionGauge,
+		comparisonBackoffGauge:     comparisonBackoffGauge,
+		manifestRequestHistogram:   manifestRequestHistogram,
+		manifestRequestErrors:      manifestRequestErrors,
+		manifestRequestsInflight:   manifestRequestsInflight,
+		dbRequestHistogram:         dbRequestHistogram,
+		clusterSharding:            clusterSharding,
 	}
 }
 
@@ -152,21 +290,78 @@ func (m *MetricsServer) DecKubectlExecPending(command string) {
 	m.kubectlExecPendingGauge.WithLabelValues(command).Dec()
 }
 
+// IncManifestCacheHit increments the counter of lookups which found the generated manifests in the controller cache
+func (m *MetricsServer) IncManifestCacheHit() {
+	m.manifestCacheCounter.WithLabelValues("hit").Inc()
+}
+
+// IncManifestCacheMiss increments the counter of lookups which didn't find the generated manifests in the controller cache
+func (m *MetricsServer) IncManifestCacheMiss() {
+	m.manifestCacheCounter.WithLabelValues("miss").Inc()
+}
+
+// IncComparisonCacheHit increments the counter of lookups which found an up-to-date comparison result in the controller cache
+func (m *MetricsServer) IncComparisonCacheHit() {
+	m.comparisonCacheCounter.WithLabelValues("hit").Inc()
+}
+
+// IncComparisonCacheMiss increments the counter of lookups which didn't find an up-to-date comparison result in the controller cache
+func (m *MetricsServer) IncComparisonCacheMiss() {
+	m.comparisonCacheCounter.WithLabelValues("miss").Inc()
+}
+
+// AddResourceDiffs increments the counters of the resource diffs which were computed or reused from the previous comparison
+func (m *MetricsServer) AddResourceDiffs(computed int, skipped int) {
+	m.resourceDiffCounter.WithLabelValues("computed").Add(float64(computed))
+	m.resourceDiffCounter.WithLabelValues("skipped").Add(float64(skipped))
+}
+
+// IncClusterCacheRebuild increments the counter of cache rebuilds of the given cluster
+func (m *MetricsServer) IncClusterCacheRebuild(server string) {
+	m.clusterCacheRebuildCounter.WithLabelValues(server).Inc()
+}
+
+// IncComparison increments the counter of performed or skipped comparisons of an application
+func (m *MetricsServer) IncComparison(app *argoappv1.Application, performed bool) {
+	result := "skipped"
+	if performed {
+		result = "performed"
+	}
+	m.comparisonCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), result).Inc()
+}
+
+// SetComparisonQueueDepth sets the number of postponed comparisons of the given cost bucket
+func (m *MetricsServer) SetComparisonQueueDepth(bucket string, depth int) {
+	m.comparisonQueueDepthGauge.WithLabelValues(bucket).Set(float64(depth))
+}
+
+// ObserveComparisonWait records the time a comparison of the given cost bucket waited for a free comparison slot
+func (m *MetricsServer) ObserveComparisonWait(bucket string, duration time.Duration) {
+	m.comparisonWaitHistogram.WithLabelValues(bucket).Observe(duration.Seconds())
+}
+
+// SetShardClusters sets the number of clusters processed by the application controller replica
+func (m *MetricsServer) SetShardClusters(count int) {
+	m.shardClustersGauge.WithLabelValues(strconv.Itoa(m.clusterSharding.GetShard())).Set(float64(count))
+}
+
 type appCollector struct {
-	store applister.ApplicationLister
+	store           applister.ApplicationLister
+	clusterSharding *sharding.Sharding
 }
 
-// NewAppCollector returns a prometheus collector for application metrics
-func NewAppCollector(appLister applister.ApplicationLister) prometheus.Collector {
+// NewAppCollector returns a prometheus collector for metrics of applications processed by the controller replica
+func NewAppCollector(appLister applister.ApplicationLister, clusterSharding *sharding.Sharding) prometheus.Collector {
 	return &appCollector{
-		store: appLister,
+		store:           appLister,
+		clusterSharding: clusterSharding,
 	}
 }
 
 // NewAppRegistry creates a new prometheus registry that collects applications
-func NewAppRegistry(appLister applister.ApplicationLister) *prometheus.Registry {
+func NewAppRegistry(appLister applister.ApplicationLister, clusterSharding *sharding.Sharding) *prometheus.Registry {
 	registry := prometheus.NewRegistry()
-	registry.MustRegister(NewAppCollector(appLister))
+	registry.MustRegister(NewAppCollector(appLister, clusterSharding))
 	return registry
 }
 
@@ -176,6 +371,8 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descAppCreated
 	ch <- descAppSyncStatusCode
 	ch <- descAppHealthStatus
+	ch <- descShardApps
+	ch <- descReconcilePausedApps
 }
 
 // Collect implements the prometheus.Collector interface
@@ -185,9 +382,20 @@ func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
 		log.Warnf("Failed to collect applications: %v", err)
 		return
 	}
+	ownedApps := 0
+	pausedApps := 0
 	for _, app := range apps {
+		if !c.clusterSharding.IsAppOwned(app) {
+			continue
+		}
+		ownedApps++
+		if app.IsReconcilePaused() {
+			pausedApps++
+		}
 		collectApps(ch, app)
 	}
+	ch <- prometheus.MustNewConstMetric(descShardApps, prometheus.GaugeValue, float64(ownedApps), strconv.Itoa(c.clusterSharding.GetShard()))
+	ch <- prometheus.MustNewConstMetric(descReconcilePausedApps, prometheus.GaugeValue, float64(pausedApps))
 }
 
 func boolFloat64(b bool) float64 {
@@ -224,3 +432,56 @@ func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusDegraded), argoappv1.HealthStatusDegraded)
 	addGauge(descAppHealthStatus, boolFloat64(healthStatus == argoappv1.HealthStatusMissing), argoappv1.HealthStatusMissing)
 }
+
+// SetClusterConnectionStatus sets the connection status of the given cluster
+func (m *MetricsServer) SetClusterConnectionStatus(server string, status argoappv1.ConnectionStatus) {
+	value := 0.0
+	if status == argoappv1.ConnectionStatusSuccessful {
+		value = 1
+	}
+	m.clusterConnectionGauge.WithLabelValues(server).Set(value)
+}
+
+// DeleteClusterConnectionStatus removes the connection status of the given cluster once the cluster is removed
+func (m *MetricsServer) DeleteClusterConnectionStatus(server string) {
+	m.clusterConnectionGauge.DeleteLabelValues(server)
+}
+
+// SetComparisonBackoffApps sets the number of applications which manifest generation is backed off
+func (m *MetricsServer) SetComparisonBackoffApps(count int) {
+	m.comparisonBackoffGauge.Set(float64(count))
+}
+
+// ObserveManifestRequest records the duration of a manifest generation request for the given repository, and counts
+// the request by its gRPC status code if it failed
+func (m *MetricsServer) ObserveManifestRequest(repoURL string, duration time.Duration, err error) {
+	m.manifestRequestHistogram.WithLabelValues(repoLabel(repoURL)).Observe(duration.Seconds())
+	if err != nil {
+		m.manifestRequestErrors.WithLabelValues(status.Code(err).String()).Inc()
+	}
+}
+
+// IncManifestRequestsInflight increments the number of manifest generation requests in progress
+func (m *MetricsServer) IncManifestRequestsInflight() {
+	m.manifestRequestsInflight.Inc()
+}
+
+// DecManifestRequestsInflight decrements the number of manifest generation requests in progress
+func (m *MetricsServer) DecManifestRequestsInflight() {
+	m.manifestRequestsInflight.Dec()
+}
+
+// ObserveDBRequest records the duration of the given settings database request
+func (m *MetricsServer) ObserveDBRequest(request string, duration time.Duration) {
+	m.dbRequestHistogram.WithLabelValues(request).Observe(duration.Seconds())
+}
+
+// repoLabel returns a short hash of the normalized repository URL, so the label neither exposes credentials which
+// might be part of the URL nor has unbounded length
+func repoLabel(repoURL string) string {
+	if repoURL == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(git.NormalizeGitURL(repoURL)))
+	return hex.EncodeToString(sum[:])[:repoLabelLength]
+}
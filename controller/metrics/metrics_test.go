@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -11,14 +12,20 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/controller/sharding"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	appinformer "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
 	applister "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/reposerver/apiclient/mocks"
 )
 
 const fakeApp = `
@@ -137,7 +144,7 @@ func newFakeLister(fakeApp ...string) (context.CancelFunc, applister.Application
 func testApp(t *testing.T, fakeApp string, expectedResponse string) {
 	cancel, appLister := newFakeLister(fakeApp)
 	defer cancel()
-	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
 	req, err := http.NewRequest("GET", "/metrics", nil)
 	assert.NoError(t, err)
 	rr := httptest.NewRecorder()
@@ -180,7 +187,7 @@ argocd_app_sync_total{name="my-app",namespace="argocd",phase="Succeeded",project
 func TestMetricsSyncCounter(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
-	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
 
 	fakeApp := newFakeApp(fakeApp)
 	metricsServ.IncSync(fakeApp, &argoappv1.OperationState{Phase: argoappv1.OperationRunning})
@@ -221,7 +228,7 @@ argocd_app_reconcile_count{name="my-app",namespace="argocd",project="important-p
 func TestReconcileMetrics(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
-	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
 
 	fakeApp := newFakeApp(fakeApp)
 	metricsServ.IncReconcile(fakeApp, 5*time.Second)
@@ -235,3 +242,268 @@ func TestReconcileMetrics(t *testing.T) {
 	log.Println(body)
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
+
+const manifestCacheMetrics = `
+# HELP argocd_app_manifest_cache_total Number of lookups of generated manifests in the controller cache.
+# TYPE argocd_app_manifest_cache_total counter
+argocd_app_manifest_cache_total{result="hit"} 2
+argocd_app_manifest_cache_total{result="miss"} 1
+`
+
+func TestManifestCacheMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.IncManifestCacheMiss()
+	metricsServ.IncManifestCacheHit()
+	metricsServ.IncManifestCacheHit()
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, manifestCacheMetrics, rr.Body.String())
+}
+
+const comparisonCacheMetrics = `
+# HELP argocd_app_comparison_cache_total Number of lookups of comparison results in the controller cache.
+# TYPE argocd_app_comparison_cache_total counter
+argocd_app_comparison_cache_total{result="hit"} 1
+argocd_app_comparison_cache_total{result="miss"} 2
+`
+
+func TestComparisonCacheMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.IncComparisonCacheMiss()
+	metricsServ.IncComparisonCacheMiss()
+	metricsServ.IncComparisonCacheHit()
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, comparisonCacheMetrics, rr.Body.String())
+}
+
+const resourceDiffMetrics = `
+# HELP argocd_app_resource_diff_total Number of resource diffs which were computed or skipped because neither the target nor the live resource changed.
+# TYPE argocd_app_resource_diff_total counter
+argocd_app_resource_diff_total{result="computed"} 3
+argocd_app_resource_diff_total{result="skipped"} 5
+`
+
+func TestResourceDiffMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.AddResourceDiffs(3, 0)
+	metricsServ.AddResourceDiffs(0, 5)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, resourceDiffMetrics, rr.Body.String())
+}
+
+const comparisonSchedulerMetrics = `
+# HELP argocd_app_comparison_queue_depth Number of application comparisons postponed because of comparison concurrency limits.
+# TYPE argocd_app_comparison_queue_depth gauge
+argocd_app_comparison_queue_depth{bucket="heavy"} 3
+argocd_app_comparison_queue_depth{bucket="light"} 0
+# HELP argocd_app_comparison_wait_seconds Time application comparisons waited because of comparison concurrency limits.
+# TYPE argocd_app_comparison_wait_seconds histogram
+argocd_app_comparison_wait_seconds_bucket{bucket="heavy",le="0.5"} 0
+argocd_app_comparison_wait_seconds_bucket{bucket="heavy",le="1"} 0
+argocd_app_comparison_wait_seconds_bucket{bucket="heavy",le="2"} 0
+argocd_app_comparison_wait_seconds_bucket{bucket="heavy",le="4"} 1
+`
+
+func TestComparisonSchedulerMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.SetComparisonQueueDepth("heavy", 3)
+	metricsServ.SetComparisonQueueDepth("light", 0)
+	metricsServ.ObserveComparisonWait("heavy", 3*time.Second)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, comparisonSchedulerMetrics, rr.Body.String())
+}
+
+const clusterCacheRebuildMetrics = `
+# HELP argocd_cluster_cache_rebuild_total Number of cluster cache rebuilds caused by cluster settings changes.
+# TYPE argocd_cluster_cache_rebuild_total counter
+argocd_cluster_cache_rebuild_total{server="https://localhost:6443"} 2
+`
+
+func TestClusterCacheRebuildMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.IncClusterCacheRebuild("https://localhost:6443")
+	metricsServ.IncClusterCacheRebuild("https://localhost:6443")
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, clusterCacheRebuildMetrics, rr.Body.String())
+}
+
+const clusterConnectionMetrics = `
+# HELP argocd_cluster_connection_status Connection status of the clusters processed by the application controller replica: 1 if the last attempt to sync or watch the cluster succeeded, 0 otherwise.
+# TYPE argocd_cluster_connection_status gauge
+argocd_cluster_connection_status{server="https://localhost:6443"} 1
+argocd_cluster_connection_status{server="https://localhost:6444"} 0
+`
+
+func TestClusterConnectionMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.SetClusterConnectionStatus("https://localhost:6443", argoappv1.ConnectionStatusSuccessful)
+	metricsServ.SetClusterConnectionStatus("https://localhost:6444", argoappv1.ConnectionStatusFailed)
+	metricsServ.SetClusterConnectionStatus("https://localhost:6445", argoappv1.ConnectionStatusFailed)
+	metricsServ.DeleteClusterConnectionStatus("https://localhost:6445")
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, clusterConnectionMetrics, body)
+	assert.NotContains(t, body, "https://localhost:6445")
+}
+
+const appComparisonMetrics = `
+# HELP argocd_app_comparison_total Number of application comparisons which were performed or skipped because the refresh interval has not elapsed.
+# TYPE argocd_app_comparison_total counter
+argocd_app_comparison_total{name="my-app",namespace="argocd",project="important-project",result="performed"} 1
+argocd_app_comparison_total{name="my-app",namespace="argocd",project="important-project",result="skipped"} 2
+`
+
+func TestComparisonMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.IncComparison(fakeApp, true)
+	metricsServ.IncComparison(fakeApp, false)
+	metricsServ.IncComparison(fakeApp, false)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, appComparisonMetrics, rr.Body.String())
+}
+
+func TestShardMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister(fakeApp)
+	defer cancel()
+	ownerShard := sharding.GetShardByServer("https://localhost:6443", 2)
+	for _, shard := range []int{0, 1} {
+		clusterSharding, err := sharding.NewSharding(2, shard)
+		assert.NoError(t, err)
+		metricsServ := NewMetricsServer("localhost:8082", appLister, clusterSharding, noOpHealthCheck)
+		metricsServ.SetShardClusters(3)
+
+		req, err := http.NewRequest("GET", "/metrics", nil)
+		assert.NoError(t, err)
+		rr := httptest.NewRecorder()
+		metricsServ.Handler.ServeHTTP(rr, req)
+		assert.Equal(t, rr.Code, http.StatusOK)
+		body := rr.Body.String()
+		assert.Contains(t, body, fmt.Sprintf(`argocd_controller_shard_clusters{shard="%d"} 3`, shard))
+		if shard == ownerShard {
+			assert.Contains(t, body, fmt.Sprintf(`argocd_controller_shard_apps{shard="%d"} 1`, shard))
+			assert.Contains(t, body, `argocd_app_info{`)
+		} else {
+			assert.Contains(t, body, fmt.Sprintf(`argocd_controller_shard_apps{shard="%d"} 0`, shard))
+			assert.NotContains(t, body, `argocd_app_info{`)
+		}
+	}
+}
+
+const comparisonBackoffMetrics = `
+# HELP argocd_app_comparison_backoff_apps Number of applications which manifest generation is backed off after consecutive failures.
+# TYPE argocd_app_comparison_backoff_apps gauge
+argocd_app_comparison_backoff_apps 3
+`
+
+func TestReconcilePausedMetrics(t *testing.T) {
+	pausedApp := strings.Replace(fakeApp, "  namespace: argocd\n", "  namespace: argocd\n  annotations:\n    argocd.argoproj.io/reconcile: paused\n", 1)
+	testApp(t, fakeApp, "argocd_app_reconcile_paused_apps 0")
+	testApp(t, pausedApp, "argocd_app_reconcile_paused_apps 1")
+}
+
+func TestComparisonBackoffMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	metricsServ.SetComparisonBackoffApps(3)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, comparisonBackoffMetrics, rr.Body.String())
+}
+
+func TestRepoServerMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, nil, noOpHealthCheck)
+
+	repoClient := &repomocks.RepoServerServiceClient{}
+	repoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	clientset := &repomocks.Clientset{}
+	clientset.On("NewRepoServerClient").Return(&fakeCloser{}, repoClient, nil)
+
+	_, client, err := AddRepoServerMetricsWrapper(metricsServ, clientset).NewRepoServerClient()
+	assert.NoError(t, err)
+	req := &apiclient.ManifestRequest{Repo: &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}}
+	_, err = client.GenerateManifest(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	metricsServ.ObserveDBRequest("GetRepository", 10*time.Millisecond)
+
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	// the label is the same for equivalent repo URLs
+	repo := repoLabel("https://github.com/argoproj/argocd-example-apps")
+	assert.Len(t, repo, repoLabelLength)
+	assert.Contains(t, body, fmt.Sprintf(`argocd_repo_server_manifest_request_duration_seconds_count{repo="%s"} 1`, repo))
+	assert.Contains(t, body, `argocd_repo_server_manifest_request_errors_total{grpc_code="Unavailable"} 1`)
+	assert.Contains(t, body, `argocd_repo_server_manifest_requests_inflight 0`)
+	assert.Contains(t, body, `argocd_controller_db_request_duration_seconds_count{request="GetRepository"} 1`)
+}
+
+type fakeCloser struct{}
+
+func (c *fakeCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/util"
+)
+
+type metricsRepoServerClientset struct {
+	clientset     apiclient.Clientset
+	metricsServer *MetricsServer
+}
+
+func (c *metricsRepoServerClientset) NewRepoServerClient() (util.Closer, apiclient.RepoServerServiceClient, error) {
+	conn, client, err := c.clientset.NewRepoServerClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, &metricsRepoServerClient{RepoServerServiceClient: client, metricsServer: c.metricsServer}, nil
+}
+
+// AddRepoServerMetricsWrapper wraps the repo server clientset so the manifest requests of its clients are recorded by
+// the 'argocd_repo_server_manifest_request*' metrics. The context of a manifest stream must be cancelled once the stream
+// is no longer received from.
+func AddRepoServerMetricsWrapper(server *MetricsServer, clientset apiclient.Clientset) apiclient.Clientset {
+	return &metricsRepoServerClientset{clientset: clientset, metricsServer: server}
+}
+
+type metricsRepoServerClient struct {
+	apiclient.RepoServerServiceClient
+	metricsServer *MetricsServer
+}
+
+func (c *metricsRepoServerClient) GenerateManifest(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
+	done := c.startManifestRequest(in)
+	res, err := c.RepoServerServiceClient.GenerateManifest(ctx, in, opts...)
+	done(err)
+	return res, err
+}
+
+func (c *metricsRepoServerClient) GenerateManifestStream(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error) {
+	done := c.startManifestRequest(in)
+	stream, err := c.RepoServerServiceClient.GenerateManifestStream(ctx, in, opts...)
+	if err != nil {
+		done(err)
+		return nil, err
+	}
+	metricsStream := &metricsManifestStream{RepoServerService_GenerateManifestStreamClient: stream, done: done}
+	// the stream might be abandoned before it ends, in which case the request completes once its context is done
+	go func() {
+		<-ctx.Done()
+		metricsStream.finish(ctx.Err())
+	}()
+	return metricsStream, nil
+}
+
+// startManifestRequest records the start of a manifest request and returns the function which records its completion
+func (c *metricsRepoServerClient) startManifestRequest(in *apiclient.ManifestRequest) func(err error) {
+	repoURL := ""
+	if in.Repo != nil {
+		repoURL = in.Repo.Repo
+	}
+	start := time.Now()
+	c.metricsServer.IncManifestRequestsInflight()
+	return func(err error) {
+		c.metricsServer.DecManifestRequestsInflight()
+		c.metricsServer.ObserveManifestRequest(repoURL, time.Since(start), err)
+	}
+}
+
+type metricsManifestStream struct {
+	apiclient.RepoServerService_GenerateManifestStreamClient
+	done func(err error)
+	once sync.Once
+}
+
+func (s *metricsManifestStream) Recv() (*apiclient.ManifestResponseChunk, error) {
+	chunk, err := s.RepoServerService_GenerateManifestStreamClient.Recv()
+	if err == io.EOF {
+		s.finish(nil)
+	} else if err != nil {
+		s.finish(err)
+	}
+	return chunk, err
+}
+
+// finish records the completion of the manifest request when the stream ends for the first time
+func (s *metricsManifestStream) finish(err error) {
+	s.once.Do(func() {
+		s.done(err)
+	})
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// ignoreOrphanedResourcesOption is the compare option which disables the orphaned resources monitoring of an
+// application, e.g. of an application deployed into a namespace which is shared with resources managed by other tools
+const ignoreOrphanedResourcesOption = "IgnoreOrphanedResources"
+
+// isOrphanedResourcesMonitoringEnabled returns whether the orphaned resources of the application are monitored: the
+// project of the application must enable the monitoring and the application must not opt out of it
+func isOrphanedResourcesMonitoringEnabled(app *v1alpha1.Application, proj *v1alpha1.AppProject) bool {
+	return proj.Spec.OrphanedResources != nil && app.Spec.Destination.Namespace != "" && !hasCompareOption(app, ignoreOrphanedResourcesOption+"=true")
+}
+
+// getOrphanedResources returns the resources of the destination namespace of the application which are neither managed
+// by the application nor referenced by any other application, sorted by group, kind and name. Resources which are not
+// permitted by the project or are excluded in the settings are not reported.
+func (m *appStateManager) getOrphanedResources(app *v1alpha1.Application, proj *v1alpha1.AppProject, cs *comparisonSettings, managedResources []managedResource) ([]v1alpha1.OrphanedResource, error) {
+	if !isOrphanedResourcesMonitoringEnabled(app, proj) {
+		return nil, nil
+	}
+	nodes, err := m.liveStateCache.GetNamespaceUnmanagedResources(app.Spec.Destination.Server, app.Spec.Destination.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range managedResources {
+		ns := util.FirstNonEmpty(res.Namespace, app.Spec.Destination.Namespace)
+		delete(nodes, kube.NewResourceKey(res.Group, res.Kind, ns, res.Name))
+	}
+	var orphanedResources []v1alpha1.OrphanedResource
+	for key, node := range nodes {
+		if !proj.IsResourcePermitted(metav1.GroupKind{Group: key.Group, Kind: key.Kind}, true) || isKnownOrphanedResourceExclusion(key) ||
+			cs.resourcesFilter.IsExcludedResource(key.Group, key.Kind, app.Spec.Destination.Server) {
+			continue
+		}
+		orphanedResources = append(orphanedResources, v1alpha1.OrphanedResource{
+			Group:     key.Group,
+			Kind:      key.Kind,
+			Name:      key.Name,
+			CreatedAt: node.CreatedAt,
+		})
+	}
+	sort.Slice(orphanedResources, func(i, j int) bool {
+		a, b := orphanedResources[i], orphanedResources[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return orphanedResources, nil
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/controller/metrics"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// comparisonBucketLight holds the comparisons of applications with less resources than the heavy app threshold
+	comparisonBucketLight = "light"
+	// comparisonBucketHeavy holds the comparisons of applications with at least as many resources as the heavy app threshold
+	comparisonBucketHeavy = "heavy"
+)
+
+// comparisonPostponeDelay is the delay after which a comparison postponed because of the concurrency limits is retried
+var comparisonPostponeDelay = 1 * time.Second
+
+// ComparisonSchedulerConfig holds the concurrency limits of application comparisons
+type ComparisonSchedulerConfig struct {
+	// HeavyAppResources is the number of resources from which the comparison of an application is considered heavy
+	HeavyAppResources int
+	// HeavyComparisonLimit is the maximum number of concurrent heavy comparisons. 0 means no limit.
+	HeavyComparisonLimit int
+	// ClusterComparisonLimit is the maximum number of concurrent comparisons of applications deployed to the same
+	// cluster. 0 means no limit.
+	ClusterComparisonLimit int
+}
+
+// comparisonScheduler limits the number of concurrent comparisons, so that a few big applications or a slow cluster
+// cannot occupy all status processors. The cost of a comparison is estimated using the number of resources found by
+// the last comparison of the application. Comparisons which exceed a limit are postponed instead of waiting for a free
+// slot, so the status processor can compare other applications meanwhile.
+type comparisonScheduler struct {
+	config        ComparisonSchedulerConfig
+	metricsServer *metrics.MetricsServer
+	// getAppServer returns the server of the destination cluster of an application, which might only have a name
+	getAppServer func(app *appv1.Application) string
+
+	lock         sync.Mutex
+	heavyRunning int
+	// clusterRunning holds the number of running comparisons per destination cluster
+	clusterRunning map[string]int
+	// postponed holds the postponed comparisons by application key
+	postponed map[string]*postponedComparison
+}
+
+type postponedComparison struct {
+	bucket string
+	since  time.Time
+}
+
+// newComparisonScheduler returns a comparison scheduler with the given limits. The applications are limited by the
+// destination server if getAppServer is nil.
+func newComparisonScheduler(config ComparisonSchedulerConfig, metricsServer *metrics.MetricsServer, getAppServer func(app *appv1.Application) string) *comparisonScheduler {
+	if getAppServer == nil {
+		getAppServer = func(app *appv1.Application) string {
+			return app.Spec.Destination.Server
+		}
+	}
+	return &comparisonScheduler{
+		config:         config,
+		metricsServer:  metricsServer,
+		getAppServer:   getAppServer,
+		clusterRunning: make(map[string]int),
+		postponed:      make(map[string]*postponedComparison),
+	}
+}
+
+// reserveLightWorkers lowers the heavy comparison limit, so that at least one of the given status processors is
+// always available for light comparisons
+func (s *comparisonScheduler) reserveLightWorkers(statusProcessors int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.config.HeavyComparisonLimit > 0 && s.config.HeavyComparisonLimit >= statusProcessors && statusProcessors > 1 {
+		s.config.HeavyComparisonLimit = statusProcessors - 1
+	}
+}
+
+func (s *comparisonScheduler) getBucket(app *appv1.Application) string {
+	if s.config.HeavyAppResources > 0 && len(app.Status.Resources) >= s.config.HeavyAppResources {
+		return comparisonBucketHeavy
+	}
+	return comparisonBucketLight
+}
+
+// tryAcquire reserves a comparison slot for the application. The returned function releases the slot. False is
+// returned if the comparison exceeds a limit and has to be postponed.
+func (s *comparisonScheduler) tryAcquire(appKey string, app *appv1.Application) (func(), bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	bucket := s.getBucket(app)
+	server := s.getAppServer(app)
+	heavy := bucket == comparisonBucketHeavy
+	if heavy && s.config.HeavyComparisonLimit > 0 && s.heavyRunning >= s.config.HeavyComparisonLimit ||
+		s.config.ClusterComparisonLimit > 0 && s.clusterRunning[server] >= s.config.ClusterComparisonLimit {
+		if _, ok := s.postponed[appKey]; !ok {
+			s.postponed[appKey] = &postponedComparison{bucket: bucket, since: time.Now()}
+			s.updateQueueDepth()
+		}
+		return nil, false
+	}
+
+	wait := time.Duration(0)
+	if postponed, ok := s.postponed[appKey]; ok {
+		wait = time.Since(postponed.since)
+		delete(s.postponed, appKey)
+		s.updateQueueDepth()
+	}
+	if s.metricsServer != nil {
+		s.metricsServer.ObserveComparisonWait(bucket, wait)
+	}
+	if heavy {
+		s.heavyRunning++
+	}
+	s.clusterRunning[server]++
+	return func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if heavy {
+			s.heavyRunning--
+		}
+		if s.clusterRunning[server]--; s.clusterRunning[server] <= 0 {
+			delete(s.clusterRunning, server)
+		}
+	}, true
+}
+
+// forget removes the postponed comparison of a deleted application
+func (s *comparisonScheduler) forget(appKey string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.postponed[appKey]; ok {
+		delete(s.postponed, appKey)
+		s.updateQueueDepth()
+	}
+}
+
+// updateQueueDepth reports the number of postponed comparisons per bucket. Must be called with the lock held.
+func (s *comparisonScheduler) updateQueueDepth() {
+	if s.metricsServer == nil {
+		return
+	}
+	depth := map[string]int{comparisonBucketLight: 0, comparisonBucketHeavy: 0}
+	for _, postponed := range s.postponed {
+		depth[postponed.bucket]++
+	}
+	for bucket, count := range depth {
+		s.metricsServer.SetComparisonQueueDepth(bucket, count)
+	}
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/test"
+)
+
+func newSchedulerTestApp(name string, server string, resources int) *appv1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Spec.Destination.Server = server
+	app.Status.Resources = make([]appv1.ResourceStatus, resources)
+	return app
+}
+
+func TestComparisonSchedulerHeavyLimit(t *testing.T) {
+	scheduler := newComparisonScheduler(ComparisonSchedulerConfig{HeavyAppResources: 10, HeavyComparisonLimit: 1}, nil, nil)
+	heavy1 := newSchedulerTestApp("heavy-1", test.FakeClusterURL, 10)
+	heavy2 := newSchedulerTestApp("heavy-2", test.FakeClusterURL, 20)
+	light := newSchedulerTestApp("light", test.FakeClusterURL, 9)
+
+	release, ok := scheduler.tryAcquire("argocd/heavy-1", heavy1)
+	assert.True(t, ok)
+	_, ok = scheduler.tryAcquire("argocd/heavy-2", heavy2)
+	assert.False(t, ok)
+	assert.Equal(t, comparisonBucketHeavy, scheduler.postponed["argocd/heavy-2"].bucket)
+
+	// light apps are not limited
+	releaseLight, ok := scheduler.tryAcquire("argocd/light", light)
+	assert.True(t, ok)
+	releaseLight()
+
+	release()
+	release, ok = scheduler.tryAcquire("argocd/heavy-2", heavy2)
+	assert.True(t, ok)
+	assert.Empty(t, scheduler.postponed)
+	release()
+	assert.Equal(t, 0, scheduler.heavyRunning)
+	assert.Empty(t, scheduler.clusterRunning)
+}
+
+func TestComparisonSchedulerClusterLimit(t *testing.T) {
+	scheduler := newComparisonScheduler(ComparisonSchedulerConfig{ClusterComparisonLimit: 1}, nil, nil)
+
+	release, ok := scheduler.tryAcquire("argocd/app-1", newSchedulerTestApp("app-1", "https://cluster-1", 1))
+	assert.True(t, ok)
+	_, ok = scheduler.tryAcquire("argocd/app-2", newSchedulerTestApp("app-2", "https://cluster-1", 1))
+	assert.False(t, ok)
+	releaseOther, ok := scheduler.tryAcquire("argocd/app-3", newSchedulerTestApp("app-3", "https://cluster-2", 1))
+	assert.True(t, ok)
+	releaseOther()
+	release()
+
+	_, ok = scheduler.tryAcquire("argocd/app-2", newSchedulerTestApp("app-2", "https://cluster-1", 1))
+	assert.True(t, ok)
+}
+
+func TestComparisonSchedulerNoLimits(t *testing.T) {
+	scheduler := newComparisonScheduler(ComparisonSchedulerConfig{HeavyAppResources: 1}, nil, nil)
+	for i := 0; i < 10; i++ {
+		_, ok := scheduler.tryAcquire("argocd/app", newSchedulerTestApp("app", test.FakeClusterURL, 100))
+		assert.True(t, ok)
+	}
+	assert.Equal(t, 10, scheduler.heavyRunning)
+}
+
+func TestComparisonSchedulerReserveLightWorkers(t *testing.T) {
+	scheduler := newComparisonScheduler(ComparisonSchedulerConfig{HeavyComparisonLimit: 10}, nil, nil)
+	scheduler.reserveLightWorkers(4)
+	assert.Equal(t, 3, scheduler.config.HeavyComparisonLimit)
+	scheduler.reserveLightWorkers(20)
+	assert.Equal(t, 3, scheduler.config.HeavyComparisonLimit)
+
+	// a single status processor cannot be reserved
+	scheduler = newComparisonScheduler(ComparisonSchedulerConfig{HeavyComparisonLimit: 1}, nil, nil)
+	scheduler.reserveLightWorkers(1)
+	assert.Equal(t, 1, scheduler.config.HeavyComparisonLimit)
+}
+
+func TestComparisonSchedulerForget(t *testing.T) {
+	scheduler := newComparisonScheduler(ComparisonSchedulerConfig{ClusterComparisonLimit: 1}, nil, nil)
+	_, ok := scheduler.tryAcquire("argocd/app-1", newSchedulerTestApp("app-1", test.FakeClusterURL, 1))
+	assert.True(t, ok)
+	_, ok = scheduler.tryAcquire("argocd/app-2", newSchedulerTestApp("app-2", test.FakeClusterURL, 1))
+	assert.False(t, ok)
+	scheduler.forget("argocd/app-2")
+	assert.Empty(t, scheduler.postponed)
+}
+
+func TestProcessAppRefreshQueueItemPostponed(t *testing.T) {
+	app := newFakeApp()
+	app.Status.Resources = make([]appv1.ResourceStatus, 10)
+	now := metav1.Now()
+	app.Status.ReconciledAt = &now
+	ctrl := newFakeController(&fakeData{
+		apps:                      []runtime.Object{app, &defaultProj},
+		comparisonSchedulerConfig: ComparisonSchedulerConfig{HeavyAppResources: 10, HeavyComparisonLimit: 1},
+	})
+	appKey := app.Namespace + "/" + app.Name
+	release, ok := ctrl.comparisonScheduler.tryAcquire("other", app)
+	assert.True(t, ok)
+	defer release()
+
+	ctrl.requestAppRefresh(app.Name, CompareWithRecent)
+	ctrl.appRefreshQueue.Add(appKey)
+	assert.True(t, ctrl.processAppRefreshQueueItem(context.Background()))
+
+	// the refresh is still requested and the app is postponed
+	requested, level := ctrl.isRefreshRequested(app.Name)
+	assert.True(t, requested)
+	assert.Equal(t, CompareWithRecent, level)
+	assert.Contains(t, ctrl.comparisonScheduler.postponed, appKey)
+}
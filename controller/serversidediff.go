@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller/metrics"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/resource"
+)
+
+// serverSideDiffOption is the compare option which enables the server-side diff of the resources of an application if
+// the application has the ServerSideDiff=true option, and forces the client-side diff of a resource which has the
+// ServerSideDiff=false option
+const serverSideDiffOption = "ServerSideDiff"
+
+// serverPopulatedMetadataFields are the metadata fields which the API server sets on the result of a dry-run apply,
+// they are removed from the predicted objects unless the target objects set them as well
+var serverPopulatedMetadataFields = []string{"managedFields", "resourceVersion", "generation", "uid", "selfLink", "creationTimestamp"}
+
+// isServerSideDiffEnabled returns whether the application has the ServerSideDiff=true compare option
+func isServerSideDiffEnabled(app *v1alpha1.Application) bool {
+	return hasCompareOption(app, serverSideDiffOption+"=true")
+}
+
+// isServerSideDiffDisabled returns whether the target or the live object has the ServerSideDiff=false compare option
+func isServerSideDiffDisabled(targetObj, liveObj *unstructured.Unstructured) bool {
+	for _, obj := range []*unstructured.Unstructured{targetObj, liveObj} {
+		if obj == nil {
+			continue
+		}
+		if val, ok := resource.GetAnnotationOptionValue(obj, common.AnnotationCompareOptions, serverSideDiffOption); ok {
+			return val == "false"
+		}
+	}
+	return false
+}
+
+// getServerSideDiffTargets returns the objects which the live objects are diffed against: the target objects as they
+// are predicted by a server-side dry-run apply. Target objects which don't exist in the cluster, secrets, and resources
+// which force the client-side diff are returned unchanged. The target objects of failed dry-runs (e.g. because an
+// admission webhook is unreachable) are returned unchanged as well and reported by the returned condition.
+func (m *appStateManager) getServerSideDiffTargets(app *v1alpha1.Application, cluster *v1alpha1.Cluster, targetObjs, liveObjs []*unstructured.Unstructured, now metav1.Time) ([]*unstructured.Unstructured, []v1alpha1.ApplicationCondition) {
+	diffTargets := make([]*unstructured.Unstructured, len(targetObjs))
+	copy(diffTargets, targetObjs)
+	config := metrics.AddMetricsTransportWrapper(m.metricsServer, app, cluster.RESTConfig())
+	var failures []string
+	for i, targetObj := range targetObjs {
+		liveObj := liveObjs[i]
+		if targetObj == nil || liveObj == nil || isSecret(targetObj) || isServerSideDiffDisabled(targetObj, liveObj) {
+			continue
+		}
+		namespace := util.FirstNonEmpty(targetObj.GetNamespace(), app.Spec.Destination.Namespace)
+		predicted, err := m.kubectl.ServerSideDryRunApply(config, targetObj, namespace)
+		if err != nil {
+			log.WithField("application", app.Name).Warnf("Failed to perform the server-side dry-run of %s/%s: %v", targetObj.GetKind(), targetObj.GetName(), err)
+			failures = append(failures, fmt.Sprintf("%s %s: %v", targetObj.GetKind(), targetObj.GetName(), err))
+			continue
+		}
+		removeServerPopulatedFields(predicted, targetObj)
+		diffTargets[i] = predicted
+	}
+	if len(failures) == 0 {
+		return diffTargets, nil
+	}
+	sort.Strings(failures)
+	return diffTargets, []v1alpha1.ApplicationCondition{{
+		Type:               v1alpha1.ApplicationConditionServerSideDiffWarning,
+		Message:            fmt.Sprintf("Server-side diff failed, the following resources were diffed client-side: %s", strings.Join(failures, ", ")),
+		LastTransitionTime: &now,
+	}}
+}
+
+// removeServerPopulatedFields removes the metadata fields and the status which the API server populates from the
+// predicted object, unless they are set by the target object
+func removeServerPopulatedFields(predicted, targetObj *unstructured.Unstructured) {
+	for _, field := range serverPopulatedMetadataFields {
+		if _, ok, _ := unstructured.NestedFieldNoCopy(targetObj.Object, "metadata", field); !ok {
+			unstructured.RemoveNestedField(predicted.Object, "metadata", field)
+		}
+	}
+	if _, ok := targetObj.Object["status"]; !ok {
+		delete(predicted.Object, "status")
+	}
+}
@@ -0,0 +1,130 @@
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// ServerResolver returns the server URL of the cluster which the given destination refers to
+type ServerResolver func(dest appv1.ApplicationDestination) (string, error)
+
+// Sharding describes which clusters are processed by the application controller replica. Each cluster is owned by
+// exactly one replica: the one which ordinal is equal to the hash of the cluster server URL modulo the number of replicas.
+type Sharding struct {
+	// Replicas is the total number of application controller replicas
+	Replicas int
+	// Shard is the ordinal of the current replica
+	Shard int
+	// resolveServer resolves the destination names of applications, so that they are owned by the replica which owns
+	// the named cluster
+	resolveServer ServerResolver
+}
+
+// NewSharding returns the sharding of the replica with the given ordinal
+func NewSharding(replicas int, shard int) (*Sharding, error) {
+	if replicas < 1 {
+		return nil, fmt.Errorf("number of replicas must be positive but was %d", replicas)
+	}
+	if shard < 0 || shard >= replicas {
+		return nil, fmt.Errorf("shard %d is out of range of %d replicas", shard, replicas)
+	}
+	return &Sharding{Replicas: replicas, Shard: shard}, nil
+}
+
+// GetSharding returns the sharding of the current replica. The number of replicas is taken from the
+// ARGOCD_CONTROLLER_REPLICAS env variable and the ordinal of the replica is taken from the ARGOCD_CONTROLLER_SHARD env
+// variable or from the ordinal suffix of the hostname (e.g. argocd-application-controller-2) if the variable is not set.
+func GetSharding() (*Sharding, error) {
+	replicas := 1
+	if replicasStr := os.Getenv(common.EnvControllerReplicas); replicasStr != "" {
+		val, err := strconv.Atoi(replicasStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in %s env variable: %s", common.EnvControllerReplicas, replicasStr)
+		}
+		replicas = val
+	}
+	if replicas == 1 {
+		return NewSharding(1, 0)
+	}
+	if shardStr := os.Getenv(common.EnvControllerShard); shardStr != "" {
+		shard, err := strconv.Atoi(shardStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in %s env variable: %s", common.EnvControllerShard, shardStr)
+		}
+		return NewSharding(replicas, shard)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	shard, err := getShardFromHostname(hostname)
+	if err != nil {
+		return nil, err
+	}
+	return NewSharding(replicas, shard)
+}
+
+// getShardFromHostname parses the ordinal of the StatefulSet pod with the given hostname
+func getShardFromHostname(hostname string) (int, error) {
+	index := strings.LastIndex(hostname, "-")
+	if index < 0 {
+		return 0, fmt.Errorf("hostname %s does not have an ordinal suffix, set %s env variable", hostname, common.EnvControllerShard)
+	}
+	shard, err := strconv.Atoi(hostname[index+1:])
+	if err != nil {
+		return 0, fmt.Errorf("hostname %s does not have an ordinal suffix, set %s env variable", hostname, common.EnvControllerShard)
+	}
+	return shard, nil
+}
+
+// GetShardByServer returns the ordinal of the replica which owns the cluster with the given server URL
+func GetShardByServer(server string, replicas int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(server))
+	return int(h.Sum32() % uint32(replicas))
+}
+
+// IsClusterOwned returns true if the cluster with the given server URL is processed by the current replica.
+// Nil sharding owns all clusters.
+func (s *Sharding) IsClusterOwned(server string) bool {
+	if s == nil || s.Replicas <= 1 {
+		return true
+	}
+	return GetShardByServer(server, s.Replicas) == s.Shard
+}
+
+// SetServerResolver sets the resolver of the destination names of applications
+func (s *Sharding) SetServerResolver(resolveServer ServerResolver) {
+	if s != nil {
+		s.resolveServer = resolveServer
+	}
+}
+
+// IsAppOwned returns true if the destination cluster of the given application is processed by the current replica.
+// Destination names which fail to resolve are left unresolved, the comparison of the application reports the failure.
+func (s *Sharding) IsAppOwned(app *appv1.Application) bool {
+	if s == nil || s.Replicas <= 1 {
+		return true
+	}
+	server := app.Spec.Destination.Server
+	if server == "" && app.Spec.Destination.Name != "" && s.resolveServer != nil {
+		if resolved, err := s.resolveServer(app.Spec.Destination); err == nil {
+			server = resolved
+		}
+	}
+	return s.IsClusterOwned(server)
+}
+
+// GetShard returns the ordinal of the current replica
+func (s *Sharding) GetShard() int {
+	if s == nil {
+		return 0
+	}
+	return s.Shard
+}
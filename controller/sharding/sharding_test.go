@@ -0,0 +1,105 @@
+package sharding
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/common"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestGetShardFromHostname(t *testing.T) {
+	shard, err := getShardFromHostname("argocd-application-controller-2")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, shard)
+
+	_, err = getShardFromHostname("argocd-application-controller-5d8c7f9b4-x2k4z")
+	assert.Error(t, err)
+
+	_, err = getShardFromHostname("localhost")
+	assert.Error(t, err)
+}
+
+func TestGetSharding(t *testing.T) {
+	defer func() {
+		_ = os.Unsetenv(common.EnvControllerReplicas)
+		_ = os.Unsetenv(common.EnvControllerShard)
+	}()
+
+	clusterSharding, err := GetSharding()
+	assert.NoError(t, err)
+	assert.Equal(t, &Sharding{Replicas: 1, Shard: 0}, clusterSharding)
+
+	_ = os.Setenv(common.EnvControllerReplicas, "3")
+	_ = os.Setenv(common.EnvControllerShard, "2")
+	clusterSharding, err = GetSharding()
+	assert.NoError(t, err)
+	assert.Equal(t, &Sharding{Replicas: 3, Shard: 2}, clusterSharding)
+
+	_ = os.Setenv(common.EnvControllerShard, "3")
+	_, err = GetSharding()
+	assert.Error(t, err)
+
+	_ = os.Setenv(common.EnvControllerReplicas, "abc")
+	_, err = GetSharding()
+	assert.Error(t, err)
+}
+
+func TestIsClusterOwned(t *testing.T) {
+	servers := []string{"https://kubernetes.default.svc", "https://cluster-1", "https://cluster-2", "https://cluster-3"}
+	var shards []*Sharding
+	for i := 0; i < 3; i++ {
+		clusterSharding, err := NewSharding(3, i)
+		assert.NoError(t, err)
+		shards = append(shards, clusterSharding)
+	}
+	for _, server := range servers {
+		owners := 0
+		for _, clusterSharding := range shards {
+			if clusterSharding.IsClusterOwned(server) {
+				owners++
+			}
+		}
+		assert.Equal(t, 1, owners, server)
+	}
+
+	var noSharding *Sharding
+	assert.True(t, noSharding.IsAppOwned(&appv1.Application{Spec: appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: "https://cluster-1"}}}))
+}
+
+func TestIsAppOwnedByDestinationName(t *testing.T) {
+	server := "https://cluster-1"
+	owner, err := NewSharding(3, GetShardByServer(server, 3))
+	assert.NoError(t, err)
+	other, err := NewSharding(3, (GetShardByServer(server, 3)+1)%3)
+	assert.NoError(t, err)
+	resolveServer := func(dest appv1.ApplicationDestination) (string, error) {
+		if dest.Name == "cluster-1" {
+			return server, nil
+		}
+		return "", fmt.Errorf("cluster %s not found", dest.Name)
+	}
+	owner.SetServerResolver(resolveServer)
+	other.SetServerResolver(resolveServer)
+
+	// the app is owned by the replica of the named cluster
+	app := &appv1.Application{Spec: appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Name: "cluster-1"}}}
+	assert.True(t, owner.IsAppOwned(app))
+	assert.False(t, other.IsAppOwned(app))
+
+	// unknown names are owned by a single replica, which reports the failure
+	unknown := &appv1.Application{Spec: appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Name: "unknown"}}}
+	owners := 0
+	for shard := 0; shard < 3; shard++ {
+		clusterSharding, err := NewSharding(3, shard)
+		assert.NoError(t, err)
+		clusterSharding.SetServerResolver(resolveServer)
+		if clusterSharding.IsAppOwned(unknown) {
+			owners++
+		}
+	}
+	assert.Equal(t, 1, owners)
+}
@@ -2,11 +2,22 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,21 +30,33 @@ import (
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/health"
 	hookutil "github.com/argoproj/argo-cd/util/hook"
 	kubeutil "github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/lua"
 	"github.com/argoproj/argo-cd/util/resource"
 	"github.com/argoproj/argo-cd/util/resource/ignore"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
+// persistRevisionHistoryAttempts is the number of attempts to persist the revision history if the application is updated concurrently
+const persistRevisionHistoryAttempts = 5
+
+// maxModifiedFields is the maximum number of modified fields which are reported in the status of an OutOfSync resource
+const maxModifiedFields = 20
+
 type managedResource struct {
-	Target    *unstructured.Unstructured
+	Target *unstructured.Unstructured
+	// Predicted is the target object as predicted by the server-side dry-run which the live object was diffed against,
+	// it is only set if the resource was diffed server-side
+	Predicted *unstructured.Unstructured
 	Live      *unstructured.Unstructured
 	Diff      diff.DiffResult
 	Group     string
@@ -54,16 +77,29 @@ func GetLiveObjs(res []managedResource) []*unstructured.Unstructured {
 
 type ResourceInfoProvider interface {
 	IsNamespaced(server string, gk schema.GroupKind) (bool, error)
+	IsKnownGroupKind(server string, gk schema.GroupKind) (bool, error)
 }
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, noCache bool, localObjects []string) *comparisonResult
-	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
+	CompareAppState(ctx context.Context, app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, localObjects []string) *comparisonResult
+	PreviewAppState(ctx context.Context, app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource) *comparisonResult
+	SyncAppState(ctx context.Context, app *v1alpha1.Application, state *v1alpha1.OperationState, reportProgress OperationProgressReporter)
+	GetResourceDiff(app *v1alpha1.Application, key kubeutil.ResourceKey) (string, error)
+	InvalidateComparisonCache(appName string)
+	ResetComparisonBackoff(appName string)
+	ForgetComparisonBackoff(appName string)
+	WatchSettings(ctx context.Context)
+	WatchClusters(ctx context.Context)
+	ResolveDestinationServer(dest v1alpha1.ApplicationDestination) (string, error)
+	CancelComparisons(appName string) bool
 }
 
 type comparisonResult struct {
-	reconciledAt     metav1.Time
+	reconciledAt metav1.Time
+	// attemptedAt is the time of the manifest generation attempt whose result is reported, which precedes reconciledAt
+	// if the manifest generation is backed off
+	attemptedAt      metav1.Time
 	syncStatus       *v1alpha1.SyncStatus
 	healthStatus     *v1alpha1.HealthStatus
 	resources        []v1alpha1.ResourceStatus
@@ -71,6 +107,24 @@ type comparisonResult struct {
 	hooks            []*unstructured.Unstructured
 	diffNormalizer   diff.Normalizer
 	appSourceType    v1alpha1.ApplicationSourceType
+	// passthroughAnnotations matches annotations of live resources which must be preserved on sync
+	passthroughAnnotations *argo.PassthroughAnnotations
+	// resourceOverrides holds the configured resource overrides merged with the health scripts of the application source
+	resourceOverrides map[string]v1alpha1.ResourceOverride
+	// signatureError is set if the revision is not signed with a key allowed by the project
+	signatureError error
+	// resourceNodes holds the nodes of the managed resources and their children collected during the comparison
+	resourceNodes []v1alpha1.ResourceNode
+	// orphanedResources holds the resources of the destination namespace which are not managed by any application
+	orphanedResources []v1alpha1.OrphanedResource
+	// conditions holds the conditions reported by the comparison, which are restored if the result is reused
+	conditions []v1alpha1.ApplicationCondition
+	// summary holds the images and external URLs of the managed resources and their children
+	summary v1alpha1.ApplicationSummary
+	// driftGraceDeadline is the earliest time when the drift grace period of a resource with a pending drift elapses
+	driftGraceDeadline time.Time
+	// cancelled is true if the comparison was cancelled before it completed, the application is not updated then
+	cancelled bool
 }
 
 func (cr *comparisonResult) targetObjs() []*unstructured.Unstructured {
@@ -94,14 +148,60 @@ type appStateManager struct {
 	repoClientset  apiclient.Clientset
 	liveStateCache statecache.LiveStateCache
 	namespace      string
+	manifestCache  *manifestCache
+	// comparisonResults holds the last comparison result of each application
+	comparisonResults map[string]*comparisonResult
+	// comparisonCache holds the last cacheable comparison result of each application with the fingerprint of its inputs
+	comparisonCache       map[string]*cachedComparison
+	comparisonResultsLock sync.RWMutex
+	// diffCache holds the diff results of the resources of each application from its last comparison
+	diffCache     map[string]*cachedDiffs
+	diffCacheLock sync.Mutex
+	// comparisonBackoffs holds the consecutive failures to generate the manifests of each application
+	comparisonBackoffs map[string]*comparisonBackoff
+	// knownGoodManifests holds the manifests last generated successfully for each application while backoff is enabled
+	knownGoodManifests      map[string]*knownGoodManifests
+	comparisonBackoffConfig ComparisonBackoffConfig
+	comparisonBackoffLock   sync.Mutex
+	// comparisonSettings is the snapshot of the settings used by the comparisons, it is reloaded when it is outdated
+	comparisonSettings         *comparisonSettings
+	comparisonSettingsOutdated bool
+	comparisonSettingsLock     sync.Mutex
+	// inflightComparisons holds the status comparisons of each application which are in progress
+	inflightComparisons     map[string]map[*inflightComparison]bool
+	inflightComparisonsLock sync.Mutex
+	// diffParallelism is the number of goroutines which diff the resources of an application, any value less than 1
+	// means the number of CPUs
+	diffParallelism int
+	// clusters is the snapshot of the clusters used to resolve destination names, it is reloaded when it is outdated
+	clusters         []v1alpha1.Cluster
+	clustersOutdated bool
+	clustersLock     sync.Mutex
+	// tracer traces the phases of comparisons
+	tracer Tracer
 }
 
-func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	helmRepos, err := m.db.ListHelmRepositories(context.Background())
+// getRepoObjs generates the manifests of the application source. Only the Helm repositories permitted by the project
+// are made available to the repo server, so no Helm repository is passed if the project is nil. The manifest generation
+// is aborted if the given context is cancelled.
+func (m *appStateManager) getRepoObjs(ctx context.Context, app *v1alpha1.Application, proj *v1alpha1.AppProject, source v1alpha1.ApplicationSource, appLabelKey string, trackingMethod kubeutil.TrackingMethod, trackingValue kubeutil.TrackingValue, revision string, noCache, verifySignature bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+	start := time.Now()
+	allHelmRepos, err := m.db.ListHelmRepositories(ctx)
+	m.observeDBRequest("ListHelmRepositories", start)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
+	helmRepos := make([]*appv1.Repository, 0)
+	if proj != nil {
+		for _, repo := range allHelmRepos {
+			if proj.IsSourcePermitted(appv1.ApplicationSource{RepoURL: repo.Repo}) {
+				helmRepos = append(helmRepos, repo)
+			}
+		}
+	}
+	start = time.Now()
+	repo, err := m.db.GetRepository(ctx, source.RepoURL)
+	m.observeDBRequest("GetRepository", start)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -129,96 +229,497 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	cluster, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	serverVersion, err := m.liveStateCache.GetServerVersion(app.Spec.Destination.Server)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	cluster.ServerVersion, err = m.kubectl.GetServerVersion(cluster.RESTConfig())
+	apiVersions, err := m.liveStateCache.GetAPIVersions(app.Spec.Destination.Server)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+	var env v1alpha1.Env
+	if source.Plugin != nil {
+		env = v1alpha1.Env{
+			{Name: common.EnvAppName, Value: app.Name},
+			{Name: common.EnvAppNamespace, Value: app.Spec.Destination.Namespace},
+			{Name: common.EnvAppSourceRepoURL, Value: source.RepoURL},
+			{Name: common.EnvAppSourceTargetRevision, Value: source.TargetRevision},
+		}
+		if err := validatePluginEnv(append(env, source.Plugin.Env...)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	req := &apiclient.ManifestRequest{
 		Repo:              repo,
 		Repos:             helmRepos,
 		Revision:          revision,
 		NoCache:           noCache,
 		AppLabelKey:       appLabelKey,
-		AppLabelValue:     app.Name,
+		AppLabelValue:     trackingValue.Format(app.Name),
+		AppName:           app.Name,
 		Namespace:         app.Spec.Destination.Namespace,
 		ApplicationSource: &source,
 		Plugins:           tools,
 		KustomizeOptions: &appv1.KustomizeOptions{
 			BuildOptions: buildOptions,
 		},
-		KubeVersion: cluster.ServerVersion,
-	})
-	if err != nil {
-		return nil, nil, nil, err
+		KubeVersion:     serverVersion,
+		ApiVersions:     apiVersions,
+		VerifySignature: verifySignature,
+		Env:             env,
+		TrackingMethod:  string(trackingMethod),
+	}
+
+	cacheKey, cacheable := manifestCacheKey(app.Name, req)
+	if cacheable {
+		if noCache {
+			m.manifestCache.remove(cacheKey)
+		} else if targetObjs, hooks, manifestInfo, ok := m.manifestCache.get(cacheKey); ok {
+			m.metricsServer.IncManifestCacheHit()
+			return targetObjs, hooks, manifestInfo, nil
+		} else {
+			m.metricsServer.IncManifestCacheMiss()
+		}
+	}
+
+	objs := newManifestObjs()
+	manifestInfo, err := receiveManifests(ctx, repoClient, req, objs)
+	if status.Code(err) == codes.Unimplemented {
+		// the repo server doesn't support manifest streaming yet
+		objs = newManifestObjs()
+		manifestInfo, err = repoClient.GenerateManifest(ctx, req)
+		if err == nil {
+			err = objs.add(manifestInfo.Manifests)
+		}
 	}
-	targetObjs, hooks, err := unmarshalManifests(manifestInfo.Manifests)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	return targetObjs, hooks, manifestInfo, nil
+
+	// branches and tags are cached using the commit SHA they resolved to
+	resolvedReq := *req
+	resolvedReq.Revision = manifestInfo.Revision
+	if cacheKey, cacheable := manifestCacheKey(app.Name, &resolvedReq); cacheable {
+		m.manifestCache.set(cacheKey, objs.targetObjs, objs.hooks, manifestInfo)
+	}
+	return objs.targetObjs, objs.hooks, manifestInfo, nil
 }
 
-func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+// getRepoObjsOfSources generates the manifests of each source of an application with multiple sources and returns the
+// target objects and hooks of all sources in the order of the sources, together with the manifest response of each
+// source. The revisions are the revisions of the sources at the same positions. The origin of the target objects is
+// qualified with their source, so that resources duplicated across sources can be told apart.
+func (m *appStateManager) getRepoObjsOfSources(ctx context.Context, app *v1alpha1.Application, proj *v1alpha1.AppProject, sources []v1alpha1.ApplicationSource, revisions []string, appLabelKey string, trackingMethod kubeutil.TrackingMethod, trackingValue kubeutil.TrackingValue, noCache, verifySignature bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	targetObjs := make([]*unstructured.Unstructured, 0)
 	hooks := make([]*unstructured.Unstructured, 0)
+	manifestInfos := make([]*apiclient.ManifestResponse, len(sources))
+	for i, source := range sources {
+		sourceObjs, sourceHooks, manifestInfo, err := m.getRepoObjs(ctx, app, proj, source, appLabelKey, trackingMethod, trackingValue, revisions[i], noCache, verifySignature)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("Failed to generate the manifests of source %s: %v", source.RepoURL, err)
+		}
+		for _, obj := range sourceObjs {
+			if origin, ok := obj.GetAnnotations()[common.AnnotationManifestOrigin]; ok {
+				setManifestOrigin(obj, fmt.Sprintf("%s of source %s", origin, source.RepoURL))
+			}
+		}
+		targetObjs = append(targetObjs, sourceObjs...)
+		hooks = append(hooks, sourceHooks...)
+		manifestInfos[i] = manifestInfo
+	}
+	return targetObjs, hooks, manifestInfos, nil
+}
+
+// observeDBRequest records the duration of a settings database request which started at the given time
+func (m *appStateManager) observeDBRequest(request string, start time.Time) {
+	if m.metricsServer != nil {
+		m.metricsServer.ObserveDBRequest(request, time.Since(start))
+	}
+}
+
+// receiveManifests generates manifests using the streaming manifest RPC and adds them to the given objects as they are
+// received. The returned manifest response holds the response metadata without manifests.
+func receiveManifests(ctx context.Context, repoClient apiclient.RepoServerServiceClient, req *apiclient.ManifestRequest, objs *manifestObjs) (*apiclient.ManifestResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := repoClient.GenerateManifestStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var metadata *apiclient.ManifestResponse
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, withAttemptedRevision(err, stream.Trailer())
+		}
+		if err := objs.add(chunk.Manifests); err != nil {
+			return nil, err
+		}
+		if chunk.Metadata != nil {
+			metadata = chunk.Metadata
+		}
+	}
+	if metadata == nil {
+		return nil, fmt.Errorf("manifest stream ended without response metadata")
+	}
+	return metadata, nil
+}
+
+// manifestGenerationError is the error of a manifest generation which failed after the repo server resolved the revision
+type manifestGenerationError struct {
+	revision string
+	err      error
+}
+
+func (e *manifestGenerationError) Error() string {
+	return fmt.Sprintf("Failed to generate the manifests of revision %s: %v", e.revision, e.err)
+}
+
+// GRPCStatus returns the status of the repo server error, so that its code is preserved
+func (e *manifestGenerationError) GRPCStatus() *status.Status {
+	return status.Convert(e.err)
+}
+
+// withAttemptedRevision returns the given manifest generation error with the revision which the repo server resolved
+// before it failed, if the repo server reported the revision in the trailer of the request
+func withAttemptedRevision(err error, trailer metadata.MD) error {
+	if revisions := trailer.Get(apiclient.RevisionTrailerKey); len(revisions) > 0 && revisions[0] != "" {
+		return &manifestGenerationError{revision: revisions[0], err: err}
+	}
+	return err
+}
+
+// getAttemptedRevision returns the revision whose manifests failed to generate with the given error. The given
+// previous revision is returned if the revision could not be resolved, e.g. because the repository is unreachable.
+func getAttemptedRevision(err error, previousRevision string) string {
+	if genErr, ok := err.(*manifestGenerationError); ok {
+		return genErr.revision
+	}
+	return previousRevision
+}
+
+// manifestObjs holds the target objects and hooks of generated manifests
+type manifestObjs struct {
+	targetObjs []*unstructured.Unstructured
+	hooks      []*unstructured.Unstructured
+	// count is the number of manifests added so far
+	count int
+}
+
+func newManifestObjs() *manifestObjs {
+	return &manifestObjs{
+		targetObjs: make([]*unstructured.Unstructured, 0),
+		hooks:      make([]*unstructured.Unstructured, 0),
+	}
+}
+
+// add unmarshals the given manifests and adds them either to the target objects or the hooks. The target objects are
+// annotated with their position among the manifests, which is reported if they are duplicated.
+func (o *manifestObjs) add(manifests []string) error {
 	for _, manifest := range manifests {
+		o.count++
 		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 		if ignore.Ignore(obj) {
 			continue
 		}
 		if hookutil.IsHook(obj) {
-			hooks = append(hooks, obj)
+			o.hooks = append(o.hooks, obj)
 		} else {
-			targetObjs = append(targetObjs, obj)
+			setManifestOrigin(obj, fmt.Sprintf("manifest %d", o.count))
+			o.targetObjs = append(o.targetObjs, obj)
 		}
 	}
-	return targetObjs, hooks, nil
+	return nil
 }
 
+// setManifestOrigin records the origin of the target object in the internal manifest origin annotation
+func setManifestOrigin(obj *unstructured.Unstructured, origin string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.AnnotationManifestOrigin] = origin
+	obj.SetAnnotations(annotations)
+}
+
+// removeManifestOrigin removes the internal manifest origin annotation from the target object and returns its value
+func removeManifestOrigin(obj *unstructured.Unstructured) string {
+	annotations := obj.GetAnnotations()
+	origin, ok := annotations[common.AnnotationManifestOrigin]
+	if !ok {
+		return ""
+	}
+	delete(annotations, common.AnnotationManifestOrigin)
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+	return origin
+}
+
+func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+	objs := newManifestObjs()
+	if err := objs.add(manifests); err != nil {
+		return nil, nil, err
+	}
+	return objs.targetObjs, objs.hooks, nil
+}
+
+// setLocalManifestsAppInstance marks the given local objects as part of the application like the repo server marks the
+// generated manifests, since local manifests are usually rendered without the app instance label or annotation. A label
+// or annotation which is already set is kept, so that objects which manage it themselves are not marked twice. CRDs are
+// never marked, like by the repo server.
+func setLocalManifestsAppInstance(objs []*unstructured.Unstructured, trackingMethod kubeutil.TrackingMethod, appLabelKey, val string) error {
+	for _, obj := range objs {
+		if kubeutil.IsCRD(obj) {
+			continue
+		}
+		if trackingMethod.UsesAnnotation() && kubeutil.GetAppInstanceAnnotation(obj) == "" {
+			kubeutil.SetAppInstanceAnnotation(obj, val)
+		}
+		if _, ok := obj.GetLabels()[appLabelKey]; trackingMethod.SetsLabel() && !ok {
+			if err := kubeutil.SetAppInstanceLabel(obj, appLabelKey, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// localManifestsRevisionPrefix is the prefix of the pseudo-revisions reported for comparisons with local manifests
+const localManifestsRevisionPrefix = "local-"
+
+// localManifestsRevision returns the pseudo-revision of the given local manifests, which is made of a hash of the manifests
+func localManifestsRevision(manifests []string) string {
+	hash := sha256.New()
+	for _, manifest := range manifests {
+		_, _ = hash.Write([]byte(manifest))
+		_, _ = hash.Write([]byte{0})
+	}
+	return fmt.Sprintf("%s%x", localManifestsRevisionPrefix, hash.Sum(nil))
+}
+
+// isLocalManifestsRevision returns true if the revision is the pseudo-revision of local manifests and cannot be resolved
+// by the repo server
+func isLocalManifestsRevision(revision string) bool {
+	return strings.HasPrefix(revision, localManifestsRevisionPrefix)
+}
+
+// getLocalManifestsSourceType returns the source type of an application compared with local manifests. The type is
+// taken from the source spec or the last comparison with the source repository if possible, since local manifests
+// are usually generated from the same source. Otherwise, manifests rendered by Helm are detected using the standard
+// Helm labels and anything else is considered to be a directory of plain manifests.
+func getLocalManifestsSourceType(app *v1alpha1.Application, source v1alpha1.ApplicationSource, targetObjs []*unstructured.Unstructured) v1alpha1.ApplicationSourceType {
+	if sourceType, err := source.ExplicitType(); err == nil && sourceType != nil {
+		return *sourceType
+	}
+	if source.IsHelm() {
+		return v1alpha1.ApplicationSourceTypeHelm
+	}
+	if app.Status.SourceType != "" {
+		return app.Status.SourceType
+	}
+	for _, obj := range targetObjs {
+		labels := obj.GetLabels()
+		if _, ok := labels["helm.sh/chart"]; ok || labels["app.kubernetes.io/managed-by"] == "Helm" {
+			return v1alpha1.ApplicationSourceTypeHelm
+		}
+	}
+	return v1alpha1.ApplicationSourceTypeDirectory
+}
+
+// DeduplicationStrategy selects which of the target objects with the same key is kept
+type DeduplicationStrategy string
+
+const (
+	// DeduplicationLastWins keeps the last of the duplicated objects, this is the default
+	DeduplicationLastWins DeduplicationStrategy = "last-wins"
+	// DeduplicationFirstWins keeps the first of the duplicated objects
+	DeduplicationFirstWins DeduplicationStrategy = "first-wins"
+	// DeduplicationError reports duplicated objects as a comparison error, which prevents syncs
+	DeduplicationError DeduplicationStrategy = "error"
+	// DeduplicationErrorOnConflict keeps the last of the duplicated objects if they are identical, and reports them as a
+	// comparison error otherwise
+	DeduplicationErrorOnConflict DeduplicationStrategy = "error-on-conflict"
+)
+
+// duplicateResourcesOptionPrefix is the prefix of the sync option which selects the deduplication strategy
+const duplicateResourcesOptionPrefix = "DuplicateResources="
+
+// blockClusterResourcesOption is the sync option which excludes the cluster-scoped resources that are not whitelisted by
+// the project from syncs entirely, instead of reporting them with the Blocked result
+const blockClusterResourcesOption = "ClusterResources=block"
+
+// blocksClusterResources returns whether the ClusterResources=block sync option is set for the application
+func blocksClusterResources(app *v1alpha1.Application) bool {
+	return app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption(blockClusterResourcesOption)
+}
+
+// GetDeduplicationStrategy returns the deduplication strategy selected by the DuplicateResources sync option of the
+// application, e.g. DuplicateResources=first-wins. Unknown strategies fall back to the default.
+func GetDeduplicationStrategy(app *v1alpha1.Application) DeduplicationStrategy {
+	if app.Spec.SyncPolicy == nil {
+		return DeduplicationLastWins
+	}
+	for _, option := range app.Spec.SyncPolicy.SyncOptions {
+		if !strings.HasPrefix(option, duplicateResourcesOptionPrefix) {
+			continue
+		}
+		switch strategy := DeduplicationStrategy(strings.TrimPrefix(option, duplicateResourcesOptionPrefix)); strategy {
+		case DeduplicationFirstWins, DeduplicationError, DeduplicationErrorOnConflict:
+			return strategy
+		}
+	}
+	return DeduplicationLastWins
+}
+
+// hasCompareOption returns whether the compare options annotation of the application contains the given option, e.g.
+// ServerSideDiff=true
+func hasCompareOption(app *v1alpha1.Application, option string) bool {
+	for _, item := range strings.Split(app.Annotations[common.AnnotationCompareOptions], ",") {
+		if strings.TrimSpace(item) == option {
+			return true
+		}
+	}
+	return false
+}
+
+// DeduplicateTargetObjects sets the namespace of the target objects according to the scope of their kinds and returns
+// one of the objects which have the same key, according to the given strategy. Namespaced objects without namespace get
+// the namespace of their default namespace annotation, or the given destination namespace. The namespace of objects whose
+// kind is not registered in the cluster, or whose scope cannot be determined, is left untouched. Hooks are never
+// deduplicated, since they are created for each phase and wave they belong to. The internal manifest origin annotation
+// is removed from the returned objects. The returned rejected flag is true if the strategy does not allow some of the
+// duplicated objects, in which case the sync status of the application cannot be determined.
 func DeduplicateTargetObjects(
 	server string,
 	namespace string,
 	objs []*unstructured.Unstructured,
 	infoProvider ResourceInfoProvider,
-) ([]*unstructured.Unstructured, []v1alpha1.ApplicationCondition, error) {
+	strategy DeduplicationStrategy,
+) (_ []*unstructured.Unstructured, _ []v1alpha1.ApplicationCondition, rejected bool) {
 
+	now := metav1.Now()
+	conditions := make([]v1alpha1.ApplicationCondition, 0)
+	failedGroupKinds := make(map[schema.GroupKind]bool)
 	targetByKey := make(map[kubeutil.ResourceKey][]*unstructured.Unstructured)
+	result := make([]*unstructured.Unstructured, 0)
 	for i := range objs {
 		obj := objs[i]
-		isNamespaced, err := infoProvider.IsNamespaced(server, obj.GroupVersionKind().GroupKind())
-		if err != nil {
-			return objs, nil, err
+		gk := obj.GroupVersionKind().GroupKind()
+		known, err := infoProvider.IsKnownGroupKind(server, gk)
+		isNamespaced := false
+		if err == nil && known {
+			isNamespaced, err = infoProvider.IsNamespaced(server, gk)
 		}
-		if !isNamespaced {
+		switch {
+		case err != nil:
+			// the namespace is left untouched since the scope of the kind is unknown
+			if !failedGroupKinds[gk] {
+				failedGroupKinds[gk] = true
+				conditions = append(conditions, appv1.ApplicationCondition{
+					Type:               appv1.ApplicationConditionComparisonError,
+					Message:            fmt.Sprintf("Failed to determine the scope of %s: %v", gk.String(), err),
+					LastTransitionTime: &now,
+				})
+			}
+		case !known:
+			// the kind is not registered in the cluster yet, so the namespace is left untouched as it might be cluster-scoped
+		case !isNamespaced:
 			obj.SetNamespace("")
-		} else if obj.GetNamespace() == "" {
-			obj.SetNamespace(namespace)
+		case obj.GetNamespace() == "":
+			obj.SetNamespace(util.FirstNonEmpty(obj.GetAnnotations()[common.AnnotationDefaultNamespace], namespace))
+		}
+		if hookutil.IsHook(obj) {
+			removeManifestOrigin(obj)
+			result = append(result, obj)
+			continue
 		}
 		key := kubeutil.GetResourceKey(obj)
 		targetByKey[key] = append(targetByKey[key], obj)
 	}
-	conditions := make([]v1alpha1.ApplicationCondition, 0)
-	result := make([]*unstructured.Unstructured, 0)
 	for key, targets := range targetByKey {
+		origins := make([]string, len(targets))
+		for i := range targets {
+			origins[i] = util.FirstNonEmpty(removeManifestOrigin(targets[i]), "unknown origin")
+		}
+		kept := len(targets) - 1
+		if strategy == DeduplicationFirstWins {
+			kept = 0
+		}
 		if len(targets) > 1 {
-			now := metav1.Now()
-			conditions = append(conditions, appv1.ApplicationCondition{
-				Type:               appv1.ApplicationConditionRepeatedResourceWarning,
-				Message:            fmt.Sprintf("Resource %s appeared %d times among application resources.", key.String(), len(targets)),
+			condition := appv1.ApplicationCondition{
+				Type: appv1.ApplicationConditionRepeatedResourceWarning,
+				Message: fmt.Sprintf("Resource %s appeared %d times among application resources (from %s), kept occurrence %d of %d (from %s).",
+					key.String(), len(targets), strings.Join(origins, ", "), kept+1, len(targets), origins[kept]),
 				LastTransitionTime: &now,
-			})
+			}
+			switch {
+			case strategy == DeduplicationError:
+				condition.Type = appv1.ApplicationConditionComparisonError
+				condition.Message = fmt.Sprintf("Resource %s appeared %d times among application resources (from %s), which is not allowed by the sync option %s%s.",
+					key.String(), len(targets), strings.Join(origins, ", "), duplicateResourcesOptionPrefix, DeduplicationError)
+				rejected = true
+			case strategy == DeduplicationErrorOnConflict && !areIdentical(targets):
+				condition.Type = appv1.ApplicationConditionComparisonError
+				condition.Message = fmt.Sprintf("Resource %s appeared %d times among application resources with different content (from %s), which is not allowed by the sync option %s%s.",
+					key.String(), len(targets), strings.Join(origins, ", "), duplicateResourcesOptionPrefix, DeduplicationErrorOnConflict)
+				rejected = true
+			}
+			conditions = append(conditions, condition)
 		}
-		result = append(result, targets[len(targets)-1])
+		result = append(result, targets[kept])
 	}
 
-	return result, conditions, nil
+	return result, conditions, rejected
+}
+
+// areIdentical returns true if all of the given objects have the same content
+func areIdentical(objs []*unstructured.Unstructured) bool {
+	for i := 1; i < len(objs); i++ {
+		if !reflect.DeepEqual(objs[0].Object, objs[i].Object) {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyNamespaceRestrictions returns conditions for the target objects which cannot be managed because the cluster is
+// restricted to a list of namespaces. Target objects are expected to be deduplicated, so cluster-scoped objects have no namespace.
+func verifyNamespaceRestrictions(cluster *appv1.Cluster, namespace string, targetObjs []*unstructured.Unstructured) []v1alpha1.ApplicationCondition {
+	conditions := make([]v1alpha1.ApplicationCondition, 0)
+	allowed := strings.Join(cluster.Namespaces, ", ")
+	reported := make(map[string]bool)
+	verifyNamespace := func(ns string) {
+		if ns == "" || reported[ns] || cluster.IsNamespaceAllowed(ns) {
+			return
+		}
+		reported[ns] = true
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:    v1alpha1.ApplicationConditionNamespaceRestrictionError,
+			Message: fmt.Sprintf("Namespace %s is not managed on cluster %s. Managed namespaces: %s", ns, cluster.Server, allowed),
+		})
+	}
+	verifyNamespace(namespace)
+	for _, obj := range targetObjs {
+		if obj.GetNamespace() == "" {
+			gvk := obj.GroupVersionKind()
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:    v1alpha1.ApplicationConditionClusterPermissionWarning,
+				Message: fmt.Sprintf("Cluster-scoped resource %s/%s %s cannot be managed on cluster %s which is restricted to namespaces: %s", gvk.Group, gvk.Kind, obj.GetName(), cluster.Server, allowed),
+			})
+		} else {
+			verifyNamespace(obj.GetNamespace())
+		}
+	}
+	return conditions
 }
 
 // dedupLiveResources handles removes live resource duplicates with the same UID. Duplicates are created in a separate resource groups.
@@ -257,39 +758,211 @@ func dedupLiveResources(targetObjs []*unstructured.Unstructured, liveObjsByKey m
 	}
 }
 
-func (m *appStateManager) getComparisonSettings(app *appv1.Application) (string, map[string]v1alpha1.ResourceOverride, diff.Normalizer, error) {
-	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
-	if err != nil {
-		return "", nil, nil, err
+// newComparedTo returns the sources and destination the application is compared to. Applications with multiple sources
+// report the list of sources, other applications their single source.
+func newComparedTo(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource) appv1.ComparedTo {
+	if app.Spec.HasMultipleSources() {
+		return appv1.ComparedTo{Sources: sources, Destination: app.Spec.Destination}
 	}
-	appLabelKey, err := m.settingsMgr.GetAppInstanceLabelKey()
-	if err != nil {
-		return "", nil, nil, err
+	return appv1.ComparedTo{Source: sources[0], Destination: app.Spec.Destination}
+}
+
+// unknownSyncStatus returns the unknown sync status of a comparison which did not compare any resources, which keeps
+// the previously compared revisions
+func unknownSyncStatus(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource) *v1alpha1.SyncStatus {
+	return &v1alpha1.SyncStatus{
+		ComparedTo:         newComparedTo(app, sources),
+		Status:             appv1.SyncStatusCodeUnknown,
+		Revision:           app.Status.Sync.Revision,
+		Revisions:          app.Status.Sync.Revisions,
+		LastTransitionTime: syncTransitionTime(app, appv1.SyncStatusCodeUnknown, metav1.Now()),
 	}
-	diffNormalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, resourceOverrides)
-	if err != nil {
-		return "", nil, nil, err
+}
+
+func unknownHealthStatus(app *v1alpha1.Application) *v1alpha1.HealthStatus {
+	return &v1alpha1.HealthStatus{
+		Status:             appv1.HealthStatusUnknown,
+		LastTransitionTime: healthTransitionTime(app, appv1.HealthStatusUnknown, metav1.Now()),
+	}
+}
+
+// transitionTime returns the time a status last changed, which is the given time if the status differs from the
+// previous status. Changes from and to the unknown status are not considered transitions, so that transient comparison
+// failures, e.g. failures to generate the manifests, don't reset the time.
+func transitionTime(previousStatus string, previousTime *metav1.Time, status string, unknownStatus string, now metav1.Time) *metav1.Time {
+	if previousTime != nil && (status == previousStatus || status == unknownStatus || previousStatus == unknownStatus) {
+		return previousTime.DeepCopy()
+	}
+	return &now
+}
+
+// syncTransitionTime returns the time the sync status of the application last changed if its new status is the given one
+func syncTransitionTime(app *v1alpha1.Application, status v1alpha1.SyncStatusCode, now metav1.Time) *metav1.Time {
+	return transitionTime(string(app.Status.Sync.Status), app.Status.Sync.LastTransitionTime, string(status), string(appv1.SyncStatusCodeUnknown), now)
+}
+
+// healthTransitionTime returns the time the health status of the application last changed if its new status is the
+// given one
+func healthTransitionTime(app *v1alpha1.Application, status v1alpha1.HealthStatusCode, now metav1.Time) *metav1.Time {
+	return transitionTime(app.Status.Health.Status, app.Status.Health.LastTransitionTime, status, appv1.HealthStatusUnknown, now)
+}
+
+// setSyncRevisions sets the compared revisions of the sync status to the revisions of the given manifest responses,
+// which are reported per source if the manifests were generated from multiple sources
+func setSyncRevisions(syncStatus *v1alpha1.SyncStatus, manifestInfos []*apiclient.ManifestResponse, multipleSources bool) {
+	if !multipleSources {
+		syncStatus.Revision = manifestInfos[0].Revision
+		syncStatus.RevisionMetadata = manifestInfos[0].RevisionMetadata
+		return
+	}
+	syncStatus.Revisions = make([]string, len(manifestInfos))
+	for i := range manifestInfos {
+		syncStatus.Revisions[i] = manifestInfos[i].Revision
+	}
+}
+
+// invalidSpecComparison returns the unknown comparison result of an application whose spec is invalid and reports the
+// given message as the InvalidSpecError condition of the application, unless the comparison is a preview
+func invalidSpecComparison(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, reconciledAt metav1.Time, message string, preview bool) *comparisonResult {
+	return failedComparison(app, sources, reconciledAt, v1alpha1.ApplicationConditionInvalidSpecError, message, preview)
+}
+
+// failedComparison returns the unknown result of a comparison which failed before comparing any resources, reported by
+// a condition of the given type
+func failedComparison(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, reconciledAt metav1.Time, conditionType v1alpha1.ApplicationConditionType, message string, preview bool) *comparisonResult {
+	now := metav1.Now()
+	conditions := []v1alpha1.ApplicationCondition{{Type: conditionType, Message: message, LastTransitionTime: &now}}
+	if !preview {
+		app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{conditionType: true})
+	}
+	return &comparisonResult{
+		reconciledAt: reconciledAt,
+		attemptedAt:  reconciledAt,
+		syncStatus:   unknownSyncStatus(app, sources),
+		healthStatus: unknownHealthStatus(app),
+		conditions:   conditions,
 	}
-	return appLabelKey, resourceOverrides, diffNormalizer, nil
 }
 
 // CompareAppState compares application git state to the live app state, using the specified
-// revision and supplied source. If revision or overrides are empty, then compares against
-// revision and overrides in the app spec.
-func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, noCache bool, localManifests []string) *comparisonResult {
+// revisions and supplied sources. Revisions are given for the sources at the same positions, an
+// empty revision compares against the target revision of the source. Applications with a single
+// source are compared with a single source and revision. The comparison is cancelled if the given
+// context is done or the comparisons of the application are cancelled.
+func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, localManifests []string) *comparisonResult {
+	ctx, done := m.startComparison(ctx, app.Name)
+	defer done()
+	return m.compareAppState(ctx, app, revisions, sources, noCache, localManifests, true, false)
+}
+
+// PreviewAppState compares the live app state to the given revisions and sources without updating the application
+// conditions or the last comparison result of the application, e.g. to show what a sync to the revisions would change.
+// The conditions reported by the comparison are available in the returned result.
+func (m *appStateManager) PreviewAppState(ctx context.Context, app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource) *comparisonResult {
+	return m.compareAppState(ctx, app.DeepCopy(), revisions, sources, false, nil, true, true)
+}
+
+// compareAppState compares the application state. The data of Secrets in the returned managed resources and hooks is
+// replaced with placeholders if redactSecrets is true and redaction is not disabled in the settings. Secrets must not
+// be redacted if the result is used to sync the application, unredacted results don't replace the last comparison
+// result of the application, which is served by the API. Preview comparisons neither update the application
+// conditions nor replace the last comparison result and the comparison cache of the application. If the given context
+// is cancelled, the comparison aborts at the next phase boundary without updating the application and returns a
+// cancelled result. The comparison and its phases are traced by the tracer of the manager.
+func (m *appStateManager) compareAppState(ctx context.Context, app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, localManifests []string, redactSecrets bool, preview bool) (result *comparisonResult) {
+	ctx, comparisonSpan := m.tracer.StartSpan(ctx, spanCompareAppState)
+	comparisonSpan.SetAttribute(spanAttributeApp, app.Name)
+	comparisonSpan.SetAttribute(spanAttributeServer, app.Spec.Destination.Server)
+	defer func() {
+		endComparisonSpan(comparisonSpan, result)
+	}()
 	reconciledAt := metav1.Now()
-	appLabelKey, resourceOverrides, diffNormalizer, err := m.getComparisonSettings(app)
+	// redactSecrets is later cleared if redaction is disabled in the settings, which doesn't make the result a sync one
+	forSync := !redactSecrets
+	// the manifests of applications with multiple sources are generated from all sources, unless local manifests are
+	// given, all other comparisons use the single source and revision
+	multipleSources := app.Spec.HasMultipleSources() && len(localManifests) == 0
+	source, revision := sources[0], revisions[0]
+	// a failure to load the settings falls back to the last loaded settings, so that the results of all applications
+	// do not flap because of a transient failure
+	cs, settingsErr := m.getComparisonSettings()
+	var diffNormalizer diff.Normalizer
+	var passthroughAnnotations *argo.PassthroughAnnotations
+	var ignoredChanges *argo.IgnoredChanges
+	var normalizers *comparisonNormalizers
+	var err error
+	if cs != nil {
+		// the normalizers are reused by the comparisons of applications with the same inputs
+		if normalizers, err = cs.getNormalizers(app); err == nil {
+			diffNormalizer, passthroughAnnotations, ignoredChanges = normalizers.diffNormalizer, normalizers.passthroughAnnotations, normalizers.ignoredChanges
+		}
+	}
 
-	// return unknown comparison result if basic comparison settings cannot be loaded
+	// invalid ignored differences, e.g. jq path expressions which fail to compile, are reported instead of being skipped
 	if err != nil {
+		return failedComparison(app, sources, reconciledAt, v1alpha1.ApplicationConditionComparisonError,
+			fmt.Sprintf("Failed to build the diff normalizers: %v", err), preview)
+	}
+	// return unknown comparison result if basic comparison settings cannot be loaded
+	if cs == nil {
 		return &comparisonResult{
 			reconciledAt: reconciledAt,
-			syncStatus: &v1alpha1.SyncStatus{
-				ComparedTo: appv1.ComparedTo{Source: source, Destination: app.Spec.Destination},
-				Status:     appv1.SyncStatusCodeUnknown,
-			},
-			healthStatus: &appv1.HealthStatus{Status: appv1.HealthStatusUnknown},
+			attemptedAt:  reconciledAt,
+			syncStatus:   unknownSyncStatus(app, sources),
+			healthStatus: unknownHealthStatus(app),
+		}
+	}
+
+	// the project might be deleted after the app spec was validated, so the comparison is short-circuited instead of
+	// dereferencing a missing project
+	proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(m.projInformer.GetIndexer()), m.namespace)
+	if err != nil || proj == nil {
+		message := fmt.Sprintf("Application referencing project %s which does not exist", app.Spec.Project)
+		if err != nil && !apierr.IsNotFound(err) {
+			message = fmt.Sprintf("Failed to load project %s: %v", app.Spec.Project, err)
 		}
+		return invalidSpecComparison(app, sources, reconciledAt, message, preview)
+	}
+
+	// the destination name is resolved to the server for the duration of the comparison, so that the resolved server is
+	// used to get the live state and is reported in the compared destination, while the spec is left unchanged
+	dest, err := m.resolveDestination(ctx, app.Spec.Destination)
+	if err != nil {
+		return invalidSpecComparison(app, sources, reconciledAt, err.Error(), preview)
+	}
+	specDest := app.Spec.Destination
+	app.Spec.Destination = dest
+	defer func() {
+		app.Spec.Destination = specDest
+	}()
+
+	// results of previews, comparisons with local manifests and unredacted results used for syncing are not cached
+	appLabelKeys, trackingMethod, trackingValue, resourceOverrides := cs.appLabelKeys, cs.trackingMethod, cs.trackingValue, cs.resourceOverrides
+	maxResources := proj.GetMaxResources(cs.maxResources)
+	fingerprint, modificationCount, cacheable := "", int64(0), false
+	if redactSecrets && !preview && len(localManifests) == 0 && settingsErr == nil {
+		fingerprint, modificationCount, cacheable = m.comparisonFingerprint(app, proj, revisions, sources, appLabelKeys, trackingMethod, trackingValue, resourceOverrides, maxResources)
+	}
+	if ctx.Err() != nil {
+		return cancelledComparison(app, sources, reconciledAt)
+	}
+	if cacheable && !noCache {
+		if cached, ok := m.getCachedComparison(app, fingerprint); ok {
+			m.metricsServer.IncComparisonCacheHit()
+			compRes := *cached
+			compRes.reconciledAt = reconciledAt
+			// the cached statuses are shared, their transition times are derived from the current application status
+			syncStatus, healthStatus := *cached.syncStatus, *cached.healthStatus
+			syncStatus.LastTransitionTime = syncTransitionTime(app, syncStatus.Status, reconciledAt)
+			healthStatus.LastTransitionTime = healthTransitionTime(app, healthStatus.Status, reconciledAt)
+			compRes.syncStatus, compRes.healthStatus = &syncStatus, &healthStatus
+			app.Status.SetConditions(compRes.conditions, comparisonConditionTypes)
+			m.comparisonResultsLock.Lock()
+			m.comparisonResults[app.Name] = &compRes
+			m.comparisonResultsLock.Unlock()
+			return &compRes
+		}
+		m.metricsServer.IncComparisonCacheMiss()
 	}
 
 	// do best effort loading live and target state to present as much information about app state as possible
@@ -302,86 +975,350 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	var targetObjs []*unstructured.Unstructured
 	var hooks []*unstructured.Unstructured
 	var manifestInfo *apiclient.ManifestResponse
+	var manifestInfos []*apiclient.ManifestResponse
+	var signatureErr error
 	now := metav1.Now()
+	attemptedAt := reconciledAt
+	attemptedRevision := app.Status.Sync.Revision
 
-	if len(localManifests) == 0 {
-		targetObjs, hooks, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache)
+	if settingsErr != nil {
+		logCtx.Warnf("Failed to load settings, comparing with the last loaded settings: %v", settingsErr)
+		conditions = append(conditions, staleSettingsCondition(cs, settingsErr, now))
+	}
+
+	if multipleSources {
+		// the manifest generation of applications with multiple sources does not back off
+		verifySignature := len(proj.Spec.SignatureKeys) > 0
+		manifestsCtx, manifestsSpan := m.tracer.StartSpan(ctx, spanGenerateManifests)
+		targetObjs, hooks, manifestInfos, err = m.getRepoObjsOfSources(manifestsCtx, app, proj, sources, revisions, appLabelKeys[0], trackingMethod, trackingValue, noCache, verifySignature)
+		endSpan(manifestsSpan, err)
+		if ctx.Err() != nil {
+			return cancelledComparison(app, sources, reconciledAt)
+		}
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
-			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 			failedToLoadObjs = true
+			logCtx.Warn(err.Error())
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+		}
+		if err == nil && verifySignature {
+			for _, info := range manifestInfos {
+				if signatureErr = verifyRevisionSignature(proj, info); signatureErr != nil {
+					break
+				}
+			}
+		}
+	} else if len(localManifests) == 0 {
+		verifySignature := len(proj.Spec.SignatureKeys) > 0
+		// only status comparisons back off, the comparisons of syncs and previews always generate the manifests
+		backoffEnabled := m.comparisonBackoffConfig.InitialDelay > 0 && redactSecrets && !preview
+		var backoff *comparisonBackoff
+		inBackoff := false
+		if backoffEnabled && !noCache {
+			backoff, inBackoff = m.getComparisonBackoff(app.Name, source, revision)
+		}
+		if inBackoff {
+			err = backoff.err
+			attemptedAt = metav1.NewTime(backoff.attemptedAt)
+		} else {
+			manifestsCtx, manifestsSpan := m.tracer.StartSpan(ctx, spanGenerateManifests)
+			targetObjs, hooks, manifestInfo, err = m.getRepoObjs(manifestsCtx, app, proj, source, appLabelKeys[0], trackingMethod, trackingValue, revision, noCache, verifySignature)
+			endSpan(manifestsSpan, err)
+			if ctx.Err() != nil {
+				// the failure to generate the manifests of a cancelled comparison is not recorded
+				return cancelledComparison(app, sources, reconciledAt)
+			}
+			if backoffEnabled {
+				if err != nil {
+					backoff = m.recordComparisonFailure(app.Name, source, revision, err)
+				} else {
+					m.recordComparisonSuccess(app.Name, source, revision, targetObjs, hooks, manifestInfo)
+				}
+			}
+		}
+		if err != nil {
+			message := err.Error()
+			ok := false
+			if backoff != nil {
+				message = backoff.message()
+				// the last known good manifests are compared with the live state until the manifests generate again
+				targetObjs, hooks, manifestInfo, ok = m.getKnownGoodManifests(app.Name, source, revision)
+			}
+			if !ok {
+				targetObjs = make([]*unstructured.Unstructured, 0)
+				failedToLoadObjs = true
+			}
+			// the revision which the repo server resolved before failing is reported, if the repo server was reached
+			attemptedRevision = getAttemptedRevision(err, app.Status.Sync.Revision)
+			logCtx.Warnf("Failed to generate the manifests of revision %s: %v", attemptedRevision, err)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: message, LastTransitionTime: &now})
+		}
+		if err == nil && verifySignature {
+			signatureErr = verifyRevisionSignature(proj, manifestInfo)
 		}
 	} else {
 		targetObjs, hooks, err = unmarshalManifests(localManifests)
+		for _, objs := range [][]*unstructured.Unstructured{targetObjs, hooks} {
+			if err == nil {
+				err = setLocalManifestsAppInstance(objs, trackingMethod, appLabelKeys[0], trackingValue.Format(app.Name))
+			}
+		}
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 			failedToLoadObjs = true
 		}
-		manifestInfo = nil
+		// local manifests bypass the verification of the revision, so they can't be used if the project requires signatures
+		if len(proj.Spec.SignatureKeys) > 0 {
+			signatureErr = fmt.Errorf("local manifests cannot be verified: project %s requires revisions signed with one of its signature keys", proj.Name)
+		}
+		// local manifests have no revision, so a pseudo-revision identifying the manifests is reported instead
+		manifestInfo = &apiclient.ManifestResponse{
+			Revision:   localManifestsRevision(localManifests),
+			SourceType: string(getLocalManifestsSourceType(app, source, targetObjs)),
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionLocalManifestsWarning,
+			Message:            "Application is compared with local manifests instead of the manifests generated from the source repository",
+			LastTransitionTime: &now,
+		})
+	}
+	if manifestInfo != nil {
+		manifestInfos = []*apiclient.ManifestResponse{manifestInfo}
+	}
+	if signatureErr != nil {
+		// the sync status is unknown since the manifests must not be deployed
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionSignatureVerificationError, Message: signatureErr.Error(), LastTransitionTime: &now})
+		failedToLoadObjs = true
 	}
 
-	targetObjs, dedupConditions, err := DeduplicateTargetObjects(app.Spec.Destination.Server, app.Spec.Destination.Namespace, targetObjs, m.liveStateCache)
-	if err != nil {
-		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	// applications with too many resources are neither diffed nor deduplicated, so that pathological manifests cannot
+	// exhaust the memory of the controller
+	if resourceCount := int64(len(targetObjs) + len(hooks)); maxResources > 0 && resourceCount > maxResources {
+		message := fmt.Sprintf("application has %d resources which exceeds the limit of %d", resourceCount, maxResources)
+		logCtx.Warn(message)
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionResourceLimitError, Message: message, LastTransitionTime: &now})
+		return m.resourceLimitComparison(ctx, app, sources, reconciledAt, attemptedAt, manifestInfos, multipleSources, conditions, preview)
+	}
+
+	if !failedToLoadObjs {
+		conditions = append(conditions, m.validateTargetObjs(app, now, targetObjs, hooks)...)
+	}
+
+	for _, info := range manifestInfos {
+		if len(info.HealthScripts) == 0 {
+			continue
+		}
+		var scriptConditions []v1alpha1.ApplicationCondition
+		resourceOverrides, scriptConditions = mergeHealthScripts(resourceOverrides, info.HealthScripts)
+		for i := range scriptConditions {
+			scriptConditions[i].LastTransitionTime = &now
+		}
+		conditions = append(conditions, scriptConditions...)
+	}
+
+	if redactSecrets {
+		if disabled, err := m.settingsMgr.GetSecretRedactionDisabled(); err != nil {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+		} else {
+			redactSecrets = !disabled
+		}
 	}
+
+	targetObjs, dedupConditions, duplicatesRejected := DeduplicateTargetObjects(app.Spec.Destination.Server, app.Spec.Destination.Namespace, targetObjs, m.liveStateCache, GetDeduplicationStrategy(app))
 	conditions = append(conditions, dedupConditions...)
+	if duplicatesRejected {
+		failedToLoadObjs = true
+	}
 
-	resFilter, err := m.settingsMgr.GetResourcesFilter()
+	var ignoredTargetObjs []*unstructured.Unstructured
+	namespaceDenied := false
+	for i := len(targetObjs) - 1; i >= 0; i-- {
+		targetObj := targetObjs[i]
+		gvk := targetObj.GroupVersionKind()
+		if isIgnoredResource(targetObj) {
+			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
+			ignoredTargetObjs = append(ignoredTargetObjs, targetObj)
+		} else if cs.resourcesFilter.IsExcludedResource(gvk.Group, gvk.Kind, app.Spec.Destination.Server) {
+			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionExcludedResourceWarning,
+				Message:            fmt.Sprintf("Resource %s/%s %s is excluded in the settings", gvk.Group, gvk.Kind, targetObj.GetName()),
+				LastTransitionTime: &now,
+			})
+		} else if app.Spec.IsExcludedResource(gvk.Group, gvk.Kind, targetObj.GetName()) {
+			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionExcludedResourceWarning,
+				Message:            fmt.Sprintf("Resource %s/%s %s is excluded in the application", gvk.Group, gvk.Kind, targetObj.GetName()),
+				LastTransitionTime: &now,
+			})
+		} else if ns := targetObj.GetNamespace(); ns != "" && !proj.IsDestinationPermitted(v1alpha1.ApplicationDestination{Server: app.Spec.Destination.Server, Namespace: ns}) {
+			// target objects are deduplicated, so only namespaced objects have a namespace. Cluster-scoped objects are
+			// verified against the cluster resource rules of the project instead.
+			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
+			namespaceDenied = true
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionNamespacePermissionError,
+				Message:            fmt.Sprintf("Resource %s/%s %s is in namespace %s which is not permitted in project %s", gvk.Group, gvk.Kind, targetObj.GetName(), ns, proj.Name),
+				LastTransitionTime: &now,
+			})
+		}
+	}
+
+	cluster, err := m.db.GetCluster(ctx, app.Spec.Destination.Server)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
-	} else {
-		for i := len(targetObjs) - 1; i >= 0; i-- {
-			targetObj := targetObjs[i]
-			gvk := targetObj.GroupVersionKind()
-			if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, app.Spec.Destination.Server) {
-				targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
-				conditions = append(conditions, v1alpha1.ApplicationCondition{
-					Type:               v1alpha1.ApplicationConditionExcludedResourceWarning,
-					Message:            fmt.Sprintf("Resource %s/%s %s is excluded in the settings", gvk.Group, gvk.Kind, targetObj.GetName()),
-					LastTransitionTime: &now,
-				})
-			}
+	} else if cluster.IsNamespaceScoped() {
+		nsConditions := verifyNamespaceRestrictions(cluster, app.Spec.Destination.Namespace, targetObjs)
+		for i := range nsConditions {
+			nsConditions[i].LastTransitionTime = &now
 		}
+		conditions = append(conditions, nsConditions...)
 	}
 
 	logCtx.Debugf("Generated config manifests")
+	_, liveSpan := m.tracer.StartSpan(ctx, spanGetLiveObjects)
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(app, targetObjs)
+	liveSpan.SetAttribute(spanAttributeTargetObjects, len(targetObjs))
+	liveSpan.SetAttribute(spanAttributeLiveObjects, len(liveObjByKey))
+	endSpan(liveSpan, err)
+	if ctx.Err() != nil {
+		return cancelledComparison(app, sources, reconciledAt)
+	}
 	dedupLiveResources(targetObjs, liveObjByKey)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
-		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionComparisonError,
+			Message:            withClusterConnectionState(err.Error(), m.liveStateCache.GetClusterConnectionState(app.Spec.Destination.Server)),
+			LastTransitionTime: &now,
+		})
 		failedToLoadObjs = true
 	}
+	// excluded resources are ignored on the live side as well, so that they are neither reported as out of sync nor pruned
+	for key := range liveObjByKey {
+		if cs.resourcesFilter.IsExcludedResource(key.Group, key.Kind, app.Spec.Destination.Server) || app.Spec.IsExcludedResource(key.Group, key.Kind, key.Name) {
+			delete(liveObjByKey, key)
+		}
+	}
+	// resources with the IgnoreResource compare option are invisible to the application, no matter whether the option
+	// is set on the target or on the live resource
+	for _, obj := range ignoredTargetObjs {
+		delete(liveObjByKey, m.getTargetResourceKey(app, obj))
+	}
+	ignoredLiveKeys := make(map[kubeutil.ResourceKey]bool)
+	for key, liveObj := range liveObjByKey {
+		if isIgnoredResource(liveObj) {
+			ignoredLiveKeys[key] = true
+			delete(liveObjByKey, key)
+		}
+	}
+	if len(ignoredLiveKeys) > 0 {
+		filteredTargetObjs := make([]*unstructured.Unstructured, 0, len(targetObjs))
+		for _, obj := range targetObjs {
+			if !ignoredLiveKeys[m.getTargetResourceKey(app, obj)] {
+				filteredTargetObjs = append(filteredTargetObjs, obj)
+			}
+		}
+		targetObjs = filteredTargetObjs
+	}
 	logCtx.Debugf("Retrieved lived manifests")
+	_, reconcileSpan := m.tracer.StartSpan(ctx, spanReconcile)
+	// resources of other applications are not reported as shared if they are owned by a resource of this application,
+	// e.g. replica sets which inherit the instance label of another application through the pod template of a deployment
+	// tracking values are compared by the application name they are formatted with, resources which are tracked by the
+	// bare application name are still owned by the application
+	isOwnTrackingValue := func(val string) bool {
+		appName, _ := trackingValue.Parse(val)
+		return val != "" && appName == app.Name
+	}
+	managedKeys := make(map[kubeutil.ResourceKey]bool)
+	for key, liveObj := range liveObjByKey {
+		if liveObj != nil {
+			if val := kubeutil.GetAppInstanceName(liveObj, trackingMethod, appLabelKeys...); val == "" || isOwnTrackingValue(val) {
+				managedKeys[key] = true
+			}
+		}
+	}
+	legacyLabeledCount, legacyTrackingValueCount := 0, 0
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
-			appInstanceName := kubeutil.GetAppInstanceLabel(liveObj, appLabelKey)
-			if appInstanceName != "" && appInstanceName != app.Name {
+			appInstanceName := kubeutil.GetAppInstanceName(liveObj, trackingMethod, appLabelKeys...)
+			if appInstanceName != "" && !isOwnTrackingValue(appInstanceName) {
+				if m.isOwnedByManagedResource(app.Spec.Destination.Server, liveObj, managedKeys) {
+					logCtx.Debugf("%s/%s is part of application %s but owned by a resource of this application", liveObj.GetKind(), liveObj.GetName(), appInstanceName)
+					continue
+				}
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:               v1alpha1.ApplicationConditionSharedResourceWarning,
 					Message:            fmt.Sprintf("%s/%s is part of a different application: %s", liveObj.GetKind(), liveObj.GetName(), appInstanceName),
 					LastTransitionTime: &now,
 				})
+				continue
+			}
+			if _, legacy := trackingValue.Parse(appInstanceName); legacy {
+				legacyTrackingValueCount++
+			}
+			if trackingMethod.SetsLabel() && isOwnTrackingValue(kubeutil.GetAppInstanceLabel(liveObj, appLabelKeys...)) && !isOwnTrackingValue(kubeutil.GetAppInstanceLabel(liveObj, appLabelKeys[0])) {
+				legacyLabeledCount++
 			}
 		}
 	}
+	if legacyTrackingValueCount > 0 {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type: v1alpha1.ApplicationConditionLegacyTrackingValueWarning,
+			Message: fmt.Sprintf("%d resources are tracked by the bare application name and will be tracked by %s when synced",
+				legacyTrackingValueCount, trackingValue.Format(app.Name)),
+			LastTransitionTime: &now,
+		})
+	}
+	if legacyLabeledCount > 0 {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type: v1alpha1.ApplicationConditionLegacyInstanceLabelWarning,
+			Message: fmt.Sprintf("%d resources are only labeled with the legacy app instance label keys %s and will be labeled with %s when synced",
+				legacyLabeledCount, strings.Join(appLabelKeys[1:], ", "), appLabelKeys[0]),
+			LastTransitionTime: &now,
+		})
+	}
+
+	failedGroups, discoveryConditions := m.getDiscoveryConditions(app, append(append([]*unstructured.Unstructured{}, targetObjs...), hooks...))
+	for i := range discoveryConditions {
+		discoveryConditions[i].LastTransitionTime = &now
+	}
+	conditions = append(conditions, discoveryConditions...)
 
 	managedLiveObj := make([]*unstructured.Unstructured, len(targetObjs))
+	unknownKinds := make(map[string]bool)
 	for i, obj := range targetObjs {
 		gvk := obj.GroupVersionKind()
-		ns := util.FirstNonEmpty(obj.GetNamespace(), app.Spec.Destination.Namespace)
-		if namespaced, err := m.liveStateCache.IsNamespaced(app.Spec.Destination.Server, obj.GroupVersionKind().GroupKind()); err == nil && !namespaced {
-			ns = ""
-		}
-		key := kubeutil.NewResourceKey(gvk.Group, gvk.Kind, ns, obj.GetName())
+		key := m.getTargetResourceKey(app, obj)
 		if liveObj, ok := liveObjByKey[key]; ok {
 			managedLiveObj[i] = liveObj
 			delete(liveObjByKey, key)
 		} else {
 			managedLiveObj[i] = nil
+			// the resource is missing because its kind is not registered in the cluster, e.g. if its CRD is not
+			// installed yet. The kinds whose group could not be discovered are already reported.
+			if known, err := m.liveStateCache.IsKnownGroupKind(app.Spec.Destination.Server, gvk.GroupKind()); err == nil && !known && !failedGroups[gvk.Group] {
+				unknownKinds[fmt.Sprintf("%s/%s", gvk.Group, gvk.Kind)] = true
+			}
+		}
+	}
+	if len(unknownKinds) > 0 {
+		kinds := make([]string, 0, len(unknownKinds))
+		for kind := range unknownKinds {
+			kinds = append(kinds, kind)
 		}
+		sort.Strings(kinds)
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionUnknownResourceKindWarning,
+			Message:            fmt.Sprintf("Resource kinds are not registered in the destination cluster: %s", strings.Join(kinds, ", ")),
+			LastTransitionTime: &now,
+		})
 	}
+	reconcileSpan.SetAttribute(spanAttributeResources, len(targetObjs))
+	reconcileSpan.End()
 	logCtx.Debugf("built managed objects list")
 	// Everything remaining in liveObjByKey are "extra" resources that aren't tracked in git.
 	// The following adds all the extras to the managedLiveObj list and backfills the targetObj
@@ -390,9 +1327,25 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		targetObjs = append(targetObjs, nil)
 		managedLiveObj = append(managedLiveObj, obj)
 	}
+	conditions = append(conditions, m.detectForeignManagers(app, now, targetObjs, managedLiveObj)...)
 
-	// Do the actual comparison
-	diffResults, err := diff.DiffArray(targetObjs, managedLiveObj, diffNormalizer)
+	// applications with the server-side diff option are diffed against the target objects as predicted by the API server,
+	// the managed resources keep the target objects which are applied by syncs
+	diffTargets := targetObjs
+	if isServerSideDiffEnabled(app) && cluster != nil {
+		var serverSideDiffConditions []v1alpha1.ApplicationCondition
+		diffTargets, serverSideDiffConditions = m.getServerSideDiffTargets(app, cluster, targetObjs, managedLiveObj, now)
+		conditions = append(conditions, serverSideDiffConditions...)
+		if ctx.Err() != nil {
+			return cancelledComparison(app, sources, reconciledAt)
+		}
+	}
+
+	// Do the actual comparison, the resources which did not change since the last comparison are not diffed again
+	_, diffSpan := m.tracer.StartSpan(ctx, spanDiff)
+	diffSpan.SetAttribute(spanAttributeResources, len(diffTargets))
+	diffResults, err := m.diffResources(app.Name, app.Spec.Destination.Server, normalizers, diffTargets, managedLiveObj, noCache, preview)
+	endSpan(diffSpan, err)
 	if err != nil {
 		diffResults = &diff.DiffResultList{}
 		failedToLoadObjs = true
@@ -400,6 +1353,9 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	}
 
 	syncCode := v1alpha1.SyncStatusCodeSynced
+	var driftGraceDeadline time.Time
+	var deniedResources, deniedClusterResources []string
+	getResourceDenyingRule := m.newResourceDenyingRuleGetter(app, proj)
 	managedResources := make([]managedResource, len(targetObjs))
 	resourceSummaries := make([]v1alpha1.ResourceStatus, len(targetObjs))
 	for i, targetObj := range targetObjs {
@@ -421,25 +1377,69 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 			Group:           gvk.Group,
 			Hook:            hookutil.IsHook(obj),
 			RequiresPruning: targetObj == nil && liveObj != nil,
+			PruneProtected:  isPruneProtected(liveObj, targetObj),
 		}
 
 		diffResult := diffResults.Diffs[i]
 		if resState.Hook || ignore.Ignore(obj) {
 			// For resource hooks, don't store sync status, and do not affect overall sync status
+		} else if rule, denied := getResourceDenyingRule(gvk.GroupKind()); denied {
+			// The resource cannot be synced, so its status is unknown. Denied resources are reported by the
+			// ResourcePermissionError condition instead of affecting the overall sync status.
+			resState.Status = v1alpha1.SyncStatusCodeUnknown
+			resState.Message = fmt.Sprintf("blocked by project %s rule %s", proj.Name, rule)
+			name := resState.Name
+			if resState.Namespace != "" {
+				name = fmt.Sprintf("%s/%s", resState.Namespace, resState.Name)
+			}
+			if resState.Namespace == "" {
+				deniedClusterResources = append(deniedClusterResources, fmt.Sprintf("%s/%s %s", gvk.Group, gvk.Kind, name))
+			} else {
+				deniedResources = append(deniedResources, fmt.Sprintf("%s %s (%s)", gvk.Kind, name, rule))
+			}
+		} else if diffResult.Modified && targetObj != nil && liveObj != nil && ignoredChanges.IsIgnored(gvk.GroupKind(), diffResult.ModifiedPaths()) {
+			// The resource only differs in fields which are updated by controllers or operators, e.g. the status. The
+			// diff is still kept in the managed resources for inspection.
+			resState.Status = v1alpha1.SyncStatusCodeSynced
+		} else if deadline, ok := getDriftGraceDeadline(app, resState, targetObj, liveObj, now.Time); ok && diffResult.Modified {
+			// The resource drifted shortly after it was synced, it is expected to converge within its grace period
+			resState.Status = v1alpha1.SyncStatusCodeSynced
+			resState.PendingDrift = true
+			if driftGraceDeadline.IsZero() || deadline.Before(driftGraceDeadline) {
+				driftGraceDeadline = deadline
+			}
 		} else if diffResult.Modified || targetObj == nil || liveObj == nil {
 			// Set resource state to OutOfSync since one of the following is true:
 			// * target and live resource are different
 			// * target resource not defined and live resource is extra
 			// * target resource present but live resource is missing
 			resState.Status = v1alpha1.SyncStatusCodeOutOfSync
-			// we ignore the status if the obj needs pruning AND we have the annotation
+			if diffResult.Modified && targetObj != nil && liveObj != nil {
+				// the diff is performed on normalized objects, so ignored fields are never reported
+				resState.ModifiedFields = diffResult.ModifiedFields(maxModifiedFields)
+			}
+			// we ignore the status if the obj needs pruning AND we have the annotation or an override of its kind
 			needsPruning := targetObj == nil && liveObj != nil
-			if !(needsPruning && resource.HasAnnotationOption(obj, common.AnnotationCompareOptions, "IgnoreExtraneous")) {
+			if !(needsPruning && (resource.HasAnnotationOption(obj, common.AnnotationCompareOptions, "IgnoreExtraneous") || ignoresExtraneous(resourceOverrides, gvk.GroupKind()))) {
 				syncCode = v1alpha1.SyncStatusCodeOutOfSync
 			}
 		} else {
 			resState.Status = v1alpha1.SyncStatusCodeSynced
 		}
+		var predictedObj *unstructured.Unstructured
+		if diffTargets[i] != targetObjs[i] {
+			predictedObj = diffTargets[i]
+		}
+		if redactSecrets && isSecret(obj) {
+			// the sync status is already known, so only the keys which differ have to be preserved in the diff
+			if redactedTarget, redactedPredicted, redactedLive, redactedDiff, err := redactSecretData(targetObj, predictedObj, liveObj, diffNormalizer); err != nil {
+				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+				// the data which failed to be redacted must not be reported
+				targetObj, predictedObj, liveObj, diffResult = stripSecretData(targetObj, predictedObj, liveObj, diffNormalizer)
+			} else {
+				targetObj, predictedObj, liveObj, diffResult = redactedTarget, redactedPredicted, redactedLive, redactedDiff
+			}
+		}
 		managedResources[i] = managedResource{
 			Name:      resState.Name,
 			Namespace: resState.Namespace,
@@ -448,80 +1448,704 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 			Version:   resState.Version,
 			Live:      liveObj,
 			Target:    targetObj,
+			Predicted: predictedObj,
 			Diff:      diffResult,
 			Hook:      resState.Hook,
 		}
 		resourceSummaries[i] = resState
 	}
 
+	if len(deniedResources) > 0 {
+		sort.Strings(deniedResources)
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionResourcePermissionError,
+			Message:            fmt.Sprintf("Resources are not permitted in project %s: %s", proj.Name, strings.Join(deniedResources, ", ")),
+			LastTransitionTime: &now,
+		})
+	}
+	if len(deniedClusterResources) > 0 {
+		sort.Strings(deniedClusterResources)
+		action := "reported as blocked by syncs"
+		if blocksClusterResources(app) {
+			action = "excluded from syncs"
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionClusterResourceWarning,
+			Message:            fmt.Sprintf("Cluster-scoped resources are not whitelisted in project %s and are %s: %s", proj.Name, action, strings.Join(deniedClusterResources, ", ")),
+			LastTransitionTime: &now,
+		})
+	}
 	if failedToLoadObjs {
 		syncCode = v1alpha1.SyncStatusCodeUnknown
+	} else if namespaceDenied {
+		// the resources in namespaces which are not permitted are not deployed, so the app cannot be in sync
+		syncCode = v1alpha1.SyncStatusCodeOutOfSync
 	}
 	syncStatus := v1alpha1.SyncStatus{
-		ComparedTo: appv1.ComparedTo{
-			Source:      source,
-			Destination: app.Spec.Destination,
-		},
-		Status: syncCode,
+		ComparedTo:         newComparedTo(app, sources),
+		Status:             syncCode,
+		LastTransitionTime: syncTransitionTime(app, syncCode, now),
 	}
-	if manifestInfo != nil {
-		syncStatus.Revision = manifestInfo.Revision
+	if len(manifestInfos) > 0 {
+		setSyncRevisions(&syncStatus, manifestInfos, multipleSources)
+	} else if multipleSources {
+		syncStatus.Revisions = app.Status.Sync.Revisions
+	} else {
+		syncStatus.Revision = attemptedRevision
 	}
 
-	healthStatus, err := health.SetApplicationHealth(resourceSummaries, GetLiveObjs(managedResources), resourceOverrides, func(obj *unstructured.Unstructured) bool {
+	_, healthSpan := m.tracer.StartSpan(ctx, spanAssessHealth)
+	healthStatus, err := health.SetApplicationHealth(resourceSummaries, GetLiveObjs(managedResources), resourceOverrides, app.Spec.HealthRollupPolicy, func(obj *unstructured.Unstructured) bool {
 		return !isSelfReferencedApp(app, kubeutil.GetObjectRef(obj))
 	})
+	endSpan(healthSpan, err)
+
+	if err != nil {
+		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+	healthStatus.LastTransitionTime = healthTransitionTime(app, healthStatus.Status, now)
+
+	resourceNodes, err := m.getResourceNodes(app, managedResources)
+	if err != nil {
+		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
 
+	orphanedResources, err := m.getOrphanedResources(app, proj, cs, managedResources)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 	}
 
+	if redactSecrets {
+		for i := range hooks {
+			if !isSecret(hooks[i]) {
+				continue
+			}
+			if redacted, _, err := diff.HideSecretData(hooks[i], nil); err != nil {
+				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+				hooks[i] = withoutSecretData(hooks[i])
+			} else {
+				hooks[i] = redacted
+			}
+		}
+	}
+
 	compRes := comparisonResult{
-		reconciledAt:     reconciledAt,
-		syncStatus:       &syncStatus,
-		healthStatus:     healthStatus,
-		resources:        resourceSummaries,
-		managedResources: managedResources,
-		hooks:            hooks,
-		diffNormalizer:   diffNormalizer,
+		reconciledAt:           reconciledAt,
+		attemptedAt:            attemptedAt,
+		syncStatus:             &syncStatus,
+		healthStatus:           healthStatus,
+		resources:              resourceSummaries,
+		managedResources:       managedResources,
+		hooks:                  hooks,
+		diffNormalizer:         diffNormalizer,
+		passthroughAnnotations: passthroughAnnotations,
+		resourceOverrides:      resourceOverrides,
+		signatureError:         signatureErr,
+		resourceNodes:          resourceNodes,
+		orphanedResources:      orphanedResources,
+		conditions:             conditions,
+		summary:                getApplicationSummary(managedResources, resourceNodes),
+		driftGraceDeadline:     driftGraceDeadline,
 	}
-	if manifestInfo != nil {
-		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+	if len(manifestInfos) > 0 {
+		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfos[0].SourceType)
+	}
+	if preview {
+		return &compRes
+	}
+	if ctx.Err() != nil {
+		return cancelledComparison(app, sources, reconciledAt)
+	}
+	app.Status.SetConditions(conditions, comparisonConditionTypes)
+	if forSync {
+		return &compRes
+	}
+	m.comparisonResultsLock.Lock()
+	m.comparisonResults[app.Name] = &compRes
+	m.comparisonResultsLock.Unlock()
+	// failed comparisons (including failed server-side dry-runs) are retried on the next refresh instead of being served
+	// from the cache, and pending drifts expire regardless of the comparison inputs
+	if cacheable && !failedToLoadObjs && driftGraceDeadline.IsZero() && !hasConditionOfType(conditions, v1alpha1.ApplicationConditionComparisonError) &&
+		!hasConditionOfType(conditions, v1alpha1.ApplicationConditionServerSideDiffWarning) {
+		m.setCachedComparison(app.Name, fingerprint, modificationCount, &compRes)
 	}
-	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionComparisonError:         true,
-		appv1.ApplicationConditionSharedResourceWarning:   true,
-		appv1.ApplicationConditionRepeatedResourceWarning: true,
-		appv1.ApplicationConditionExcludedResourceWarning: true,
-	})
 	return &compRes
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource) error {
-	var nextID int64
-	if len(app.Status.History) > 0 {
-		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
+// resourceLimitComparison returns the unknown comparison result of an application which has more resources than its
+// limit. The target and live resources are not reported, since they were not compared.
+func (m *appStateManager) resourceLimitComparison(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, reconciledAt, attemptedAt metav1.Time, manifestInfos []*apiclient.ManifestResponse, multipleSources bool, conditions []v1alpha1.ApplicationCondition, preview bool) *comparisonResult {
+	compRes := comparisonResult{
+		reconciledAt: reconciledAt,
+		attemptedAt:  attemptedAt,
+		syncStatus:   unknownSyncStatus(app, sources),
+		healthStatus: unknownHealthStatus(app),
+		conditions:   conditions,
 	}
-	history := append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:   revision,
-		DeployedAt: metav1.NewTime(time.Now().UTC()),
-		ID:         nextID,
-		Source:     source,
-	})
+	if len(manifestInfos) > 0 {
+		setSyncRevisions(compRes.syncStatus, manifestInfos, multipleSources)
+		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfos[0].SourceType)
+	}
+	if preview {
+		return &compRes
+	}
+	if ctx.Err() != nil {
+		return cancelledComparison(app, sources, reconciledAt)
+	}
+	app.Status.SetConditions(conditions, comparisonConditionTypes)
+	m.comparisonResultsLock.Lock()
+	m.comparisonResults[app.Name] = &compRes
+	m.comparisonResultsLock.Unlock()
+	return &compRes
+}
 
-	if len(history) > common.RevisionHistoryLimit {
-		history = history[1 : common.RevisionHistoryLimit+1]
+// cancelledComparison returns the result of a comparison which was cancelled before it completed. The sync and health
+// status of the result are unknown, so that callers which do not check for the cancellation do not fail on it.
+func cancelledComparison(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, reconciledAt metav1.Time) *comparisonResult {
+	log.WithField("application", app.Name).Info("Comparison cancelled")
+	return &comparisonResult{
+		reconciledAt: reconciledAt,
+		attemptedAt:  reconciledAt,
+		syncStatus:   unknownSyncStatus(app, sources),
+		healthStatus: unknownHealthStatus(app),
+		cancelled:    true,
 	}
+}
 
-	patch, err := json.Marshal(map[string]map[string][]v1alpha1.RevisionHistory{
-		"status": {
-			"history": history,
-		},
-	})
+// validateTargetObjs validates the given target objects and hooks against the OpenAPI schema of the destination cluster
+// and returns a condition with the field paths and reasons of the violations of each invalid object. Kinds without a
+// schema and resources with the Validate=false sync option are skipped, and applications with the
+// SchemaValidation=false sync option are not validated at all. The comparison proceeds if the schema is unavailable.
+func (m *appStateManager) validateTargetObjs(app *v1alpha1.Application, now metav1.Time, objLists ...[]*unstructured.Unstructured) []v1alpha1.ApplicationCondition {
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption("SchemaValidation=false") {
+		return nil
+	}
+	openAPISchema, err := m.liveStateCache.GetOpenAPISchema(app.Spec.Destination.Server)
+	if err != nil {
+		log.WithField("application", app.Name).Warnf("Failed to get OpenAPI schema of cluster %s, skipping schema validation: %v", app.Spec.Destination.Server, err)
+		return nil
+	}
+	if openAPISchema == nil {
+		return nil
+	}
+	var conditions []v1alpha1.ApplicationCondition
+	for _, objs := range objLists {
+		for _, obj := range objs {
+			if resource.HasAnnotationOption(obj, common.AnnotationSyncOptions, "Validate=false") {
+				continue
+			}
+			errs, ok := openAPISchema.ValidateObject(obj)
+			if !ok || len(errs) == 0 {
+				continue
+			}
+			reasons := make([]string, len(errs))
+			for i := range errs {
+				reasons[i] = errs[i].Error()
+			}
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionSchemaValidationError,
+				Message:            fmt.Sprintf("%s/%s does not match the schema of the destination cluster: %s", obj.GetKind(), obj.GetName(), strings.Join(reasons, "; ")),
+				LastTransitionTime: &now,
+			})
+		}
+	}
+	return conditions
+}
+
+// detectForeignManagers returns a warning for every live resource which appears to be managed by another tool as well,
+// e.g. Flux or Helm. Resources with the IgnoreForeignManager compare option are not reported.
+func (m *appStateManager) detectForeignManagers(app *v1alpha1.Application, now metav1.Time, targetObjs, liveObjs []*unstructured.Unstructured) []v1alpha1.ApplicationCondition {
+	logCtx := log.WithField("application", app.Name)
+	managers, err := m.settingsMgr.GetForeignManagers()
+	if err != nil {
+		logCtx.Warnf("Failed to load foreign managers, skipping detection: %v", err)
+		return nil
+	}
+	foreignManagers, err := argo.NewForeignManagers(managers)
 	if err != nil {
-		return err
+		logCtx.Warnf("Invalid foreign managers, skipping detection: %v", err)
+		return nil
+	}
+	var messages []string
+	for i, liveObj := range liveObjs {
+		targetObj := targetObjs[i]
+		if liveObj == nil || resource.HasAnnotationOption(liveObj, common.AnnotationCompareOptions, "IgnoreForeignManager") ||
+			targetObj != nil && resource.HasAnnotationOption(targetObj, common.AnnotationCompareOptions, "IgnoreForeignManager") {
+			continue
+		}
+		if name, marker, ok := foreignManagers.Detect(liveObj, targetObj); ok {
+			messages = append(messages, fmt.Sprintf("%s/%s appears to be managed by %s (%s)", liveObj.GetKind(), liveObj.GetName(), name, marker))
+		}
+	}
+	// extraneous resources are in random order
+	sort.Strings(messages)
+	conditions := make([]v1alpha1.ApplicationCondition, len(messages))
+	for i := range messages {
+		conditions[i] = v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionForeignManagerWarning,
+			Message:            messages[i],
+			LastTransitionTime: &now,
+		}
+	}
+	return conditions
+}
+
+// getApplicationSummary returns the images of the target and live pod templates of the managed resources and the images
+// and external URLs of the resource nodes, which include the running pods and the ingresses and services
+func getApplicationSummary(managedResources []managedResource, resourceNodes []v1alpha1.ResourceNode) v1alpha1.ApplicationSummary {
+	var urls []string
+	var images []string
+	for _, res := range managedResources {
+		if res.Target != nil {
+			images = append(images, kubeutil.GetImages(res.Target)...)
+		}
+		if res.Live != nil {
+			images = append(images, kubeutil.GetImages(res.Live)...)
+		}
+	}
+	tree := v1alpha1.ApplicationTree{Nodes: resourceNodes}
+	nodesSummary := tree.GetSummary()
+	urls = append(urls, nodesSummary.ExternalURLs...)
+	images = append(images, nodesSummary.Images...)
+	return v1alpha1.NewApplicationSummary(urls, images)
+}
+
+// getDiscoveryConditions returns the groups of the destination cluster which could not be discovered and the conditions
+// reporting them. The comparison fails only if some of the given objects belong to the failed groups, otherwise the
+// broken APIServices are reported by a single warning.
+func (m *appStateManager) getDiscoveryConditions(app *v1alpha1.Application, objs []*unstructured.Unstructured) (map[string]bool, []v1alpha1.ApplicationCondition) {
+	failedGroupVersions, err := m.liveStateCache.GetFailedGroupVersions(app.Spec.Destination.Server)
+	if err != nil || len(failedGroupVersions) == 0 {
+		return nil, nil
+	}
+	failedGroups := make(map[string]bool)
+	apiServices := make([]string, 0, len(failedGroupVersions))
+	for gv, gvErr := range failedGroupVersions {
+		failedGroups[gv.Group] = true
+		apiServices = append(apiServices, fmt.Sprintf("%s (%s)", kubeutil.APIServiceName(gv), gvErr))
+	}
+	sort.Strings(apiServices)
+
+	affectedKinds := make(map[string]bool)
+	for _, obj := range objs {
+		if gvk := obj.GroupVersionKind(); failedGroups[gvk.Group] {
+			affectedKinds[fmt.Sprintf("%s/%s", gvk.Group, gvk.Kind)] = true
+		}
+	}
+	if len(affectedKinds) == 0 {
+		return failedGroups, []v1alpha1.ApplicationCondition{{
+			Type:    v1alpha1.ApplicationConditionAPIDiscoveryWarning,
+			Message: fmt.Sprintf("APIs of the destination cluster which are not used by the application could not be discovered: APIService %s", strings.Join(apiServices, ", ")),
+		}}
+	}
+	kinds := make([]string, 0, len(affectedKinds))
+	for kind := range affectedKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return failedGroups, []v1alpha1.ApplicationCondition{{
+		Type:    v1alpha1.ApplicationConditionComparisonError,
+		Message: fmt.Sprintf("Failed to discover the APIs of the resource kinds %s in the destination cluster: APIService %s", strings.Join(kinds, ", "), strings.Join(apiServices, ", ")),
+	}}
+}
+
+// wellKnownClusterScopedKinds are the cluster-scoped kinds of Kubernetes, whose scope is known without discovery
+var wellKnownClusterScopedKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:                                                  true,
+	{Group: "", Kind: "Node"}:                                                       true,
+	{Group: "", Kind: "PersistentVolume"}:                                           true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:                       true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:                true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:               true,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:                           true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:   true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: true,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                                 true,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:                             true,
+	{Group: "policy", Kind: "PodSecurityPolicy"}:                                    true,
+}
+
+// getResourceDenyingRule returns the rule of the project which denies the resource group/kind in the destination
+// cluster. Resources whose kind is not registered in the cluster are not evaluated, they are validated during the sync.
+// If the scope of a kind cannot be determined, e.g. because the APIs of the cluster are not available, only the
+// well-known cluster-scoped kinds are evaluated.
+func (m *appStateManager) getResourceDenyingRule(app *v1alpha1.Application, proj *v1alpha1.AppProject, gk schema.GroupKind) (string, bool) {
+	namespaced, err := m.liveStateCache.IsNamespaced(app.Spec.Destination.Server, gk)
+	if err != nil {
+		if !wellKnownClusterScopedKinds[gk] {
+			return "", false
+		}
+		namespaced = false
+	}
+	return proj.GetResourceDenyingRule(metav1.GroupKind{Group: gk.Group, Kind: gk.Kind}, namespaced)
+}
+
+// resourceDenyingRule is the result of getResourceDenyingRule
+type resourceDenyingRule struct {
+	rule   string
+	denied bool
+}
+
+// newResourceDenyingRuleGetter returns a function which works like getResourceDenyingRule for the given application and
+// project, but evaluates the project rules only once per group/kind, since applications usually have many resources of
+// few kinds. The function is meant to be used for a single comparison, so that changes of the project or of the
+// resource kinds of the cluster apply to the next comparison.
+func (m *appStateManager) newResourceDenyingRuleGetter(app *v1alpha1.Application, proj *v1alpha1.AppProject) func(gk schema.GroupKind) (string, bool) {
+	rules := make(map[schema.GroupKind]resourceDenyingRule)
+	return func(gk schema.GroupKind) (string, bool) {
+		if r, ok := rules[gk]; ok {
+			return r.rule, r.denied
+		}
+		rule, denied := m.getResourceDenyingRule(app, proj, gk)
+		rules[gk] = resourceDenyingRule{rule: rule, denied: denied}
+		return rule, denied
+	}
+}
+
+// comparisonConditionTypes are the types of the application conditions which are managed by the comparison
+var comparisonConditionTypes = map[appv1.ApplicationConditionType]bool{
+	appv1.ApplicationConditionComparisonError:            true,
+	appv1.ApplicationConditionSignatureVerificationError: true,
+	appv1.ApplicationConditionSharedResourceWarning:      true,
+	appv1.ApplicationConditionRepeatedResourceWarning:    true,
+	appv1.ApplicationConditionExcludedResourceWarning:    true,
+	appv1.ApplicationConditionNamespaceRestrictionError:  true,
+	appv1.ApplicationConditionSchemaValidationError:      true,
+	appv1.ApplicationConditionClusterPermissionWarning:   true,
+	appv1.ApplicationConditionLocalManifestsWarning:      true,
+	appv1.ApplicationConditionUnknownResourceKindWarning: true,
+	appv1.ApplicationConditionAPIDiscoveryWarning:        true,
+	appv1.ApplicationConditionLegacyInstanceLabelWarning: true,
+	appv1.ApplicationConditionLegacyTrackingValueWarning: true,
+	appv1.ApplicationConditionForeignManagerWarning:      true,
+	appv1.ApplicationConditionStaleSettingsWarning:       true,
+	appv1.ApplicationConditionResourcePermissionError:    true,
+	appv1.ApplicationConditionResourceLimitError:         true,
+	appv1.ApplicationConditionNamespacePermissionError:   true,
+	appv1.ApplicationConditionClusterResourceWarning:     true,
+	appv1.ApplicationConditionServerSideDiffWarning:      true,
+}
+
+// withClusterConnectionState appends the connection state of the destination cluster to the given error message, so
+// that failures caused by an unreachable cluster can be told apart from problems of the application
+func withClusterConnectionState(errMessage string, state v1alpha1.ConnectionState) string {
+	message := fmt.Sprintf("%s (cluster connection status: %s", errMessage, state.Status)
+	if state.ModifiedAt != nil {
+		message = fmt.Sprintf("%s, last attempted at %s", message, state.ModifiedAt.UTC().Format(time.RFC3339))
+	}
+	if state.Status != v1alpha1.ConnectionStatusSuccessful && state.Message != "" && !strings.Contains(state.Message, errMessage) {
+		message = fmt.Sprintf("%s: %s", message, state.Message)
+	}
+	return message + ")"
+}
+
+func hasConditionOfType(conditions []v1alpha1.ApplicationCondition, conditionType v1alpha1.ApplicationConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// GetResourceDiff returns the text diff of the given managed resource of the application. The diff is produced from
+// the last comparison result of the application, so it matches the sync status reported for the resource.
+func (m *appStateManager) GetResourceDiff(app *v1alpha1.Application, key kubeutil.ResourceKey) (string, error) {
+	m.comparisonResultsLock.RLock()
+	compRes, ok := m.comparisonResults[app.Name]
+	m.comparisonResultsLock.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("application %s has not been compared yet", app.Name)
+	}
+	for _, res := range compRes.managedResources {
+		if kubeutil.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name) == key {
+			target := res.Target
+			if res.Predicted != nil {
+				target = res.Predicted
+			}
+			return diff.TextDiff(res.Name, target, res.Live, compRes.diffNormalizer)
+		}
+	}
+	return "", fmt.Errorf("resource %s is not managed by application %s", key.String(), app.Name)
+}
+
+func isSecret(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == kubeutil.SecretKind
+}
+
+// redactSecretData replaces the data of a Secret with placeholders in its target, predicted and live states and diffs
+// the redacted states. Like the sync status, the diff is produced from the object predicted by the server-side dry-run
+// if there is one.
+func redactSecretData(target, predicted, live *unstructured.Unstructured, normalizer diff.Normalizer) (*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.Unstructured, diff.DiffResult, error) {
+	redactedTarget, redactedLive, err := diff.HideSecretData(target, live)
+	if err != nil {
+		return nil, nil, nil, diff.DiffResult{}, err
+	}
+	if predicted == nil {
+		return redactedTarget, nil, redactedLive, *diff.Diff(redactedTarget, redactedLive, normalizer), nil
+	}
+	redactedPredicted, redactedLive, err := diff.HideSecretData(predicted, live)
+	if err != nil {
+		return nil, nil, nil, diff.DiffResult{}, err
+	}
+	return redactedTarget, redactedPredicted, redactedLive, *diff.Diff(redactedPredicted, redactedLive, normalizer), nil
+}
+
+// stripSecretData removes the data of a Secret which failed to be redacted from its target, predicted and live states
+// and diffs the stripped states, so that the Secret is still reported without its data
+func stripSecretData(target, predicted, live *unstructured.Unstructured, normalizer diff.Normalizer) (*unstructured.Unstructured, *unstructured.Unstructured, *unstructured.Unstructured, diff.DiffResult) {
+	target, predicted, live = withoutSecretData(target), withoutSecretData(predicted), withoutSecretData(live)
+	if predicted != nil {
+		return target, predicted, live, *diff.Diff(predicted, live, normalizer)
+	}
+	return target, predicted, live, *diff.Diff(target, live, normalizer)
+}
+
+// withoutSecretData returns a copy of the given Secret without its data and last applied configuration
+func withoutSecretData(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if obj == nil {
+		return nil
+	}
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "data")
+	unstructured.RemoveNestedField(obj.Object, "stringData")
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		delete(annotations, apiv1.LastAppliedConfigAnnotation)
+		obj.SetAnnotations(annotations)
+	}
+	return obj
+}
+
+// getResourceNodes returns nodes of the managed resources and all their children discovered via owner references
+func (m *appStateManager) getResourceNodes(app *v1alpha1.Application, managedResources []managedResource) ([]v1alpha1.ResourceNode, error) {
+	nodes := make([]v1alpha1.ResourceNode, 0)
+	for _, res := range managedResources {
+		if res.Live == nil {
+			if res.Target != nil {
+				nodes = append(nodes, v1alpha1.ResourceNode{
+					ResourceRef: v1alpha1.ResourceRef{
+						Version:   res.Version,
+						Name:      res.Name,
+						Kind:      res.Kind,
+						Group:     res.Group,
+						Namespace: res.Namespace,
+					},
+				})
+			}
+			continue
+		}
+		err := m.liveStateCache.IterateHierarchy(app.Spec.Destination.Server, kubeutil.GetResourceKey(res.Live), func(child v1alpha1.ResourceNode, appName string) {
+			nodes = append(nodes, child)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// validatePluginEnv returns an error if any of the environment variables passed to a config management plugin
+// has a value which cannot be passed safely
+func validatePluginEnv(env v1alpha1.Env) error {
+	for _, item := range env {
+		if item != nil && strings.ContainsAny(item.Value, "\r\n") {
+			return fmt.Errorf("value of config management plugin environment variable %s must not contain newlines", item.Name)
+		}
+	}
+	return nil
+}
+
+// verifyRevisionSignature returns an error if the revision of the generated manifests is not signed with a key
+// allowed by the project
+func verifyRevisionSignature(proj *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) error {
+	res := manifestInfo.VerifyResult
+	switch {
+	case res == nil:
+		return fmt.Errorf("revision %s cannot be verified: signature verification is only supported for Git repositories", manifestInfo.Revision)
+	case res.Validity == git.SignatureValidityUnsigned:
+		return fmt.Errorf("revision %s is an unsigned commit", manifestInfo.Revision)
+	case !res.Valid:
+		return fmt.Errorf("revision %s does not have a valid signature: %s (key %s)", manifestInfo.Revision, res.Validity, res.KeyID)
+	case !proj.Spec.IsSignatureKeyAllowed(res.KeyID):
+		return fmt.Errorf("revision %s is signed by %s with key %s which is not allowed by project %s", manifestInfo.Revision, res.Signer, res.KeyID, proj.Name)
+	}
+	return nil
+}
+
+// mergeHealthScripts returns a copy of the resource overrides which includes the health scripts loaded from the
+// application source. Health checks configured in the resource overrides take precedence over the scripts of the
+// application source. Scripts which fail to compile are skipped and reported as comparison errors.
+func mergeHealthScripts(resourceOverrides map[string]v1alpha1.ResourceOverride, scripts []*apiclient.HealthScript) (map[string]v1alpha1.ResourceOverride, []v1alpha1.ApplicationCondition) {
+	merged := make(map[string]v1alpha1.ResourceOverride)
+	for k, v := range resourceOverrides {
+		merged[k] = v
+	}
+	var conditions []v1alpha1.ApplicationCondition
+	for _, script := range scripts {
+		key := script.Kind
+		if script.Group != "" {
+			key = fmt.Sprintf("%s/%s", script.Group, script.Kind)
+		}
+		override := merged[key]
+		if override.HealthLua != "" {
+			continue
+		}
+		if err := lua.ValidateScript(script.Script); err != nil {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:    v1alpha1.ApplicationConditionComparisonError,
+				Message: fmt.Sprintf("Failed to load health script %s: %v", script.Path, err),
+			})
+			continue
+		}
+		override.HealthLua = script.Script
+		merged[key] = override
+	}
+	return merged, conditions
+}
+
+// getTargetResourceKey returns the key of the live resource which corresponds to the given target object, which is
+// deployed to the destination namespace of the application unless it specifies a namespace or is cluster-scoped
+func (m *appStateManager) getTargetResourceKey(app *v1alpha1.Application, obj *unstructured.Unstructured) kubeutil.ResourceKey {
+	gvk := obj.GroupVersionKind()
+	ns := util.FirstNonEmpty(obj.GetNamespace(), app.Spec.Destination.Namespace)
+	if namespaced, err := m.liveStateCache.IsNamespaced(app.Spec.Destination.Server, gvk.GroupKind()); err == nil && !namespaced {
+		ns = ""
+	}
+	return kubeutil.NewResourceKey(gvk.Group, gvk.Kind, ns, obj.GetName())
+}
+
+// isOwnedByManagedResource returns true if the owner chain of the given live object ends in one of the given resources,
+// which are managed by the application. The owners are resolved using the resource hierarchy of the cluster cache, an
+// owner which is missing from the cache, e.g. because it was already deleted, ends the chain.
+func (m *appStateManager) isOwnedByManagedResource(server string, obj *unstructured.Unstructured, managedKeys map[kubeutil.ResourceKey]bool) bool {
+	var owners []v1alpha1.ResourceRef
+	for _, ownerRef := range obj.GetOwnerReferences() {
+		gv, _ := schema.ParseGroupVersion(ownerRef.APIVersion)
+		owners = append(owners, v1alpha1.ResourceRef{Group: gv.Group, Kind: ownerRef.Kind, Namespace: obj.GetNamespace(), Name: ownerRef.Name})
+	}
+	visited := map[kubeutil.ResourceKey]bool{kubeutil.GetResourceKey(obj): true}
+	for len(owners) > 0 {
+		owner := owners[0]
+		owners = owners[1:]
+		if owner.Name == "" || owner.Kind == "" {
+			continue
+		}
+		// owners of namespaced resources are either in the same namespace or cluster scoped
+		gk := schema.GroupKind{Group: owner.Group, Kind: owner.Kind}
+		if namespaced, err := m.liveStateCache.IsNamespaced(server, gk); err == nil && !namespaced {
+			owner.Namespace = ""
+		}
+		key := kubeutil.NewResourceKey(owner.Group, owner.Kind, owner.Namespace, owner.Name)
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		if managedKeys[key] {
+			return true
+		}
+		found := false
+		err := m.liveStateCache.IterateHierarchy(server, key, func(child v1alpha1.ResourceNode, _ string) {
+			// the first node is the owner itself, the others are its children
+			if !found {
+				found = true
+				owners = append(owners, child.ParentRefs...)
+			}
+		})
+		if err != nil {
+			log.Warnf("Failed to resolve the owners of %s: %v", key.String(), err)
+		}
+	}
+	return false
+}
+
+// isIgnoredResource returns true if the resource has the IgnoreResource compare option, which excludes it from the
+// comparison, sync, resource summaries and health of the application
+func isIgnoredResource(obj *unstructured.Unstructured) bool {
+	return obj != nil && resource.HasAnnotationOption(obj, common.AnnotationCompareOptions, "IgnoreResource")
+}
+
+// ignoresExtraneous returns true if a resource override of the given kind declares that its resources do not affect
+// the sync status of the application when they require pruning
+func ignoresExtraneous(resourceOverrides map[string]v1alpha1.ResourceOverride, gk schema.GroupKind) bool {
+	for key, override := range resourceOverrides {
+		if override.IgnoreExtraneous && v1alpha1.ResourceOverrideKeyMatches(key, gk.Group, gk.Kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSameDeployment returns whether both history entries deployed the same revisions of the same sources. The sources
+// are compared in their serialized form, so that unset and empty fields are not told apart.
+func isSameDeployment(a v1alpha1.RevisionHistory, b v1alpha1.RevisionHistory) bool {
+	key := func(entry v1alpha1.RevisionHistory) ([]byte, error) {
+		return json.Marshal(v1alpha1.RevisionHistory{Revision: entry.Revision, Source: entry.Source, Sources: entry.Sources, Revisions: entry.Revisions})
+	}
+	aKey, err := key(a)
+	if err != nil {
+		return false
+	}
+	bKey, err := key(b)
+	if err != nil {
+		return false
+	}
+	return string(aKey) == string(bKey)
+}
+
+// persistRevisionHistory appends the given deployment entry to the history of the application, the ID and deployment
+// time of the entry are set here. If the most recent entry deployed the same revision and source (e.g. because
+// self-heal re-applied it), that entry is replaced instead, so repeated syncs do not push older rollback targets out
+// of the history. The RecordDuplicateHistory=true sync option restores appending an entry for every sync. The patch is
+// conditional on the resource version of the application, so a concurrent update never causes the history to be
+// overwritten by a stale copy. On conflict the latest application is fetched and the history is rebuilt from it.
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, entry v1alpha1.RevisionHistory) error {
+	appIf := m.appclientset.ArgoprojV1alpha1().Applications(m.namespace)
+	entry.DeployedAt = metav1.NewTime(time.Now().UTC())
+	var err error
+	for attempt := 0; attempt < persistRevisionHistoryAttempts; attempt++ {
+		if attempt > 0 {
+			log.Warnf("Failed to persist revision history of app '%s' due to update conflict. Retrying again...", app.Name)
+			app, err = appIf.Get(app.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+		}
+		recordDuplicates := app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption("RecordDuplicateHistory=true")
+		var history []v1alpha1.RevisionHistory
+		if n := len(app.Status.History); n > 0 && !recordDuplicates && isSameDeployment(app.Status.History[n-1], entry) {
+			entry.ID = app.Status.History[n-1].ID
+			history = append(history, app.Status.History[:n-1]...)
+			history = append(history, entry)
+		} else {
+			entry.ID = 0
+			if n > 0 {
+				entry.ID = app.Status.History[n-1].ID + 1
+			}
+			history = append(app.Status.History, entry)
+		}
+
+		if len(history) > common.RevisionHistoryLimit {
+			history = history[1 : common.RevisionHistoryLimit+1]
+		}
+
+		patch := map[string]interface{}{
+			"status": map[string][]v1alpha1.RevisionHistory{
+				"history": history,
+			},
+		}
+		if app.ResourceVersion != "" {
+			patch["metadata"] = map[string]string{"resourceVersion": app.ResourceVersion}
+		}
+		var patchJSON []byte
+		if patchJSON, err = json.Marshal(patch); err != nil {
+			return err
+		}
+		_, err = appIf.Patch(app.Name, types.MergePatchType, patchJSON)
+		if !apierr.IsConflict(err) {
+			return err
+		}
 	}
-	_, err = m.appclientset.ArgoprojV1alpha1().Applications(m.namespace).Patch(app.Name, types.MergePatchType, patch)
 	return err
 }
 
@@ -536,7 +2160,16 @@ func NewAppStateManager(
 	liveStateCache statecache.LiveStateCache,
 	projInformer cache.SharedIndexInformer,
 	metricsServer *metrics.MetricsServer,
+	comparisonBackoffConfig ComparisonBackoffConfig,
+	diffParallelism int,
+	tracer Tracer,
 ) AppStateManager {
+	if metricsServer != nil {
+		repoClientset = metrics.AddRepoServerMetricsWrapper(metricsServer, repoClientset)
+	}
+	if tracer == nil {
+		tracer = noopTracer{}
+	}
 	return &appStateManager{
 		liveStateCache: liveStateCache,
 		db:             db,
@@ -547,5 +2180,19 @@ func NewAppStateManager(
 		settingsMgr:    settingsMgr,
 		projInformer:   projInformer,
 		metricsServer:  metricsServer,
+		manifestCache:  newManifestCache(manifestCacheSize),
+
+		comparisonResults: make(map[string]*comparisonResult),
+		comparisonCache:   make(map[string]*cachedComparison),
+		diffCache:         make(map[string]*cachedDiffs),
+
+		comparisonBackoffs:      make(map[string]*comparisonBackoff),
+		knownGoodManifests:      make(map[string]*knownGoodManifests),
+		comparisonBackoffConfig: comparisonBackoffConfig,
+
+		inflightComparisons: make(map[string]map[*inflightComparison]bool),
+
+		diffParallelism: diffParallelism,
+		tracer:          tracer,
 	}
 }
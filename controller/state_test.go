@@ -1,26 +1,44 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	goruntime "runtime"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-cd/common"
+	mockstatecache "github.com/argoproj/argo-cd/controller/cache/mocks"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/kube/kubetest"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
 func TestCompareAppStateEmpty(t *testing.T) {
 	app := newFakeApp()
 	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
 			Manifests: []string{},
 			Namespace: test.FakeDestNamespace,
@@ -30,7 +48,7 @@ func TestCompareAppStateEmpty(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -39,11 +57,457 @@ func TestCompareAppStateEmpty(t *testing.T) {
 	assert.Len(t, app.Status.Conditions, 0)
 }
 
+// TestCompareAppStateCancelled tests that a comparison whose context is done reports an unknown status
+func TestCompareAppStateCancelled(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	compRes := ctrl.appStateManager.CompareAppState(ctx, app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.True(t, compRes.cancelled)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	assert.Equal(t, app.Spec.Source, compRes.syncStatus.ComparedTo.Source)
+	assert.Equal(t, argoappv1.HealthStatusUnknown, compRes.healthStatus.Status)
+	assert.Len(t, app.Status.Conditions, 0)
+	_, ok := ctrl.appStateManager.(*appStateManager).comparisonResults[app.Name]
+	assert.False(t, ok)
+}
+
+func TestCompareAppStateRevisionMetadata(t *testing.T) {
+	app := newFakeApp()
+	revisionMetadata := &argoappv1.ResolvedRevisionMetadata{ChartName: "my-chart", ChartVersion: "1.0.0", ChartAppVersion: "2.0.0"}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests:        []string{},
+			Namespace:        test.FakeDestNamespace,
+			Server:           test.FakeClusterURL,
+			Revision:         "1.0.0",
+			RevisionMetadata: revisionMetadata,
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "1.0.0", compRes.syncStatus.Revision)
+	assert.Equal(t, revisionMetadata, compRes.syncStatus.RevisionMetadata)
+}
+
+func TestCompareAppStateIgnoredChanges(t *testing.T) {
+	// the schema of the custom resource is unknown, so it is compared using a plain two-way diff
+	newWidget := func(replicas int64, phase string, reconciled string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":        "my-widget",
+				"namespace":   test.FakeDestNamespace,
+				"annotations": map[string]interface{}{"operator.example.com/last-reconciled": reconciled},
+			},
+			"spec":   map[string]interface{}{"replicas": replicas},
+			"status": map[string]interface{}{"phase": phase},
+		}}
+	}
+	widgetKey := kube.NewResourceKey("example.com", "Widget", test.FakeDestNamespace, "my-widget")
+	tests := []struct {
+		name          string
+		configMapData map[string]string
+		live          *unstructured.Unstructured
+		expected      argoappv1.SyncStatusCode
+	}{{
+		name:     "StatusOnly",
+		live:     newWidget(1, "Pending", "a"),
+		expected: argoappv1.SyncStatusCodeSynced,
+	}, {
+		name:     "MetadataKeyNotIgnored",
+		live:     newWidget(1, "Running", "b"),
+		expected: argoappv1.SyncStatusCodeOutOfSync,
+	}, {
+		name:          "GlobalMetadataKey",
+		configMapData: map[string]string{"resource.ignoredMetadataKeys": "- operator.example.com/*"},
+		live:          newWidget(1, "Pending", "b"),
+		expected:      argoappv1.SyncStatusCodeSynced,
+	}, {
+		name: "KindMetadataKey",
+		configMapData: map[string]string{"resource.customizations": `
+example.com/Widget:
+  ignoreDifferences: |
+    ignoredMetadataKeys:
+    - operator.example.com/last-reconciled`},
+		live:     newWidget(1, "Running", "b"),
+		expected: argoappv1.SyncStatusCodeSynced,
+	}, {
+		name:          "SpecChange",
+		configMapData: map[string]string{"resource.ignoredMetadataKeys": "- operator.example.com/*"},
+		live:          newWidget(2, "Pending", "b"),
+		expected:      argoappv1.SyncStatusCodeOutOfSync,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeApp()
+			data := fakeData{
+				apps: []runtime.Object{app, &defaultProj},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{toJSON(t, newWidget(1, "Running", "a"))},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{widgetKey: tt.live},
+				configMapData:   tt.configMapData,
+			}
+			ctrl := newFakeController(&data)
+			compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+			assert.Equal(t, tt.expected, compRes.syncStatus.Status)
+			if assert.Len(t, compRes.resources, 1) {
+				assert.Equal(t, tt.expected, compRes.resources[0].Status)
+			}
+			// the raw diff is retained for inspection
+			if assert.Len(t, compRes.managedResources, 1) {
+				assert.True(t, compRes.managedResources[0].Diff.Modified)
+			}
+		})
+	}
+}
+
+// TestCompareAppStateUnknownResourceKind tests when a manifest is of a kind which isn't registered in the cluster
+func TestCompareAppStateUnknownResourceKind(t *testing.T) {
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "my-widget", "namespace": test.FakeDestNamespace},
+	}}
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, widget), toJSON(t, test.NewPod())},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs:   make(map[kube.ResourceKey]*unstructured.Unstructured),
+		unknownGroupKinds: []schema.GroupKind{{Group: "example.com", Kind: "Widget"}},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	for _, res := range compRes.resources {
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, res.Status)
+	}
+	var conditions []argoappv1.ApplicationCondition
+	for _, condition := range compRes.conditions {
+		if condition.Type == argoappv1.ApplicationConditionUnknownResourceKindWarning {
+			conditions = append(conditions, condition)
+		}
+	}
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, "Resource kinds are not registered in the destination cluster: example.com/Widget", conditions[0].Message)
+	}
+}
+
+func TestCompareAppStateUnknownResourceKindNamespace(t *testing.T) {
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "my-widget"},
+	}}
+	pod := test.NewPod()
+	pod.SetNamespace("")
+	otherPod := test.NewPod()
+	otherPod.SetName("other-pod")
+	otherPod.SetNamespace("")
+	otherPod.SetAnnotations(map[string]string{common.AnnotationDefaultNamespace: "other"})
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, widget), toJSON(t, pod), toJSON(t, otherPod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs:   make(map[kube.ResourceKey]*unstructured.Unstructured),
+		unknownGroupKinds: []schema.GroupKind{{Group: "example.com", Kind: "Widget"}},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	namespaces := make(map[string]string)
+	for _, res := range compRes.managedResources {
+		namespaces[res.Name] = res.Target.GetNamespace()
+	}
+	assert.Equal(t, map[string]string{"my-widget": "", pod.GetName(): test.FakeDestNamespace, "other-pod": "other"}, namespaces)
+	for _, condition := range compRes.conditions {
+		assert.NotEqual(t, argoappv1.ApplicationConditionComparisonError, condition.Type, condition.Message)
+	}
+}
+
+type fakeResourceInfoProvider struct {
+	// namespaced holds the scope of the known kinds
+	namespaced map[schema.GroupKind]bool
+	// errors holds the errors returned for the kinds whose scope can't be determined
+	errors map[schema.GroupKind]error
+}
+
+func (p *fakeResourceInfoProvider) IsNamespaced(server string, gk schema.GroupKind) (bool, error) {
+	return p.namespaced[gk], p.errors[gk]
+}
+
+func (p *fakeResourceInfoProvider) IsKnownGroupKind(server string, gk schema.GroupKind) (bool, error) {
+	if err, ok := p.errors[gk]; ok {
+		return false, err
+	}
+	_, known := p.namespaced[gk]
+	return known, nil
+}
+
+func TestDeduplicateTargetObjects(t *testing.T) {
+	newObj := func(apiVersion, kind, namespace, name string, annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": apiVersion, "kind": kind}}
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	infoProvider := &fakeResourceInfoProvider{
+		namespaced: map[schema.GroupKind]bool{
+			{Kind: kube.PodKind}: true,
+			{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}: false,
+		},
+		errors: map[schema.GroupKind]error{
+			{Group: "broken.example.com", Kind: "Gadget"}: fmt.Errorf("discovery failed"),
+		},
+	}
+
+	t.Run("KnownKinds", func(t *testing.T) {
+		objs, conditions, _ := DeduplicateTargetObjects(test.FakeClusterURL, "dest", []*unstructured.Unstructured{
+			newObj("v1", kube.PodKind, "", "pod", nil),
+			newObj("v1", kube.PodKind, "", "other-pod", map[string]string{common.AnnotationDefaultNamespace: "other"}),
+			newObj("v1", kube.PodKind, "explicit", "explicit-pod", map[string]string{common.AnnotationDefaultNamespace: "other"}),
+			newObj("rbac.authorization.k8s.io/v1", "ClusterRole", "dest", "role", nil),
+		}, infoProvider, DeduplicationLastWins)
+		assert.Empty(t, conditions)
+		namespaces := make(map[string]string)
+		for _, obj := range objs {
+			namespaces[obj.GetName()] = obj.GetNamespace()
+		}
+		assert.Equal(t, map[string]string{"pod": "dest", "other-pod": "other", "explicit-pod": "explicit", "role": ""}, namespaces)
+	})
+
+	t.Run("UnknownKind", func(t *testing.T) {
+		objs, conditions, _ := DeduplicateTargetObjects(test.FakeClusterURL, "dest", []*unstructured.Unstructured{
+			newObj("example.com/v1", "Widget", "", "cluster-widget", nil),
+			newObj("example.com/v1", "Widget", "explicit", "namespaced-widget", nil),
+		}, infoProvider, DeduplicationLastWins)
+		assert.Empty(t, conditions)
+		namespaces := make(map[string]string)
+		for _, obj := range objs {
+			namespaces[obj.GetName()] = obj.GetNamespace()
+		}
+		assert.Equal(t, map[string]string{"cluster-widget": "", "namespaced-widget": "explicit"}, namespaces)
+	})
+
+	t.Run("DiscoveryFailure", func(t *testing.T) {
+		objs, conditions, _ := DeduplicateTargetObjects(test.FakeClusterURL, "dest", []*unstructured.Unstructured{
+			newObj("broken.example.com/v1", "Gadget", "", "gadget-1", nil),
+			newObj("broken.example.com/v1", "Gadget", "", "gadget-2", nil),
+			newObj("v1", kube.PodKind, "", "pod", nil),
+		}, infoProvider, DeduplicationLastWins)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionComparisonError, conditions[0].Type)
+			assert.Equal(t, "Failed to determine the scope of Gadget.broken.example.com: discovery failed", conditions[0].Message)
+		}
+		namespaces := make(map[string]string)
+		for _, obj := range objs {
+			namespaces[obj.GetName()] = obj.GetNamespace()
+		}
+		assert.Equal(t, map[string]string{"gadget-1": "", "gadget-2": "", "pod": "dest"}, namespaces)
+	})
+
+	t.Run("Duplicates", func(t *testing.T) {
+		objs, conditions, _ := DeduplicateTargetObjects(test.FakeClusterURL, "dest", []*unstructured.Unstructured{
+			newObj("v1", kube.PodKind, "", "pod", nil),
+			newObj("v1", kube.PodKind, "dest", "pod", nil),
+		}, infoProvider, DeduplicationLastWins)
+		assert.Len(t, objs, 1)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, conditions[0].Type)
+		}
+	})
+
+	newDuplicates := func() []*unstructured.Unstructured {
+		objs := make([]*unstructured.Unstructured, 3)
+		for i := range objs {
+			objs[i] = newObj("v1", kube.PodKind, "", "pod", map[string]string{"index": strconv.Itoa(i)})
+			setManifestOrigin(objs[i], fmt.Sprintf("manifest %d", i+1))
+		}
+		return objs
+	}
+	tests := []struct {
+		strategy         DeduplicationStrategy
+		expectedIndex    string
+		expectedType     argoappv1.ApplicationConditionType
+		expected         string
+		expectedRejected bool
+	}{{
+		strategy:      DeduplicationLastWins,
+		expectedIndex: "2",
+		expectedType:  argoappv1.ApplicationConditionRepeatedResourceWarning,
+		expected:      "Resource /Pod/dest/pod appeared 3 times among application resources (from manifest 1, manifest 2, manifest 3), kept occurrence 3 of 3 (from manifest 3).",
+	}, {
+		strategy:      DeduplicationFirstWins,
+		expectedIndex: "0",
+		expectedType:  argoappv1.ApplicationConditionRepeatedResourceWarning,
+		expected:      "Resource /Pod/dest/pod appeared 3 times among application resources (from manifest 1, manifest 2, manifest 3), kept occurrence 1 of 3 (from manifest 1).",
+	}, {
+		strategy:         DeduplicationError,
+		expectedIndex:    "2",
+		expectedType:     argoappv1.ApplicationConditionComparisonError,
+		expected:         "Resource /Pod/dest/pod appeared 3 times among application resources (from manifest 1, manifest 2, manifest 3), which is not allowed by the sync option DuplicateResources=error.",
+		expectedRejected: true,
+	}, {
+		strategy:         DeduplicationErrorOnConflict,
+		expectedIndex:    "2",
+		expectedType:     argoappv1.ApplicationConditionComparisonError,
+		expected:         "Resource /Pod/dest/pod appeared 3 times among application resources with different content (from manifest 1, manifest 2, manifest 3), which is not allowed by the sync option DuplicateResources=error-on-conflict.",
+		expectedRejected: true,
+	}}
+	for _, tt := range tests {
+		t.Run("Strategy="+string(tt.strategy), func(t *testing.T) {
+			objs, conditions, rejected := DeduplicateTargetObjects(test.FakeClusterURL, "dest", newDuplicates(), infoProvider, tt.strategy)
+			if assert.Len(t, objs, 1) {
+				assert.Equal(t, map[string]string{"index": tt.expectedIndex}, objs[0].GetAnnotations())
+			}
+			if assert.Len(t, conditions, 1) {
+				assert.Equal(t, tt.expectedType, conditions[0].Type)
+				assert.Equal(t, tt.expected, conditions[0].Message)
+			}
+			assert.Equal(t, tt.expectedRejected, rejected)
+		})
+	}
+
+	t.Run("IdenticalDuplicatesWithoutConflict", func(t *testing.T) {
+		duplicates := make([]*unstructured.Unstructured, 2)
+		for i := range duplicates {
+			duplicates[i] = newObj("v1", kube.PodKind, "", "pod", nil)
+			setManifestOrigin(duplicates[i], fmt.Sprintf("manifest %d", i+1))
+		}
+		objs, conditions, rejected := DeduplicateTargetObjects(test.FakeClusterURL, "dest", duplicates, infoProvider, DeduplicationErrorOnConflict)
+		assert.Len(t, objs, 1)
+		assert.False(t, rejected)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, conditions[0].Type)
+		}
+	})
+
+	t.Run("HooksAreNotDuplicates", func(t *testing.T) {
+		newHook := func(wave string) *unstructured.Unstructured {
+			hook := newObj("batch/v1", "Job", "", "migrate", map[string]string{common.AnnotationKeyHook: "PreSync", common.AnnotationSyncWave: wave})
+			setManifestOrigin(hook, "manifest "+wave)
+			return hook
+		}
+		objs, conditions, rejected := DeduplicateTargetObjects(test.FakeClusterURL, "dest", []*unstructured.Unstructured{newHook("1"), newHook("2")}, infoProvider, DeduplicationError)
+		assert.Len(t, objs, 2)
+		assert.Empty(t, conditions)
+		assert.False(t, rejected)
+		for _, obj := range objs {
+			assert.NotContains(t, obj.GetAnnotations(), common.AnnotationManifestOrigin)
+		}
+	})
+
+	t.Run("RemovesOrigin", func(t *testing.T) {
+		obj := newObj("v1", kube.PodKind, "", "pod", nil)
+		setManifestOrigin(obj, "manifest 1")
+		objs, conditions, _ := DeduplicateTargetObjects(test.FakeClusterURL, "dest", []*unstructured.Unstructured{obj}, infoProvider, DeduplicationLastWins)
+		assert.Empty(t, conditions)
+		if assert.Len(t, objs, 1) {
+			_, ok, _ := unstructured.NestedFieldNoCopy(objs[0].Object, "metadata", "annotations")
+			assert.False(t, ok)
+		}
+	})
+}
+
+func TestGetDeduplicationStrategy(t *testing.T) {
+	app := newFakeApp()
+	assert.Equal(t, DeduplicationLastWins, GetDeduplicationStrategy(app))
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"CreateNamespace=true", "DuplicateResources=first-wins"}}
+	assert.Equal(t, DeduplicationFirstWins, GetDeduplicationStrategy(app))
+	app.Spec.SyncPolicy.SyncOptions = argoappv1.SyncOptions{"DuplicateResources=error"}
+	assert.Equal(t, DeduplicationError, GetDeduplicationStrategy(app))
+	app.Spec.SyncPolicy.SyncOptions = argoappv1.SyncOptions{"DuplicateResources=error-on-conflict"}
+	assert.Equal(t, DeduplicationErrorOnConflict, GetDeduplicationStrategy(app))
+	app.Spec.SyncPolicy.SyncOptions = argoappv1.SyncOptions{"DuplicateResources=unknown"}
+	assert.Equal(t, DeduplicationLastWins, GetDeduplicationStrategy(app))
+}
+
+func TestCompareAppStateClusterConnectionState(t *testing.T) {
+	attemptedAt := metav1.NewTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	tests := []struct {
+		name     string
+		err      error
+		state    argoappv1.ConnectionState
+		expected string
+	}{{
+		name:     "Failed",
+		err:      fmt.Errorf("dial tcp: connection refused"),
+		state:    argoappv1.ConnectionState{Status: argoappv1.ConnectionStatusFailed, Message: "Unable to connect to cluster: dial tcp: connection refused", ModifiedAt: &attemptedAt},
+		expected: "dial tcp: connection refused (cluster connection status: Failed, last attempted at 2020-01-02T03:04:05Z)",
+	}, {
+		name:     "FailedWatch",
+		err:      fmt.Errorf("cache of cluster has been stopped"),
+		state:    argoappv1.ConnectionState{Status: argoappv1.ConnectionStatusFailed, Message: "Unable to connect to cluster: i/o timeout", ModifiedAt: &attemptedAt},
+		expected: "cache of cluster has been stopped (cluster connection status: Failed, last attempted at 2020-01-02T03:04:05Z: Unable to connect to cluster: i/o timeout)",
+	}, {
+		name:     "Successful",
+		err:      fmt.Errorf("cache of cluster has been stopped"),
+		state:    argoappv1.ConnectionState{Status: argoappv1.ConnectionStatusSuccessful, ModifiedAt: &attemptedAt},
+		expected: "cache of cluster has been stopped (cluster connection status: Successful, last attempted at 2020-01-02T03:04:05Z)",
+	}, {
+		name:     "Unknown",
+		err:      fmt.Errorf("cluster not found"),
+		state:    argoappv1.ConnectionState{Status: argoappv1.ConnectionStatusUnknown, Message: "Cluster has not been synced yet"},
+		expected: "cluster not found (cluster connection status: Unknown: Cluster has not been synced yet)",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeApp()
+			data := fakeData{
+				apps: []runtime.Object{app, &defaultProj},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjsErr:     tt.err,
+				clusterConnectionState: &tt.state,
+			}
+			ctrl := newFakeController(&data)
+			compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+			assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+			if assert.Len(t, compRes.conditions, 1) {
+				assert.Equal(t, argoappv1.ApplicationConditionComparisonError, compRes.conditions[0].Type)
+				assert.Equal(t, tt.expected, compRes.conditions[0].Message)
+			}
+		})
+	}
+}
+
 // TestCompareAppStateMissing tests when there is a manifest defined in the repo which doesn't exist in live
 func TestCompareAppStateMissing(t *testing.T) {
 	app := newFakeApp()
 	data := fakeData{
-		apps: []runtime.Object{app},
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
 			Manifests: []string{string(test.PodManifest)},
 			Namespace: test.FakeDestNamespace,
@@ -53,7 +517,7 @@ func TestCompareAppStateMissing(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
@@ -69,6 +533,7 @@ func TestCompareAppStateExtra(t *testing.T) {
 	app := newFakeApp()
 	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: app.Name}
 	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
 			Manifests: []string{},
 			Namespace: test.FakeDestNamespace,
@@ -80,7 +545,7 @@ func TestCompareAppStateExtra(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
 	assert.Equal(t, 1, len(compRes.resources))
@@ -88,185 +553,254 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Equal(t, 0, len(app.Status.Conditions))
 }
 
-// TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
-// considered as part of resources when assessing Synced status
-func TestCompareAppStateHook(t *testing.T) {
-	pod := test.NewPod()
-	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
-	podBytes, _ := json.Marshal(pod)
+func TestCompareAppStateResourceExclusions(t *testing.T) {
+	extraPod := test.NewPod()
+	extraPod.SetName("extra-pod")
+	extraPod.SetNamespace(test.FakeDestNamespace)
 	app := newFakeApp()
+	app.Spec.ResourceExclusions = []argoappv1.ResourceExclusion{{Kind: "Pod"}}
 	data := fakeData{
-		apps: []runtime.Object{app},
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
-			Manifests: []string{string(podBytes)},
+			Manifests: []string{string(test.PodManifest)},
 			Namespace: test.FakeDestNamespace,
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
 		},
-		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(extraPod): extraPod,
+		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
-	assert.NotNil(t, compRes)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	// neither the excluded target pod is missing nor the excluded live pod requires pruning
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
-	assert.Equal(t, 0, len(compRes.resources))
-	assert.Equal(t, 0, len(compRes.managedResources))
-	assert.Equal(t, 1, len(compRes.hooks))
-	assert.Equal(t, 0, len(app.Status.Conditions))
+	assert.Len(t, compRes.resources, 0)
+	assert.Len(t, compRes.managedResources, 0)
+	if assert.Len(t, compRes.conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionExcludedResourceWarning, compRes.conditions[0].Type)
+		assert.Equal(t, "Resource /Pod my-pod is excluded in the application", compRes.conditions[0].Message)
+	}
 }
 
-// checks that ignore resources are detected, but excluded from status
-func TestCompareAppStateCompareOptionIgnoreExtraneous(t *testing.T) {
-	pod := test.NewPod()
-	pod.SetAnnotations(map[string]string{common.AnnotationCompareOptions: "IgnoreExtraneous"})
+// checks that live resources of kinds excluded in the settings are neither reported as out of sync nor pruned
+func TestCompareAppStateSettingsResourceExclusions(t *testing.T) {
+	extraPod := test.NewPod()
+	extraPod.SetName("extra-pod")
+	extraPod.SetNamespace(test.FakeDestNamespace)
 	app := newFakeApp()
 	data := fakeData{
-		apps: []runtime.Object{app},
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
 			Manifests: []string{},
 			Namespace: test.FakeDestNamespace,
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
 		},
-		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(extraPod): extraPod,
+		},
+		configMapData: map[string]string{"resource.exclusions": `- apiGroups: [""]
+  kinds: [Pod]
+  clusters: ["*"]`},
 	}
 	ctrl := newFakeController(&data)
-
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
-
-	assert.NotNil(t, compRes)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
 	assert.Len(t, compRes.resources, 0)
 	assert.Len(t, compRes.managedResources, 0)
-	assert.Len(t, app.Status.Conditions, 0)
+	assert.Len(t, compRes.conditions, 0)
 }
 
-// TestCompareAppStateExtraHook tests when there is an extra _hook_ object in live but not defined in git
-func TestCompareAppStateExtraHook(t *testing.T) {
-	pod := test.NewPod()
-	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
-	pod.SetNamespace(test.FakeDestNamespace)
+func TestCompareAppStatePruneProtected(t *testing.T) {
+	newPod := func(annotations map[string]string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetAnnotations(annotations)
+		return pod
+	}
+	tests := []struct {
+		name              string
+		target            *unstructured.Unstructured
+		live              *unstructured.Unstructured
+		expectedStatus    argoappv1.SyncStatusCode
+		expectedPruning   bool
+		expectedProtected bool
+	}{{
+		name:              "ExtraneousProtected",
+		live:              newPod(map[string]string{common.AnnotationSyncOptions: "Prune=false"}),
+		expectedStatus:    argoappv1.SyncStatusCodeOutOfSync,
+		expectedPruning:   true,
+		expectedProtected: true,
+	}, {
+		name:              "ExtraneousProtectedAndIgnored",
+		live:              newPod(map[string]string{common.AnnotationSyncOptions: "Prune=false", common.AnnotationCompareOptions: "IgnoreExtraneous"}),
+		expectedStatus:    argoappv1.SyncStatusCodeSynced,
+		expectedPruning:   true,
+		expectedProtected: true,
+	}, {
+		name:              "ExtraneousIgnored",
+		live:              newPod(map[string]string{common.AnnotationCompareOptions: "IgnoreExtraneous"}),
+		expectedStatus:    argoappv1.SyncStatusCodeSynced,
+		expectedPruning:   true,
+		expectedProtected: false,
+	}, {
+		name:              "TargetProtected",
+		target:            newPod(map[string]string{common.AnnotationSyncOptions: "Prune=false"}),
+		live:              newPod(map[string]string{common.AnnotationSyncOptions: "Prune=false"}),
+		expectedStatus:    argoappv1.SyncStatusCodeSynced,
+		expectedPruning:   false,
+		expectedProtected: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeApp()
+			manifests := []string{}
+			if tt.target != nil {
+				manifests = append(manifests, toJSON(t, tt.target))
+			}
+			data := fakeData{
+				apps: []runtime.Object{app, &defaultProj},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: manifests,
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(tt.live): tt.live},
+			}
+			ctrl := newFakeController(&data)
+			compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+			assert.Equal(t, tt.expectedStatus, compRes.syncStatus.Status)
+			if assert.Len(t, compRes.resources, 1) {
+				assert.Equal(t, tt.expectedPruning, compRes.resources[0].RequiresPruning)
+				assert.Equal(t, tt.expectedProtected, compRes.resources[0].PruneProtected)
+			}
+		})
+	}
+}
+
+func TestCompareAppStateSummary(t *testing.T) {
 	app := newFakeApp()
-	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: app.Name}
+	target := test.NewDeployment()
+	target.SetNamespace(test.FakeDestNamespace)
+	live := target.DeepCopy()
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.14.2"
+	containers = append(containers, map[string]interface{}{"name": "sidecar", "image": "busybox:1.31"})
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers"))
 	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
-			Manifests: []string{},
+			Manifests: []string{toJSON(t, target)},
 			Namespace: test.FakeDestNamespace,
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
 		},
-		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
-			key: pod,
-		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
-
-	assert.NotNil(t, compRes)
-	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
-	assert.Equal(t, 1, len(compRes.resources))
-	assert.Equal(t, 1, len(compRes.managedResources))
-	assert.Equal(t, 0, len(compRes.hooks))
-	assert.Equal(t, 0, len(app.Status.Conditions))
-}
-
-func toJSON(t *testing.T, obj *unstructured.Unstructured) string {
-	data, err := json.Marshal(obj)
-	assert.NoError(t, err)
-	return string(data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, []string{"busybox:1.31", "nginx:1.14.2", "nginx:1.15.4"}, compRes.summary.Images)
+	assert.Empty(t, compRes.summary.ExternalURLs)
 }
 
-func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
-	obj1 := test.NewPod()
-	obj1.SetNamespace(test.FakeDestNamespace)
-	obj2 := test.NewPod()
-	obj3 := test.NewPod()
-	obj3.SetNamespace("kube-system")
-
+// TestCompareAppStateModifiedFields tests that the fields which make a resource OutOfSync are reported, except for
+// ignored differences
+func TestCompareAppStateModifiedFields(t *testing.T) {
 	app := newFakeApp()
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}
+	target := test.NewDeployment()
+	target.SetNamespace(test.FakeDestNamespace)
+	live := target.DeepCopy()
+	live.SetLabels(map[string]string{"app": "nginx", common.LabelKeyAppInstance: app.Name})
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(1), "spec", "replicas"))
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.14.2"
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers"))
+	extra := test.NewPod()
+	extra.SetNamespace(test.FakeDestNamespace)
+	extra.SetLabels(map[string]string{common.LabelKeyAppInstance: app.Name})
 	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
-			Manifests: []string{toJSON(t, obj1), toJSON(t, obj2), toJSON(t, obj3)},
+			Manifests: []string{toJSON(t, target)},
 			Namespace: test.FakeDestNamespace,
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
 		},
 		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
-			kube.GetResourceKey(obj1): obj1,
-			kube.GetResourceKey(obj3): obj3,
+			kube.GetResourceKey(live):  live,
+			kube.GetResourceKey(extra): extra,
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
-
-	assert.NotNil(t, compRes)
-	assert.Equal(t, 1, len(app.Status.Conditions))
-	assert.NotNil(t, app.Status.Conditions[0].LastTransitionTime)
-	assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, app.Status.Conditions[0].Type)
-	assert.Equal(t, "Resource /Pod/fake-dest-ns/my-pod appeared 2 times among application resources.", app.Status.Conditions[0].Message)
-	assert.Equal(t, 2, len(compRes.resources))
-}
-
-var defaultProj = argoappv1.AppProject{
-	ObjectMeta: metav1.ObjectMeta{
-		Name:      "default",
-		Namespace: test.FakeArgoCDNamespace,
-	},
-	Spec: argoappv1.AppProjectSpec{
-		SourceRepos: []string{"*"},
-		Destinations: []argoappv1.ApplicationDestination{
-			{
-				Server:    "*",
-				Namespace: "*",
-			},
-		},
-	},
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Len(t, compRes.resources, 2)
+	for _, res := range compRes.resources {
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, res.Status)
+		if res.RequiresPruning {
+			assert.Empty(t, res.ModifiedFields)
+		} else {
+			assert.Equal(t, []string{"spec.template.spec.containers[0].image"}, res.ModifiedFields)
+		}
+	}
 }
 
-func TestSetHealth(t *testing.T) {
+func TestCompareAppStateReusesUnchangedDiffs(t *testing.T) {
 	app := newFakeApp()
-	deployment := kube.MustToUnstructured(&v1.Deployment{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: "apps/v1beta1",
-			Kind:       "Deployment",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "demo",
-			Namespace: "default",
-		},
-	})
-	ctrl := newFakeController(&fakeData{
+	target := test.NewDeployment()
+	target.SetNamespace(test.FakeDestNamespace)
+	live := target.DeepCopy()
+	live.SetLabels(map[string]string{"app": "nginx", common.LabelKeyAppInstance: app.Name})
+	live.SetResourceVersion("1")
+	data := fakeData{
 		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
-			Manifests: []string{},
+			Manifests: []string{toJSON(t, target)},
 			Namespace: test.FakeDestNamespace,
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
 		},
-		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
-			kube.GetResourceKey(deployment): deployment,
-		},
-	})
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
+	}
+	ctrl := newFakeController(&data)
+	compare := func(noCache bool) argoappv1.SyncStatusCode {
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, noCache, nil)
+		assert.Len(t, compRes.resources, 1)
+		return compRes.syncStatus.Status
+	}
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compare(false))
 
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	// the live object changes without a new resource version, so the previous diff is reused
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(5), "spec", "replicas"))
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compare(false))
 
-	assert.Equal(t, compRes.healthStatus.Status, argoappv1.HealthStatusHealthy)
+	// hard refresh
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compare(true))
+
+	// the live object is diffed again once its resource version changes
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(3), "spec", "replicas"))
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compare(false))
+	live.SetResourceVersion("2")
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compare(false))
+
+	// the target object changes
+	assert.NoError(t, unstructured.SetNestedField(target.Object, int64(4), "spec", "replicas"))
+	data.manifestResponse.Manifests = []string{toJSON(t, target)}
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compare(false))
+
+	// the ignored differences change
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compare(false))
 }
 
-func TestSetHealthSelfReferencedApp(t *testing.T) {
+func TestCompareAppStateTransitionTimes(t *testing.T) {
 	app := newFakeApp()
-	unstructuredApp := kube.MustToUnstructured(app)
-	deployment := kube.MustToUnstructured(&v1.Deployment{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: "apps/v1beta1",
-			Kind:       "Deployment",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "demo",
-			Namespace: "default",
-		},
-	})
-	ctrl := newFakeController(&fakeData{
+	previous := metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+	app.Status.Sync = argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced, LastTransitionTime: &previous}
+	app.Status.Health = argoappv1.HealthStatus{Status: argoappv1.HealthStatusHealthy, LastTransitionTime: &previous}
+	data := fakeData{
 		apps: []runtime.Object{app, &defaultProj},
 		manifestResponse: &apiclient.ManifestResponse{
 			Manifests: []string{},
@@ -274,121 +808,2849 @@ func TestSetHealthSelfReferencedApp(t *testing.T) {
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
 		},
-		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
-			kube.GetResourceKey(deployment):      deployment,
-			kube.GetResourceKey(unstructuredApp): unstructuredApp,
-		},
-	})
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
 
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	// unchanged statuses keep their transition times
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.True(t, previous.Equal(compRes.syncStatus.LastTransitionTime))
+	assert.Equal(t, argoappv1.HealthStatusHealthy, compRes.healthStatus.Status)
+	assert.True(t, previous.Equal(compRes.healthStatus.LastTransitionTime))
 
-	assert.Equal(t, compRes.healthStatus.Status, argoappv1.HealthStatusHealthy)
+	// changed statuses are stamped with the time of the comparison
+	app.Status.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	app.Status.Health.Status = argoappv1.HealthStatusDegraded
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.True(t, compRes.syncStatus.LastTransitionTime.After(previous.Time))
+	assert.True(t, compRes.healthStatus.LastTransitionTime.After(previous.Time))
 }
 
-func TestSetManagedResourcesWithOrphanedResources(t *testing.T) {
-	proj := defaultProj.DeepCopy()
-	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+func TestTransitionTime(t *testing.T) {
+	previous := metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(previous.Add(time.Hour))
+	unknown := string(argoappv1.SyncStatusCodeUnknown)
+	synced, outOfSync := string(argoappv1.SyncStatusCodeSynced), string(argoappv1.SyncStatusCodeOutOfSync)
 
-	app := newFakeApp()
-	ctrl := newFakeController(&fakeData{
-		apps: []runtime.Object{app, proj},
-		namespacedResources: map[kube.ResourceKey]namespacedResource{
-			kube.NewResourceKey("apps", kube.DeploymentKind, app.Namespace, "guestbook"): {
-				ResourceNode: argoappv1.ResourceNode{
-					ResourceRef: argoappv1.ResourceRef{Kind: kube.DeploymentKind, Name: "guestbook", Namespace: app.Namespace},
-				},
-				AppName: "",
-			},
+	assert.Equal(t, previous, *transitionTime(synced, &previous, synced, unknown, now))
+	assert.Equal(t, now, *transitionTime(synced, &previous, outOfSync, unknown, now))
+	assert.Equal(t, now, *transitionTime(synced, nil, synced, unknown, now))
+	// transient failures to compare the application don't reset the time
+	assert.Equal(t, previous, *transitionTime(synced, &previous, unknown, unknown, now))
+	assert.Equal(t, previous, *transitionTime(unknown, &previous, synced, unknown, now))
+}
+
+func TestGetApplicationSummary(t *testing.T) {
+	pod := test.NewPod()
+	summary := getApplicationSummary([]managedResource{{Target: pod, Live: pod}, {Target: test.NewService()}}, []argoappv1.ResourceNode{{
+		Images:         []string{"nginx:1.7.9", "busybox:1.31"},
+		NetworkingInfo: &argoappv1.ResourceNetworkingInfo{ExternalURLs: []string{"https://guestbook.example.com/"}},
+	}})
+	assert.Equal(t, argoappv1.ApplicationSummary{
+		ExternalURLs: []string{"https://guestbook.example.com/"},
+		Images:       []string{"busybox:1.31", "nginx:1.7.9"},
+	}, summary)
+}
+
+func TestGetResourceDiff(t *testing.T) {
+	live := test.NewPod()
+	live.SetNamespace(test.FakeDestNamespace)
+	target := live.DeepCopy()
+	target.SetLabels(map[string]string{"ignored": "true"})
+	containers, _, _ := unstructured.NestedSlice(target.Object, "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.12"
+	assert.NoError(t, unstructured.SetNestedSlice(target.Object, containers, "spec", "containers"))
+
+	app := newFakeApp()
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Kind: "Pod", JSONPointers: []string{"/metadata/labels"}}}
+	key := kube.GetResourceKey(live)
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, target)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
 		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{key: live},
 	})
 
-	tree, err := ctrl.setAppManagedResources(app, &comparisonResult{managedResources: make([]managedResource, 0)})
+	_, err := ctrl.appStateManager.GetResourceDiff(app, key)
+	assert.Error(t, err)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
 
+	text, err := ctrl.appStateManager.GetResourceDiff(app, key)
 	assert.NoError(t, err)
-	assert.Equal(t, len(tree.OrphanedNodes), 1)
-	assert.Equal(t, "guestbook", tree.OrphanedNodes[0].Name)
-	assert.Equal(t, app.Namespace, tree.OrphanedNodes[0].Namespace)
+	assert.Contains(t, text, "-  - image: nginx:1.7.9\n+  - image: nginx:1.12\n")
+	assert.NotContains(t, text, "ignored")
+
+	_, err = ctrl.appStateManager.GetResourceDiff(app, kube.NewResourceKey("", "Pod", test.FakeDestNamespace, "unknown"))
+	assert.Error(t, err)
 }
 
-func TestSetManagedResourcesWithResourcesOfAnotherApp(t *testing.T) {
-	proj := defaultProj.DeepCopy()
-	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+func TestCompareAppStateJQPathExpressions(t *testing.T) {
+	target := test.NewPod()
+	target.SetNamespace(test.FakeDestNamespace)
+	live := target.DeepCopy()
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "containers")
+	containers = append(containers, map[string]interface{}{"name": "istio-proxy", "image": "istio/proxyv2"})
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "containers"))
+	newCtrl := func(app *argoappv1.Application) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, target)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
+		})
+	}
 
-	app1 := newFakeApp()
-	app1.Name = "app1"
-	app2 := newFakeApp()
-	app2.Name = "app2"
+	t.Run("InjectedContainerIgnored", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{
+			Kind:              "Pod",
+			JQPathExpressions: []string{`.spec.containers[] | select(.name == "istio-proxy")`},
+		}}
+		compRes := newCtrl(app).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	})
 
-	ctrl := newFakeController(&fakeData{
-		apps: []runtime.Object{app1, app2, proj},
-		namespacedResources: map[kube.ResourceKey]namespacedResource{
-			kube.NewResourceKey("apps", kube.DeploymentKind, app2.Namespace, "guestbook"): {
-				ResourceNode: argoappv1.ResourceNode{
-					ResourceRef: argoappv1.ResourceRef{Kind: kube.DeploymentKind, Name: "guestbook", Namespace: app2.Namespace},
-				},
-				AppName: "app2",
+	t.Run("NoMatch", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{
+			Kind:              "Pod",
+			JQPathExpressions: []string{`.spec.initContainers[] | select(.name == "istio-proxy")`},
+		}}
+		compRes := newCtrl(app).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.conditions)
+	})
+
+	t.Run("InvalidExpression", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{
+			Kind:              "Pod",
+			JQPathExpressions: []string{`.spec.containers[] | select(.name == `},
+		}}
+		compRes := newCtrl(app).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionComparisonError, compRes.conditions[0].Type)
+			assert.Contains(t, compRes.conditions[0].Message, "invalid jq path expression")
+		}
+		assert.True(t, hasConditionOfType(app.Status.Conditions, argoappv1.ApplicationConditionComparisonError))
+	})
+}
+
+func TestCompareAppStateServerSideDiff(t *testing.T) {
+	target := test.NewPod()
+	target.SetNamespace(test.FakeDestNamespace)
+	// the live pod has a container injected by an admission webhook, which the dry-run predicts as well
+	live := target.DeepCopy()
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "containers")
+	containers = append(containers, map[string]interface{}{"name": "istio-proxy", "image": "istio/proxyv2"})
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "containers"))
+	predicted := live.DeepCopy()
+	predicted.SetResourceVersion("123")
+	predicted.SetUID("my-pod")
+	newCtrl := func(app *argoappv1.Application, target *unstructured.Unstructured, kubectl *kubetest.MockKubectlCmd) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, target)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
 			},
-		},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
+			kubectl:         kubectl,
+		})
+	}
+	newApp := func() *argoappv1.Application {
+		app := newFakeApp()
+		app.Annotations = map[string]string{common.AnnotationCompareOptions: "ServerSideDiff=true"}
+		return app
+	}
+
+	t.Run("Predicted", func(t *testing.T) {
+		app := newApp()
+		kubectl := &kubetest.MockKubectlCmd{ServerSideDryRunResults: map[string]*unstructured.Unstructured{target.GetName(): predicted}}
+		compRes := newCtrl(app, target, kubectl).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.conditions)
+		if assert.Len(t, compRes.managedResources, 1) {
+			// syncs apply the target object rather than the predicted one
+			assert.Equal(t, target.GetName(), compRes.managedResources[0].Target.GetName())
+			assert.NotNil(t, compRes.managedResources[0].Predicted)
+			assert.False(t, compRes.managedResources[0].Diff.Modified)
+		}
 	})
 
-	tree, err := ctrl.setAppManagedResources(app1, &comparisonResult{managedResources: make([]managedResource, 0)})
+	t.Run("ClientSideDiffWithoutOption", func(t *testing.T) {
+		app := newFakeApp()
+		kubectl := &kubetest.MockKubectlCmd{ServerSideDryRunResults: map[string]*unstructured.Unstructured{target.GetName(): predicted}}
+		compRes := newCtrl(app, target, kubectl).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	})
 
-	assert.NoError(t, err)
-	assert.Equal(t, len(tree.OrphanedNodes), 0)
+	t.Run("ResourceForcesClientSideDiff", func(t *testing.T) {
+		app := newApp()
+		annotatedTarget := target.DeepCopy()
+		annotatedTarget.SetAnnotations(map[string]string{common.AnnotationCompareOptions: "ServerSideDiff=false"})
+		kubectl := &kubetest.MockKubectlCmd{ServerSideDryRunResults: map[string]*unstructured.Unstructured{target.GetName(): predicted}}
+		compRes := newCtrl(app, annotatedTarget, kubectl).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.conditions)
+	})
+
+	t.Run("DryRunFailure", func(t *testing.T) {
+		app := newApp()
+		kubectl := &kubetest.MockKubectlCmd{ServerSideDryRunErrors: map[string]error{
+			target.GetName(): fmt.Errorf(`Internal error occurred: failed calling webhook "sidecar-injector.istio.io": connection refused`),
+		}}
+		compRes := newCtrl(app, target, kubectl).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionServerSideDiffWarning, compRes.conditions[0].Type)
+			assert.Contains(t, compRes.conditions[0].Message, "Pod my-pod: Internal error occurred")
+		}
+		if assert.Len(t, compRes.managedResources, 1) {
+			assert.Nil(t, compRes.managedResources[0].Predicted)
+		}
+	})
 }
 
-func TestReturnUnknownComparisonStateOnSettingLoadError(t *testing.T) {
+func TestCompareAppStateOrphanedResources(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	createdAt := metav1.Now()
+	newNode := func(group, kind, name string) namespacedResource {
+		return namespacedResource{ResourceNode: argoappv1.ResourceNode{
+			ResourceRef: argoappv1.ResourceRef{Group: group, Kind: kind, Namespace: test.FakeDestNamespace, Name: name},
+			CreatedAt:   &createdAt,
+		}}
+	}
 	proj := defaultProj.DeepCopy()
 	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+	proj.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{{Group: "", Kind: "Secret"}}
+	otherApp := newNode("", "ConfigMap", "other-app-config")
+	otherApp.AppName = "other-app"
+	newCtrl := func(app *argoappv1.Application, proj *argoappv1.AppProject) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, proj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, pod)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(pod): pod},
+			namespacedResources: map[kube.ResourceKey]namespacedResource{
+				kube.GetResourceKey(pod): newNode("", kube.PodKind, pod.GetName()),
+				kube.NewResourceKey("apps", kube.DeploymentKind, test.FakeDestNamespace, "guestbook"): newNode("apps", kube.DeploymentKind, "guestbook"),
+				kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "other-app-config"):      otherApp,
+				kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "config"):                newNode("", "ConfigMap", "config"),
+				kube.NewResourceKey("", "Secret", test.FakeDestNamespace, "secret"):                   newNode("", "Secret", "secret"),
+				kube.NewResourceKey("", "Event", test.FakeDestNamespace, "event"):                     newNode("", "Event", "event"),
+				kube.NewResourceKey("", kube.ServiceAccountKind, test.FakeDestNamespace, "default"):   newNode("", kube.ServiceAccountKind, "default"),
+			},
+			configMapData: map[string]string{"resource.exclusions": `- apiGroups: [""]
+  kinds: [Event]
+  clusters: ["*"]`},
+		})
+	}
+
+	t.Run("Reported", func(t *testing.T) {
+		app := newFakeApp()
+		compRes := newCtrl(app, proj).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, []argoappv1.OrphanedResource{
+			{Kind: "ConfigMap", Name: "config", CreatedAt: &createdAt},
+			{Group: "apps", Kind: kube.DeploymentKind, Name: "guestbook", CreatedAt: &createdAt},
+		}, compRes.orphanedResources)
+	})
+
+	t.Run("MonitoringDisabledInProject", func(t *testing.T) {
+		app := newFakeApp()
+		compRes := newCtrl(app, &defaultProj).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Empty(t, compRes.orphanedResources)
+	})
+
+	t.Run("IgnoredByApplication", func(t *testing.T) {
+		app := newFakeApp()
+		app.Annotations = map[string]string{common.AnnotationCompareOptions: "IgnoreOrphanedResources=true"}
+		compRes := newCtrl(app, proj).appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Empty(t, compRes.orphanedResources)
+	})
+}
 
+// TestCompareAppStateResourceNodes tests that comparison result includes nodes of both missing and live resources
+func TestPreviewAppState(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
 	app := newFakeApp()
+	app.Status.Sync = argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced, Revision: "abc123"}
+	app.Status.Conditions = []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionComparisonError, Message: "stale error"}}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  fakeCommitSHA,
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	manager := ctrl.appStateManager.(*appStateManager)
+	_, repoClient, err := manager.repoClientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+	origApp := app.DeepCopy()
 
-	ctrl := newFakeController(&fakeData{
-		apps: []runtime.Object{app, proj},
-		configMapData: map[string]string{
-			"resource.customizations": "invalid setting",
+	compRes := ctrl.appStateManager.PreviewAppState(context.Background(), app, []string{"v2"}, []argoappv1.ApplicationSource{app.Spec.Source})
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+	assert.Len(t, compRes.managedResources, 1)
+	if assert.Len(t, compRes.conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, compRes.conditions[0].Type)
+	}
+	req := mockClient.Calls[len(mockClient.Calls)-1].Arguments.Get(1).(*apiclient.ManifestRequest)
+	assert.Equal(t, "v2", req.Revision)
+
+	// neither the application nor the last comparison result are updated
+	assert.Equal(t, origApp, app)
+	_, err = ctrl.appStateManager.GetResourceDiff(app, kube.GetResourceKey(pod))
+	assert.Error(t, err)
+	assert.Empty(t, manager.comparisonCache)
+
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	lastRes := manager.comparisonResults[app.Name]
+	ctrl.appStateManager.PreviewAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source})
+	assert.True(t, lastRes == manager.comparisonResults[app.Name])
+}
+
+func TestPreviewAppStateMissingProject(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "missing"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+
+	compRes := ctrl.appStateManager.PreviewAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source})
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	if assert.Len(t, compRes.conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, compRes.conditions[0].Type)
+	}
+	assert.Empty(t, app.Status.Conditions)
+}
+
+func TestCompareAppStateResourceNodes(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetName("extra-pod")
+	pod.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(test.PodManifest)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
 		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(pod): pod,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Len(t, compRes.resourceNodes, 2)
+	nodesByName := make(map[string]argoappv1.ResourceNode)
+	for _, node := range compRes.resourceNodes {
+		nodesByName[node.Name] = node
+	}
+	assert.Equal(t, argoappv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: "my-pod"}, nodesByName["my-pod"].ResourceRef)
+	assert.Equal(t, test.FakeDestNamespace, nodesByName["extra-pod"].Namespace)
+
+	tree, err := ctrl.setAppManagedResources(app, compRes)
+	assert.NoError(t, err)
+	assert.Equal(t, compRes.resourceNodes, tree.Nodes)
+}
+
+const degradedHealthScript = `
+hs = {}
+hs.status = "Degraded"
+hs.message = "from source"
+return hs
+`
+
+// TestCompareAppStateHealthScripts tests that health scripts of the application source are used to assess health
+func TestCompareAppStateHealthScripts(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName()}
+	newData := func() *fakeData {
+		return &fakeData{
+			apps: []runtime.Object{&defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+				HealthScripts: []*apiclient.HealthScript{
+					{Kind: "Pod", Path: ".argocd-health/Pod/health.lua", Script: degradedHealthScript},
+					{Group: "example.com", Kind: "Widget", Path: ".argocd-health/example.com/Widget/health.lua", Script: "hs = {"},
+				},
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{key: pod},
+		}
+	}
+
+	t.Run("SourceScripts", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData())
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.HealthStatusDegraded, compRes.healthStatus.Status)
+		assert.Equal(t, degradedHealthScript, compRes.resourceOverrides["Pod"].HealthLua)
+		assert.NotContains(t, compRes.resourceOverrides, "example.com/Widget")
+		if assert.Len(t, app.Status.Conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+			assert.Contains(t, app.Status.Conditions[0].Message, ".argocd-health/example.com/Widget/health.lua")
+		}
 	})
 
-	compRes := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	t.Run("ConfiguredOverrideTakesPrecedence", func(t *testing.T) {
+		app := newFakeApp()
+		data := newData()
+		data.configMapData = map[string]string{
+			"resource.customizations": `
+Pod:
+  health.lua: |
+    hs = {}
+    hs.status = "Healthy"
+    return hs
+`,
+		}
+		ctrl := newFakeController(data)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.HealthStatusHealthy, compRes.healthStatus.Status)
+		assert.NotEqual(t, degradedHealthScript, compRes.resourceOverrides["Pod"].HealthLua)
+	})
+}
 
-	assert.Equal(t, argoappv1.HealthStatusUnknown, compRes.healthStatus.Status)
+// TestCompareAppStateUnsignedRevision tests that revisions without an allowed signature are reported as conditions
+func TestCompareAppStateUnsignedRevision(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: test.FakeArgoCDNamespace, Name: "default"},
+		Spec:       argoappv1.AppProjectSpec{SignatureKeys: []argoappv1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}}},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, proj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests:    []string{},
+			Namespace:    test.FakeDestNamespace,
+			Server:       test.FakeClusterURL,
+			Revision:     "abc123",
+			VerifyResult: &apiclient.SignatureVerification{Validity: "Unsigned"},
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Error(t, compRes.signatureError)
 	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
-	assert.NotNil(t, compRes.reconciledAt)
+	if assert.Len(t, app.Status.Conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionSignatureVerificationError, app.Status.Conditions[0].Type)
+		assert.Equal(t, "revision abc123 is an unsigned commit", app.Status.Conditions[0].Message)
+	}
+
+	// local manifests bypass signature verification, so they are rejected
+	app = newFakeApp()
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, []string{string(test.PodManifest)})
+	assert.Error(t, compRes.signatureError)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	if assert.Len(t, app.Status.Conditions, 2) {
+		assert.Equal(t, argoappv1.ApplicationConditionLocalManifestsWarning, app.Status.Conditions[0].Type)
+		assert.Equal(t, argoappv1.ApplicationConditionSignatureVerificationError, app.Status.Conditions[1].Type)
+		assert.Equal(t, "local manifests cannot be verified: project default requires revisions signed with one of its signature keys", app.Status.Conditions[1].Message)
+	}
 }
 
-func TestSetManagedResourcesKnownOrphanedResourceExceptions(t *testing.T) {
-	proj := defaultProj.DeepCopy()
-	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+func TestCompareAppStateLocalManifests(t *testing.T) {
+	helmPod := test.NewPod()
+	helmPod.SetLabels(map[string]string{"helm.sh/chart": "my-chart-1.0.0"})
+	tests := []struct {
+		name         string
+		source       func(source *argoappv1.ApplicationSource)
+		statusType   argoappv1.ApplicationSourceType
+		manifest     string
+		expectedType argoappv1.ApplicationSourceType
+	}{{
+		name:         "Directory",
+		manifest:     string(test.PodManifest),
+		expectedType: argoappv1.ApplicationSourceTypeDirectory,
+	}, {
+		name:         "HelmLabels",
+		manifest:     toJSON(t, helmPod),
+		expectedType: argoappv1.ApplicationSourceTypeHelm,
+	}, {
+		name:         "HelmChart",
+		source:       func(source *argoappv1.ApplicationSource) { source.Chart = "my-chart" },
+		manifest:     string(test.PodManifest),
+		expectedType: argoappv1.ApplicationSourceTypeHelm,
+	}, {
+		name:         "ExplicitKustomize",
+		source:       func(source *argoappv1.ApplicationSource) { source.Kustomize = &argoappv1.ApplicationSourceKustomize{} },
+		manifest:     toJSON(t, helmPod),
+		expectedType: argoappv1.ApplicationSourceTypeKustomize,
+	}, {
+		name:         "LastComparedKustomize",
+		statusType:   argoappv1.ApplicationSourceTypeKustomize,
+		manifest:     string(test.PodManifest),
+		expectedType: argoappv1.ApplicationSourceTypeKustomize,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newFakeApp()
+			if tc.source != nil {
+				tc.source(&app.Spec.Source)
+			}
+			app.Status.SourceType = tc.statusType
+			data := fakeData{
+				apps:             []runtime.Object{app, &defaultProj},
+				manifestResponse: &apiclient.ManifestResponse{Revision: "abc123"},
+				managedLiveObjs:  make(map[kube.ResourceKey]*unstructured.Unstructured),
+			}
+			ctrl := newFakeController(&data)
+			compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, []string{tc.manifest})
 
-	app := newFakeApp()
-	app.Namespace = "default"
+			revision := compRes.syncStatus.Revision
+			assert.True(t, strings.HasPrefix(revision, "local-"))
+			assert.Len(t, revision, len("local-")+64)
+			assert.Equal(t, tc.expectedType, compRes.appSourceType)
+			if assert.Len(t, app.Status.Conditions, 1) {
+				assert.Equal(t, argoappv1.ApplicationConditionLocalManifestsWarning, app.Status.Conditions[0].Type)
+			}
 
-	ctrl := newFakeController(&fakeData{
+			// the pseudo-revision identifies the manifests
+			compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, []string{tc.manifest})
+			assert.Equal(t, revision, compRes.syncStatus.Revision)
+			compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, []string{tc.manifest, tc.manifest})
+			assert.NotEqual(t, revision, compRes.syncStatus.Revision)
+
+			// the condition is removed once the app is compared with the source repository
+			ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+			assert.Len(t, app.Status.Conditions, 0)
+		})
+	}
+}
+
+// checks that local manifests are marked as part of the application like the manifests generated by the repo server
+func TestCompareAppStateLocalManifestsAppInstance(t *testing.T) {
+	ownPod := test.NewPod()
+	ownPod.SetName("own-pod")
+	ownPod.SetLabels(map[string]string{common.LabelKeyAppInstance: "custom"})
+	getTargets := func(t *testing.T, trackingMethod string) map[string]*unstructured.Unstructured {
+		app := newFakeApp()
+		data := fakeData{
+			apps:             []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{Revision: "abc123"},
+			managedLiveObjs:  make(map[kube.ResourceKey]*unstructured.Unstructured),
+			configMapData:    map[string]string{"application.resourceTrackingMethod": trackingMethod},
+		}
+		ctrl := newFakeController(&data)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, []string{string(test.PodManifest), toJSON(t, ownPod)})
+		targets := make(map[string]*unstructured.Unstructured)
+		for _, res := range compRes.managedResources {
+			targets[res.Name] = res.Target
+		}
+		return targets
+	}
+
+	t.Run("Label", func(t *testing.T) {
+		targets := getTargets(t, "label")
+		assert.Equal(t, map[string]string{common.LabelKeyAppInstance: "my-app"}, targets["my-pod"].GetLabels())
+		assert.Empty(t, targets["my-pod"].GetAnnotations())
+		assert.Equal(t, test.FakeDestNamespace, targets["my-pod"].GetNamespace())
+		// the label managed by the resource itself is kept
+		assert.Equal(t, map[string]string{common.LabelKeyAppInstance: "custom"}, targets["own-pod"].GetLabels())
+	})
+
+	t.Run("Annotation", func(t *testing.T) {
+		targets := getTargets(t, "annotation")
+		assert.Empty(t, targets["my-pod"].GetLabels())
+		assert.Equal(t, "my-app", targets["my-pod"].GetAnnotations()[common.AnnotationKeyAppInstance])
+		assert.Equal(t, "my-app", targets["own-pod"].GetAnnotations()[common.AnnotationKeyAppInstance])
+	})
+}
+
+// TestCompareAppStateHelmRepos tests that only the Helm repositories permitted by the project are passed to the repo server
+func TestCompareAppStateHelmRepos(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: test.FakeArgoCDNamespace, Name: "default"},
+		Spec:       argoappv1.AppProjectSpec{SourceRepos: []string{"https://charts.example.com/*", app.Spec.Source.RepoURL}},
+	}
+	data := fakeData{
 		apps: []runtime.Object{app, proj},
-		namespacedResources: map[kube.ResourceKey]namespacedResource{
-			kube.NewResourceKey("apps", kube.DeploymentKind, app.Namespace, "guestbook"): {
-				ResourceNode: argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Group: "apps", Kind: kube.DeploymentKind, Name: "guestbook", Namespace: app.Namespace}},
-			},
-			kube.NewResourceKey("", kube.ServiceAccountKind, app.Namespace, "default"): {
-				ResourceNode: argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Kind: kube.ServiceAccountKind, Name: "default", Namespace: app.Namespace}},
-			},
-			kube.NewResourceKey("", kube.ServiceKind, app.Namespace, "kubernetes"): {
-				ResourceNode: argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Kind: kube.ServiceAccountKind, Name: "kubernetes", Namespace: app.Namespace}},
-			},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
 		},
-	})
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData: map[string]string{
+			"repositories": `
+- url: https://charts.example.com/allowed
+  name: allowed
+  type: helm
+- url: https://charts.other.com/denied
+  name: denied
+  type: helm
+`,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.NotNil(t, compRes)
+	assert.Len(t, app.Status.Conditions, 0)
 
-	tree, err := ctrl.setAppManagedResources(app, &comparisonResult{managedResources: make([]managedResource, 0)})
+	_, repoClient, err := ctrl.appStateManager.(*appStateManager).repoClientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	calls := repoClient.(*mockrepoclient.RepoServerServiceClient).Calls
+	if assert.Len(t, calls, 1) {
+		req := calls[0].Arguments.Get(1).(*apiclient.ManifestRequest)
+		if assert.Len(t, req.Repos, 1) {
+			assert.Equal(t, "https://charts.example.com/allowed", req.Repos[0].Repo)
+		}
+	}
+}
 
+// TestCompareAppStatePluginEnv tests that application metadata is passed to config management plugins
+func TestCompareAppStatePluginEnv(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Source.Plugin = &argoappv1.ApplicationSourcePlugin{Name: "my-plugin"}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.NotNil(t, compRes)
+	assert.Len(t, app.Status.Conditions, 0)
+
+	_, repoClient, err := ctrl.appStateManager.(*appStateManager).repoClientset.NewRepoServerClient()
 	assert.NoError(t, err)
-	assert.Len(t, tree.OrphanedNodes, 1)
-	assert.Equal(t, "guestbook", tree.OrphanedNodes[0].Name)
+	calls := repoClient.(*mockrepoclient.RepoServerServiceClient).Calls
+	if assert.Len(t, calls, 1) {
+		req := calls[0].Arguments.Get(1).(*apiclient.ManifestRequest)
+		assert.Equal(t, []*argoappv1.EnvEntry{
+			{Name: common.EnvAppName, Value: app.Name},
+			{Name: common.EnvAppNamespace, Value: app.Spec.Destination.Namespace},
+			{Name: common.EnvAppSourceRepoURL, Value: app.Spec.Source.RepoURL},
+			{Name: common.EnvAppSourceTargetRevision, Value: app.Spec.Source.TargetRevision},
+		}, req.Env)
+	}
+
+	app.Spec.Source.Plugin.Env = argoappv1.Env{{Name: "FOO", Value: "multi\nline"}}
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	if assert.Len(t, app.Status.Conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, "FOO must not contain newlines")
+	}
 }
 
-func Test_comparisonResult_obs(t *testing.T) {
-	assert.Len(t, (&comparisonResult{}).targetObjs(), 0)
-	assert.Len(t, (&comparisonResult{managedResources: []managedResource{{}}}).targetObjs(), 0)
-	assert.Len(t, (&comparisonResult{managedResources: []managedResource{{Target: test.NewPod()}}}).targetObjs(), 1)
-	assert.Len(t, (&comparisonResult{hooks: []*unstructured.Unstructured{{}}}).targetObjs(), 1)
+// TestCompareAppStateManifestStream tests that manifests streamed by the repo server are assembled into target objects
+func TestCompareAppStateManifestStream(t *testing.T) {
+	manifests := make([]string, 10000)
+	for i := range manifests {
+		manifests[i] = fmt.Sprintf(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm-%d","namespace":"%s"}}`, i, test.FakeDestNamespace)
+	}
+	for _, unsupported := range []bool{false, true} {
+		app := newFakeApp()
+		data := fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: manifests,
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs:           make(map[kube.ResourceKey]*unstructured.Unstructured),
+			manifestStreamUnsupported: unsupported,
+		}
+		ctrl := newFakeController(&data)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, "abc123", compRes.syncStatus.Revision)
+		assert.Len(t, app.Status.Conditions, 0)
+		if assert.Len(t, compRes.managedResources, len(manifests)) {
+			names := make(map[string]bool)
+			for _, res := range compRes.managedResources {
+				names[res.Target.GetName()] = true
+			}
+			assert.Len(t, names, len(manifests))
+			assert.True(t, names["cm-0"])
+			assert.True(t, names["cm-9999"])
+		}
+	}
+}
+
+// TestCompareAppStateManifestCache tests that manifests of commit SHAs are cached by the controller
+func TestCompareAppStateManifestCache(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(test.PodManifest)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  fakeCommitSHA,
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	_, repoClient, err := ctrl.appStateManager.(*appStateManager).repoClientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+
+	// the branch is resolved to a commit SHA, which is then cached
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Len(t, compRes.managedResources, 1)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Len(t, compRes.managedResources, 1)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 2)
+
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Len(t, compRes.managedResources, 1)
+	assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 2)
+
+	// hard refresh
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+	assert.Len(t, compRes.managedResources, 1)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 3)
+}
+
+func TestCompareAppStateComparisonCache(t *testing.T) {
+	app := newFakeApp()
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  fakeCommitSHA,
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	manager := ctrl.appStateManager.(*appStateManager)
+	_, repoClient, err := manager.repoClientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+	mockStateCache := manager.liveStateCache.(*mockstatecache.LiveStateCache)
+
+	// branches are not cacheable
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 2)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 3)
+
+	// the cached result is reused and the conditions reported by the comparison are restored
+	app.Status.Conditions = nil
+	cachedRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 3)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 2)
+	assert.Equal(t, compRes.syncStatus, cachedRes.syncStatus)
+	assert.Equal(t, compRes.resources, cachedRes.resources)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, app.Status.Conditions[0].Type)
+
+	// unrelated resources of the cluster change
+	data.clusterModificationCount++
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 3)
+
+	// compared resources change
+	data.resourcesModificationCount = data.clusterModificationCount
+	data.clusterModificationCount++
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 4)
+
+	// spec changes
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Kind: kube.DeploymentKind, JSONPointers: []string{"/spec/replicas"}}}
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 5)
+
+	// refresh requested
+	ctrl.appStateManager.InvalidateComparisonCache(app.Name)
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 6)
+
+	// hard refresh
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 7)
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 7)
+
+	// local manifests
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{fakeCommitSHA}, []argoappv1.ApplicationSource{app.Spec.Source}, false, []string{toJSON(t, pod)})
+	mockStateCache.AssertNumberOfCalls(t, "GetManagedLiveObjs", 8)
+}
+
+func newFakeSecret(name string, data map[string]string) *unstructured.Unstructured {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Secret"}}
+	secret.SetName(name)
+	secret.SetNamespace(test.FakeDestNamespace)
+	_ = unstructured.SetNestedStringMap(secret.Object, data, "data")
+	return secret
+}
+
+func TestCompareAppStateRedactsSecrets(t *testing.T) {
+	target := newFakeSecret("my-secret", map[string]string{"key1": "dmFsdWUx", "key2": "dmFsdWUy"})
+	live := newFakeSecret("my-secret", map[string]string{"key1": "dmFsdWUx", "key2": "b2xkLXZhbHVl"})
+	hook := newFakeSecret("my-hook", map[string]string{"key1": "aG9vay12YWx1ZQ=="})
+	hook.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
+	newData := func(configMapData map[string]string) *fakeData {
+		return &fakeData{
+			apps: []runtime.Object{&defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, target), toJSON(t, hook)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
+			configMapData:   configMapData,
+		}
+	}
+
+	t.Run("Redacted", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData(nil))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Len(t, compRes.managedResources, 1)
+		res := compRes.managedResources[0]
+		assert.Equal(t, map[string]interface{}{"key1": "++++++++", "key2": "++++++++"}, res.Target.Object["data"])
+		assert.Equal(t, map[string]interface{}{"key1": "++++++++", "key2": "++++++++++++"}, res.Live.Object["data"])
+		assert.True(t, res.Diff.Modified)
+		diffJSON, err := res.Diff.JSONFormat()
+		assert.NoError(t, err)
+		assert.Contains(t, diffJSON, "key2")
+		assert.NotContains(t, diffJSON, "key1")
+		assert.NotContains(t, diffJSON, "dmFsdWUy")
+		assert.Len(t, compRes.hooks, 1)
+		assert.Equal(t, map[string]interface{}{"key1": "++++++++"}, compRes.hooks[0].Object["data"])
+
+		// live objects of the cache are not modified
+		assert.Equal(t, "b2xkLXZhbHVl", live.Object["data"].(map[string]interface{})["key2"])
+	})
+
+	t.Run("NotRedactedForSync", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData(nil))
+		compRes := ctrl.appStateManager.(*appStateManager).compareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil, false, false)
+
+		assert.Equal(t, "dmFsdWUy", compRes.managedResources[0].Target.Object["data"].(map[string]interface{})["key2"])
+		assert.Equal(t, "aG9vay12YWx1ZQ==", compRes.hooks[0].Object["data"].(map[string]interface{})["key1"])
+
+		// unredacted results don't replace the last comparison result
+		_, err := ctrl.appStateManager.GetResourceDiff(app, kube.GetResourceKey(live))
+		assert.Error(t, err)
+
+		// unredacted results are redacted before they leave the controller
+		items, err := ctrl.managedResources(compRes)
+		assert.NoError(t, err)
+		if assert.Len(t, items, 1) {
+			assert.NotContains(t, items[0].TargetState, "dmFsdWUy")
+			assert.NotContains(t, items[0].LiveState, "b2xkLXZhbHVl")
+			assert.NotContains(t, items[0].Diff, "b2xkLXZhbHVl")
+		}
+	})
+
+	t.Run("RedactionDisabled", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData(map[string]string{"resource.secretRedaction.disabled": "true"}))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Equal(t, "b2xkLXZhbHVl", compRes.managedResources[0].Live.Object["data"].(map[string]interface{})["key2"])
+		items, err := ctrl.managedResources(compRes)
+		assert.NoError(t, err)
+		if assert.Len(t, items, 1) {
+			assert.Contains(t, items[0].LiveState, "b2xkLXZhbHVl")
+		}
+	})
+}
+
+func TestStripSecretData(t *testing.T) {
+	target := newFakeSecret("my-secret", nil)
+	target.Object["data"] = "invalid"
+	live := newFakeSecret("my-secret", map[string]string{"key1": "dmFsdWUx"})
+	live.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": toJSON(t, live)})
+
+	_, _, _, _, err := redactSecretData(target, nil, live, nil)
+	assert.Error(t, err)
+
+	strippedTarget, strippedPredicted, strippedLive, strippedDiff := stripSecretData(target, nil, live, nil)
+	assert.NotContains(t, strippedTarget.Object, "data")
+	assert.Nil(t, strippedPredicted)
+	assert.NotContains(t, strippedLive.Object, "data")
+	assert.Empty(t, strippedLive.GetAnnotations())
+	diffJSON, err := strippedDiff.JSONFormat()
+	assert.NoError(t, err)
+	assert.NotContains(t, diffJSON, "dmFsdWUx")
+
+	// the given objects are not modified
+	assert.Equal(t, "dmFsdWUx", live.Object["data"].(map[string]interface{})["key1"])
+}
+
+func TestCompareAppStateRedactsPredictedSecrets(t *testing.T) {
+	target := newFakeSecret("my-secret", map[string]string{"key1": "dmFsdWUx"})
+	// the live secret has a key which is added by an admission webhook, which the dry-run predicts as well
+	live := newFakeSecret("my-secret", map[string]string{"key1": "dmFsdWUx", "key2": "aW5qZWN0ZWQ="})
+	predicted := live.DeepCopy()
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationCompareOptions: "ServerSideDiff=true"}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, target)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
+		kubectl:         &kubetest.MockKubectlCmd{ServerSideDryRunResults: map[string]*unstructured.Unstructured{target.GetName(): predicted}},
+	})
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	if assert.Len(t, compRes.managedResources, 1) {
+		res := compRes.managedResources[0]
+		// the diff is produced from the predicted object like the sync status
+		assert.False(t, res.Diff.Modified)
+		assert.Equal(t, map[string]interface{}{"key1": "++++++++"}, res.Target.Object["data"])
+		assert.Equal(t, map[string]interface{}{"key1": "++++++++", "key2": "++++++++"}, res.Predicted.Object["data"])
+		assert.Equal(t, map[string]interface{}{"key1": "++++++++", "key2": "++++++++"}, res.Live.Object["data"])
+	}
+}
+
+// TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
+// considered as part of resources when assessing Synced status
+func TestCompareAppStateHook(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
+	podBytes, _ := json.Marshal(pod)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(podBytes)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.NotNil(t, compRes)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, 0, len(compRes.resources))
+	assert.Equal(t, 0, len(compRes.managedResources))
+	assert.Equal(t, 1, len(compRes.hooks))
+	assert.Equal(t, 0, len(app.Status.Conditions))
+}
+
+// checks that ignore resources are detected, but excluded from status
+func TestCompareAppStateCompareOptionIgnoreExtraneous(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationCompareOptions: "IgnoreExtraneous"})
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.NotNil(t, compRes)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Len(t, compRes.resources, 0)
+	assert.Len(t, compRes.managedResources, 0)
+	assert.Len(t, app.Status.Conditions, 0)
+}
+
+// checks that extraneous resources of a kind whose resource override ignores them are reported but excluded from the
+// sync status
+func TestCompareAppStateResourceOverrideIgnoreExtraneous(t *testing.T) {
+	cm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "generated-config", "namespace": test.FakeDestNamespace},
+	}}
+	deployment := test.NewDeployment()
+	deployment.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(cm): cm,
+		},
+		configMapData: map[string]string{"resource.customizations": `
+"*/ConfigMap":
+  ignoreExtraneous: true`},
+	}
+	ctrl := newFakeController(&data)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	if assert.Len(t, compRes.resources, 1) {
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.resources[0].Status)
+		assert.True(t, compRes.resources[0].RequiresPruning)
+	}
+
+	// the override only applies to its kind
+	data.managedLiveObjs[kube.GetResourceKey(deployment)] = deployment
+	ctrl = newFakeController(&data)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	assert.Len(t, compRes.resources, 2)
+}
+
+// checks that resources with the IgnoreResource compare option are invisible to the application, no matter whether the
+// option is set on the target or the live resource
+func TestCompareAppStateCompareOptionIgnoreResource(t *testing.T) {
+	ignored := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		obj.SetAnnotations(map[string]string{common.AnnotationCompareOptions: "IgnoreResource"})
+		return obj
+	}
+	// the target pod is ignored, its live counterpart must not require pruning
+	targetPod := ignored(test.NewPod())
+	livePod := test.NewPod()
+	livePod.SetNamespace(test.FakeDestNamespace)
+	// the live deployment is ignored, its target must not be applied
+	targetDeployment := test.NewDeployment()
+	liveDeployment := ignored(test.NewDeployment())
+	liveDeployment.SetNamespace(test.FakeDestNamespace)
+	// the live service is ignored although it carries the tracking label of the application
+	liveService := ignored(test.NewService())
+	liveService.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, targetPod), toJSON(t, targetDeployment)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(livePod):        livePod,
+			kube.GetResourceKey(liveDeployment): liveDeployment,
+			kube.GetResourceKey(liveService):    liveService,
+		},
+	}
+	ctrl := newFakeController(&data)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Len(t, compRes.resources, 0)
+	assert.Len(t, compRes.managedResources, 0)
+	assert.Len(t, compRes.conditions, 0)
+}
+
+// TestCompareAppStateExtraHook tests when there is an extra _hook_ object in live but not defined in git
+func TestCompareAppStateExtraHook(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
+	pod.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: app.Name}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			key: pod,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.NotNil(t, compRes)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, 1, len(compRes.resources))
+	assert.Equal(t, 1, len(compRes.managedResources))
+	assert.Equal(t, 0, len(compRes.hooks))
+	assert.Equal(t, 0, len(app.Status.Conditions))
+}
+
+func toJSON(t *testing.T, obj *unstructured.Unstructured) string {
+	data, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestCompareAppStateLegacyInstanceLabel(t *testing.T) {
+	newPod := func(name string, labels map[string]string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetName(name)
+		pod.SetUID(types.UID(name))
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetLabels(labels)
+		return pod
+	}
+	app := newFakeApp()
+	legacyPod := newPod("legacy-pod", map[string]string{common.LabelKeyAppInstance: app.Name})
+	migratedPod := newPod("migrated-pod", map[string]string{"mycompany.com/appname": app.Name, common.LabelKeyAppInstance: app.Name})
+	sharedPod := newPod("shared-pod", map[string]string{common.LabelKeyAppInstance: "other-app"})
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(legacyPod):   legacyPod,
+			kube.GetResourceKey(migratedPod): migratedPod,
+			kube.GetResourceKey(sharedPod):   sharedPod,
+		},
+		configMapData: map[string]string{
+			"application.instanceLabelKey": "mycompany.com/appname, " + common.LabelKeyAppInstance,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	conditions := make(map[argoappv1.ApplicationConditionType]string)
+	for _, condition := range compRes.conditions {
+		conditions[condition.Type] = condition.Message
+	}
+	assert.Equal(t, map[argoappv1.ApplicationConditionType]string{
+		argoappv1.ApplicationConditionSharedResourceWarning:      "Pod/shared-pod is part of a different application: other-app",
+		argoappv1.ApplicationConditionLegacyInstanceLabelWarning: "1 resources are only labeled with the legacy app instance label keys app.kubernetes.io/instance and will be labeled with mycompany.com/appname when synced",
+	}, conditions)
+
+	// resources with the legacy key only are not reported once the legacy key is removed from the list
+	data.configMapData["application.instanceLabelKey"] = "mycompany.com/appname"
+	ctrl = newFakeController(&data)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	for _, condition := range compRes.conditions {
+		assert.NotEqual(t, argoappv1.ApplicationConditionLegacyInstanceLabelWarning, condition.Type)
+		assert.NotEqual(t, argoappv1.ApplicationConditionSharedResourceWarning, condition.Type)
+	}
+}
+
+func TestCompareAppStateTrackingAnnotation(t *testing.T) {
+	newPod := func(name string, labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetName(name)
+		pod.SetUID(types.UID(name))
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetLabels(labels)
+		pod.SetAnnotations(annotations)
+		return pod
+	}
+	app := newFakeApp()
+	// the instance label of resources of Helm charts is usually set to the release name by the chart itself
+	relabeledPod := newPod("relabeled-pod", map[string]string{common.LabelKeyAppInstance: "my-release"}, map[string]string{common.AnnotationKeyAppInstance: app.Name})
+	unmigratedPod := newPod("unmigrated-pod", map[string]string{common.LabelKeyAppInstance: app.Name}, nil)
+	sharedPod := newPod("shared-pod", map[string]string{common.LabelKeyAppInstance: app.Name}, map[string]string{common.AnnotationKeyAppInstance: "other-app"})
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(relabeledPod):  relabeledPod,
+			kube.GetResourceKey(unmigratedPod): unmigratedPod,
+			kube.GetResourceKey(sharedPod):     sharedPod,
+		},
+		configMapData: map[string]string{
+			"application.resourceTrackingMethod": "annotation",
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	conditions := make(map[argoappv1.ApplicationConditionType]string)
+	for _, condition := range compRes.conditions {
+		conditions[condition.Type] = condition.Message
+	}
+	assert.Equal(t, map[argoappv1.ApplicationConditionType]string{
+		argoappv1.ApplicationConditionSharedResourceWarning: "Pod/shared-pod is part of a different application: other-app",
+	}, conditions)
+
+	// the tracking annotation is ignored by the label tracking method
+	data.configMapData["application.resourceTrackingMethod"] = "label"
+	ctrl = newFakeController(&data)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	conditions = make(map[argoappv1.ApplicationConditionType]string)
+	for _, condition := range compRes.conditions {
+		conditions[condition.Type] = condition.Message
+	}
+	assert.Equal(t, map[argoappv1.ApplicationConditionType]string{
+		argoappv1.ApplicationConditionSharedResourceWarning: "Pod/relabeled-pod is part of a different application: my-release",
+	}, conditions)
+}
+
+// checks that resources of other applications which are owned by a resource of the application are not reported as
+// shared resources
+func TestCompareAppStateSharedResourceOwnedByManagedResource(t *testing.T) {
+	app := newFakeApp()
+	deployment := test.NewDeployment()
+	deployment.SetNamespace(test.FakeDestNamespace)
+	deployment.SetLabels(map[string]string{common.LabelKeyAppInstance: app.Name})
+	newReplicaSet := func(name string, owner string) *unstructured.Unstructured {
+		rs := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "ReplicaSet",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": test.FakeDestNamespace,
+				"labels":    map[string]interface{}{common.LabelKeyAppInstance: "other-app"},
+			},
+		}}
+		rs.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kube.DeploymentKind, Name: owner}})
+		return rs
+	}
+	// the replica set inherited the instance label of another application from the pod template of the deployment
+	ownedReplicaSet := newReplicaSet("owned-rs", deployment.GetName())
+	// the deployment owning this replica set was already deleted
+	orphanedReplicaSet := newReplicaSet("orphaned-rs", "deleted-deployment")
+	// the pod is owned by a replica set of the deployment which is not part of any application itself
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	pod.SetLabels(map[string]string{common.LabelKeyAppInstance: "other-app"})
+	pod.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "unlabeled-rs"}})
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(deployment):         deployment,
+			kube.GetResourceKey(ownedReplicaSet):    ownedReplicaSet,
+			kube.GetResourceKey(orphanedReplicaSet): orphanedReplicaSet,
+			kube.GetResourceKey(pod):                pod,
+		},
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("apps", "ReplicaSet", test.FakeDestNamespace, "unlabeled-rs"): {
+				ResourceNode: argoappv1.ResourceNode{
+					ResourceRef: argoappv1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: test.FakeDestNamespace, Name: "unlabeled-rs"},
+					ParentRefs:  []argoappv1.ResourceRef{{Group: "apps", Kind: kube.DeploymentKind, Namespace: test.FakeDestNamespace, Name: deployment.GetName()}},
+				},
+			},
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	var messages []string
+	for _, condition := range compRes.conditions {
+		if condition.Type == argoappv1.ApplicationConditionSharedResourceWarning {
+			messages = append(messages, condition.Message)
+		}
+	}
+	assert.Equal(t, []string{"ReplicaSet/orphaned-rs is part of a different application: other-app"}, messages)
+}
+
+func TestCompareAppStateTrackingValue(t *testing.T) {
+	newPod := func(name string, val string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetName(name)
+		pod.SetUID(types.UID(name))
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetLabels(map[string]string{common.LabelKeyAppInstance: val})
+		return pod
+	}
+	app := newFakeApp()
+	ownPod := newPod("own-pod", "prod:"+app.Name)
+	// resources which were synced before the tracking value format was configured are still owned by the application
+	legacyPod := newPod("legacy-pod", app.Name)
+	// a same-named application of another installation
+	otherPod := newPod("other-pod", "staging:"+app.Name)
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(ownPod):    ownPod,
+			kube.GetResourceKey(legacyPod): legacyPod,
+			kube.GetResourceKey(otherPod):  otherPod,
+		},
+		configMapData: map[string]string{
+			"application.resourceTrackingValueFormat": "instanceID",
+			"application.instanceID":                  "prod",
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	conditions := make(map[argoappv1.ApplicationConditionType]string)
+	for _, condition := range compRes.conditions {
+		conditions[condition.Type] = condition.Message
+	}
+	assert.Equal(t, map[argoappv1.ApplicationConditionType]string{
+		argoappv1.ApplicationConditionSharedResourceWarning:      "Pod/other-pod is part of a different application: staging:" + app.Name,
+		argoappv1.ApplicationConditionLegacyTrackingValueWarning: "1 resources are tracked by the bare application name and will be tracked by prod:" + app.Name + " when synced",
+	}, conditions)
+}
+
+func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
+	obj1 := test.NewPod()
+	obj1.SetNamespace(test.FakeDestNamespace)
+	obj2 := test.NewPod()
+	obj3 := test.NewPod()
+	obj3.SetNamespace("kube-system")
+
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, obj1), toJSON(t, obj2), toJSON(t, obj3)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(obj1): obj1,
+			kube.GetResourceKey(obj3): obj3,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.NotNil(t, compRes)
+	assert.Equal(t, 1, len(app.Status.Conditions))
+	assert.NotNil(t, app.Status.Conditions[0].LastTransitionTime)
+	assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Resource /Pod/fake-dest-ns/my-pod appeared 2 times among application resources (from manifest 1, manifest 2), kept occurrence 2 of 2 (from manifest 2).", app.Status.Conditions[0].Message)
+	assert.Equal(t, 2, len(compRes.resources))
+	// the internal origin annotation is neither compared nor applied
+	for _, res := range compRes.managedResources {
+		if res.Target != nil {
+			assert.NotContains(t, res.Target.GetAnnotations(), common.AnnotationManifestOrigin)
+		}
+	}
+}
+
+func TestCompareAppStateDuplicatedResourcesError(t *testing.T) {
+	obj := test.NewPod()
+	obj.SetNamespace(test.FakeDestNamespace)
+
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"DuplicateResources=error"}}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, obj), toJSON(t, test.NewService()), toJSON(t, obj)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(obj): obj,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	if assert.Len(t, app.Status.Conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+		assert.Equal(t, "Resource /Pod/fake-dest-ns/my-pod appeared 2 times among application resources (from manifest 1, manifest 3), which is not allowed by the sync option DuplicateResources=error.", app.Status.Conditions[0].Message)
+	}
+}
+
+func TestCompareAppStateMultipleSources(t *testing.T) {
+	sources := []argoappv1.ApplicationSource{
+		{RepoURL: "https://example.com/frontend.git", Path: "manifests", TargetRevision: "master"},
+		{RepoURL: "https://example.com/backend.git", Path: "manifests", TargetRevision: "v1.0.0"},
+	}
+	newController := func(app *argoappv1.Application, frontend, backend *unstructured.Unstructured) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponses: map[string]*apiclient.ManifestResponse{
+				sources[0].RepoURL: {
+					Manifests: []string{toJSON(t, frontend)},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "aaa111",
+				},
+				sources[1].RepoURL: {
+					Manifests: []string{toJSON(t, backend)},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "bbb222",
+				},
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		})
+	}
+
+	t.Run("Combined", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Sources = sources
+		ctrl := newController(app, test.NewPod(), test.NewService())
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"", ""}, app.Spec.Sources, false, nil)
+
+		assert.Len(t, app.Status.Conditions, 0)
+		assert.Len(t, compRes.resources, 2)
+		assert.Equal(t, []string{"aaa111", "bbb222"}, compRes.syncStatus.Revisions)
+		assert.Equal(t, "", compRes.syncStatus.Revision)
+		assert.Equal(t, sources, compRes.syncStatus.ComparedTo.Sources)
+		assert.True(t, compRes.syncStatus.ComparedTo.Source.IsZero())
+	})
+
+	t.Run("DuplicatedAcrossSources", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Sources = sources
+		ctrl := newController(app, test.NewPod(), test.NewPod())
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"", ""}, app.Spec.Sources, false, nil)
+
+		assert.Len(t, compRes.resources, 1)
+		if assert.Len(t, app.Status.Conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, app.Status.Conditions[0].Type)
+			assert.Equal(t, "Resource /Pod/fake-dest-ns/my-pod appeared 2 times among application resources (from manifest 1 of source https://example.com/frontend.git, manifest 1 of source https://example.com/backend.git), kept occurrence 2 of 2 (from manifest 1 of source https://example.com/backend.git).", app.Status.Conditions[0].Message)
+		}
+	})
+
+	t.Run("SingleSource", func(t *testing.T) {
+		// applications with a single source are compared with their source as before
+		app := newFakeApp()
+		app.Spec.Source = sources[0]
+		ctrl := newController(app, test.NewPod(), test.NewService())
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, app.Spec.GetSources(), false, nil)
+
+		assert.Len(t, compRes.resources, 1)
+		assert.Equal(t, "aaa111", compRes.syncStatus.Revision)
+		assert.Equal(t, sources[0], compRes.syncStatus.ComparedTo.Source)
+		assert.Nil(t, compRes.syncStatus.ComparedTo.Sources)
+		assert.Nil(t, compRes.syncStatus.Revisions)
+	})
+}
+
+func TestCompareAppStatePartialDiscoveryFailure(t *testing.T) {
+	metricsGV := schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}
+	newData := func(objs ...*unstructured.Unstructured) *fakeData {
+		manifests := make([]string, len(objs))
+		for i := range objs {
+			manifests[i] = toJSON(t, objs[i])
+		}
+		return &fakeData{
+			apps: []runtime.Object{&defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: manifests,
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs:     make(map[kube.ResourceKey]*unstructured.Unstructured),
+			failedGroupVersions: map[schema.GroupVersion]string{metricsGV: "the server is currently unable to handle the request"},
+		}
+	}
+
+	t.Run("UnrelatedGroup", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData(test.NewPod()))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Len(t, compRes.managedResources, 1)
+		if assert.Len(t, app.Status.Conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionAPIDiscoveryWarning, app.Status.Conditions[0].Type)
+			assert.Equal(t, "APIs of the destination cluster which are not used by the application could not be discovered: APIService v1beta1.metrics.k8s.io (the server is currently unable to handle the request)", app.Status.Conditions[0].Message)
+		}
+	})
+
+	t.Run("AffectedGroup", func(t *testing.T) {
+		app := newFakeApp()
+		metrics := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "metrics.k8s.io/v1beta1", "kind": "PodMetrics"}}
+		metrics.SetName("my-metrics")
+		data := newData(test.NewPod(), metrics)
+		data.unknownGroupKinds = []schema.GroupKind{{Group: "metrics.k8s.io", Kind: "PodMetrics"}}
+		ctrl := newFakeController(data)
+		ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		if assert.Len(t, app.Status.Conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+			assert.Equal(t, "Failed to discover the APIs of the resource kinds metrics.k8s.io/PodMetrics in the destination cluster: APIService v1beta1.metrics.k8s.io (the server is currently unable to handle the request)", app.Status.Conditions[0].Message)
+		}
+	})
+}
+
+func TestCompareAppStateDestinationName(t *testing.T) {
+	newData := func(app *argoappv1.Application) *fakeData {
+		return &fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			clusterSecrets: []runtime.Object{
+				newFakeClusterSecret("prod", "https://prod.example.com"),
+				newFakeClusterSecret("staging", "https://staging-1.example.com"),
+				newFakeClusterSecret("staging-2", "https://staging-2.example.com"),
+			},
+		}
+	}
+
+	t.Run("Resolved", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination = argoappv1.ApplicationDestination{Name: "prod", Namespace: test.FakeDestNamespace}
+		ctrl := newFakeController(newData(app))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Empty(t, app.Status.Conditions)
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Equal(t, argoappv1.ApplicationDestination{Name: "prod", Server: "https://prod.example.com", Namespace: test.FakeDestNamespace}, compRes.syncStatus.ComparedTo.Destination)
+		// the spec is not modified
+		assert.Equal(t, argoappv1.ApplicationDestination{Name: "prod", Namespace: test.FakeDestNamespace}, app.Spec.Destination)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination = argoappv1.ApplicationDestination{Name: "dev", Namespace: test.FakeDestNamespace}
+		ctrl := newFakeController(newData(app))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		if assert.Len(t, app.Status.Conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+			assert.Equal(t, "unable to find destination server: there are no clusters with the name dev", app.Status.Conditions[0].Message)
+		}
+	})
+
+	t.Run("Conflicting", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination = argoappv1.ApplicationDestination{Name: "staging", Server: "https://staging-2.example.com", Namespace: test.FakeDestNamespace}
+		ctrl := newFakeController(newData(app))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		if assert.Len(t, app.Status.Conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+			assert.Equal(t, "application destination can't have both name staging and server https://staging-2.example.com defined, the cluster staging has the server https://staging-1.example.com", app.Status.Conditions[0].Message)
+		}
+	})
+
+	t.Run("ReloadedAfterClusterChange", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination = argoappv1.ApplicationDestination{Name: "prod", Namespace: test.FakeDestNamespace}
+		ctrl := newFakeController(newData(app))
+		manager := ctrl.appStateManager.(*appStateManager)
+		dest, err := manager.resolveDestination(context.Background(), app.Spec.Destination)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://prod.example.com", dest.Server)
+
+		manager.clustersLock.Lock()
+		manager.clusters = []argoappv1.Cluster{{Name: "prod", Server: "https://prod-2.example.com"}}
+		manager.clustersLock.Unlock()
+		dest, err = manager.resolveDestination(context.Background(), app.Spec.Destination)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://prod-2.example.com", dest.Server)
+
+		manager.invalidateClusters()
+		dest, err = manager.resolveDestination(context.Background(), app.Spec.Destination)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://prod.example.com", dest.Server)
+	})
+
+	t.Run("NormalizersUseClusterSnapshot", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData(app))
+		manager := ctrl.appStateManager.(*appStateManager)
+		cs, err := manager.getComparisonSettings()
+		assert.NoError(t, err)
+
+		// the child apps are resolved using the snapshot of the clusters rather than by listing them
+		manager.clustersLock.Lock()
+		manager.clusters = []argoappv1.Cluster{{Name: "prod", Server: "https://prod-2.example.com"}}
+		manager.clustersLock.Unlock()
+		server, err := cs.resolveDestinationServer(argoappv1.ApplicationDestination{Name: "prod"})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://prod-2.example.com", server)
+	})
+}
+
+func TestCompareAppStatePreservesConditionTimestamps(t *testing.T) {
+	obj1 := test.NewPod()
+	obj1.SetNamespace(test.FakeDestNamespace)
+	obj2 := test.NewPod()
+	obj2.SetNamespace(test.FakeDestNamespace)
+
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, obj1), toJSON(t, obj2)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(obj1): obj1,
+		},
+	}
+	ctrl := newFakeController(&data)
+	tenMinsAgo := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	app.Status.Conditions = []argoappv1.ApplicationCondition{{
+		Type:               argoappv1.ApplicationConditionRepeatedResourceWarning,
+		Message:            "Resource /Pod/fake-dest-ns/my-pod appeared 2 times among application resources (from manifest 1, manifest 2), kept occurrence 2 of 2 (from manifest 2).",
+		LastTransitionTime: &tenMinsAgo,
+	}, {
+		Type:               argoappv1.ApplicationConditionComparisonError,
+		Message:            "stale error",
+		LastTransitionTime: &tenMinsAgo,
+	}, {
+		Type:               argoappv1.ApplicationConditionSyncError,
+		Message:            "not owned by the comparison",
+		LastTransitionTime: &tenMinsAgo,
+	}}
+
+	ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.Len(t, app.Status.Conditions, 2)
+	assert.Equal(t, argoappv1.ApplicationConditionSyncError, app.Status.Conditions[0].Type)
+	assert.Equal(t, tenMinsAgo, *app.Status.Conditions[0].LastTransitionTime)
+	assert.Equal(t, argoappv1.ApplicationConditionRepeatedResourceWarning, app.Status.Conditions[1].Type)
+	assert.Equal(t, tenMinsAgo, *app.Status.Conditions[1].LastTransitionTime)
+}
+
+var defaultProj = argoappv1.AppProject{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "default",
+		Namespace: test.FakeArgoCDNamespace,
+	},
+	Spec: argoappv1.AppProjectSpec{
+		SourceRepos: []string{"*"},
+		Destinations: []argoappv1.ApplicationDestination{
+			{
+				Server:    "*",
+				Namespace: "*",
+			},
+		},
+	},
+}
+
+func TestVerifyNamespaceRestrictions(t *testing.T) {
+	cluster := &argoappv1.Cluster{Server: test.FakeClusterURL, Namespaces: []string{test.FakeDestNamespace}}
+
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	otherPod := test.NewPod()
+	otherPod.SetNamespace("kube-system")
+	anotherPod := test.NewPod()
+	anotherPod.SetName("another-pod")
+	anotherPod.SetNamespace("kube-system")
+	clusterRole := kube.MustToUnstructured(&rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-role"},
+	})
+
+	conditions := verifyNamespaceRestrictions(cluster, test.FakeDestNamespace, []*unstructured.Unstructured{pod})
+	assert.Len(t, conditions, 0)
+
+	conditions = verifyNamespaceRestrictions(cluster, "other", []*unstructured.Unstructured{pod, otherPod, anotherPod, clusterRole})
+	assert.Len(t, conditions, 3)
+	assert.Equal(t, argoappv1.ApplicationConditionNamespaceRestrictionError, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "Namespace other is not managed")
+	assert.Equal(t, argoappv1.ApplicationConditionNamespaceRestrictionError, conditions[1].Type)
+	assert.Contains(t, conditions[1].Message, "Namespace kube-system is not managed")
+	assert.Equal(t, argoappv1.ApplicationConditionClusterPermissionWarning, conditions[2].Type)
+	assert.Contains(t, conditions[2].Message, "rbac.authorization.k8s.io/ClusterRole my-role")
+}
+
+func TestSetHealth(t *testing.T) {
+	app := newFakeApp()
+	deployment := kube.MustToUnstructured(&v1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1beta1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo",
+			Namespace: "default",
+		},
+	})
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(deployment): deployment,
+		},
+	})
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.Equal(t, compRes.healthStatus.Status, argoappv1.HealthStatusHealthy)
+}
+
+func TestSetHealthSelfReferencedApp(t *testing.T) {
+	app := newFakeApp()
+	unstructuredApp := kube.MustToUnstructured(app)
+	deployment := kube.MustToUnstructured(&v1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1beta1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo",
+			Namespace: "default",
+		},
+	})
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(deployment):      deployment,
+			kube.GetResourceKey(unstructuredApp): unstructuredApp,
+		},
+	})
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.Equal(t, compRes.healthStatus.Status, argoappv1.HealthStatusHealthy)
+}
+
+func TestSetManagedResourcesWithOrphanedResources(t *testing.T) {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, proj},
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("apps", kube.DeploymentKind, app.Namespace, "guestbook"): {
+				ResourceNode: argoappv1.ResourceNode{
+					ResourceRef: argoappv1.ResourceRef{Kind: kube.DeploymentKind, Name: "guestbook", Namespace: app.Namespace},
+				},
+				AppName: "",
+			},
+		},
+	})
+
+	tree, err := ctrl.setAppManagedResources(app, &comparisonResult{managedResources: make([]managedResource, 0)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(tree.OrphanedNodes), 1)
+	assert.Equal(t, "guestbook", tree.OrphanedNodes[0].Name)
+	assert.Equal(t, app.Namespace, tree.OrphanedNodes[0].Namespace)
+}
+
+func TestSetManagedResourcesWithResourcesOfAnotherApp(t *testing.T) {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+
+	app1 := newFakeApp()
+	app1.Name = "app1"
+	app2 := newFakeApp()
+	app2.Name = "app2"
+
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app1, app2, proj},
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("apps", kube.DeploymentKind, app2.Namespace, "guestbook"): {
+				ResourceNode: argoappv1.ResourceNode{
+					ResourceRef: argoappv1.ResourceRef{Kind: kube.DeploymentKind, Name: "guestbook", Namespace: app2.Namespace},
+				},
+				AppName: "app2",
+			},
+		},
+	})
+
+	tree, err := ctrl.setAppManagedResources(app1, &comparisonResult{managedResources: make([]managedResource, 0)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(tree.OrphanedNodes), 0)
+}
+
+func TestReturnUnknownComparisonStateOnSettingLoadError(t *testing.T) {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+
+	app := newFakeApp()
+
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, proj},
+		configMapData: map[string]string{
+			"resource.customizations": "invalid setting",
+		},
+	})
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	assert.Equal(t, argoappv1.HealthStatusUnknown, compRes.healthStatus.Status)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	assert.NotNil(t, compRes.reconciledAt)
+}
+
+func TestReturnUnknownComparisonStateOnMissingProject(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(test.PodManifest)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	})
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+
+	// project is deleted after the app spec was validated
+	assert.NoError(t, ctrl.projInformer.GetIndexer().Delete(&defaultProj))
+
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	assert.Equal(t, argoappv1.HealthStatusUnknown, compRes.healthStatus.Status)
+	assert.NotNil(t, compRes.reconciledAt)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+	assert.Contains(t, app.Status.Conditions[0].Message, app.Spec.Project)
+}
+
+func TestSetManagedResourcesKnownOrphanedResourceExceptions(t *testing.T) {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+
+	app := newFakeApp()
+	app.Namespace = "default"
+
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, proj},
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("apps", kube.DeploymentKind, app.Namespace, "guestbook"): {
+				ResourceNode: argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Group: "apps", Kind: kube.DeploymentKind, Name: "guestbook", Namespace: app.Namespace}},
+			},
+			kube.NewResourceKey("", kube.ServiceAccountKind, app.Namespace, "default"): {
+				ResourceNode: argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Kind: kube.ServiceAccountKind, Name: "default", Namespace: app.Namespace}},
+			},
+			kube.NewResourceKey("", kube.ServiceKind, app.Namespace, "kubernetes"): {
+				ResourceNode: argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Kind: kube.ServiceAccountKind, Name: "kubernetes", Namespace: app.Namespace}},
+			},
+		},
+	})
+
+	tree, err := ctrl.setAppManagedResources(app, &comparisonResult{managedResources: make([]managedResource, 0)})
+
+	assert.NoError(t, err)
+	assert.Len(t, tree.OrphanedNodes, 1)
+	assert.Equal(t, "guestbook", tree.OrphanedNodes[0].Name)
+}
+
+func Test_comparisonResult_obs(t *testing.T) {
+	assert.Len(t, (&comparisonResult{}).targetObjs(), 0)
+	assert.Len(t, (&comparisonResult{managedResources: []managedResource{{}}}).targetObjs(), 0)
+	assert.Len(t, (&comparisonResult{managedResources: []managedResource{{Target: test.NewPod()}}}).targetObjs(), 1)
+	assert.Len(t, (&comparisonResult{hooks: []*unstructured.Unstructured{{}}}).targetObjs(), 1)
+}
+
+func TestComparisonBackoffConfigDelay(t *testing.T) {
+	config := ComparisonBackoffConfig{InitialDelay: 10 * time.Second, MaxDelay: time.Minute}
+	assert.Equal(t, 10*time.Second, config.delay(1))
+	assert.Equal(t, 20*time.Second, config.delay(2))
+	assert.Equal(t, 40*time.Second, config.delay(3))
+	assert.Equal(t, time.Minute, config.delay(4))
+	assert.Equal(t, time.Minute, config.delay(100))
+
+	// the maximum delay is never less than the initial delay
+	config = ComparisonBackoffConfig{InitialDelay: 10 * time.Second}
+	assert.Equal(t, 10*time.Second, config.delay(3))
+}
+
+func TestCompareAppStateComparisonErrorBackoff(t *testing.T) {
+	app := newFakeApp()
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  fakeCommitSHA,
+		},
+		managedLiveObjs:         make(map[kube.ResourceKey]*unstructured.Unstructured),
+		comparisonBackoffConfig: ComparisonBackoffConfig{InitialDelay: time.Minute, MaxDelay: 3 * time.Minute},
+	}
+	ctrl := newFakeController(&data)
+	manager := ctrl.appStateManager.(*appStateManager)
+	_, repoClient, err := manager.repoClientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+	successfulCalls := mockClient.ExpectedCalls
+	failingCalls := []*mock.Call{
+		mockClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("repo unavailable")),
+	}
+	mockClient.ExpectedCalls = successfulCalls
+	comparisonError := func(compRes *comparisonResult) string {
+		for _, condition := range compRes.conditions {
+			if condition.Type == argoappv1.ApplicationConditionComparisonError {
+				return condition.Message
+			}
+		}
+		return ""
+	}
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Empty(t, comparisonError(compRes))
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 1)
+
+	// the last known good manifests are compared while the manifests fail to generate
+	mockClient.ExpectedCalls = failingCalls
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "repo unavailable (attempt 1, retrying in 1m0s)", comparisonError(compRes))
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+	assert.Len(t, compRes.managedResources, 1)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 2)
+
+	// the manifests are not generated until the delay has elapsed
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "repo unavailable (attempt 1, retrying in 1m0s)", comparisonError(compRes))
+	assert.Len(t, compRes.managedResources, 1)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 2)
+
+	manager.comparisonBackoffs[app.Name].retryAt = time.Now()
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "repo unavailable (attempt 2, retrying in 2m0s)", comparisonError(compRes))
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 3)
+
+	manager.comparisonBackoffs[app.Name].retryAt = time.Now()
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "repo unavailable (attempt 3, retrying in 3m0s)", comparisonError(compRes))
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 4)
+
+	// explicit refreshes reset the backoff
+	ctrl.appStateManager.ResetComparisonBackoff(app.Name)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "repo unavailable (attempt 1, retrying in 1m0s)", comparisonError(compRes))
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 5)
+
+	// hard refreshes are not backed off
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+	assert.Equal(t, "repo unavailable (attempt 2, retrying in 2m0s)", comparisonError(compRes))
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 6)
+
+	// the manifests of other revisions are not backed off and have no known good manifests
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"other"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Equal(t, "repo unavailable (attempt 1, retrying in 1m0s)", comparisonError(compRes))
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	assert.Empty(t, compRes.managedResources)
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 7)
+
+	// the backoff is reset once the manifests generate again
+	mockClient.ExpectedCalls = successfulCalls
+	manager.comparisonBackoffs[app.Name].retryAt = time.Now()
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"other"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	assert.Empty(t, comparisonError(compRes))
+	assert.Empty(t, manager.comparisonBackoffs)
+
+	ctrl.appStateManager.ForgetComparisonBackoff(app.Name)
+	assert.Empty(t, manager.knownGoodManifests)
+}
+
+func TestCompareAppStateComparisonErrorBackoffDisabled(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps:            []runtime.Object{app, &defaultProj},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	manager := ctrl.appStateManager.(*appStateManager)
+	_, repoClient, err := manager.repoClientset.NewRepoServerClient()
+	assert.NoError(t, err)
+	mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+	mockClient.ExpectedCalls = nil
+	mockClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("repo unavailable"))
+
+	for i := 0; i < 2; i++ {
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, "repo unavailable", compRes.conditions[0].Message)
+		}
+	}
+	mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 2)
+	assert.Empty(t, manager.comparisonBackoffs)
+}
+
+func TestCompareAppStateAttemptedRevision(t *testing.T) {
+	newController := func(app *argoappv1.Application) (*ApplicationController, *mockrepoclient.RepoServerServiceClient) {
+		ctrl := newFakeController(&fakeData{
+			apps:            []runtime.Object{app, &defaultProj},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		})
+		_, repoClient, err := ctrl.appStateManager.(*appStateManager).repoClientset.NewRepoServerClient()
+		assert.NoError(t, err)
+		mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+		mockClient.ExpectedCalls = nil
+		return ctrl, mockClient
+	}
+
+	t.Run("RepoUnreachable", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Sync.Revision = "previous-sha"
+		ctrl, mockClient := newController(app)
+		mockClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unavailable, "repo unavailable"))
+
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		assert.Equal(t, "previous-sha", compRes.syncStatus.Revision)
+		assert.Equal(t, compRes.reconciledAt, compRes.attemptedAt)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, "rpc error: code = Unavailable desc = repo unavailable", compRes.conditions[0].Message)
+		}
+	})
+
+	t.Run("ManifestGenerationFailed", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.Sync.Revision = "previous-sha"
+		ctrl, mockClient := newController(app)
+		mockClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(&fakeManifestStream{
+			err:     status.Error(codes.Unknown, "kustomize build failed"),
+			trailer: metadata.Pairs(apiclient.RevisionTrailerKey, fakeCommitSHA),
+		}, nil)
+
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+		assert.Equal(t, compRes.reconciledAt, compRes.attemptedAt)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, fmt.Sprintf("Failed to generate the manifests of revision %s: rpc error: code = Unknown desc = kustomize build failed", fakeCommitSHA), compRes.conditions[0].Message)
+		}
+	})
+
+	t.Run("BackedOff", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{
+			apps:                    []runtime.Object{app, &defaultProj},
+			managedLiveObjs:         make(map[kube.ResourceKey]*unstructured.Unstructured),
+			comparisonBackoffConfig: ComparisonBackoffConfig{InitialDelay: time.Minute, MaxDelay: 3 * time.Minute},
+		})
+		manager := ctrl.appStateManager.(*appStateManager)
+		_, repoClient, err := manager.repoClientset.NewRepoServerClient()
+		assert.NoError(t, err)
+		mockClient := repoClient.(*mockrepoclient.RepoServerServiceClient)
+		mockClient.ExpectedCalls = nil
+		mockClient.On("GenerateManifestStream", mock.Anything, mock.Anything).Return(&fakeManifestStream{
+			err:     status.Error(codes.Unknown, "kustomize build failed"),
+			trailer: metadata.Pairs(apiclient.RevisionTrailerKey, fakeCommitSHA),
+		}, nil)
+
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+		attemptedAt := manager.comparisonBackoffs[app.Name].attemptedAt
+		manager.comparisonBackoffs[app.Name].attemptedAt = attemptedAt.Add(-time.Minute)
+
+		// the backed off comparison reports the revision and the time of the failed attempt
+		compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{"master"}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		mockClient.AssertNumberOfCalls(t, "GenerateManifestStream", 1)
+		assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+		assert.True(t, compRes.attemptedAt.Time.Equal(attemptedAt.Add(-time.Minute)))
+		assert.True(t, compRes.attemptedAt.Before(&compRes.reconciledAt))
+	})
+}
+
+func TestCompareAppStateResourceLimit(t *testing.T) {
+	newData := func(proj *argoappv1.AppProject, app *argoappv1.Application) *fakeData {
+		var manifests []string
+		for i := 0; i < 3; i++ {
+			pod := test.NewPod()
+			pod.SetName(fmt.Sprintf("pod-%d", i))
+			pod.SetNamespace(test.FakeDestNamespace)
+			manifests = append(manifests, toJSON(t, pod))
+		}
+		return &fakeData{
+			apps: []runtime.Object{app, proj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: manifests,
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  fakeCommitSHA,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			configMapData:   map[string]string{"application.maxResources": "2"},
+		}
+	}
+
+	t.Run("ExceedsSettingsLimit", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(newData(&defaultProj, app))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		assert.Equal(t, fakeCommitSHA, compRes.syncStatus.Revision)
+		assert.Empty(t, compRes.managedResources)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, argoappv1.ApplicationConditionResourceLimitError, compRes.conditions[0].Type)
+			assert.Equal(t, "application has 3 resources which exceeds the limit of 2", compRes.conditions[0].Message)
+		}
+		assert.Len(t, app.Status.GetConditions(map[argoappv1.ApplicationConditionType]bool{argoappv1.ApplicationConditionResourceLimitError: true}), 1)
+		// the live state is not retrieved
+		mockStateCache := ctrl.appStateManager.(*appStateManager).liveStateCache.(*mockstatecache.LiveStateCache)
+		mockStateCache.AssertNotCalled(t, "GetManagedLiveObjs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ProjectOverridesLimit", func(t *testing.T) {
+		app := newFakeApp()
+		proj := defaultProj.DeepCopy()
+		proj.Spec.MaxResources = 3
+		ctrl := newFakeController(newData(proj, app))
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Len(t, compRes.managedResources, 3)
+		assert.False(t, hasConditionOfType(compRes.conditions, argoappv1.ApplicationConditionResourceLimitError))
+	})
+
+	t.Run("LimitedByProject", func(t *testing.T) {
+		app := newFakeApp()
+		proj := defaultProj.DeepCopy()
+		proj.Spec.MaxResources = 1
+		data := newData(proj, app)
+		data.configMapData = nil
+		ctrl := newFakeController(data)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		if assert.Len(t, compRes.conditions, 1) {
+			assert.Equal(t, "application has 3 resources which exceeds the limit of 1", compRes.conditions[0].Message)
+		}
+	})
+}
+
+func TestCompareAppStateSchemaValidation(t *testing.T) {
+	newPod := func(name string, containers string) string {
+		return fmt.Sprintf(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": %q, "namespace": %q}, "spec": {"containers": %s}}`, name, test.FakeDestNamespace, containers)
+	}
+	openAPISchema, err := kube.NewOpenAPISchema(test.NewOpenAPIDocument())
+	assert.NoError(t, err)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{
+				newPod("valid-pod", `[{"name": "nginx", "image": "nginx:1.17"}]`),
+				newPod("invalid-pod", `[{"name": "nginx", "imag": "nginx:1.17"}, {"image": "nginx:1.17"}]`),
+				newPod("unvalidated-pod", `[{"imag": "nginx:1.17"}]`),
+				`{"apiVersion": "example.com/v1", "kind": "Widget", "metadata": {"name": "my-widget"}, "spec": {"unknown": true}}`,
+			},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		openAPISchema:   openAPISchema,
+	}
+	data.manifestResponse.Manifests[2] = strings.Replace(data.manifestResponse.Manifests[2], `"metadata": {`, `"metadata": {"annotations": {"argocd.argoproj.io/sync-options": "Validate=false"}, `, 1)
+	ctrl := newFakeController(&data)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	var messages []string
+	for _, condition := range compRes.conditions {
+		if condition.Type == argoappv1.ApplicationConditionSchemaValidationError {
+			messages = append(messages, condition.Message)
+		}
+	}
+	assert.Equal(t, []string{
+		`Pod/invalid-pod does not match the schema of the destination cluster: ValidationError(Pod.spec.containers[0]): unknown field "imag" in io.k8s.api.core.v1.Container; ValidationError(Pod.spec.containers[1]): missing required field "name" in io.k8s.api.core.v1.Container`,
+	}, messages)
+	// the rest of the comparison is not affected
+	assert.Len(t, compRes.managedResources, 4)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+
+	// validation is disabled by the sync option of the application
+	app.Spec.SyncPolicy = &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"SchemaValidation=false"}}
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	for _, condition := range compRes.conditions {
+		assert.NotEqual(t, argoappv1.ApplicationConditionSchemaValidationError, condition.Type)
+	}
+}
+
+func TestCompareAppStateForeignManager(t *testing.T) {
+	newPod := func(name string, labels, annotations map[string]string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetName(name)
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetLabels(labels)
+		pod.SetAnnotations(annotations)
+		return pod
+	}
+	app := newFakeApp()
+	chartLabels := map[string]string{"app.kubernetes.io/managed-by": "Helm"}
+	releaseAnnotations := map[string]string{"meta.helm.sh/release-name": "my-release"}
+	chartPod := newPod("chart-pod", chartLabels, nil)
+	fluxPod := newPod("flux-pod", nil, nil)
+	helmPod := newPod("helm-pod", nil, nil)
+	adoptedPod := newPod("adopted-pod", nil, map[string]string{common.AnnotationCompareOptions: "IgnoreForeignManager"})
+	operatorPod := newPod("operator-pod", nil, nil)
+	liveObjs := []*unstructured.Unstructured{
+		newPod("chart-pod", chartLabels, nil),
+		newPod("flux-pod", map[string]string{"kustomize.toolkit.fluxcd.io/name": "apps"}, nil),
+		newPod("helm-pod", chartLabels, releaseAnnotations),
+		newPod("adopted-pod", chartLabels, releaseAnnotations),
+		newPod("operator-pod", map[string]string{"example.com/owner": "operator"}, nil),
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, chartPod), toJSON(t, fluxPod), toJSON(t, helmPod), toJSON(t, adoptedPod), toJSON(t, operatorPod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData: map[string]string{
+			"resource.foreignManagers": `
+- name: Operator
+  labels: [example.com/owner=operator]`,
+		},
+	}
+	for _, liveObj := range liveObjs {
+		data.managedLiveObjs[kube.GetResourceKey(liveObj)] = liveObj
+	}
+	ctrl := newFakeController(&data)
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	var messages []string
+	for _, condition := range compRes.conditions {
+		if condition.Type == argoappv1.ApplicationConditionForeignManagerWarning {
+			messages = append(messages, condition.Message)
+		}
+	}
+	assert.Equal(t, []string{
+		"Pod/flux-pod appears to be managed by Flux (label kustomize.toolkit.fluxcd.io/name)",
+		"Pod/helm-pod appears to be managed by Helm (label app.kubernetes.io/managed-by)",
+		"Pod/operator-pod appears to be managed by Operator (label example.com/owner)",
+	}, messages)
+}
+
+func TestCompareAppStateDriftGracePeriod(t *testing.T) {
+	newPod := func(name string, labels, annotations map[string]string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetName(name)
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetLabels(labels)
+		pod.SetAnnotations(annotations)
+		return pod
+	}
+	gracePeriod := map[string]string{common.AnnotationCompareOptions: "DriftGracePeriod=120s"}
+	targetLabels := map[string]string{"version": "v2"}
+	drifted := newPod("drifted-pod", targetLabels, gracePeriod)
+	plain := newPod("plain-pod", targetLabels, nil)
+	data := fakeData{
+		apps: []runtime.Object{&defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, drifted), toJSON(t, plain)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	for _, liveObj := range []*unstructured.Unstructured{newPod("drifted-pod", nil, gracePeriod), newPod("plain-pod", nil, nil)} {
+		data.managedLiveObjs[kube.GetResourceKey(liveObj)] = liveObj
+	}
+	ctrl := newFakeController(&data)
+
+	newSyncedApp := func(syncedAgo time.Duration, prevStatus argoappv1.SyncStatusCode) *argoappv1.Application {
+		app := newFakeApp()
+		finishedAt := metav1.NewTime(time.Now().Add(-syncedAgo))
+		app.Status.OperationState = &argoappv1.OperationState{
+			Phase:      argoappv1.OperationSucceeded,
+			StartedAt:  finishedAt,
+			FinishedAt: &finishedAt,
+			SyncResult: &argoappv1.SyncOperationResult{},
+		}
+		for _, name := range []string{"drifted-pod", "plain-pod"} {
+			app.Status.OperationState.SyncResult.Resources = append(app.Status.OperationState.SyncResult.Resources, &argoappv1.ResourceResult{
+				Version: "v1", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: name, Status: argoappv1.ResultCodeSynced,
+			})
+			app.Status.Resources = append(app.Status.Resources, argoappv1.ResourceStatus{
+				Version: "v1", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: name, Status: prevStatus,
+			})
+		}
+		return app
+	}
+	getResource := func(compRes *comparisonResult, name string) argoappv1.ResourceStatus {
+		for _, res := range compRes.resources {
+			if res.Name == name {
+				return res
+			}
+		}
+		t.Fatalf("resource %s not found", name)
+		return argoappv1.ResourceStatus{}
+	}
+
+	t.Run("WithinGracePeriod", func(t *testing.T) {
+		app := newSyncedApp(30*time.Second, argoappv1.SyncStatusCodeSynced)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		res := getResource(compRes, "drifted-pod")
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, res.Status)
+		assert.True(t, res.PendingDrift)
+		assert.WithinDuration(t, app.Status.OperationState.FinishedAt.Add(120*time.Second), compRes.driftGraceDeadline, time.Second)
+		res = getResource(compRes, "plain-pod")
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, res.Status)
+		assert.False(t, res.PendingDrift)
+	})
+
+	t.Run("GracePeriodElapsed", func(t *testing.T) {
+		app := newSyncedApp(5*time.Minute, argoappv1.SyncStatusCodeSynced)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		res := getResource(compRes, "drifted-pod")
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, res.Status)
+		assert.False(t, res.PendingDrift)
+		assert.True(t, compRes.driftGraceDeadline.IsZero())
+	})
+
+	t.Run("PreviouslyOutOfSync", func(t *testing.T) {
+		app := newSyncedApp(30*time.Second, argoappv1.SyncStatusCodeOutOfSync)
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		res := getResource(compRes, "drifted-pod")
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, res.Status)
+		assert.False(t, res.PendingDrift)
+	})
+
+	t.Run("NotSyncedByOperation", func(t *testing.T) {
+		app := newSyncedApp(30*time.Second, argoappv1.SyncStatusCodeSynced)
+		app.Status.OperationState.SyncResult.Resources = nil
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+		res := getResource(compRes, "drifted-pod")
+		assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, res.Status)
+		assert.False(t, res.PendingDrift)
+	})
+}
+
+func TestCompareAppStateStaleSettings(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(pod): pod},
+		configMapData:   map[string]string{},
+	}
+	ctrl := newFakeController(&data)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go ctrl.appStateManager.WatchSettings(ctx)
+
+	// updates the settings until the comparison observes the update, the watch might not be subscribed yet
+	version := 0
+	updateSettings := func(customizations string, stale bool) {
+		for i := 0; i < 100; i++ {
+			cm, err := ctrl.kubeClientset.CoreV1().ConfigMaps(test.FakeArgoCDNamespace).Get(common.ArgoCDConfigMapName, metav1.GetOptions{})
+			assert.NoError(t, err)
+			cm.Data = map[string]string{"resource.customizations": customizations}
+			// the settings manager only notifies about updates which change the resource version
+			version++
+			cm.ResourceVersion = fmt.Sprintf("%d", version)
+			_, err = ctrl.kubeClientset.CoreV1().ConfigMaps(test.FakeArgoCDNamespace).Update(cm)
+			assert.NoError(t, err)
+			time.Sleep(50 * time.Millisecond)
+			compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+			if hasCondition(compRes, argoappv1.ApplicationConditionStaleSettingsWarning) == stale {
+				return
+			}
+		}
+		t.Fatalf("comparison did not observe the settings update")
+	}
+
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.False(t, hasCondition(compRes, argoappv1.ApplicationConditionStaleSettingsWarning))
+	healthStatus := compRes.healthStatus.Status
+
+	// the sync status does not flap while the settings fail to load
+	updateSettings("{", true)
+	for i := 0; i < 3; i++ {
+		compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Equal(t, healthStatus, compRes.healthStatus.Status)
+		assert.True(t, hasCondition(compRes, argoappv1.ApplicationConditionStaleSettingsWarning))
+		for _, condition := range compRes.conditions {
+			if condition.Type == argoappv1.ApplicationConditionStaleSettingsWarning {
+				assert.Contains(t, condition.Message, "Failed to load settings, using the settings loaded")
+			}
+		}
+	}
+
+	updateSettings("{}", false)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+
+	// the result is unknown if the settings have never been loaded
+	data.configMapData = map[string]string{"resource.customizations": "{"}
+	ctrl = newFakeController(&data)
+	compRes = ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+}
+
+func hasCondition(compRes *comparisonResult, conditionType argoappv1.ApplicationConditionType) bool {
+	for _, condition := range compRes.conditions {
+		if condition.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCompareAppStateDeniedResources(t *testing.T) {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{{Group: "*", Kind: "Pod"}}
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	svc := test.NewService()
+	svc.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: []runtime.Object{proj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, svc)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(svc): svc,
+		},
+	}
+	ctrl := newFakeController(&data)
+	app := newFakeApp()
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	for _, res := range compRes.resources {
+		switch res.Kind {
+		case "Pod":
+			assert.Equal(t, argoappv1.SyncStatusCodeUnknown, res.Status)
+			assert.Equal(t, "blocked by project default rule namespaceResourceBlacklist */Pod", res.Message)
+		case "Service":
+			assert.Equal(t, argoappv1.SyncStatusCodeSynced, res.Status)
+			assert.Empty(t, res.Message)
+		}
+	}
+	// the missing pod cannot be synced, so it doesn't make the application out of sync
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	var conditions []argoappv1.ApplicationCondition
+	for _, condition := range compRes.conditions {
+		if condition.Type == argoappv1.ApplicationConditionResourcePermissionError {
+			conditions = append(conditions, condition)
+		}
+	}
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, "Resources are not permitted in project default: Pod "+test.FakeDestNamespace+"/my-pod (namespaceResourceBlacklist */Pod)", conditions[0].Message)
+}
+
+func TestCompareAppStateNamespaceNotPermitted(t *testing.T) {
+	proj := defaultProj.DeepCopy()
+	proj.Spec.Destinations = []argoappv1.ApplicationDestination{{Server: "*", Namespace: test.FakeDestNamespace}}
+	pod := test.NewPod()
+	pod.SetNamespace("kube-system")
+	svc := test.NewService()
+	svc.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: []runtime.Object{proj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, svc)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(svc): svc,
+		},
+	}
+	ctrl := newFakeController(&data)
+	app := newFakeApp()
+	compRes := ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+	// the pod is not deployed, so only the service is managed
+	assert.Len(t, compRes.resources, 1)
+	assert.Equal(t, "Service", compRes.resources[0].Kind)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.resources[0].Status)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	var conditions []argoappv1.ApplicationCondition
+	for _, condition := range compRes.conditions {
+		if condition.Type == argoappv1.ApplicationConditionNamespacePermissionError {
+			conditions = append(conditions, condition)
+		}
+	}
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, "Resource /Pod my-pod is in namespace kube-system which is not permitted in project default", conditions[0].Message)
+}
+
+func TestCompareAppStateClusterResourcesNotWhitelisted(t *testing.T) {
+	clusterRole := kube.MustToUnstructured(&rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-role"},
+	})
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: []runtime.Object{&defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, clusterRole), toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(pod): pod,
+		},
+		clusterScopedGroupKinds: []schema.GroupKind{clusterRole.GroupVersionKind().GroupKind()},
+	}
+	compare := func(app *argoappv1.Application) *comparisonResult {
+		ctrl := newFakeController(&data)
+		return ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+	}
+	getConditions := func(compRes *comparisonResult, conditionType argoappv1.ApplicationConditionType) []argoappv1.ApplicationCondition {
+		var conditions []argoappv1.ApplicationCondition
+		for _, condition := range compRes.conditions {
+			if condition.Type == conditionType {
+				conditions = append(conditions, condition)
+			}
+		}
+		return conditions
+	}
+
+	t.Run("Warn", func(t *testing.T) {
+		compRes := compare(newFakeApp())
+		for _, res := range compRes.resources {
+			if res.Kind == "ClusterRole" {
+				assert.Equal(t, argoappv1.SyncStatusCodeUnknown, res.Status)
+				assert.Equal(t, "blocked by project default rule clusterResourceWhitelist", res.Message)
+			}
+		}
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Empty(t, getConditions(compRes, argoappv1.ApplicationConditionResourcePermissionError))
+		conditions := getConditions(compRes, argoappv1.ApplicationConditionClusterResourceWarning)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, "Cluster-scoped resources are not whitelisted in project default and are reported as blocked by syncs: rbac.authorization.k8s.io/ClusterRole my-role", conditions[0].Message)
+		}
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &argoappv1.SyncPolicy{SyncOptions: argoappv1.SyncOptions{"ClusterResources=block"}}
+		compRes := compare(app)
+		conditions := getConditions(compRes, argoappv1.ApplicationConditionClusterResourceWarning)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, "Cluster-scoped resources are not whitelisted in project default and are excluded from syncs: rbac.authorization.k8s.io/ClusterRole my-role", conditions[0].Message)
+		}
+	})
+}
+
+func newNormalizerComparisonSettings(overrideCount int) *comparisonSettings {
+	overrides := make(map[string]argoappv1.ResourceOverride)
+	for i := 0; i < overrideCount; i++ {
+		overrides[fmt.Sprintf("example.com/Kind%d", i)] = argoappv1.ResourceOverride{
+			IgnoreDifferences: "jsonPointers:\n- /spec/replicas\n- /spec/template/metadata/annotations\nignoredMetadataKeys:\n- example.com/*",
+		}
+	}
+	return &comparisonSettings{
+		appLabelKeys:        []string{common.LabelKeyAppInstance},
+		resourceOverrides:   overrides,
+		passthroughPatterns: []string{"example.com/*"},
+		ignoredMetadataKeys: []string{"kubectl.kubernetes.io/*"},
+	}
+}
+
+func TestComparisonNormalizersReuse(t *testing.T) {
+	cs := newNormalizerComparisonSettings(3)
+	app := newFakeApp()
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Kind: "Pod", JSONPointers: []string{"/spec/replicas"}}}
+
+	normalizers, err := cs.getNormalizers(app)
+	assert.NoError(t, err)
+	otherApp := app.DeepCopy()
+	otherApp.Name = "other-app"
+	reused, err := cs.getNormalizers(otherApp)
+	assert.NoError(t, err)
+	assert.True(t, normalizers == reused)
+
+	otherApp.Spec.IgnoreDifferences[0].JSONPointers = []string{"/spec/template"}
+	rebuilt, err := cs.getNormalizers(otherApp)
+	assert.NoError(t, err)
+	assert.False(t, normalizers == rebuilt)
+
+	otherApp = app.DeepCopy()
+	otherApp.Spec.PassthroughAnnotations = []string{"other.example.com/*"}
+	rebuilt, err = cs.getNormalizers(otherApp)
+	assert.NoError(t, err)
+	assert.False(t, normalizers == rebuilt)
+
+	// the normalizers are dropped together with the snapshot of the settings
+	reloaded, err := newNormalizerComparisonSettings(3).getNormalizers(app)
+	assert.NoError(t, err)
+	assert.False(t, normalizers == reloaded)
+}
+
+func TestComparisonNormalizersConcurrentUse(t *testing.T) {
+	cs := newNormalizerComparisonSettings(3)
+	app := newFakeApp()
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Kind: "Pod", JSONPointers: []string{"/metadata/labels"}}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				normalizers, err := cs.getNormalizers(app)
+				if !assert.NoError(t, err) {
+					return
+				}
+				pod := test.NewPod()
+				pod.SetLabels(map[string]string{"app": "guestbook"})
+				pod.SetAnnotations(map[string]string{"example.com/owner": "ops"})
+				assert.NoError(t, normalizers.diffNormalizer.Normalize(pod))
+				assert.Empty(t, pod.GetLabels())
+				assert.Empty(t, pod.GetAnnotations())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkComparisonNormalizers(b *testing.B) {
+	app := newFakeApp()
+	app.Spec.IgnoreDifferences = []argoappv1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}
+
+	b.Run("Cold", func(b *testing.B) {
+		cs := newNormalizerComparisonSettings(50)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := cs.newNormalizers(app); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Cached", func(b *testing.B) {
+		cs := newNormalizerComparisonSettings(50)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := cs.getNormalizers(app); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkCompareAppStateManyResources compares an application with 5000 resources, a third of which are out of sync,
+// diffing the resources serially and in parallel
+func BenchmarkCompareAppStateManyResources(b *testing.B) {
+	app := newFakeApp()
+	manifests := make([]string, 0, 5000)
+	managedLiveObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for i := 0; i < 5000; i++ {
+		target := test.NewDeployment()
+		target.SetName(fmt.Sprintf("demo-%d", i))
+		target.SetNamespace(test.FakeDestNamespace)
+		live := target.DeepCopy()
+		labels := map[string]string{common.LabelKeyAppInstance: app.Name}
+		if i%3 == 0 {
+			labels["drift"] = "true"
+		}
+		live.SetLabels(labels)
+		managedLiveObjs[kube.GetResourceKey(live)] = live
+		data, err := json.Marshal(target)
+		if err != nil {
+			b.Fatal(err)
+		}
+		manifests = append(manifests, string(data))
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: manifests,
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: managedLiveObjs,
+	})
+	appStateManager := ctrl.appStateManager.(*appStateManager)
+
+	for _, parallelism := range []int{1, 0} {
+		name := "Serial"
+		if parallelism != 1 {
+			name = fmt.Sprintf("Parallel-%d", goruntime.NumCPU())
+		}
+		b.Run(name, func(b *testing.B) {
+			appStateManager.diffParallelism = parallelism
+			for i := 0; i < b.N; i++ {
+				// the comparison cache is bypassed so that every iteration diffs the resources
+				compRes := appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, true, nil)
+				if len(compRes.managedResources) != 5000 {
+					b.Fatalf("expected 5000 managed resources, got %d", len(compRes.managedResources))
+				}
+			}
+		})
+	}
+}
+
+func TestResourceDenyingRuleGetter(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+		Spec: argoappv1.AppProjectSpec{
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}},
+			ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+		},
+	}
+	liveStateCache := &mockstatecache.LiveStateCache{}
+	liveStateCache.On("IsNamespaced", app.Spec.Destination.Server, schema.GroupKind{Kind: "Pod"}).Return(true, nil)
+	liveStateCache.On("IsNamespaced", app.Spec.Destination.Server, schema.GroupKind{Kind: "Secret"}).Return(true, nil)
+	liveStateCache.On("IsNamespaced", app.Spec.Destination.Server, schema.GroupKind{Kind: "Namespace"}).Return(false, nil)
+	liveStateCache.On("IsNamespaced", app.Spec.Destination.Server, schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}).Return(false, nil)
+	manager := &appStateManager{liveStateCache: liveStateCache, tracer: noopTracer{}}
+
+	getResourceDenyingRule := manager.newResourceDenyingRuleGetter(app, proj)
+	for i := 0; i < 2; i++ {
+		_, denied := getResourceDenyingRule(schema.GroupKind{Kind: "Pod"})
+		assert.False(t, denied)
+		rule, denied := getResourceDenyingRule(schema.GroupKind{Kind: "Secret"})
+		assert.True(t, denied)
+		assert.Equal(t, "namespaceResourceBlacklist /Secret", rule)
+		_, denied = getResourceDenyingRule(schema.GroupKind{Kind: "Namespace"})
+		assert.False(t, denied)
+		rule, denied = getResourceDenyingRule(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"})
+		assert.True(t, denied)
+		assert.Equal(t, "clusterResourceWhitelist", rule)
+	}
+	// the scope of every kind is looked up once
+	liveStateCache.AssertNumberOfCalls(t, "IsNamespaced", 4)
+
+	// a new getter evaluates the rules of the project again
+	proj.Spec.NamespaceResourceBlacklist = nil
+	_, denied := manager.newResourceDenyingRuleGetter(app, proj)(schema.GroupKind{Kind: "Secret"})
+	assert.False(t, denied)
+}
+
+func TestResourceDenyingRuleDiscoveryUnavailable(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+		Spec: argoappv1.AppProjectSpec{
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "*", Kind: "*"}},
+			ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+		},
+	}
+	liveStateCache := &mockstatecache.LiveStateCache{}
+	liveStateCache.On("IsNamespaced", app.Spec.Destination.Server, mock.Anything).Return(false, fmt.Errorf("cluster unreachable"))
+	manager := &appStateManager{liveStateCache: liveStateCache, tracer: noopTracer{}}
+
+	// the well-known cluster-scoped kinds are evaluated against the cluster resource rules
+	rule, denied := manager.getResourceDenyingRule(app, proj, schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"})
+	assert.True(t, denied)
+	assert.Equal(t, "clusterResourceWhitelist", rule)
+	_, denied = manager.getResourceDenyingRule(app, proj, schema.GroupKind{Kind: "Namespace"})
+	assert.False(t, denied)
+	// other kinds are not evaluated since their scope is unknown
+	_, denied = manager.getResourceDenyingRule(app, proj, schema.GroupKind{Kind: "Pod"})
+	assert.False(t, denied)
+	_, denied = manager.getResourceDenyingRule(app, proj, schema.GroupKind{Group: "argoproj.io", Kind: "Rollout"})
+	assert.False(t, denied)
+}
+
+// BenchmarkResourceDenyingRule evaluates the project rules for the 5000 resources of an application, with and without
+// memoizing the rules per group/kind
+func BenchmarkResourceDenyingRule(b *testing.B) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+		Spec: argoappv1.AppProjectSpec{
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}, {Group: "networking.k8s.io", Kind: "*"}},
+			ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+		},
+	}
+	kinds := []schema.GroupKind{{Kind: "Pod"}, {Kind: "Service"}, {Kind: "ConfigMap"}, {Group: "apps", Kind: "Deployment"}, {Kind: "Namespace"}}
+	resources := make([]schema.GroupKind, 5000)
+	for i := range resources {
+		resources[i] = kinds[i%len(kinds)]
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+	manager := ctrl.appStateManager.(*appStateManager)
+
+	b.Run("Uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, gk := range resources {
+				manager.getResourceDenyingRule(app, proj, gk)
+			}
+		}
+	})
+	b.Run("Memoized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			getResourceDenyingRule := manager.newResourceDenyingRuleGetter(app, proj)
+			for _, gk := range resources {
+				getResourceDenyingRule(gk)
+			}
+		}
+	})
 }
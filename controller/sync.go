@@ -3,8 +3,8 @@ package controller
 import (
 	"context"
 	"fmt"
-	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -23,6 +23,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/argo-cd/common"
+	statecache "github.com/argoproj/argo-cd/controller/cache"
 	"github.com/argoproj/argo-cd/controller/metrics"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	listersv1alpha1 "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
@@ -38,30 +39,69 @@ const (
 	crdReadinessTimeout = time.Duration(3) * time.Second
 )
 
+var (
+	// applyRetries is the number of times the apply of a resource is retried if it failed with a retryable error
+	applyRetries = 2
+	// applyRetryDelay is the delay before the apply of a resource is retried
+	applyRetryDelay = 1 * time.Second
+)
+
 var syncIdPrefix uint64 = 0
 
 type syncContext struct {
-	resourceOverrides   map[string]v1alpha1.ResourceOverride
-	appName             string
-	proj                *v1alpha1.AppProject
-	compareResult       *comparisonResult
-	config              *rest.Config
-	dynamicIf           dynamic.Interface
-	disco               discovery.DiscoveryInterface
-	extensionsclientset *clientset.Clientset
-	kubectl             kube.Kubectl
-	namespace           string
-	server              string
-	syncOp              *v1alpha1.SyncOperation
-	syncRes             *v1alpha1.SyncOperationResult
-	syncResources       []v1alpha1.SyncOperationResource
-	opState             *v1alpha1.OperationState
-	log                 *log.Entry
+	// ctx aborts the operation at the next wave and the running kubectl applies if it is done, e.g. because the
+	// application was deleted or the controller stops
+	ctx               context.Context
+	resourceOverrides map[string]v1alpha1.ResourceOverride
+	appName           string
+	proj              *v1alpha1.AppProject
+	compareResult     *comparisonResult
+	config            *rest.Config
+	// applyConfig is used to apply and delete resources, it impersonates the destination service account of the project
+	// if there is one and is the same as config otherwise
+	applyConfig *rest.Config
+	// dynamicIf is used to delete hooks, it uses the applyConfig
+	dynamicIf dynamic.Interface
+	// impersonatedServiceAccount is the <namespace>:<name> of the impersonated service account, empty if there is none
+	impersonatedServiceAccount string
+	disco                      discovery.DiscoveryInterface
+	extensionsclientset        *clientset.Clientset
+	kubectl                    kube.Kubectl
+	namespace                  string
+	server                     string
+	syncOp                     *v1alpha1.SyncOperation
+	syncRes                    *v1alpha1.SyncOperationResult
+	syncResources              []v1alpha1.SyncOperationResource
+	opState                    *v1alpha1.OperationState
+	log                        *log.Entry
+	// respectIgnoreDifferences preserves the live values of fields with ignored differences when applying resources
+	respectIgnoreDifferences bool
+	// createNamespace creates the destination namespace if it does not exist
+	createNamespace bool
+	// managedNamespaceMetadata is applied to the destination namespace if createNamespace is set
+	managedNamespaceMetadata *v1alpha1.ManagedNamespaceMetadata
+	// skipDryRunOnMissingResource skips the dry-run of all resources whose kind is not registered in the cluster
+	skipDryRunOnMissingResource bool
+	// blockClusterResources excludes the cluster-scoped resources which are denied by the project from the operation,
+	// instead of reporting them with the Blocked result
+	blockClusterResources bool
+	// liveStateCache is refreshed after a CRD is applied, so that the resources of the CRD are found by later waves
+	liveStateCache statecache.LiveStateCache
+	// tasks are all tasks of the operation, the progress of the operation is calculated from them
+	tasks syncTasks
+	// reportProgress persists the progress of the operation between the tasks of a wave, it may be nil
+	reportProgress OperationProgressReporter
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
 }
 
-func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
+// OperationProgressReporter persists the progress of a running sync operation. It returns true if the termination of
+// the operation has been requested.
+type OperationProgressReporter func(progress *v1alpha1.OperationProgress) (terminating bool)
+
+// SyncAppState performs the sync operation of the application and records its progress and result in the given state.
+// If the given context is done, the operation is interrupted and remains running, so that it is resumed later.
+func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Application, state *v1alpha1.OperationState, reportProgress OperationProgressReporter) {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult field.
@@ -72,6 +112,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	var syncRes *v1alpha1.SyncOperationResult
 	var syncResources []v1alpha1.SyncOperationResource
 	var source v1alpha1.ApplicationSource
+	// the sources and revisions of applications with multiple sources, the revisions are resolved per source
+	var sources []v1alpha1.ApplicationSource
+	var revisions []string
+	multipleSources := app.Spec.HasMultipleSources()
 
 	if state.Operation.Sync == nil {
 		state.Phase = v1alpha1.OperationFailed
@@ -79,23 +123,50 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 	syncOp = *state.Operation.Sync
-	if syncOp.Source == nil {
-		// normal sync case (where source is taken from app.spec.source)
+	if multipleSources && syncOp.Source != nil {
+		state.Phase = v1alpha1.OperationFailed
+		state.Message = "Invalid operation request: the source of an application with multiple sources cannot be overridden"
+		return
+	}
+	if syncOp.RollbackID != nil {
+		// rollback case (where revision and source are taken from the revision history)
+		history, err := getRollbackHistory(app, *syncOp.RollbackID)
+		if err != nil {
+			state.Phase = v1alpha1.OperationFailed
+			state.Message = err.Error()
+			return
+		}
+		if multipleSources != (len(history.Sources) > 0) {
+			state.Phase = v1alpha1.OperationFailed
+			state.Message = fmt.Sprintf("cannot rollback to history id %d since the application was switched between a single and multiple sources", *syncOp.RollbackID)
+			return
+		}
+		syncOp.Revision = history.Revision
+		source = history.Source
+		sources, revisions = history.Sources, history.Revisions
+	} else if syncOp.Source == nil {
+		// normal sync case (where source is taken from app.spec.source, or app.spec.sources which are synced to
+		// their target revisions)
 		source = app.Spec.Source
+		sources, revisions = app.Spec.Sources, make([]string, len(app.Spec.Sources))
 	} else {
-		// rollback case
+		// sync of an overridden source
 		source = *state.Operation.Sync.Source
 	}
 	syncResources = syncOp.Resources
 	if state.SyncResult != nil {
 		syncRes = state.SyncResult
 		revision = state.SyncResult.Revision
+		if multipleSources && len(state.SyncResult.Revisions) == len(sources) {
+			revisions = state.SyncResult.Revisions
+		}
 	} else {
 		syncRes = &v1alpha1.SyncOperationResult{}
 		// status.operationState.syncResult.source. must be set properly since auto-sync relies
 		// on this information to decide if it should sync (if source is different than the last
 		// sync attempt)
 		syncRes.Source = source
+		syncRes.Sources = sources
 		state.SyncResult = syncRes
 	}
 
@@ -105,24 +176,46 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		// Take the value in the requested operation. We will resolve this to a SHA later.
 		revision = syncOp.Revision
 	}
+	if !multipleSources {
+		sources, revisions = []v1alpha1.ApplicationSource{source}, []string{revision}
+	}
 
-	compareResult := m.CompareAppState(app, revision, source, false, syncOp.Manifests)
+	compareResult := m.compareAppState(ctx, app, revisions, sources, false, syncOp.Manifests, false, false)
+	if compareResult.cancelled {
+		state.Message = "operation interrupted before the comparison completed"
+		return
+	}
 
-	// If there are any comparison or spec errors error conditions do not perform the operation
+	// If there are any comparison, spec or resource limit error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:    true,
+		v1alpha1.ApplicationConditionInvalidSpecError:   true,
+		v1alpha1.ApplicationConditionResourceLimitError: true,
 	}); len(errConditions) > 0 {
 		state.Phase = v1alpha1.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
 		return
 	}
+	if compareResult.signatureError != nil {
+		state.Phase = v1alpha1.OperationError
+		state.Message = fmt.Sprintf("Signature verification failed: %v", compareResult.signatureError)
+		return
+	}
 
 	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = compareResult.syncStatus.Revision
+	syncRes.Revisions = compareResult.syncStatus.Revisions
+
+	// the comparison already failed if the destination name cannot be resolved
+	dest, err := m.resolveDestination(ctx, app.Spec.Destination)
+	if err != nil {
+		state.Phase = v1alpha1.OperationError
+		state.Message = err.Error()
+		return
+	}
 
-	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	clst, err := m.db.GetCluster(ctx, dest.Server)
 	if err != nil {
 		state.Phase = v1alpha1.OperationError
 		state.Message = err.Error()
@@ -157,32 +250,66 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	// resources are applied and deleted as the destination service account of the project, if any, while dry-runs and
+	// reads keep using the cluster credentials
+	applyConfig := restConfig
+	serviceAccount, impersonate := proj.GetDestinationServiceAccount(dest)
+	if impersonate {
+		applyConfig = rest.CopyConfig(restConfig)
+		applyConfig.Impersonate = rest.ImpersonationConfig{UserName: fmt.Sprintf("system:serviceaccount:%s", serviceAccount)}
+		dynamicIf, err = dynamic.NewForConfig(applyConfig)
+		if err != nil {
+			state.Phase = v1alpha1.OperationError
+			state.Message = fmt.Sprintf("Failed to initialize dynamic client of service account %s: %v", serviceAccount, err)
+			return
+		}
+	}
+
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		state.Phase = v1alpha1.OperationError
 		state.Message = fmt.Sprintf("Failed to load resource overrides: %v", err)
 		return
 	}
+	if compareResult.resourceOverrides != nil {
+		// includes the health scripts of the application source
+		resourceOverrides = compareResult.resourceOverrides
+	}
 
 	atomic.AddUint64(&syncIdPrefix, 1)
 	syncId := fmt.Sprintf("%05d-%s", syncIdPrefix, rand.RandString(5))
 	syncCtx := syncContext{
+		ctx:                 ctx,
 		resourceOverrides:   resourceOverrides,
 		appName:             app.Name,
 		proj:                proj,
 		compareResult:       compareResult,
 		config:              restConfig,
 		dynamicIf:           dynamicIf,
+		applyConfig:         applyConfig,
 		disco:               disco,
 		extensionsclientset: extensionsclientset,
 		kubectl:             m.kubectl,
-		namespace:           app.Spec.Destination.Namespace,
-		server:              app.Spec.Destination.Server,
+		namespace:           dest.Namespace,
+		server:              dest.Server,
 		syncOp:              &syncOp,
 		syncRes:             syncRes,
 		syncResources:       syncResources,
 		opState:             state,
 		log:                 log.WithFields(log.Fields{"application": app.Name, "syncId": syncId}),
+		respectIgnoreDifferences: app.Spec.SyncPolicy != nil &&
+			app.Spec.SyncPolicy.SyncOptions.HasOption("RespectIgnoreDifferences=true"),
+		createNamespace: app.Spec.SyncPolicy != nil &&
+			app.Spec.SyncPolicy.SyncOptions.HasOption("CreateNamespace=true"),
+		skipDryRunOnMissingResource: app.Spec.SyncPolicy != nil &&
+			app.Spec.SyncPolicy.SyncOptions.HasOption("SkipDryRunOnMissingResource=true"),
+		blockClusterResources:      blocksClusterResources(app),
+		impersonatedServiceAccount: serviceAccount,
+		liveStateCache:             m.liveStateCache,
+		reportProgress:             reportProgress,
+	}
+	if syncCtx.createNamespace {
+		syncCtx.managedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
 	}
 
 	start := time.Now()
@@ -195,17 +322,51 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	syncCtx.log.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
-	if !syncOp.DryRun && !syncCtx.isSelectiveSync() && syncCtx.opState.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source)
+	// syncs of local manifests are not recorded since their pseudo-revision cannot be rolled back to
+	if !syncOp.DryRun && !syncCtx.isSelectiveSync() && syncCtx.opState.Phase.Successful() && !isLocalManifestsRevision(compareResult.syncStatus.Revision) {
+		entry := v1alpha1.RevisionHistory{
+			Revision:         compareResult.syncStatus.Revision,
+			Source:           source,
+			RevisionMetadata: compareResult.syncStatus.RevisionMetadata,
+			RollbackID:       syncOp.RollbackID,
+			DeployStartedAt:  state.StartedAt.DeepCopy(),
+			InitiatedBy:      state.Operation.InitiatedBy,
+		}
+		if multipleSources {
+			entry.Sources = sources
+			entry.Revisions = compareResult.syncStatus.Revisions
+		}
+		err := m.persistRevisionHistory(app, entry)
 		if err != nil {
 			syncCtx.setOperationPhase(v1alpha1.OperationError, fmt.Sprintf("failed to record sync to history: %v", err))
 		}
 	}
 }
 
+// getRollbackHistory returns the entry of the revision history of the application which should be rolled back to.
+// Only the most recent deployments are kept in the history, so older entries cannot be rolled back to.
+func getRollbackHistory(app *v1alpha1.Application, id int64) (*v1alpha1.RevisionHistory, error) {
+	for i := range app.Status.History {
+		if app.Status.History[i].ID == id {
+			history := app.Status.History[i]
+			if history.Source.IsZero() && len(history.Sources) == 0 {
+				return nil, fmt.Errorf("cannot rollback to history id %d since its source was not recorded", id)
+			}
+			return &history, nil
+		}
+	}
+	return nil, fmt.Errorf("application %s does not have deployment with id %d, only the last %d deployments are kept in the history", app.Name, id, common.RevisionHistoryLimit)
+}
+
 // sync has performs the actual apply or hook based sync
 func (sc *syncContext) sync() {
 	sc.log.WithFields(log.Fields{"isSelectiveSync": sc.isSelectiveSync(), "skipHooks": sc.skipHooks(), "started": sc.started()}).Info("syncing")
+	if !sc.started() {
+		if unmatched := sc.unmatchedSyncResources(); len(unmatched) > 0 {
+			sc.setOperationPhase(v1alpha1.OperationFailed, fmt.Sprintf("selected resources don't match any resource of the application: %s", strings.Join(unmatched, ", ")))
+			return
+		}
+	}
 	tasks, ok := sc.getSyncTasks()
 	if !ok {
 		sc.setOperationPhase(v1alpha1.OperationFailed, "one or more synchronization tasks are not valid")
@@ -214,6 +375,16 @@ func (sc *syncContext) sync() {
 
 	sc.log.WithFields(log.Fields{"tasks": tasks, "isSelectiveSync": sc.isSelectiveSync()}).Info("tasks")
 
+	if sc.syncOp.DryRun {
+		sc.preview(tasks)
+		return
+	}
+
+	sc.tasks = tasks
+	defer func() {
+		sc.opState.Progress = sc.progress()
+	}()
+
 	// Perform a `kubectl apply --dry-run` against all the manifests. This will detect most (but
 	// not all) validation issues with the user's manifests (e.g. will detect syntax issues, but
 	// will not not detect if they are mutating immutable fields). If anything fails, we will refuse
@@ -221,6 +392,9 @@ func (sc *syncContext) sync() {
 	// is harmless, but redundant. The indicator we use to detect if we have already performed
 	// the dry-run for this operation, is if the resource or hook list is empty.
 	if !sc.started() {
+		if sc.interrupted() {
+			return
+		}
 		sc.log.Debug("dry-run")
 		if sc.runTasks(tasks, true) == failed {
 			sc.setOperationPhase(v1alpha1.OperationFailed, "one or more objects failed to apply (dry run)")
@@ -234,6 +408,10 @@ func (sc *syncContext) sync() {
 		return t.running() && t.liveObj != nil
 	}) {
 		if task.isHook() {
+			if task.isStaleHook() {
+				// the live object is still the instance of a previous operation, wait for the new instance
+				continue
+			}
 			// update the hook's result
 			operationState, message := getOperationPhase(task.liveObj)
 			sc.setResourceResult(task, "", operationState, message)
@@ -245,6 +423,9 @@ func (sc *syncContext) sync() {
 					sc.setResourceResult(task, "", v1alpha1.OperationError, fmt.Sprintf("failed to delete resource: %v", err))
 				}
 			}
+		} else if health.IsHealthCheckIgnored(task.liveObj) {
+			// the health of the resource must not block the following waves
+			sc.setResourceResult(task, task.syncStatus, v1alpha1.OperationSucceeded, task.message)
 		} else {
 			// this must be calculated on the live object
 			healthStatus, err := health.GetResourceHealth(task.liveObj, sc.resourceOverrides)
@@ -306,6 +487,9 @@ func (sc *syncContext) sync() {
 	sc.log.WithFields(log.Fields{"phase": phase, "wave": wave, "tasks": tasks, "syncFailTasks": syncFailTasks}).Debug("filtering tasks in correct phase and wave")
 	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
 
+	if sc.interrupted() {
+		return
+	}
 	sc.setOperationPhase(v1alpha1.OperationRunning, "one or more tasks are running")
 
 	sc.log.WithFields(log.Fields{"tasks": tasks}).Debug("wet-run")
@@ -320,6 +504,96 @@ func (sc *syncContext) sync() {
 	}
 }
 
+// preview runs the tasks of all phases and waves using a server-side dry-run and records the results without changing
+// the cluster. Resources which cannot be dry-run are marked as such instead of failing the preview.
+func (sc *syncContext) preview(tasks syncTasks) {
+	// syncFailTasks only run during failure, so they are never previewed
+	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase != v1alpha1.SyncPhaseSyncFail })
+
+	// namespaces which are created by the sync do not exist during the dry-run of the resources in them
+	createdNamespaces := map[string]bool{}
+	for _, task := range tasks {
+		if task.targetObj != nil && task.liveObj == nil && task.group() == "" && task.kind() == kube.NamespaceKind {
+			createdNamespaces[task.name()] = true
+		}
+	}
+
+	previewTask := func(t *syncTask) v1alpha1.ResultCode {
+		sc.log.WithFields(log.Fields{"task": t}).Debug("previewing")
+		var result v1alpha1.ResultCode
+		var message string
+		switch {
+		case t.isPrune():
+			result, message = sc.pruneObject(t.liveObj, t.targetObj, sc.syncOp.Prune, true)
+		case t.skipDryRun && sc.hasCRDOfGroupKind(t.group(), t.kind()):
+			result, message = v1alpha1.ResultCodeDryRunUnsupported, "custom resource definition is created during sync"
+		case t.skipDryRun:
+			result, message = v1alpha1.ResultCodeDryRunUnsupported, fmt.Sprintf("resource kind %s/%s is not registered in the cluster", t.group(), t.kind())
+		case t.liveObj == nil && createdNamespaces[t.namespace()]:
+			result, message = v1alpha1.ResultCodeDryRunUnsupported, fmt.Sprintf("namespace %s is created during sync", t.namespace())
+		default:
+			if targetObj, err := sc.getApplyTarget(t); err != nil {
+				result, message = v1alpha1.ResultCodeSyncFailed, fmt.Sprintf("failed to preserve ignored fields: %v", err)
+			} else {
+				result, message, _ = sc.applyObject(targetObj, kube.DryRunServer, sc.syncOp.SyncStrategy.Force())
+			}
+		}
+		phase := v1alpha1.OperationSucceeded
+		if result == v1alpha1.ResultCodeSyncFailed {
+			phase = v1alpha1.OperationFailed
+		}
+		sc.setResourceResult(t, result, phase, message)
+		return result
+	}
+
+	failed := false
+	// previewTasks previews the tasks concurrently, the results are collected after all of them completed
+	previewTasks := func(tasks syncTasks) {
+		results := make([]v1alpha1.ResultCode, len(tasks))
+		var wg sync.WaitGroup
+		for i := range tasks {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i] = previewTask(tasks[i])
+			}(i)
+		}
+		wg.Wait()
+		for _, result := range results {
+			if result == v1alpha1.ResultCodeSyncFailed {
+				failed = true
+			}
+		}
+	}
+
+	// the waves are previewed in order like they are synced: the prunes of a wave first, then its other tasks grouped
+	// by kind
+	for _, waveTasks := range tasks.groupByWave() {
+		pruneTasks, createTasks := waveTasks.Split(func(t *syncTask) bool { return t.isPrune() })
+		previewTasks(pruneTasks)
+		for _, kindTasks := range createTasks.groupByKind() {
+			previewTasks(kindTasks)
+		}
+	}
+
+	if failed {
+		sc.setOperationPhase(v1alpha1.OperationFailed, "one or more objects failed to apply (preview)")
+	} else {
+		sc.setOperationPhase(v1alpha1.OperationSucceeded, "successfully previewed (all tasks run)")
+	}
+}
+
+// interrupted returns true if the context of the operation is done. The operation remains running then, so that the
+// remaining tasks are run when it is resumed.
+func (sc *syncContext) interrupted() bool {
+	if sc.ctx.Err() == nil {
+		return false
+	}
+	sc.log.Info("Sync interrupted")
+	sc.setOperationPhase(v1alpha1.OperationRunning, "operation interrupted")
+	return true
+}
+
 func (sc *syncContext) setOperationFailed(syncFailTasks syncTasks, message string) {
 	if len(syncFailTasks) > 0 {
 		// if all the failure hooks are completed, don't run them again, and mark the sync as failed
@@ -342,41 +616,82 @@ func (sc *syncContext) started() bool {
 	return len(sc.syncRes.Resources) > 0
 }
 
+// isSelectiveSync returns true unless the selected resources match exactly the resources of the application
 func (sc *syncContext) isSelectiveSync() bool {
 	// we've selected no resources
 	if sc.syncResources == nil {
 		return false
 	}
 
-	// map both lists into string
-	var a []string
+	matched := make([]bool, len(sc.syncResources))
 	for _, r := range sc.compareResult.resources {
-		if !r.Hook {
-			a = append(a, fmt.Sprintf("%s:%s:%s", r.Group, r.Kind, r.Name))
+		if r.Hook {
+			continue
+		}
+		selected := false
+		for i := range sc.syncResources {
+			if sc.syncResources[i].HasIdentity(r.Name, r.Namespace, r.GroupVersionKind()) {
+				matched[i] = true
+				selected = true
+			}
+		}
+		if !selected {
+			return true
 		}
 	}
-	sort.Strings(a)
-
-	var b []string
-	for _, r := range sc.syncResources {
-		b = append(b, fmt.Sprintf("%s:%s:%s", r.Group, r.Kind, r.Name))
+	for i := range matched {
+		if !matched[i] {
+			return true
+		}
 	}
-	sort.Strings(b)
-
-	return !reflect.DeepEqual(a, b)
+	return false
 }
 
 // this essentially enforces the old "apply" behaviour
 func (sc *syncContext) skipHooks() bool {
 	// All objects passed a `kubectl apply --dry-run`, so we are now ready to actually perform the sync.
 	// default sync strategy to hook if no strategy
-	return sc.syncOp.IsApplyStrategy() || sc.isSelectiveSync()
+	return sc.syncOp.IsApplyStrategy() || sc.isSelectiveSync() && !sc.syncOp.IncludeHooks
 }
 
 func (sc *syncContext) containsResource(resourceState managedResource) bool {
-	return !sc.isSelectiveSync() ||
-		(resourceState.Live != nil && argo.ContainsSyncResource(resourceState.Live.GetName(), resourceState.Live.GroupVersionKind(), sc.syncResources)) ||
-		(resourceState.Target != nil && argo.ContainsSyncResource(resourceState.Target.GetName(), resourceState.Target.GroupVersionKind(), sc.syncResources))
+	return !sc.isSelectiveSync() || sc.isSelected(resourceState.Live) || sc.isSelected(resourceState.Target)
+}
+
+// isSelected returns true if the given object matches one of the selected resources
+func (sc *syncContext) isSelected(obj *unstructured.Unstructured) bool {
+	return obj != nil && argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), obj.GroupVersionKind(), sc.syncResources)
+}
+
+// unmatchedSyncResources returns the selected resources which match neither a resource nor a hook of the application
+func (sc *syncContext) unmatchedSyncResources() []string {
+	objs := append([]*unstructured.Unstructured{}, sc.compareResult.hooks...)
+	for _, res := range sc.compareResult.managedResources {
+		for _, obj := range []*unstructured.Unstructured{res.Live, res.Target} {
+			if obj != nil {
+				objs = append(objs, obj)
+			}
+		}
+	}
+	var unmatched []string
+	for _, r := range sc.syncResources {
+		selector := []v1alpha1.SyncOperationResource{r}
+		matched := false
+		for _, obj := range objs {
+			if argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), obj.GroupVersionKind(), selector) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			name := r.Name
+			if r.Namespace != "" {
+				name = r.Namespace + "/" + name
+			}
+			unmatched = append(unmatched, fmt.Sprintf("%s:%s:%s", r.Group, r.Kind, name))
+		}
+	}
+	return unmatched
 }
 
 // generates the list of sync tasks we will be performing during this sync.
@@ -408,8 +723,16 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 	sc.log.WithFields(log.Fields{"resourceTasks": resourceTasks}).Debug("tasks from managed resources")
 
 	hookTasks := syncTasks{}
-	if !sc.skipHooks() {
+	if !sc.syncOp.IsApplyStrategy() {
+		// the hooks are labeled with the run id of the operation, so the live instances of the hooks which were created
+		// by previous operations are recognized
+		hookRunID := strconv.FormatInt(sc.opState.StartedAt.UTC().Unix(), 10)
+		skipHooks := sc.skipHooks()
 		for _, obj := range sc.compareResult.hooks {
+			// during a selective sync only the explicitly selected hooks are run, unless hooks are included
+			if skipHooks && !sc.isSelected(obj) {
+				continue
+			}
 			for _, phase := range syncPhases(obj) {
 				// Hook resources names are deterministic, whether they are defined by the user (metadata.name),
 				// or formulated at the time of the operation (metadata.generateName). If user specifies
@@ -420,8 +743,14 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 					generateName := obj.GetGenerateName()
 					targetObj.SetName(fmt.Sprintf("%s%s", generateName, postfix))
 				}
+				labels := targetObj.GetLabels()
+				if labels == nil {
+					labels = map[string]string{}
+				}
+				labels[common.LabelKeyHookRunID] = hookRunID
+				targetObj.SetLabels(labels)
 
-				hookTasks = append(hookTasks, &syncTask{phase: phase, targetObj: targetObj})
+				hookTasks = append(hookTasks, &syncTask{phase: phase, targetObj: targetObj, hookRunID: hookRunID})
 			}
 		}
 	}
@@ -431,6 +760,20 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 	tasks := resourceTasks
 	tasks = append(tasks, hookTasks...)
 
+	if sc.createNamespace {
+		namespaceTask, err := sc.getNamespaceTask()
+		if err != nil {
+			sc.log.Warnf("failed to get destination namespace %s: %v", sc.namespace, err)
+			successful = false
+		}
+		if namespaceTask != nil {
+			tasks = append(tasks, namespaceTask)
+			if err != nil {
+				sc.setResourceResult(namespaceTask, v1alpha1.ResultCodeSyncFailed, "", fmt.Sprintf("failed to get namespace: %v", err))
+			}
+		}
+	}
+
 	// enrich target objects with the namespace
 	for _, task := range tasks {
 		if task.targetObj == nil {
@@ -465,14 +808,16 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
-	// check permissions
+	// check permissions, the resources which are denied by the project are excluded from the sync
+	blocked := map[*syncTask]bool{}
 	for _, task := range tasks {
 		serverRes, err := kube.ServerResourceForGroupVersionKind(sc.disco, task.groupVersionKind())
 		if err != nil {
 			// Special case for custom resources: if CRD is not yet known by the K8s API server,
 			// skip verification during `kubectl apply --dry-run` since we expect the CRD
-			// to be created during app synchronization.
-			if apierr.IsNotFound(err) && sc.hasCRDOfGroupKind(task.group(), task.kind()) {
+			// to be created during app synchronization, or the user expects it to be created by
+			// another application or an operator before the resource is applied.
+			if apierr.IsNotFound(err) && (sc.hasCRDOfGroupKind(task.group(), task.kind()) || sc.canSkipDryRunOnMissingResource(task)) {
 				sc.log.WithFields(log.Fields{"task": task}).Debug("skip dry-run for custom resource")
 				task.skipDryRun = true
 			} else {
@@ -480,9 +825,15 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 				successful = false
 			}
 		} else {
-			if !sc.proj.IsResourcePermitted(metav1.GroupKind{Group: task.group(), Kind: task.kind()}, serverRes.Namespaced) {
-				sc.setResourceResult(task, v1alpha1.ResultCodeSyncFailed, "", fmt.Sprintf("Resource %s:%s is not permitted in project %s.", task.group(), task.kind(), sc.proj.Name))
-				successful = false
+			if rule, denied := sc.proj.GetResourceDenyingRule(metav1.GroupKind{Group: task.group(), Kind: task.kind()}, serverRes.Namespaced); denied {
+				blocked[task] = true
+				if !serverRes.Namespaced && sc.blockClusterResources {
+					// the resource is reported by the ClusterResourceWarning condition of the application instead
+					sc.log.WithFields(log.Fields{"task": task}).Debugf("excluding cluster-scoped resource denied by project %s rule %s", sc.proj.Name, rule)
+					continue
+				}
+				sc.setResourceResult(task, v1alpha1.ResultCodeBlocked, "", fmt.Sprintf("blocked by project %s rule %s", sc.proj.Name, rule))
+				continue
 			}
 			if serverRes.Namespaced && !sc.proj.IsDestinationPermitted(v1alpha1.ApplicationDestination{Namespace: task.namespace(), Server: sc.server}) {
 				sc.setResourceResult(task, v1alpha1.ResultCodeSyncFailed, "", fmt.Sprintf("namespace %v is not permitted in project '%s'", task.namespace(), sc.proj.Name))
@@ -490,12 +841,50 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 			}
 		}
 	}
+	tasks = tasks.Filter(func(t *syncTask) bool { return !blocked[t] })
 
 	sort.Sort(tasks)
 
 	return tasks, successful
 }
 
+// getNamespaceTask returns the task which creates the destination namespace and applies the managed namespace
+// metadata. Returns nil if the namespace is managed by the application or already exists without managed metadata.
+// The namespace is not labeled with the application instance label, so it is never pruned.
+func (sc *syncContext) getNamespaceTask() (*syncTask, error) {
+	if sc.namespace == "" {
+		return nil, nil
+	}
+	for _, resource := range sc.compareResult.managedResources {
+		if resource.Group == "" && resource.Kind == kube.NamespaceKind && resource.Name == sc.namespace {
+			return nil, nil
+		}
+	}
+	targetObj := &unstructured.Unstructured{}
+	targetObj.SetAPIVersion("v1")
+	targetObj.SetKind(kube.NamespaceKind)
+	targetObj.SetName(sc.namespace)
+	if sc.managedNamespaceMetadata != nil {
+		targetObj.SetLabels(sc.managedNamespaceMetadata.Labels)
+		targetObj.SetAnnotations(sc.managedNamespaceMetadata.Annotations)
+	}
+	task := &syncTask{phase: v1alpha1.SyncPhasePreSync, targetObj: targetObj}
+
+	liveObj, err := sc.kubectl.GetResource(sc.config, targetObj.GroupVersionKind(), sc.namespace, "")
+	if err != nil && !apierr.IsNotFound(err) {
+		return task, err
+	}
+	if liveObj != nil && err == nil {
+		// keep the task of a namespace which was created earlier in this operation, so that its result is updated
+		_, result := sc.syncRes.Resources.Find("", kube.NamespaceKind, sc.namespace, sc.namespace, task.phase)
+		if sc.managedNamespaceMetadata == nil && result == nil {
+			return nil, nil
+		}
+		task.liveObj = liveObj
+	}
+	return task, nil
+}
+
 func obj(a, b *unstructured.Unstructured) *unstructured.Unstructured {
 	if a != nil {
 		return a
@@ -541,25 +930,97 @@ func (sc *syncContext) ensureCRDReady(name string) {
 	})
 }
 
-// applyObject performs a `kubectl apply` of a single resource
-func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun bool, force bool) (v1alpha1.ResultCode, string) {
+// getApplyTarget returns the object which should be applied for the given task. Annotations which were added to
+// the live object out-of-band and are configured to be passed through are preserved. If the RespectIgnoreDifferences
+// sync option is set, fields with ignored differences keep their live values.
+func (sc *syncContext) getApplyTarget(t *syncTask) (*unstructured.Unstructured, error) {
+	if t.isHook() || t.liveObj == nil {
+		return t.targetObj, nil
+	}
+	targetObj := t.targetObj
+	if sc.respectIgnoreDifferences {
+		var err error
+		targetObj, err = argo.PreserveIgnoredFields(targetObj, t.liveObj, sc.compareResult.diffNormalizer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if sc.compareResult.passthroughAnnotations != nil {
+		targetObj = targetObj.DeepCopy()
+		sc.compareResult.passthroughAnnotations.Preserve(targetObj, t.liveObj)
+	}
+	return targetObj, nil
+}
+
+// applyObject performs a `kubectl apply` of a single resource. The error of a failed apply is returned as well.
+func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRunStrategy kube.DryRunStrategy, force bool) (v1alpha1.ResultCode, string, error) {
 	validate := !resource.HasAnnotationOption(targetObj, common.AnnotationSyncOptions, "Validate=false")
-	message, err := sc.kubectl.ApplyResource(sc.config, targetObj, targetObj.GetNamespace(), dryRun, force, validate)
+	config := sc.applyConfig
+	if dryRunStrategy != kube.DryRunNone {
+		config = sc.config
+	}
+	message, err := sc.kubectl.ApplyResource(sc.ctx, config, targetObj, targetObj.GetNamespace(), dryRunStrategy, force, validate)
+	for attempt := 0; err != nil && attempt < applyRetries && sc.ctx.Err() == nil; attempt++ {
+		kubectlErr, ok := err.(*kube.KubectlError)
+		if !ok || !kubectlErr.IsRetryable() {
+			break
+		}
+		sc.log.Infof("Retrying apply of %s/%s after %s error: %v", targetObj.GetKind(), targetObj.GetName(), kubectlErr.Type, err)
+		time.Sleep(applyRetryDelay)
+		message, err = sc.kubectl.ApplyResource(sc.ctx, config, targetObj, targetObj.GetNamespace(), dryRunStrategy, force, validate)
+	}
 	if err != nil {
-		return v1alpha1.ResultCodeSyncFailed, err.Error()
+		if dryRunStrategy == kube.DryRunServer && kube.IsDryRunUnsupportedError(err) {
+			return v1alpha1.ResultCodeDryRunUnsupported, err.Error(), err
+		}
+		message = err.Error()
+		if kube.GetKubectlErrorType(err) == kube.ErrImmutableField && !force {
+			message += ". Sync with the Force option to delete and re-create the resource"
+		}
+		return v1alpha1.ResultCodeSyncFailed, sc.withImpersonationInfo(message, config, err), err
 	}
-	if kube.IsCRD(targetObj) && !dryRun {
+	if kube.IsCRD(targetObj) && dryRunStrategy == kube.DryRunNone {
 		sc.ensureCRDReady(targetObj.GetName())
+		if sc.liveStateCache != nil {
+			if err := sc.liveStateCache.RefreshAPIResources(sc.server); err != nil {
+				sc.log.Warnf("Failed to refresh API resources after applying CRD %s: %v", targetObj.GetName(), err)
+			}
+		}
+	}
+	return v1alpha1.ResultCodeSynced, message, nil
+}
+
+// canRetryHookOnConflict returns true if the hook of the task could not be applied because of an existing object with
+// the same name, and the hook is deleted and re-created in this case
+func canRetryHookOnConflict(task *syncTask, err error) bool {
+	if !task.hasHookDeletePolicy(v1alpha1.HookDeletePolicyHookRetryOnConflict) {
+		return false
 	}
-	return v1alpha1.ResultCodeSynced, message
+	switch kube.GetKubectlErrorType(err) {
+	case kube.ErrAlreadyExists, kube.ErrConflict, kube.ErrImmutableField:
+		return true
+	}
+	return false
 }
 
-// pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
-func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dryRun bool) (v1alpha1.ResultCode, string) {
-	if !prune {
+// isPruneProtected returns true if pruning of a resource is disabled by the sync options annotation of its live or
+// target object
+func isPruneProtected(liveObj, targetObj *unstructured.Unstructured) bool {
+	for _, obj := range []*unstructured.Unstructured{liveObj, targetObj} {
+		if obj != nil && resource.HasAnnotationOption(obj, common.AnnotationSyncOptions, "Prune=false") {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneObject deletes the object if both prune is true and dryRun is false, unless the resource is protected from
+// pruning. Otherwise appropriate message
+func (sc *syncContext) pruneObject(liveObj, targetObj *unstructured.Unstructured, prune, dryRun bool) (v1alpha1.ResultCode, string) {
+	if isPruneProtected(liveObj, targetObj) {
+		return v1alpha1.ResultCodePruneSkipped, "skipped (prune disabled)"
+	} else if !prune {
 		return v1alpha1.ResultCodePruneSkipped, "ignored (requires pruning)"
-	} else if resource.HasAnnotationOption(liveObj, common.AnnotationSyncOptions, "Prune=false") {
-		return v1alpha1.ResultCodePruneSkipped, "ignored (no prune)"
 	} else {
 		if dryRun {
 			return v1alpha1.ResultCodePruned, "pruned (dry run)"
@@ -567,9 +1028,9 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 			// Skip deletion if object is already marked for deletion, so we don't cause a resource update hotloop
 			deletionTimestamp := liveObj.GetDeletionTimestamp()
 			if deletionTimestamp == nil || deletionTimestamp.IsZero() {
-				err := sc.kubectl.DeleteResource(sc.config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), false)
+				err := sc.kubectl.DeleteResource(sc.applyConfig, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), false)
 				if err != nil {
-					return v1alpha1.ResultCodeSyncFailed, err.Error()
+					return v1alpha1.ResultCodeSyncFailed, sc.withImpersonationInfo(err.Error(), sc.applyConfig, err)
 				}
 			}
 			return v1alpha1.ResultCodePruned, "pruned"
@@ -577,6 +1038,24 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 	}
 }
 
+// withImpersonationInfo names the impersonated service account in the message of an error caused by missing permissions
+func (sc *syncContext) withImpersonationInfo(message string, config *rest.Config, err error) string {
+	if sc.impersonatedServiceAccount == "" || config != sc.applyConfig {
+		return message
+	}
+	if apierr.IsForbidden(err) || kube.GetKubectlErrorType(err) == kube.ErrForbidden {
+		return fmt.Sprintf("%s (service account %s of project '%s' lacks permissions)", message, sc.impersonatedServiceAccount, sc.proj.Name)
+	}
+	return message
+}
+
+// canSkipDryRunOnMissingResource returns true if the dry-run of the resource of the task can be skipped if its kind is
+// not registered in the cluster, either because of the application sync option or the sync options annotation
+func (sc *syncContext) canSkipDryRunOnMissingResource(task *syncTask) bool {
+	return sc.skipDryRunOnMissingResource ||
+		task.targetObj != nil && resource.HasAnnotationOption(task.targetObj, common.AnnotationSyncOptions, "SkipDryRunOnMissingResource=true")
+}
+
 func (sc *syncContext) hasCRDOfGroupKind(group string, kind string) bool {
 	for _, obj := range sc.compareResult.targetObjs() {
 		if kube.IsCRD(obj) {
@@ -629,7 +1108,11 @@ func (sc *syncContext) deleteResource(task *syncTask) error {
 		return err
 	}
 	propagationPolicy := metav1.DeletePropagationForeground
-	return resIf.Delete(task.name(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	err = resIf.Delete(task.name(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	if apierr.IsForbidden(err) && sc.impersonatedServiceAccount != "" {
+		return fmt.Errorf("%s", sc.withImpersonationInfo(err.Error(), sc.applyConfig, err))
+	}
+	return err
 }
 
 func (sc *syncContext) getResourceIf(task *syncTask) (dynamic.ResourceInterface, error) {
@@ -683,7 +1166,7 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 			go func(t *syncTask) {
 				defer wg.Done()
 				sc.log.WithFields(log.Fields{"dryRun": dryRun, "task": t}).Debug("pruning")
-				result, message := sc.pruneObject(t.liveObj, sc.syncOp.Prune, dryRun)
+				result, message := sc.pruneObject(t.liveObj, t.targetObj, sc.syncOp.Prune, dryRun)
 				if result == v1alpha1.ResultCodeSyncFailed {
 					runState = failed
 				}
@@ -734,7 +1217,30 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 				go func(t *syncTask) {
 					defer createWg.Done()
 					sc.log.WithFields(log.Fields{"dryRun": dryRun, "task": t}).Debug("applying")
-					result, message := sc.applyObject(t.targetObj, dryRun, sc.syncOp.SyncStrategy.Force())
+					result, message := v1alpha1.ResultCodeSyncFailed, ""
+					var applyErr error
+					if targetObj, err := sc.getApplyTarget(t); err != nil {
+						message = fmt.Sprintf("failed to preserve ignored fields: %v", err)
+					} else {
+						dryRunStrategy := kube.DryRunNone
+						if dryRun {
+							dryRunStrategy = kube.DryRunClient
+						}
+						result, message, applyErr = sc.applyObject(targetObj, dryRunStrategy, sc.syncOp.SyncStrategy.Force())
+					}
+					if !dryRun && result == v1alpha1.ResultCodeSyncFailed && canRetryHookOnConflict(t, applyErr) {
+						// delete the conflicting object and wait for the sync to be invoked again to re-create the hook
+						sc.log.WithFields(log.Fields{"task": t}).Infof("deleting conflicting object of hook: %v", applyErr)
+						err := sc.deleteResource(t)
+						if err == nil || apierr.IsNotFound(err) {
+							sc.setResourceResult(t, "", "", fmt.Sprintf("re-creating hook after conflict: %s", message))
+							if runState != failed {
+								runState = pending
+							}
+							return
+						}
+						message = fmt.Sprintf("%s, failed to delete conflicting object: %v", message, err)
+					}
 					if result == v1alpha1.ResultCodeSyncFailed {
 						runState = failed
 					}
@@ -746,23 +1252,75 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 			createWg.Wait()
 		}
 
-		var tasksGroup syncTasks
-		for _, task := range createTasks {
-			//Only wait if the type of the next task is different than the previous type
-			if len(tasksGroup) > 0 && tasksGroup[0].targetObj.GetKind() != task.kind() {
-				processCreateTasks(tasksGroup)
-				tasksGroup = syncTasks{task}
-			} else {
-				tasksGroup = append(tasksGroup, task)
+		// only wait if the kind of the next task is different than the previous kind
+		for i, tasksGroup := range createTasks.groupByKind() {
+			// a termination request is observed between the groups, the operation stays running until it is resumed
+			// in the terminating phase
+			if !dryRun && i > 0 && sc.terminationRequested() {
+				sc.log.Info("termination requested, skipping remaining tasks")
+				if runState == successful {
+					runState = pending
+				}
+				break
 			}
-		}
-		if len(tasksGroup) > 0 {
 			processCreateTasks(tasksGroup)
 		}
 	}
 	return runState
 }
 
+// progress calculates the progress of the operation from the state of its tasks
+func (sc *syncContext) progress() *v1alpha1.OperationProgress {
+	progress := &v1alpha1.OperationProgress{}
+	var current, waitingOn, last *syncTask
+	for _, task := range sc.tasks {
+		if task.phase == v1alpha1.SyncPhaseSyncFail && task.pending() {
+			// sync fail tasks only run if the sync fails
+			continue
+		}
+		last = task
+		switch {
+		case task.pending():
+			progress.Pending++
+			if current == nil {
+				current = task
+			}
+		case task.running():
+			progress.Running++
+			if waitingOn == nil {
+				waitingOn = task
+			}
+		case task.successful():
+			progress.Succeeded++
+		default:
+			progress.Failed++
+		}
+	}
+	if waitingOn != nil {
+		current = waitingOn
+		if !sc.opState.Phase.Completed() {
+			progress.WaitingOn = fmt.Sprintf("%s/%s", waitingOn.kind(), waitingOn.name())
+		}
+	}
+	if current == nil {
+		current = last
+	}
+	if current != nil {
+		progress.Phase = current.phase
+		progress.Wave = int64(current.wave())
+	}
+	if !sc.opState.StartedAt.IsZero() {
+		progress.ElapsedSeconds = int64(time.Since(sc.opState.StartedAt.Time).Seconds())
+	}
+	return progress
+}
+
+// terminationRequested reports the progress of the operation and returns true if its termination has been requested
+func (sc *syncContext) terminationRequested() bool {
+	sc.opState.Progress = sc.progress()
+	return sc.reportProgress != nil && sc.reportProgress(sc.opState.Progress)
+}
+
 // setResourceResult sets a resource details in the SyncResult.Resources list
 func (sc *syncContext) setResourceResult(task *syncTask, syncStatus v1alpha1.ResultCode, operationState v1alpha1.OperationPhase, message string) {
 
@@ -788,6 +1346,7 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus v1alpha1.Res
 		HookType:  task.hookType(),
 		HookPhase: task.operationState,
 		SyncPhase: task.phase,
+		Previewed: sc.syncOp.DryRun,
 	}
 
 	logCtx := sc.log.WithFields(log.Fields{"namespace": task.namespace(), "kind": task.kind(), "name": task.name(), "phase": task.phase})
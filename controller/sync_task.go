@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/hook"
 	"github.com/argoproj/argo-cd/util/resource/syncwaves"
@@ -22,6 +23,8 @@ type syncTask struct {
 	syncStatus     v1alpha1.ResultCode
 	operationState v1alpha1.OperationPhase
 	message        string
+	// hookRunID identifies the operation which runs the hook, it is empty for resources
+	hookRunID string
 }
 
 func ternary(val bool, a, b string) string {
@@ -123,8 +126,19 @@ func (t *syncTask) hasHookDeletePolicy(policy v1alpha1.HookDeletePolicy) bool {
 	return false
 }
 
+// isStaleHook returns true if the live object of the hook is the instance of a previous operation, or an instance which
+// is being deleted. Its status is never the result of the current operation.
+func (t *syncTask) isStaleHook() bool {
+	if t.liveObj == nil || t.hookRunID == "" {
+		return false
+	}
+	return t.liveObj.GetLabels()[common.LabelKeyHookRunID] != t.hookRunID || t.liveObj.GetDeletionTimestamp() != nil
+}
+
+// needsDeleting returns true if the live object has to be deleted. Hooks which exist from a previous operation are
+// always deleted before they are created again.
 func (t *syncTask) needsDeleting() bool {
-	return t.liveObj != nil && (t.pending() && t.hasHookDeletePolicy(v1alpha1.HookDeletePolicyBeforeHookCreation) ||
+	return t.liveObj != nil && (t.pending() && (t.hasHookDeletePolicy(v1alpha1.HookDeletePolicyBeforeHookCreation) || t.isStaleHook()) ||
 		t.successful() && t.hasHookDeletePolicy(v1alpha1.HookDeletePolicyHookSucceeded) ||
 		t.failed() && t.hasHookDeletePolicy(v1alpha1.HookDeletePolicyHookFailed))
 }
@@ -166,6 +166,33 @@ func (s syncTasks) wave() int {
 	return 0
 }
 
+// groupByWave splits the sorted tasks into the groups of consecutive tasks of the same phase and wave
+func (s syncTasks) groupByWave() []syncTasks {
+	var groups []syncTasks
+	for _, task := range s {
+		if len(groups) > 0 && groups[len(groups)-1].phase() == task.phase && groups[len(groups)-1].wave() == task.wave() {
+			groups[len(groups)-1] = append(groups[len(groups)-1], task)
+		} else {
+			groups = append(groups, syncTasks{task})
+		}
+	}
+	return groups
+}
+
+// groupByKind splits the sorted tasks into the groups of consecutive tasks of the same kind, which are applied
+// concurrently
+func (s syncTasks) groupByKind() []syncTasks {
+	var groups []syncTasks
+	for _, task := range s {
+		if len(groups) > 0 && groups[len(groups)-1][0].kind() == task.kind() {
+			groups[len(groups)-1] = append(groups[len(groups)-1], task)
+		} else {
+			groups = append(groups, syncTasks{task})
+		}
+	}
+	return groups
+}
+
 func (s syncTasks) lastPhase() v1alpha1.SyncPhase {
 	if len(s) > 0 {
 		return s[len(s)-1].phase
@@ -390,3 +390,15 @@ func Test_syncTasks_multiStep(t *testing.T) {
 		assert.True(t, tasks.multiStep())
 	})
 }
+
+func Test_syncTasks_groupByWave(t *testing.T) {
+	preSync := &syncTask{targetObj: NewPod(), phase: SyncPhasePreSync}
+	wave0Pod := &syncTask{targetObj: NewPod(), phase: SyncPhaseSync}
+	wave0Svc := &syncTask{targetObj: NewService(), phase: SyncPhaseSync}
+	wave1 := &syncTask{targetObj: Annotate(NewPod(), common.AnnotationSyncWave, "1"), phase: SyncPhaseSync}
+	tasks := syncTasks{preSync, wave0Pod, wave0Svc, wave1}
+
+	assert.Equal(t, []syncTasks{{preSync}, {wave0Pod, wave0Svc}, {wave1}}, tasks.groupByWave())
+	assert.Equal(t, []syncTasks{{preSync, wave0Pod}, {wave0Svc}, {wave1}}, tasks.groupByKind())
+	assert.Empty(t, syncTasks{}.groupByWave())
+}
@@ -1,17 +1,23 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	fakedisco "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/rest"
@@ -20,8 +26,10 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	. "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
 )
@@ -42,10 +50,13 @@ func newTestSyncCtx(resources ...*v1.APIResourceList) *syncContext {
 				{Kind: "Deployment", Group: "apps", Version: "v1", Namespaced: true},
 			},
 		})
+	config := &rest.Config{}
 	sc := syncContext{
-		config:    &rest.Config{},
-		namespace: test.FakeArgoCDNamespace,
-		server:    test.FakeClusterURL,
+		ctx:         context.Background(),
+		config:      config,
+		applyConfig: config,
+		namespace:   test.FakeArgoCDNamespace,
+		server:      test.FakeClusterURL,
 		syncRes: &v1alpha1.SyncOperationResult{
 			Revision: "FooBarBaz",
 		},
@@ -161,10 +172,45 @@ func TestSyncCreateNotWhitelistedClusterResources(t *testing.T) {
 		}},
 	}
 	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
 	assert.Len(t, syncCtx.syncRes.Resources, 1)
 	result := syncCtx.syncRes.Resources[0]
-	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, result.Status)
-	assert.Contains(t, result.Message, "not permitted in project")
+	assert.Equal(t, v1alpha1.ResultCodeBlocked, result.Status)
+	assert.Equal(t, "blocked by project test rule clusterResourceWhitelist", result.Message)
+}
+
+func TestSyncExcludesNotWhitelistedClusterResources(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: v1alpha1.SchemeGroupVersion.String(),
+		APIResources: []v1.APIResource{
+			{Name: "workflows", Namespaced: false, Kind: "Workflow", Group: "argoproj.io"},
+			{Name: "application", Namespaced: false, Kind: "Application", Group: "argoproj.io"},
+		},
+	}, &v1.APIResourceList{
+		GroupVersion: "rbac.authorization.k8s.io/v1",
+		APIResources: []v1.APIResource{
+			{Name: "clusterroles", Namespaced: false, Kind: "ClusterRole", Group: "rbac.authorization.k8s.io"},
+		},
+	})
+
+	syncCtx.proj.Spec.ClusterResourceWhitelist = []v1.GroupKind{
+		{Group: "argoproj.io", Kind: "*"},
+	}
+
+	syncCtx.blockClusterResources = true
+	syncCtx.kubectl = &kubetest.MockKubectlCmd{}
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live: nil,
+			Target: kube.MustToUnstructured(&rbacv1.ClusterRole{
+				TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "argo-ui-cluster-role"}}),
+		}},
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	// the resource is excluded from the operation without a result
+	assert.Empty(t, syncCtx.syncRes.Resources)
 }
 
 func TestSyncBlacklistedNamespacedResources(t *testing.T) {
@@ -181,10 +227,43 @@ func TestSyncBlacklistedNamespacedResources(t *testing.T) {
 		}},
 	}
 	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
 	assert.Len(t, syncCtx.syncRes.Resources, 1)
 	result := syncCtx.syncRes.Resources[0]
-	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, result.Status)
-	assert.Contains(t, result.Message, "not permitted in project")
+	assert.Equal(t, v1alpha1.ResultCodeBlocked, result.Status)
+	assert.Equal(t, "blocked by project test rule namespaceResourceBlacklist */Deployment", result.Message)
+}
+
+func TestSyncExcludesBlockedResources(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+
+	syncCtx.proj.Spec.NamespaceResourceBlacklist = []v1.GroupKind{
+		{Group: "*", Kind: "Deployment"},
+	}
+
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: test.NewDeployment(),
+		}, {
+			Live:   nil,
+			Target: test.NewService(),
+		}},
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	for _, result := range syncCtx.syncRes.Resources {
+		switch result.Kind {
+		case "Deployment":
+			assert.Equal(t, v1alpha1.ResultCodeBlocked, result.Status)
+			assert.Contains(t, result.Message, "blocked by project test")
+		case "Service":
+			assert.Equal(t, v1alpha1.ResultCodeSynced, result.Status)
+		default:
+			t.Errorf("unexpected resource %s", result.Kind)
+		}
+	}
 }
 
 func TestSyncSuccessfully(t *testing.T) {
@@ -272,6 +351,145 @@ func TestSyncCreateFailure(t *testing.T) {
 	assert.Equal(t, "foo", result.Message)
 }
 
+// failingKubectl fails the applies with the given errors before applying successfully
+type failingKubectl struct {
+	kubetest.MockKubectlCmd
+	errs    []error
+	applies int
+}
+
+func (k *failingKubectl) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy kube.DryRunStrategy, force, validate bool) (string, error) {
+	k.applies++
+	if len(k.errs) > 0 {
+		err := k.errs[0]
+		k.errs = k.errs[1:]
+		return "", err
+	}
+	return "applied", nil
+}
+
+func TestSyncRetryTransientFailure(t *testing.T) {
+	defer func(delay time.Duration) { applyRetryDelay = delay }(applyRetryDelay)
+	applyRetryDelay = 0
+
+	syncCtx := newTestSyncCtx()
+	testSvc := test.NewService()
+	kubectl := &failingKubectl{errs: []error{
+		kube.ParseKubectlError("exit status 1", "Unable to connect to the server: net/http: TLS handshake timeout"),
+		kube.ParseKubectlError("exit status 1", `Operation cannot be fulfilled on services "my-service": the object has been modified; please apply your changes to the latest version and try again`),
+	}}
+	syncCtx.kubectl = kubectl
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: testSvc,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	result := syncCtx.syncRes.Resources[0]
+	assert.Equal(t, v1alpha1.ResultCodeSynced, result.Status)
+	// two failed and one successful dry-run, then the actual apply
+	assert.Equal(t, 4, kubectl.applies)
+}
+
+func TestSyncImmutableFieldFailure(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	testSvc := test.NewService()
+	immutableErr := kube.ParseKubectlError("exit status 1", `The Service "my-service" is invalid: spec.clusterIP: Invalid value: "10.96.0.12": field is immutable`)
+	kubectl := &failingKubectl{errs: []error{immutableErr, immutableErr, immutableErr}}
+	syncCtx.kubectl = kubectl
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: testSvc,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	result := syncCtx.syncRes.Resources[0]
+	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, result.Status)
+	assert.Equal(t, immutableErr.Error()+". Sync with the Force option to delete and re-create the resource", result.Message)
+	// immutable field errors are not retried
+	assert.Equal(t, 1, kubectl.applies)
+}
+
+// impersonatingKubectl records the impersonated user of each apply and fails the applies of impersonated users with err
+type impersonatingKubectl struct {
+	kubetest.MockKubectlCmd
+	users []string
+	err   error
+}
+
+func (k *impersonatingKubectl) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy kube.DryRunStrategy, force, validate bool) (string, error) {
+	k.users = append(k.users, config.Impersonate.UserName)
+	if config.Impersonate.UserName != "" && k.err != nil {
+		return "", k.err
+	}
+	return "applied", nil
+}
+
+func newImpersonatingSyncCtx(kubectl kube.Kubectl) *syncContext {
+	syncCtx := newTestSyncCtx()
+	syncCtx.kubectl = kubectl
+	syncCtx.applyConfig = rest.CopyConfig(syncCtx.config)
+	syncCtx.applyConfig.Impersonate.UserName = "system:serviceaccount:team-a:deployer"
+	syncCtx.impersonatedServiceAccount = "team-a:deployer"
+	return syncCtx
+}
+
+func TestSyncImpersonatesDestinationServiceAccount(t *testing.T) {
+	kubectl := &impersonatingKubectl{}
+	syncCtx := newImpersonatingSyncCtx(kubectl)
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: test.NewService(),
+		}},
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	// the dry-run uses the cluster credentials
+	assert.Equal(t, []string{"", "system:serviceaccount:team-a:deployer"}, kubectl.users)
+}
+
+func TestSyncImpersonatedServiceAccountForbidden(t *testing.T) {
+	forbiddenErr := kube.ParseKubectlError("exit status 1", `Error from server (Forbidden): error when creating "STDIN": services is forbidden: User "system:serviceaccount:team-a:deployer" cannot create resource "services" in API group "" in the namespace "argocd-e2e"`)
+	syncCtx := newImpersonatingSyncCtx(&impersonatingKubectl{err: forbiddenErr})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   nil,
+			Target: test.NewService(),
+		}},
+	}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, forbiddenErr.Error()+" (service account team-a:deployer of project 'test' lacks permissions)", syncCtx.syncRes.Resources[0].Message)
+}
+
+func TestPruneImpersonatedServiceAccountForbidden(t *testing.T) {
+	forbiddenErr := apierr.NewForbidden(schema.GroupResource{Resource: "services"}, "test-service", fmt.Errorf("no permission"))
+	syncCtx := newImpersonatingSyncCtx(&kubetest.MockKubectlCmd{
+		Commands: map[string]kubetest.KubectlOutput{
+			"test-service": {Err: forbiddenErr},
+		},
+	})
+	testSvc := test.NewService()
+	testSvc.SetName("test-service")
+	testSvc.SetNamespace(test.FakeArgoCDNamespace)
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{
+			Live:   testSvc,
+			Target: nil,
+		}},
+	}
+	syncCtx.sync()
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, syncCtx.syncRes.Resources[0].Status)
+	assert.Equal(t, forbiddenErr.Error()+" (service account team-a:deployer of project 'test' lacks permissions)", syncCtx.syncRes.Resources[0].Message)
+}
+
 func TestSyncPruneFailure(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	syncCtx.kubectl = &kubetest.MockKubectlCmd{
@@ -331,13 +549,61 @@ func TestDontPrunePruneFalse(t *testing.T) {
 	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
 	assert.Len(t, syncCtx.syncRes.Resources, 1)
 	assert.Equal(t, v1alpha1.ResultCodePruneSkipped, syncCtx.syncRes.Resources[0].Status)
-	assert.Equal(t, "ignored (no prune)", syncCtx.syncRes.Resources[0].Message)
+	assert.Equal(t, "skipped (prune disabled)", syncCtx.syncRes.Resources[0].Message)
 
 	syncCtx.sync()
 
 	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
 }
 
+func TestDontPrunePruneProtected(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		prune       bool
+		dryRun      bool
+	}{{
+		name:        "Prune",
+		annotations: map[string]string{common.AnnotationSyncOptions: "Prune=false"},
+		prune:       true,
+	}, {
+		name:        "NoPrune",
+		annotations: map[string]string{common.AnnotationSyncOptions: "Prune=false"},
+	}, {
+		name:        "IgnoreExtraneous",
+		annotations: map[string]string{common.AnnotationSyncOptions: "Prune=false", common.AnnotationCompareOptions: "IgnoreExtraneous"},
+		prune:       true,
+	}, {
+		name:        "Preview",
+		annotations: map[string]string{common.AnnotationSyncOptions: "Validate=false,Prune=false"},
+		prune:       true,
+		dryRun:      true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx()
+			syncCtx.syncOp.Prune = tt.prune
+			syncCtx.syncOp.DryRun = tt.dryRun
+			pod := test.NewPod()
+			pod.SetAnnotations(tt.annotations)
+			pod.SetNamespace(test.FakeArgoCDNamespace)
+			// deleting the pod would fail the sync
+			syncCtx.kubectl = &kubetest.MockKubectlCmd{
+				Commands: map[string]kubetest.KubectlOutput{pod.GetName(): {Err: fmt.Errorf("pod must not be deleted")}},
+			}
+			syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Live: pod}}}
+
+			syncCtx.sync()
+
+			assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+			if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+				assert.Equal(t, v1alpha1.ResultCodePruneSkipped, syncCtx.syncRes.Resources[0].Status)
+				assert.Equal(t, "skipped (prune disabled)", syncCtx.syncRes.Resources[0].Message)
+			}
+		})
+	}
+}
+
 // make sure Validate=false means we don't validate
 func TestSyncOptionValidate(t *testing.T) {
 	tests := []struct {
@@ -365,102 +631,625 @@ func TestSyncOptionValidate(t *testing.T) {
 	}
 }
 
-func TestSelectiveSyncOnly(t *testing.T) {
+// make sure annotations added to the live object out-of-band are preserved when applying
+func TestSyncPassthroughAnnotations(t *testing.T) {
 	syncCtx := newTestSyncCtx()
-	pod1 := test.NewPod()
-	pod1.SetName("pod-1")
-	pod2 := test.NewPod()
-	pod2.SetName("pod-2")
+	passthroughAnnotations, err := argo.NewPassthroughAnnotations([]string{"cluster-autoscaler.kubernetes.io/*"}, common.LabelKeyAppInstance)
+	assert.NoError(t, err)
+	targetPod := test.NewPod()
+	targetPod.SetNamespace(test.FakeArgoCDNamespace)
+	targetPod.SetAnnotations(map[string]string{"foo": "bar"})
+	livePod := targetPod.DeepCopy()
+	livePod.SetAnnotations(map[string]string{
+		"foo": "baz",
+		"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+		"other.io/annotation":                            "value",
+	})
 	syncCtx.compareResult = &comparisonResult{
-		managedResources: []managedResource{{Target: pod1}},
+		managedResources:       []managedResource{{Target: targetPod, Live: livePod}},
+		passthroughAnnotations: passthroughAnnotations,
 	}
-	syncCtx.syncResources = []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-1"}}
 
-	tasks, successful := syncCtx.getSyncTasks()
+	syncCtx.sync()
 
-	assert.True(t, successful)
-	assert.Len(t, tasks, 1)
-	assert.Equal(t, "pod-1", tasks[0].name())
+	kubectl, _ := syncCtx.kubectl.(*kubetest.MockKubectlCmd)
+	assert.Equal(t, map[string]string{
+		"foo": "bar",
+		"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+	}, kubectl.LastApplied.GetAnnotations())
+	assert.Equal(t, map[string]string{"foo": "bar"}, targetPod.GetAnnotations())
 }
 
-func TestUnnamedHooksGetUniqueNames(t *testing.T) {
-	syncCtx := newTestSyncCtx()
-	syncCtx.syncOp.SyncStrategy.Apply = nil
-	pod := test.NewPod()
-	pod.SetName("")
-	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync,PostSync"})
-	syncCtx.compareResult = &comparisonResult{hooks: []*unstructured.Unstructured{pod}}
+func TestSyncRespectIgnoreDifferences(t *testing.T) {
+	normalizer, err := argo.NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointers: []string{"/spec/replicas"},
+	}}, make(map[string]v1alpha1.ResourceOverride))
+	assert.NoError(t, err)
+	newDeployment := func(replicas int64) *unstructured.Unstructured {
+		deployment := test.NewDeployment()
+		deployment.SetNamespace(test.FakeArgoCDNamespace)
+		assert.NoError(t, unstructured.SetNestedField(deployment.Object, replicas, "spec", "replicas"))
+		return deployment
+	}
+	appliedReplicas := func(syncCtx *syncContext) int64 {
+		kubectl, _ := syncCtx.kubectl.(*kubetest.MockKubectlCmd)
+		replicas, _, _ := unstructured.NestedInt64(kubectl.LastApplied.Object, "spec", "replicas")
+		return replicas
+	}
 
-	tasks, successful := syncCtx.getSyncTasks()
+	t.Run("Enabled", func(t *testing.T) {
+		syncCtx := newTestSyncCtx()
+		syncCtx.respectIgnoreDifferences = true
+		syncCtx.compareResult = &comparisonResult{
+			managedResources: []managedResource{{Target: newDeployment(1), Live: newDeployment(5)}},
+			diffNormalizer:   normalizer,
+		}
+		syncCtx.sync()
+		assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+		assert.Equal(t, int64(5), appliedReplicas(syncCtx))
+	})
 
-	assert.True(t, successful)
-	assert.Len(t, tasks, 2)
-	assert.Contains(t, tasks[0].name(), "foobarb-presync-")
-	assert.Contains(t, tasks[1].name(), "foobarb-postsync-")
-	assert.Equal(t, "", pod.GetName())
+	t.Run("Disabled", func(t *testing.T) {
+		syncCtx := newTestSyncCtx()
+		syncCtx.compareResult = &comparisonResult{
+			managedResources: []managedResource{{Target: newDeployment(1), Live: newDeployment(5)}},
+			diffNormalizer:   normalizer,
+		}
+		syncCtx.sync()
+		assert.Equal(t, int64(1), appliedReplicas(syncCtx))
+	})
+
+	t.Run("NewResource", func(t *testing.T) {
+		syncCtx := newTestSyncCtx()
+		syncCtx.respectIgnoreDifferences = true
+		syncCtx.compareResult = &comparisonResult{
+			managedResources: []managedResource{{Target: newDeployment(1)}},
+			diffNormalizer:   normalizer,
+		}
+		syncCtx.sync()
+		assert.Equal(t, int64(1), appliedReplicas(syncCtx))
+	})
 }
 
-func TestManagedResourceAreNotNamed(t *testing.T) {
+func TestSyncPreview(t *testing.T) {
 	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.DryRun = true
+	syncCtx.syncOp.SyncStrategy = nil
 	pod := test.NewPod()
-	pod.SetName("")
-	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
+	pod.SetNamespace(test.FakeArgoCDNamespace)
+	svc := test.NewService()
+	svc.SetNamespace(test.FakeArgoCDNamespace)
+	test.Annotate(svc, common.AnnotationSyncWave, "1")
+	orphan := test.NewPod()
+	orphan.SetName("orphan")
+	orphan.SetNamespace(test.FakeArgoCDNamespace)
+	hook := test.NewHook(v1alpha1.HookTypePreSync)
+	hook.SetName("my-hook")
+	hook.SetNamespace(test.FakeArgoCDNamespace)
+	kubectl := &kubetest.MockKubectlCmd{
+		Commands: map[string]kubetest.KubectlOutput{
+			svc.GetName(): {Err: fmt.Errorf(`admission webhook "example.com" does not support dry run`)},
+		},
+	}
+	syncCtx.kubectl = kubectl
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{Target: pod}, {Target: svc}, {Live: orphan}},
+		hooks:            []*unstructured.Unstructured{hook},
+	}
 
-	tasks, successful := syncCtx.getSyncTasks()
+	syncCtx.sync()
 
-	assert.True(t, successful)
-	assert.Len(t, tasks, 1)
-	assert.Equal(t, "", tasks[0].name())
-	assert.Equal(t, "", pod.GetName())
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Equal(t, "successfully previewed (all tasks run)", syncCtx.opState.Message)
+	assert.Equal(t, kube.DryRunServer, kubectl.LastDryRunStrategy)
+	assert.Len(t, syncCtx.syncRes.Resources, 4)
+	for _, res := range syncCtx.syncRes.Resources {
+		assert.True(t, res.Previewed)
+		assert.Equal(t, v1alpha1.OperationSucceeded, res.HookPhase)
+	}
+	_, podResult := syncCtx.syncRes.Resources.Find("", "Pod", pod.GetNamespace(), pod.GetName(), v1alpha1.SyncPhaseSync)
+	assert.Equal(t, v1alpha1.ResultCodeSynced, podResult.Status)
+	_, svcResult := syncCtx.syncRes.Resources.Find("", "Service", svc.GetNamespace(), svc.GetName(), v1alpha1.SyncPhaseSync)
+	assert.Equal(t, v1alpha1.ResultCodeDryRunUnsupported, svcResult.Status)
+	_, orphanResult := syncCtx.syncRes.Resources.Find("", "Pod", orphan.GetNamespace(), orphan.GetName(), v1alpha1.SyncPhaseSync)
+	assert.Equal(t, v1alpha1.ResultCodePruned, orphanResult.Status)
+	assert.Equal(t, "pruned (dry run)", orphanResult.Message)
+	_, hookResult := syncCtx.syncRes.Resources.Find("", "Pod", hook.GetNamespace(), hook.GetName(), v1alpha1.SyncPhasePreSync)
+	assert.Equal(t, v1alpha1.ResultCodeSynced, hookResult.Status)
 }
 
-func TestDeDupingTasks(t *testing.T) {
+func TestSyncPreviewFailure(t *testing.T) {
 	syncCtx := newTestSyncCtx()
-	syncCtx.syncOp.SyncStrategy.Apply = nil
+	syncCtx.syncOp.DryRun = true
 	pod := test.NewPod()
-	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "Sync"})
-	syncCtx.compareResult = &comparisonResult{
-		managedResources: []managedResource{{Target: pod}},
-		hooks:            []*unstructured.Unstructured{pod},
+	pod.SetNamespace(test.FakeArgoCDNamespace)
+	syncCtx.kubectl = &kubetest.MockKubectlCmd{
+		Commands: map[string]kubetest.KubectlOutput{
+			pod.GetName(): {Err: fmt.Errorf(`admission webhook "example.com" denied the request`)},
+		},
 	}
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
 
-	tasks, successful := syncCtx.getSyncTasks()
+	syncCtx.sync()
 
-	assert.True(t, successful)
-	assert.Len(t, tasks, 1)
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, syncCtx.syncRes.Resources[0].Status)
+	assert.True(t, syncCtx.syncRes.Resources[0].Previewed)
 }
 
-func TestObjectsGetANamespace(t *testing.T) {
-	syncCtx := newTestSyncCtx()
+func TestSyncPreviewCreatedNamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{
+			{Kind: "Namespace", Group: "", Version: "v1", Namespaced: false},
+			{Kind: "Pod", Group: "", Version: "v1", Namespaced: true},
+		},
+	})
+	syncCtx.syncOp.DryRun = true
+	syncCtx.proj.Spec.Destinations = append(syncCtx.proj.Spec.Destinations, v1alpha1.ApplicationDestination{Server: test.FakeClusterURL, Namespace: "new-ns"})
+	ns := kube.MustToUnstructured(&corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: "new-ns"},
+	})
 	pod := test.NewPod()
-	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
+	pod.SetNamespace("new-ns")
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: ns}, {Target: pod}}}
 
-	tasks, successful := syncCtx.getSyncTasks()
+	syncCtx.sync()
 
-	assert.True(t, successful)
-	assert.Len(t, tasks, 1)
-	assert.Equal(t, test.FakeArgoCDNamespace, tasks[0].namespace())
-	assert.Equal(t, "", pod.GetNamespace())
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	_, podResult := syncCtx.syncRes.Resources.Find("", "Pod", "new-ns", pod.GetName(), v1alpha1.SyncPhaseSync)
+	assert.Equal(t, v1alpha1.ResultCodeDryRunUnsupported, podResult.Status)
+	assert.Equal(t, "namespace new-ns is created during sync", podResult.Message)
 }
 
-func TestPersistRevisionHistory(t *testing.T) {
-	app := newFakeApp()
-	app.Status.OperationState = nil
-	app.Status.History = nil
+func newWidget() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "my-widget", "namespace": test.FakeArgoCDNamespace},
+	}}
+}
 
-	defaultProject := &v1alpha1.AppProject{
-		ObjectMeta: v1.ObjectMeta{
-			Namespace: test.FakeArgoCDNamespace,
-			Name:      "default",
+// newTestMissingKindSyncCtx returns a sync context for a cluster which serves the group of the Widget kind, but not the
+// kind itself
+func newTestMissingKindSyncCtx() *syncContext {
+	return newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "example.com/v1",
+		APIResources: []v1.APIResource{
+			{Kind: "Gadget", Group: "example.com", Version: "v1", Namespaced: true},
 		},
-	}
-	data := fakeData{
-		apps: []runtime.Object{app, defaultProject},
-		manifestResponse: &apiclient.ManifestResponse{
+	})
+}
+
+func TestSyncMissingResourceKind(t *testing.T) {
+	syncCtx := newTestMissingKindSyncCtx()
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: newWidget()}}}
+
+	syncCtx.sync()
+
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.ResultCodeSyncFailed, syncCtx.syncRes.Resources[0].Status)
+}
+
+func TestSyncSkipDryRunOnMissingResource(t *testing.T) {
+	t.Run("SyncOption", func(t *testing.T) {
+		syncCtx := newTestMissingKindSyncCtx()
+		syncCtx.skipDryRunOnMissingResource = true
+		syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: newWidget()}}}
+
+		syncCtx.sync()
+
+		assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+		assert.Len(t, syncCtx.syncRes.Resources, 1)
+		assert.Equal(t, v1alpha1.ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+	})
+	t.Run("Annotation", func(t *testing.T) {
+		syncCtx := newTestMissingKindSyncCtx()
+		widget := newWidget()
+		widget.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "SkipDryRunOnMissingResource=true"})
+		syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: widget}}}
+
+		syncCtx.sync()
+
+		assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+		assert.Len(t, syncCtx.syncRes.Resources, 1)
+		assert.Equal(t, v1alpha1.ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+	})
+	t.Run("Preview", func(t *testing.T) {
+		syncCtx := newTestMissingKindSyncCtx()
+		syncCtx.syncOp.DryRun = true
+		syncCtx.skipDryRunOnMissingResource = true
+		syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: newWidget()}}}
+
+		syncCtx.sync()
+
+		assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+		assert.Len(t, syncCtx.syncRes.Resources, 1)
+		assert.Equal(t, v1alpha1.ResultCodeDryRunUnsupported, syncCtx.syncRes.Resources[0].Status)
+		assert.Equal(t, "resource kind example.com/Widget is not registered in the cluster", syncCtx.syncRes.Resources[0].Message)
+	})
+}
+
+func newTestNamespaceSyncCtx(liveNamespaces ...*unstructured.Unstructured) *syncContext {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []v1.APIResource{
+			{Kind: "Namespace", Group: "", Version: "v1", Namespaced: false},
+			{Kind: "Pod", Group: "", Version: "v1", Namespaced: true},
+		},
+	})
+	syncCtx.kubectl = &kubetest.MockKubectlCmd{Resources: liveNamespaces}
+	syncCtx.createNamespace = true
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeArgoCDNamespace)
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
+	return syncCtx
+}
+
+func newNamespace(name string, labels map[string]string) *unstructured.Unstructured {
+	return kube.MustToUnstructured(&corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+	})
+}
+
+func TestSyncCreateNamespace(t *testing.T) {
+	syncCtx := newTestNamespaceSyncCtx()
+	syncCtx.managedNamespaceMetadata = &v1alpha1.ManagedNamespaceMetadata{
+		Labels:      map[string]string{"owner": "team-a"},
+		Annotations: map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+	}
+
+	syncCtx.sync()
+
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	result := syncCtx.syncRes.Resources[0]
+	assert.Equal(t, "Namespace", result.Kind)
+	assert.Equal(t, test.FakeArgoCDNamespace, result.Name)
+	assert.Equal(t, v1alpha1.SyncPhasePreSync, result.SyncPhase)
+	assert.Equal(t, v1alpha1.ResultCodeSynced, result.Status)
+	applied := syncCtx.kubectl.(*kubetest.MockKubectlCmd).LastApplied
+	assert.Equal(t, "Namespace", applied.GetKind())
+	assert.Equal(t, map[string]string{"owner": "team-a"}, applied.GetLabels())
+	assert.Equal(t, map[string]string{"pod-security.kubernetes.io/enforce": "restricted"}, applied.GetAnnotations())
+	assert.Empty(t, applied.GetLabels()[common.LabelKeyAppInstance])
+
+	// the namespace exists now, the pod is created in the next wave
+	syncCtx.kubectl.(*kubetest.MockKubectlCmd).Resources = []*unstructured.Unstructured{newNamespace(test.FakeArgoCDNamespace, nil)}
+	syncCtx.managedNamespaceMetadata = nil
+	syncCtx.sync()
+
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 2)
+	_, result = syncCtx.syncRes.Resources.Find("", "Namespace", test.FakeArgoCDNamespace, test.FakeArgoCDNamespace, v1alpha1.SyncPhasePreSync)
+	assert.Equal(t, v1alpha1.OperationSucceeded, result.HookPhase)
+}
+
+func TestSyncCreateNamespace_Exists(t *testing.T) {
+	syncCtx := newTestNamespaceSyncCtx(newNamespace(test.FakeArgoCDNamespace, map[string]string{"foo": "bar"}))
+
+	syncCtx.sync()
+
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "Pod", syncCtx.syncRes.Resources[0].Kind)
+}
+
+func TestSyncCreateNamespace_ExistsWithMetadata(t *testing.T) {
+	syncCtx := newTestNamespaceSyncCtx(newNamespace(test.FakeArgoCDNamespace, map[string]string{"foo": "bar"}))
+	syncCtx.managedNamespaceMetadata = &v1alpha1.ManagedNamespaceMetadata{Labels: map[string]string{"owner": "team-a"}}
+
+	syncCtx.sync()
+
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "Namespace", syncCtx.syncRes.Resources[0].Kind)
+	assert.Equal(t, v1alpha1.ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+	// undeclared labels of the live namespace are preserved by the apply
+	applied := syncCtx.kubectl.(*kubetest.MockKubectlCmd).LastApplied
+	assert.Equal(t, map[string]string{"owner": "team-a"}, applied.GetLabels())
+}
+
+func TestSyncCreateNamespace_ManagedNamespace(t *testing.T) {
+	syncCtx := newTestNamespaceSyncCtx()
+	syncCtx.managedNamespaceMetadata = &v1alpha1.ManagedNamespaceMetadata{Labels: map[string]string{"owner": "team-a"}}
+	syncCtx.compareResult.managedResources = []managedResource{{
+		Group: "", Kind: "Namespace", Name: test.FakeArgoCDNamespace, Target: newNamespace(test.FakeArgoCDNamespace, nil),
+	}}
+
+	syncCtx.sync()
+
+	assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, v1alpha1.SyncPhaseSync, syncCtx.syncRes.Resources[0].SyncPhase)
+}
+
+func TestSyncWaveIgnoreHealthCheck(t *testing.T) {
+	for _, ignoreHealthCheck := range []bool{true, false} {
+		t.Run(fmt.Sprintf("IgnoreHealthCheck=%v", ignoreHealthCheck), func(t *testing.T) {
+			syncCtx := newTestSyncCtx()
+			// the pod has no status, so it is never healthy
+			pod := test.NewPod()
+			pod.SetNamespace(test.FakeArgoCDNamespace)
+			if ignoreHealthCheck {
+				pod.SetAnnotations(map[string]string{common.AnnotationIgnoreHealthCheck: "true"})
+			}
+			svc := test.Annotate(test.NewService(), common.AnnotationSyncWave, "1")
+			svc.SetNamespace(test.FakeArgoCDNamespace)
+			syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod, Live: pod}, {Target: svc}}}
+
+			syncCtx.sync()
+			assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+			assert.Len(t, syncCtx.syncRes.Resources, 1)
+
+			syncCtx.sync()
+			if ignoreHealthCheck {
+				assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.opState.Phase)
+				assert.Len(t, syncCtx.syncRes.Resources, 2)
+				assert.Equal(t, v1alpha1.OperationSucceeded, syncCtx.syncRes.Resources[0].HookPhase)
+			} else {
+				assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+				assert.Len(t, syncCtx.syncRes.Resources, 1)
+			}
+		})
+	}
+}
+
+func TestSyncProgress(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.opState.StartedAt = metav1.NewTime(time.Now().Add(-90 * time.Second))
+	pod := test.Annotate(test.NewPod(), common.AnnotationIgnoreHealthCheck, "true")
+	pod.SetNamespace(test.FakeArgoCDNamespace)
+	svc := test.Annotate(test.NewService(), common.AnnotationSyncWave, "1")
+	svc.SetNamespace(test.FakeArgoCDNamespace)
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod, Live: pod}, {Target: svc}}}
+
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	progress := syncCtx.opState.Progress
+	if assert.NotNil(t, progress) {
+		assert.Equal(t, SyncPhaseSync, progress.Phase)
+		assert.Equal(t, int64(0), progress.Wave)
+		assert.Equal(t, int64(1), progress.Pending)
+		assert.Equal(t, int64(1), progress.Running)
+		assert.Equal(t, int64(0), progress.Succeeded)
+		assert.Equal(t, "Pod/my-pod", progress.WaitingOn)
+		assert.True(t, progress.ElapsedSeconds >= 90)
+	}
+
+	syncCtx.sync()
+	assert.Equal(t, OperationSucceeded, syncCtx.opState.Phase)
+	progress = syncCtx.opState.Progress
+	if assert.NotNil(t, progress) {
+		assert.Equal(t, int64(1), progress.Wave)
+		assert.Equal(t, int64(0), progress.Pending)
+		assert.Equal(t, int64(1), progress.Succeeded)
+		assert.Equal(t, int64(1), progress.Running)
+		assert.Equal(t, int64(0), progress.Failed)
+		assert.Empty(t, progress.WaitingOn)
+	}
+	// the final state contains the results of all resources
+	if assert.Len(t, syncCtx.syncRes.Resources, 2) {
+		for _, res := range syncCtx.syncRes.Resources {
+			assert.Equal(t, ResultCodeSynced, res.Status)
+		}
+	}
+}
+
+func TestSyncTerminationRequestedBetweenTasks(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: test.NewPod()}, {Target: test.NewService()}}}
+	var reported []*OperationProgress
+	syncCtx.reportProgress = func(progress *OperationProgress) bool {
+		reported = append(reported, progress)
+		return true
+	}
+
+	syncCtx.sync()
+
+	// the second kind is not applied and the operation is resumed in the terminating phase
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	if assert.Len(t, reported, 1) {
+		assert.Equal(t, int64(1), reported[0].Pending)
+		assert.Equal(t, int64(1), reported[0].Running)
+	}
+}
+
+func TestSelectiveSyncOnly(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	pod1 := test.NewPod()
+	pod1.SetName("pod-1")
+	pod2 := test.NewPod()
+	pod2.SetName("pod-2")
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{Target: pod1}},
+	}
+	syncCtx.syncResources = []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-1"}}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, "pod-1", tasks[0].name())
+}
+
+func TestSelectiveSyncGlobAndNamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	newPod := func(namespace, name string) *unstructured.Unstructured {
+		pod := test.NewPod()
+		pod.SetNamespace(namespace)
+		pod.SetName(name)
+		return pod
+	}
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{
+			{Target: newPod("ns-1", "frontend-1"), Live: newPod("ns-1", "frontend-1")},
+			{Target: newPod("ns-2", "frontend-2")},
+			{Target: newPod("ns-1", "backend-1")},
+			{Live: newPod("ns-1", "extra")},
+		},
+	}
+	syncCtx.proj.Spec.Destinations = []v1alpha1.ApplicationDestination{{Server: test.FakeClusterURL, Namespace: "*"}}
+	syncCtx.syncResources = []v1alpha1.SyncOperationResource{{Kind: "Pod", Namespace: "ns-1", Name: "frontend-*"}}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, "frontend-1", tasks[0].name())
+		assert.Equal(t, "ns-1", tasks[0].namespace())
+	}
+}
+
+func TestSelectiveSyncHooks(t *testing.T) {
+	newSyncCtx := func(selected []v1alpha1.SyncOperationResource, includeHooks bool) *syncContext {
+		syncCtx := newTestSyncCtx()
+		syncCtx.syncOp.SyncStrategy.Apply = nil
+		syncCtx.syncOp.IncludeHooks = includeHooks
+		pod1 := test.NewPod()
+		pod1.SetName("pod-1")
+		pod2 := test.NewPod()
+		pod2.SetName("pod-2")
+		hook := test.NewHook(v1alpha1.HookTypePreSync)
+		hook.SetName("my-hook")
+		syncCtx.compareResult = &comparisonResult{
+			managedResources: []managedResource{{Target: pod1}, {Target: pod2}},
+			hooks:            []*unstructured.Unstructured{hook},
+		}
+		syncCtx.syncResources = selected
+		return syncCtx
+	}
+	hasHook := func(tasks syncTasks) bool {
+		for _, task := range tasks {
+			if task.isHook() {
+				return true
+			}
+		}
+		return false
+	}
+
+	tasks, successful := newSyncCtx([]v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-1"}}, false).getSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 1)
+	assert.False(t, hasHook(tasks))
+
+	tasks, successful = newSyncCtx([]v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-1"}}, true).getSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 2)
+	assert.True(t, hasHook(tasks))
+
+	tasks, successful = newSyncCtx([]v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-1"}, {Kind: "Pod", Name: "my-hook"}}, false).getSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 2)
+	assert.True(t, hasHook(tasks))
+}
+
+func TestSelectiveSyncUnmatchedResources(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeArgoCDNamespace)
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
+	syncCtx.syncResources = []v1alpha1.SyncOperationResource{
+		{Kind: "Pod", Name: "my-pod"},
+		{Kind: "Pod", Namespace: "other-ns", Name: "my-pod"},
+		{Group: "apps", Kind: "Deployment", Name: "my-*"},
+	}
+
+	syncCtx.sync()
+
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "selected resources don't match any resource of the application: :Pod:other-ns/my-pod, apps:Deployment:my-*", syncCtx.opState.Message)
+	assert.Empty(t, syncCtx.syncRes.Resources)
+}
+
+func TestUnnamedHooksGetUniqueNames(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	pod := test.NewPod()
+	pod.SetName("")
+	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync,PostSync"})
+	syncCtx.compareResult = &comparisonResult{hooks: []*unstructured.Unstructured{pod}}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	assert.Len(t, tasks, 2)
+	assert.Contains(t, tasks[0].name(), "foobarb-presync-")
+	assert.Contains(t, tasks[1].name(), "foobarb-postsync-")
+	assert.Equal(t, "", pod.GetName())
+}
+
+func TestManagedResourceAreNotNamed(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	pod := test.NewPod()
+	pod.SetName("")
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, "", tasks[0].name())
+	assert.Equal(t, "", pod.GetName())
+}
+
+func TestDeDupingTasks(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: "Sync"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{Target: pod}},
+		hooks:            []*unstructured.Unstructured{pod},
+	}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	assert.Len(t, tasks, 1)
+}
+
+func TestObjectsGetANamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	pod := test.NewPod()
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: pod}}}
+
+	tasks, successful := syncCtx.getSyncTasks()
+
+	assert.True(t, successful)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, test.FakeArgoCDNamespace, tasks[0].namespace())
+	assert.Equal(t, "", pod.GetNamespace())
+}
+
+func TestPersistRevisionHistory(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
 			Manifests: []string{},
 			Namespace: test.FakeDestNamespace,
 			Server:    test.FakeClusterURL,
 			Revision:  "abc123",
+			RevisionMetadata: &v1alpha1.ResolvedRevisionMetadata{
+				Author:  "author",
+				Message: "message",
+			},
 		},
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
@@ -470,7 +1259,7 @@ func TestPersistRevisionHistory(t *testing.T) {
 	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
 		Sync: &v1alpha1.SyncOperation{},
 	}}
-	ctrl.appStateManager.SyncAppState(app, opState)
+	ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
 	// Ensure we record spec.source into sync result
 	assert.Equal(t, app.Spec.Source, opState.SyncResult.Source)
 
@@ -479,6 +1268,161 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, 1, len(updatedApp.Status.History))
 	assert.Equal(t, app.Spec.Source, updatedApp.Status.History[0].Source)
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
+	assert.Equal(t, &v1alpha1.ResolvedRevisionMetadata{Author: "author", Message: "message"}, updatedApp.Status.History[0].RevisionMetadata)
+}
+
+func TestPersistRevisionHistoryInitiator(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	// entry recorded before the start time and the initiator were tracked
+	app.Status.History = []v1alpha1.RevisionHistory{{
+		ID:         3,
+		Revision:   "aaa111",
+		Source:     app.Spec.Source,
+		DeployedAt: v1.NewTime(time.Now().Add(-time.Hour)),
+	}}
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	startedAt := v1.NewTime(time.Now().Add(-time.Minute).UTC().Truncate(time.Second))
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "alice"},
+		},
+		StartedAt: startedAt,
+	}
+	ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, updatedApp.Status.History, 2)
+	old := updatedApp.Status.History[0]
+	assert.Equal(t, "aaa111", old.Revision)
+	assert.Nil(t, old.DeployStartedAt)
+	assert.Equal(t, v1alpha1.OperationInitiator{}, old.InitiatedBy)
+	entry := updatedApp.Status.History[1]
+	assert.Equal(t, int64(4), entry.ID)
+	assert.Equal(t, "abc123", entry.Revision)
+	if assert.NotNil(t, entry.DeployStartedAt) {
+		assert.True(t, startedAt.Equal(entry.DeployStartedAt))
+		assert.False(t, entry.DeployedAt.Before(entry.DeployStartedAt))
+	}
+	assert.Equal(t, v1alpha1.OperationInitiator{Username: "alice"}, entry.InitiatedBy)
+}
+
+func TestSyncAppStateInterrupted(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the interrupted operation remains running and is not recorded in the history
+	opState := &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning, Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(ctx, app, opState, nil)
+	assert.Equal(t, v1alpha1.OperationRunning, opState.Phase)
+	assert.Contains(t, opState.Message, "interrupted")
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, updatedApp.Status.History, 0)
+}
+
+func TestSyncInterrupted(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	syncCtx.ctx = ctx
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: test.NewPod()}}}
+	syncCtx.sync()
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Equal(t, "operation interrupted", syncCtx.opState.Message)
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+}
+
+func TestPersistRevisionHistoryLocalManifests(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	// the skipped pod is not applied, so the sync succeeds without a cluster
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{common.AnnotationKeyHook: string(v1alpha1.HookTypeSkip)})
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{Manifests: []string{toJSON(t, pod)}},
+	}}
+	ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+	assert.Equal(t, v1alpha1.OperationSucceeded, opState.Phase, opState.Message)
+	assert.True(t, isLocalManifestsRevision(opState.SyncResult.Revision))
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, updatedApp.Status.History, 0)
+}
+
+func TestSyncAppStateResourceLimitExceeded(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, test.NewService())},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData:   map[string]string{"application.maxResources": "1"},
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+	ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+	assert.Equal(t, v1alpha1.OperationError, opState.Phase)
+	assert.Contains(t, opState.Message, "application has 2 resources which exceeds the limit of 1")
 }
 
 func TestPersistRevisionHistoryRollback(t *testing.T) {
@@ -519,7 +1463,7 @@ func TestPersistRevisionHistoryRollback(t *testing.T) {
 			Source: &source,
 		},
 	}}
-	ctrl.appStateManager.SyncAppState(app, opState)
+	ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
 	// Ensure we record opState's source into sync result
 	assert.Equal(t, source, opState.SyncResult.Source)
 
@@ -530,6 +1474,298 @@ func TestPersistRevisionHistoryRollback(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestSyncAppStateRollbackID(t *testing.T) {
+	source := v1alpha1.ApplicationSource{
+		RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+		Path:           "helm-guestbook",
+		TargetRevision: "v1.0.0",
+		Helm: &v1alpha1.ApplicationSourceHelm{
+			Parameters: []v1alpha1.HelmParameter{{Name: "replicaCount", Value: "3"}},
+		},
+	}
+	newController := func(app *v1alpha1.Application) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		})
+	}
+
+	t.Run("Recorded", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = []v1alpha1.RevisionHistory{{ID: 3, Revision: "abc123", Source: source}}
+		ctrl := newController(app)
+		rollbackID := int64(3)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{RollbackID: &rollbackID},
+		}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationSucceeded, opState.Phase, opState.Message)
+		assert.Equal(t, source, opState.SyncResult.Source)
+		assert.Equal(t, "abc123", opState.SyncResult.Revision)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+		assert.NoError(t, err)
+		if assert.Len(t, updatedApp.Status.History, 2) {
+			assert.Equal(t, int64(4), updatedApp.Status.History[1].ID)
+			assert.Equal(t, source, updatedApp.Status.History[1].Source)
+			assert.Equal(t, &rollbackID, updatedApp.Status.History[1].RollbackID)
+		}
+	})
+
+	t.Run("Pruned", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = []v1alpha1.RevisionHistory{{ID: 3, Revision: "abc123", Source: source}}
+		ctrl := newController(app)
+		rollbackID := int64(1)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{RollbackID: &rollbackID},
+		}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "does not have deployment with id 1")
+	})
+}
+
+func TestSyncAppStateMultipleSources(t *testing.T) {
+	sources := []v1alpha1.ApplicationSource{
+		{RepoURL: "https://example.com/frontend.git", Path: "manifests", TargetRevision: "master"},
+		{RepoURL: "https://example.com/backend.git", Path: "manifests", TargetRevision: "v1.0.0"},
+	}
+	newController := func(app *v1alpha1.Application) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponses: map[string]*apiclient.ManifestResponse{
+				sources[0].RepoURL: {Namespace: test.FakeDestNamespace, Server: test.FakeClusterURL, Revision: "aaa111"},
+				sources[1].RepoURL: {Namespace: test.FakeDestNamespace, Server: test.FakeClusterURL, Revision: "bbb222"},
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		})
+	}
+
+	t.Run("Synced", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Sources = sources
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		ctrl := newController(app)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationSucceeded, opState.Phase, opState.Message)
+		assert.Equal(t, sources, opState.SyncResult.Sources)
+		assert.Equal(t, []string{"aaa111", "bbb222"}, opState.SyncResult.Revisions)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+		assert.NoError(t, err)
+		if assert.Len(t, updatedApp.Status.History, 1) {
+			assert.Equal(t, sources, updatedApp.Status.History[0].Sources)
+			assert.Equal(t, []string{"aaa111", "bbb222"}, updatedApp.Status.History[0].Revisions)
+		}
+	})
+
+	t.Run("SourceOverride", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Sources = sources
+		ctrl := newController(app)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{Source: &sources[0]},
+		}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "cannot be overridden")
+	})
+}
+
+func TestPersistRevisionHistoryConflict(t *testing.T) {
+	app := newFakeApp()
+	app.ResourceVersion = "1"
+	app.Status.History = nil
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+
+	// a concurrent writer updates the app after the controller has read it
+	latest := app.DeepCopy()
+	latest.ResourceVersion = "2"
+	latest.Status.History = []RevisionHistory{{ID: 0, Revision: "concurrent"}}
+	_, err := appIf.Update(latest)
+	assert.NoError(t, err)
+
+	// emulate the resource version precondition of the API server
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patches := 0
+	fakeAppCs.PrependReactor("patch", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		patches++
+		if strings.Contains(string(action.(testcore.PatchAction).GetPatch()), `"resourceVersion":"2"`) {
+			return false, nil, nil
+		}
+		return true, nil, apierr.NewConflict(SchemeGroupVersion.WithResource("applications").GroupResource(), app.Name, fmt.Errorf("the object has been modified"))
+	})
+
+	err = ctrl.appStateManager.(*appStateManager).persistRevisionHistory(app, RevisionHistory{Revision: "abc123", Source: app.Spec.Source})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, patches)
+
+	updatedApp, err := appIf.Get(app.Name, v1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, updatedApp.Status.History, 2) {
+		assert.Equal(t, "concurrent", updatedApp.Status.History[0].Revision)
+		assert.Equal(t, int64(1), updatedApp.Status.History[1].ID)
+		assert.Equal(t, "abc123", updatedApp.Status.History[1].Revision)
+	}
+}
+
+func TestPersistRevisionHistoryConflictAttemptsExceeded(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patches := 0
+	fakeAppCs.PrependReactor("patch", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		patches++
+		return true, nil, apierr.NewConflict(SchemeGroupVersion.WithResource("applications").GroupResource(), app.Name, fmt.Errorf("the object has been modified"))
+	})
+
+	err := ctrl.appStateManager.(*appStateManager).persistRevisionHistory(app, RevisionHistory{Revision: "abc123", Source: app.Spec.Source})
+	assert.True(t, apierr.IsConflict(err))
+	assert.Equal(t, persistRevisionHistoryAttempts, patches)
+}
+
+func TestPersistRevisionHistoryDuplicates(t *testing.T) {
+	// a full history whose most recent entry deployed abc123
+	newApp := func(syncOptions ...string) *Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &SyncPolicy{SyncOptions: syncOptions}
+		app.Status.History = nil
+		for i := 0; i < common.RevisionHistoryLimit; i++ {
+			app.Status.History = append(app.Status.History, RevisionHistory{ID: int64(i), Revision: fmt.Sprintf("rev%d", i), Source: app.Spec.Source})
+		}
+		app.Status.History[common.RevisionHistoryLimit-1].Revision = "abc123"
+		return app
+	}
+	persist := func(t *testing.T, app *Application, entry RevisionHistory) []RevisionHistory {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		err := ctrl.appStateManager.(*appStateManager).persistRevisionHistory(app, entry)
+		assert.NoError(t, err)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+		assert.NoError(t, err)
+		return updatedApp.Status.History
+	}
+
+	t.Run("SameRevisionAndSource", func(t *testing.T) {
+		app := newApp()
+		history := persist(t, app, RevisionHistory{Revision: "abc123", Source: app.Spec.Source, InitiatedBy: OperationInitiator{Automated: true}})
+		if assert.Len(t, history, common.RevisionHistoryLimit) {
+			// the oldest rollback target is kept and the last entry is refreshed
+			assert.Equal(t, int64(0), history[0].ID)
+			last := history[common.RevisionHistoryLimit-1]
+			assert.Equal(t, int64(common.RevisionHistoryLimit-1), last.ID)
+			assert.Equal(t, "abc123", last.Revision)
+			assert.False(t, last.DeployedAt.IsZero())
+			assert.Equal(t, OperationInitiator{Automated: true}, last.InitiatedBy)
+		}
+	})
+
+	t.Run("DifferentSource", func(t *testing.T) {
+		app := newApp()
+		source := app.Spec.Source.DeepCopy()
+		source.Path = "other"
+		history := persist(t, app, RevisionHistory{Revision: "abc123", Source: *source})
+		if assert.Len(t, history, common.RevisionHistoryLimit) {
+			assert.Equal(t, int64(1), history[0].ID)
+			assert.Equal(t, int64(common.RevisionHistoryLimit), history[common.RevisionHistoryLimit-1].ID)
+			assert.Equal(t, "other", history[common.RevisionHistoryLimit-1].Source.Path)
+		}
+	})
+
+	t.Run("DifferentRevision", func(t *testing.T) {
+		app := newApp()
+		history := persist(t, app, RevisionHistory{Revision: "def456", Source: app.Spec.Source})
+		if assert.Len(t, history, common.RevisionHistoryLimit) {
+			assert.Equal(t, int64(1), history[0].ID)
+			assert.Equal(t, "abc123", history[common.RevisionHistoryLimit-2].Revision)
+			assert.Equal(t, "def456", history[common.RevisionHistoryLimit-1].Revision)
+		}
+	})
+
+	t.Run("RecordDuplicateHistory", func(t *testing.T) {
+		app := newApp("RecordDuplicateHistory=true")
+		history := persist(t, app, RevisionHistory{Revision: "abc123", Source: app.Spec.Source})
+		if assert.Len(t, history, common.RevisionHistoryLimit) {
+			assert.Equal(t, int64(1), history[0].ID)
+			assert.Equal(t, "abc123", history[common.RevisionHistoryLimit-2].Revision)
+			assert.Equal(t, "abc123", history[common.RevisionHistoryLimit-1].Revision)
+			assert.Equal(t, int64(common.RevisionHistoryLimit), history[common.RevisionHistoryLimit-1].ID)
+		}
+	})
+}
+
+func TestSyncAppStateSignatureVerification(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+		},
+	}
+	newController := func(app *v1alpha1.Application, verifyResult *apiclient.SignatureVerification) *ApplicationController {
+		return newFakeController(&fakeData{
+			apps: []runtime.Object{app, proj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests:    []string{},
+				Namespace:    test.FakeDestNamespace,
+				Server:       test.FakeClusterURL,
+				Revision:     "abc123",
+				VerifyResult: verifyResult,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		})
+	}
+
+	t.Run("Unsigned", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, &apiclient.SignatureVerification{Validity: "Unsigned"})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "revision abc123 is an unsigned commit")
+	})
+
+	t.Run("KeyNotAllowed", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, &apiclient.SignatureVerification{Validity: "Good", Valid: true, KeyID: "D56C4FCA57A46444"})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "key D56C4FCA57A46444 which is not allowed by project default")
+	})
+
+	t.Run("Allowed", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, &apiclient.SignatureVerification{Validity: "Good", Valid: true, KeyID: "4AEE18F83AFDEB23"})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationSucceeded, opState.Phase)
+		assert.False(t, opState.SyncResult.SignatureVerificationSkipped)
+	})
+
+	t.Run("LocalManifests", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newController(app, nil)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Manifests: []string{string(test.PodManifest)}}}}
+		ctrl.appStateManager.SyncAppState(context.Background(), app, opState, nil)
+		assert.Equal(t, v1alpha1.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "local manifests cannot be verified")
+	})
+}
+
 func TestSyncFailureHookWithSuccessfulSync(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	syncCtx.syncOp.SyncStrategy.Apply = nil
@@ -580,6 +1816,120 @@ func TestBeforeHookCreation(t *testing.T) {
 	assert.Empty(t, syncCtx.syncRes.Resources[0].Message)
 }
 
+func newJobHook(runID string, complete bool) *unstructured.Unstructured {
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"name":      "my-job",
+			"namespace": test.FakeArgoCDNamespace,
+		},
+	}}
+	test.Hook(job, HookTypeSync)
+	if runID != "" {
+		job.SetLabels(map[string]string{common.LabelKeyHookRunID: runID})
+	}
+	if complete {
+		job.Object["status"] = map[string]interface{}{
+			"succeeded":  int64(1),
+			"conditions": []interface{}{map[string]interface{}{"type": "Complete", "status": "True"}},
+		}
+	}
+	return job
+}
+
+func newJobHookSyncCtx() *syncContext {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "batch/v1",
+		APIResources: []v1.APIResource{{Name: "jobs", Kind: "Job", Group: "batch", Version: "v1", Namespaced: true}},
+	})
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	syncCtx.opState.StartedAt = metav1.NewTime(time.Unix(1000, 0))
+	return syncCtx
+}
+
+func TestSyncRecreatesHookOfPreviousOperation(t *testing.T) {
+	for _, previousRunID := range []string{"", "999"} {
+		t.Run("RunID="+previousRunID, func(t *testing.T) {
+			syncCtx := newJobHookSyncCtx()
+			previous := newJobHook(previousRunID, true)
+			syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme(), previous)
+			syncCtx.compareResult = &comparisonResult{
+				managedResources: []managedResource{newManagedResource(previous)},
+				hooks:            []*unstructured.Unstructured{newJobHook("", false)},
+			}
+
+			// the completed job of the previous operation is deleted instead of being taken as the result
+			syncCtx.sync()
+			assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+			assert.Empty(t, syncCtx.syncRes.Resources)
+			_, err := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}).
+				Namespace(test.FakeArgoCDNamespace).Get("my-job", metav1.GetOptions{})
+			assert.True(t, apierr.IsNotFound(err))
+
+			// the hook is re-created once the previous job is gone
+			syncCtx.compareResult.managedResources = nil
+			syncCtx.sync()
+			assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+			if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+				assert.Equal(t, ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+				assert.Equal(t, OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+			}
+
+			// the completion of the new instance completes the operation
+			syncCtx.compareResult.managedResources = []managedResource{newManagedResource(newJobHook("1000", true))}
+			syncCtx.sync()
+			assert.Equal(t, OperationSucceeded, syncCtx.opState.Phase)
+			assert.Equal(t, OperationSucceeded, syncCtx.syncRes.Resources[0].HookPhase)
+		})
+	}
+}
+
+func TestSyncIgnoresStaleRunningHook(t *testing.T) {
+	syncCtx := newJobHookSyncCtx()
+	syncCtx.compareResult = &comparisonResult{hooks: []*unstructured.Unstructured{newJobHook("", false)}}
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+
+	// a completed job of a previous operation is still cached, or the job of this operation is being deleted
+	deleting := newJobHook("1000", true)
+	now := metav1.Now()
+	deleting.SetDeletionTimestamp(&now)
+	for _, live := range []*unstructured.Unstructured{newJobHook("999", true), deleting} {
+		syncCtx.compareResult.managedResources = []managedResource{newManagedResource(live)}
+		syncCtx.sync()
+		assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+		assert.Equal(t, OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+	}
+}
+
+func TestSyncHookRetryOnConflict(t *testing.T) {
+	syncCtx := newJobHookSyncCtx()
+	// the job exists, but is not tracked by the application
+	existing := newJobHook("", true)
+	syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+	hook := test.Annotate(newJobHook("", false), common.AnnotationKeyHookDeletePolicy, "HookRetryOnConflict")
+	syncCtx.compareResult = &comparisonResult{hooks: []*unstructured.Unstructured{hook}}
+	immutableErr := kube.ParseKubectlError("exit status 1", `The Job "my-job" is invalid: spec.template: Invalid value: core.PodTemplateSpec{}: field is immutable`)
+	kubectl := &failingKubectl{errs: []error{nil, immutableErr}}
+	syncCtx.kubectl = kubectl
+
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Empty(t, syncCtx.syncRes.Resources[0].HookPhase)
+		assert.Contains(t, syncCtx.syncRes.Resources[0].Message, "re-creating hook after conflict")
+	}
+	_, err := syncCtx.dynamicIf.Resource(schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}).
+		Namespace(test.FakeArgoCDNamespace).Get("my-job", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+
+	syncCtx.sync()
+	assert.Equal(t, ResultCodeSynced, syncCtx.syncRes.Resources[0].Status)
+	assert.Equal(t, OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+	assert.Equal(t, 3, kubectl.applies)
+}
+
 func TestRunSyncFailHooksFailed(t *testing.T) {
 	// Tests that other SyncFail Hooks run even if one of them fail.
 
@@ -641,6 +1991,9 @@ func Test_syncContext_isSelectiveSync(t *testing.T) {
 		{"GroupDifferent", fields{oneResource("", "foo", "", false), oneSyncResource}, true},
 		{"NameDifferent", fields{oneResource("", "", "foo", false), oneSyncResource}, true},
 		{"HookIgnored", fields{oneResource("", "", "", true), []SyncOperationResource{}}, false},
+		{"Glob", fields{&comparisonResult{resources: []v1alpha1.ResourceStatus{{Name: "a-1"}, {Name: "a-2"}}}, []SyncOperationResource{{Name: "a-*"}}}, false},
+		{"GlobPartial", fields{&comparisonResult{resources: []v1alpha1.ResourceStatus{{Name: "a-1"}, {Name: "b-1"}}}, []SyncOperationResource{{Name: "a-*"}}}, true},
+		{"NamespaceDifferent", fields{oneResource("", "", "", false), []SyncOperationResource{{Namespace: "foo"}}}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// Span traces a phase of the work of the controller, e.g. the generation of the manifests of a comparison
+type Span interface {
+	// SetAttribute tags the span with the given key and value
+	SetAttribute(key string, value interface{})
+	// RecordError records an error which occurred during the phase
+	RecordError(err error)
+	// End completes the span
+	End()
+}
+
+// Tracer starts spans. It is implemented by adapters of tracing libraries, e.g. OpenTelemetry, so that the controller
+// does not depend on a particular library. The spans which are started with the context returned by StartSpan are
+// children of the started span.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// noopTracer is the tracer of the controller if tracing is not configured
+type noopTracer struct{}
+
+func (noopTracer) StartSpan(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+
+func (noopSpan) RecordError(error) {}
+
+func (noopSpan) End() {}
+
+// the names of the spans of comparisons and the attributes they are tagged with
+const (
+	spanCompareAppState        = "CompareAppState"
+	spanGenerateManifests      = "CompareAppState/GenerateManifests"
+	spanGetLiveObjects         = "CompareAppState/GetManagedLiveObjs"
+	spanReconcile              = "CompareAppState/Reconcile"
+	spanDiff                   = "CompareAppState/Diff"
+	spanAssessHealth           = "CompareAppState/AssessHealth"
+	spanAttributeApp           = "argocd.app"
+	spanAttributeServer        = "argocd.destination.server"
+	spanAttributeTargetObjects = "argocd.resources.target"
+	spanAttributeLiveObjects   = "argocd.resources.live"
+	spanAttributeResources     = "argocd.resources"
+	spanAttributeCancelled     = "argocd.cancelled"
+)
+
+// endSpan records the error of the traced phase, if any, and ends the span
+func endSpan(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// endComparisonSpan ends the span of a comparison. The comparison errors which are reported to users as conditions are
+// recorded, so that the traces match the status of the application.
+func endComparisonSpan(span Span, result *comparisonResult) {
+	if result != nil {
+		span.SetAttribute(spanAttributeResources, len(result.resources))
+		span.SetAttribute(spanAttributeCancelled, result.cancelled)
+		for _, condition := range result.conditions {
+			if condition.Type == v1alpha1.ApplicationConditionComparisonError {
+				span.RecordError(errors.New(condition.Message))
+			}
+		}
+	}
+	span.End()
+}
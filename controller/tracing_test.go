@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+type fakeSpan struct {
+	name       string
+	attributes map[string]interface{}
+	errors     []string
+	ended      bool
+}
+
+func (s *fakeSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *fakeSpan) RecordError(err error) {
+	s.errors = append(s.errors, err.Error())
+}
+
+func (s *fakeSpan) End() {
+	s.ended = true
+}
+
+type fakeTracer struct {
+	spans []*fakeSpan
+	lock  sync.Mutex
+}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	span := &fakeSpan{name: name, attributes: make(map[string]interface{})}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func (t *fakeTracer) getSpan(name string) *fakeSpan {
+	for _, span := range t.spans {
+		if span.name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func TestCompareAppStateTracing(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(pod): pod,
+		},
+	}
+
+	t.Run("Phases", func(t *testing.T) {
+		tracer := &fakeTracer{}
+		ctrl := newFakeController(&data)
+		ctrl.appStateManager.(*appStateManager).tracer = tracer
+		ctrl.appStateManager.CompareAppState(context.Background(), app, []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		var names []string
+		for _, span := range tracer.spans {
+			names = append(names, span.name)
+			assert.True(t, span.ended, span.name)
+			assert.Empty(t, span.errors, span.name)
+		}
+		assert.Equal(t, []string{spanCompareAppState, spanGenerateManifests, spanGetLiveObjects, spanReconcile, spanDiff, spanAssessHealth}, names)
+		assert.Equal(t, app.Name, tracer.getSpan(spanCompareAppState).attributes[spanAttributeApp])
+		assert.Equal(t, app.Spec.Destination.Server, tracer.getSpan(spanCompareAppState).attributes[spanAttributeServer])
+		assert.Equal(t, 1, tracer.getSpan(spanCompareAppState).attributes[spanAttributeResources])
+		assert.Equal(t, 1, tracer.getSpan(spanGetLiveObjects).attributes[spanAttributeLiveObjects])
+	})
+
+	t.Run("ComparisonError", func(t *testing.T) {
+		data := data
+		data.managedLiveObjsErr = errors.New("cluster unreachable")
+		tracer := &fakeTracer{}
+		ctrl := newFakeController(&data)
+		ctrl.appStateManager.(*appStateManager).tracer = tracer
+		compRes := ctrl.appStateManager.CompareAppState(context.Background(), app.DeepCopy(), []string{""}, []argoappv1.ApplicationSource{app.Spec.Source}, false, nil)
+
+		assert.Equal(t, []string{"cluster unreachable"}, tracer.getSpan(spanGetLiveObjects).errors)
+		var messages []string
+		for _, condition := range compRes.conditions {
+			if condition.Type == argoappv1.ApplicationConditionComparisonError {
+				messages = append(messages, condition.Message)
+			}
+		}
+		assert.NotEmpty(t, messages)
+		assert.Equal(t, messages, tracer.getSpan(spanCompareAppState).errors)
+	})
+}
@@ -58,7 +58,7 @@ func (m *ApplicationQuery) Reset()         { *m = ApplicationQuery{} }
 func (m *ApplicationQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationQuery) ProtoMessage()    {}
 func (*ApplicationQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{0}
+	return fileDescriptor_application_37a7979be1b47a24, []int{0}
 }
 func (m *ApplicationQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,7 +136,7 @@ func (m *RevisionMetadataQuery) Reset()         { *m = RevisionMetadataQuery{} }
 func (m *RevisionMetadataQuery) String() string { return proto.CompactTextString(m) }
 func (*RevisionMetadataQuery) ProtoMessage()    {}
 func (*RevisionMetadataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{1}
+	return fileDescriptor_application_37a7979be1b47a24, []int{1}
 }
 func (m *RevisionMetadataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +194,7 @@ func (m *ApplicationResourceEventsQuery) Reset()         { *m = ApplicationResou
 func (m *ApplicationResourceEventsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceEventsQuery) ProtoMessage()    {}
 func (*ApplicationResourceEventsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{2}
+	return fileDescriptor_application_37a7979be1b47a24, []int{2}
 }
 func (m *ApplicationResourceEventsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +264,7 @@ func (m *ApplicationManifestQuery) Reset()         { *m = ApplicationManifestQue
 func (m *ApplicationManifestQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQuery) ProtoMessage()    {}
 func (*ApplicationManifestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{3}
+	return fileDescriptor_application_37a7979be1b47a24, []int{3}
 }
 func (m *ApplicationManifestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +317,7 @@ func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
 func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResponse) ProtoMessage()    {}
 func (*ApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{4}
+	return fileDescriptor_application_37a7979be1b47a24, []int{4}
 }
 func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +358,7 @@ func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateReque
 func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationCreateRequest) ProtoMessage()    {}
 func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{5}
+	return fileDescriptor_application_37a7979be1b47a24, []int{5}
 }
 func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +412,7 @@ func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateReque
 func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateRequest) ProtoMessage()    {}
 func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{6}
+	return fileDescriptor_application_37a7979be1b47a24, []int{6}
 }
 func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +460,7 @@ func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteReque
 func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationDeleteRequest) ProtoMessage()    {}
 func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{7}
+	return fileDescriptor_application_37a7979be1b47a24, []int{7}
 }
 func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,6 +512,8 @@ type ApplicationSyncRequest struct {
 	Strategy             *v1alpha1.SyncStrategy           `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
 	Resources            []v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources"`
 	Manifests            []string                         `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
+	RetryStrategy        *v1alpha1.RetryStrategy          `protobuf:"bytes,9,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	IncludeHooks         bool                             `protobuf:"varint,10,opt,name=includeHooks" json:"includeHooks"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
 	XXX_unrecognized     []byte                           `json:"-"`
 	XXX_sizecache        int32                            `json:"-"`
@@ -521,7 +523,7 @@ func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{}
 func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncRequest) ProtoMessage()    {}
 func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{8}
+	return fileDescriptor_application_37a7979be1b47a24, []int{8}
 }
 func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,6 +601,20 @@ func (m *ApplicationSyncRequest) GetManifests() []string {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetRetryStrategy() *v1alpha1.RetryStrategy {
+	if m != nil {
+		return m.RetryStrategy
+	}
+	return nil
+}
+
+func (m *ApplicationSyncRequest) GetIncludeHooks() bool {
+	if m != nil {
+		return m.IncludeHooks
+	}
+	return false
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -612,7 +628,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{9}
+	return fileDescriptor_application_37a7979be1b47a24, []int{9}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +685,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{10}
+	return fileDescriptor_application_37a7979be1b47a24, []int{10}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +749,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{11}
+	return fileDescriptor_application_37a7979be1b47a24, []int{11}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +822,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{12}
+	return fileDescriptor_application_37a7979be1b47a24, []int{12}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,7 +911,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{13}
+	return fileDescriptor_application_37a7979be1b47a24, []int{13}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,7 +1013,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{14}
+	return fileDescriptor_application_37a7979be1b47a24, []int{14}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1092,7 +1108,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{15}
+	return fileDescriptor_application_37a7979be1b47a24, []int{15}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1197,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{16}
+	return fileDescriptor_application_37a7979be1b47a24, []int{16}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1228,7 +1244,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{17}
+	return fileDescriptor_application_37a7979be1b47a24, []int{17}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1298,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{18}
+	return fileDescriptor_application_37a7979be1b47a24, []int{18}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1379,7 +1395,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{19}
+	return fileDescriptor_application_37a7979be1b47a24, []int{19}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1449,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{20}
+	return fileDescriptor_application_37a7979be1b47a24, []int{20}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1480,7 +1496,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{21}
+	return fileDescriptor_application_37a7979be1b47a24, []int{21}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1529,7 +1545,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{22}
+	return fileDescriptor_application_37a7979be1b47a24, []int{22}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1609,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{23}
+	return fileDescriptor_application_37a7979be1b47a24, []int{23}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1660,7 +1676,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{24}
+	return fileDescriptor_application_37a7979be1b47a24, []int{24}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1700,7 +1716,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{25}
+	return fileDescriptor_application_37a7979be1b47a24, []int{25}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1763,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_cf211a4e2a484298, []int{26}
+	return fileDescriptor_application_37a7979be1b47a24, []int{26}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3083,6 +3099,24 @@ func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n4, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	dAtA[i] = 0x50
+	i++
+	if m.IncludeHooks {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -3115,11 +3149,11 @@ func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Spec.Size()))
-	n4, err := m.Spec.MarshalTo(dAtA[i:])
+	n5, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -3533,11 +3567,11 @@ func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintApplication(dAtA, i, uint64(m.SinceTime.Size()))
-		n5, err := m.SinceTime.MarshalTo(dAtA[i:])
+		n6, err := m.SinceTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	dAtA[i] = 0x38
 	i++
@@ -3578,11 +3612,11 @@ func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.TimeStamp.Size()))
-	n6, err := m.TimeStamp.MarshalTo(dAtA[i:])
+	n7, err := m.TimeStamp.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -4010,6 +4044,11 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5551,6 +5590,59 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &v1alpha1.RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHooks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHooks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8511,137 +8603,139 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/application/application.proto", fileDescriptor_application_cf211a4e2a484298)
-}
-
-var fileDescriptor_application_cf211a4e2a484298 = []byte{
-	// 2034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xa7, 0x66, 0x67, 0x77, 0x66, 0xdf, 0x3a, 0xb1, 0x53, 0x89, 0x4d, 0xa7, 0x3d, 0x5e, 0x8f,
-	0xca, 0x5f, 0xeb, 0xb5, 0xb7, 0xdb, 0xbb, 0x18, 0x08, 0x0b, 0x52, 0xe2, 0x8d, 0xcd, 0xda, 0xb0,
-	0x36, 0xcb, 0xac, 0x43, 0x24, 0x24, 0x84, 0x3a, 0xdd, 0xb5, 0xb3, 0xcd, 0xce, 0x74, 0x37, 0xdd,
-	0x3d, 0x63, 0x0d, 0x96, 0x0f, 0x09, 0x08, 0x71, 0x40, 0x20, 0x04, 0x87, 0x80, 0xf8, 0x52, 0xb8,
-	0x72, 0x43, 0x5c, 0x38, 0x70, 0x03, 0xe5, 0x88, 0x08, 0x67, 0x0b, 0xad, 0xf8, 0x03, 0x38, 0x71,
-	0x46, 0x55, 0x5d, 0xd5, 0x5d, 0x35, 0x9e, 0xe9, 0x19, 0xc7, 0x93, 0x83, 0x6f, 0x53, 0xaf, 0xaa,
-	0xdf, 0xfb, 0xd5, 0x7b, 0xaf, 0x7e, 0x55, 0xef, 0x0d, 0x9c, 0x4f, 0x68, 0xdc, 0xa7, 0xb1, 0xed,
-	0x44, 0x51, 0xc7, 0x77, 0x9d, 0xd4, 0x0f, 0x03, 0xf5, 0xb7, 0x15, 0xc5, 0x61, 0x1a, 0xe2, 0x25,
-	0x45, 0x64, 0xbe, 0xd2, 0x0e, 0xdb, 0x21, 0x97, 0xdb, 0xec, 0x57, 0xb6, 0xc4, 0x6c, 0xb4, 0xc3,
-	0xb0, 0xdd, 0xa1, 0xb6, 0x13, 0xf9, 0xb6, 0x13, 0x04, 0x61, 0xca, 0x17, 0x27, 0x62, 0x96, 0x1c,
-	0xbe, 0x96, 0x58, 0x7e, 0xc8, 0x67, 0xdd, 0x30, 0xa6, 0x76, 0x7f, 0xdd, 0x6e, 0xd3, 0x80, 0xc6,
-	0x4e, 0x4a, 0x3d, 0xb1, 0xe6, 0x7a, 0xb1, 0xa6, 0xeb, 0xb8, 0x07, 0x7e, 0x40, 0xe3, 0x81, 0x1d,
-	0x1d, 0xb6, 0x99, 0x20, 0xb1, 0xbb, 0x34, 0x75, 0x46, 0x7d, 0x75, 0xa7, 0xed, 0xa7, 0x07, 0xbd,
-	0x77, 0x2c, 0x37, 0xec, 0xda, 0x4e, 0xcc, 0x81, 0x7d, 0x87, 0xff, 0x58, 0x73, 0xbd, 0xe2, 0x6b,
-	0x75, 0x7b, 0xfd, 0x75, 0xa7, 0x13, 0x1d, 0x38, 0x4f, 0xaa, 0xda, 0x2a, 0x53, 0x15, 0xd3, 0x28,
-	0x14, 0xbe, 0xe2, 0x3f, 0xfd, 0x34, 0x8c, 0x07, 0xca, 0xcf, 0x4c, 0x07, 0xf9, 0x0b, 0x82, 0x13,
-	0x37, 0x0a, 0x63, 0x5f, 0xef, 0xd1, 0x78, 0x80, 0x31, 0x54, 0x03, 0xa7, 0x4b, 0x0d, 0xd4, 0x44,
-	0x2b, 0x8b, 0x2d, 0xfe, 0x1b, 0x1b, 0x50, 0x8b, 0xe9, 0x7e, 0x4c, 0x93, 0x03, 0xa3, 0xc2, 0xc5,
-	0x72, 0x88, 0x2f, 0x42, 0x8d, 0x59, 0xa6, 0x6e, 0x6a, 0xcc, 0x35, 0xe7, 0x56, 0x16, 0xb7, 0x8e,
-	0x1d, 0x3d, 0x3e, 0x5b, 0xdf, 0xcd, 0x44, 0x49, 0x4b, 0x4e, 0x62, 0x0b, 0x8e, 0xc7, 0x34, 0x09,
-	0x7b, 0xb1, 0x4b, 0xbf, 0x41, 0xe3, 0xc4, 0x0f, 0x03, 0xa3, 0xca, 0x34, 0x6d, 0x55, 0x3f, 0x7c,
-	0x7c, 0xf6, 0x53, 0xad, 0xe1, 0x49, 0xdc, 0x84, 0x7a, 0x42, 0x3b, 0xd4, 0x4d, 0xc3, 0xd8, 0x98,
-	0x57, 0x16, 0xe6, 0x52, 0xb2, 0x0d, 0x27, 0x5b, 0xb4, 0xef, 0xb3, 0xd5, 0x77, 0x69, 0xea, 0x78,
-	0x4e, 0xea, 0x0c, 0x6f, 0xa0, 0x92, 0x6f, 0xc0, 0x84, 0x7a, 0x2c, 0x16, 0x1b, 0x15, 0x2e, 0xcf,
-	0xc7, 0xcc, 0x0b, 0xcb, 0x8a, 0x17, 0x5a, 0x02, 0xc9, 0xad, 0x3e, 0x0d, 0xd2, 0x64, 0xbc, 0xca,
-	0x0d, 0x78, 0x49, 0x82, 0xbe, 0xe7, 0x74, 0x69, 0x12, 0x39, 0x2e, 0xcd, 0x74, 0x0b, 0xa8, 0x4f,
-	0x4e, 0xe3, 0x15, 0x38, 0xa6, 0x0a, 0x8d, 0x39, 0x65, 0xb9, 0x36, 0x83, 0x2f, 0xc2, 0x92, 0x1c,
-	0xbf, 0x75, 0xe7, 0xa6, 0x51, 0x55, 0x16, 0xaa, 0x13, 0x64, 0x17, 0x0c, 0x05, 0xfb, 0x5d, 0x27,
-	0xf0, 0xf7, 0x69, 0x92, 0x8e, 0x47, 0xdd, 0xd4, 0x1c, 0xa1, 0xf8, 0x35, 0x77, 0xc7, 0x49, 0x78,
-	0x59, 0xf7, 0x46, 0x14, 0x06, 0x09, 0x25, 0x1f, 0x20, 0xcd, 0xd2, 0x9b, 0x31, 0x75, 0x52, 0xda,
-	0xa2, 0xdf, 0xed, 0xd1, 0x24, 0xc5, 0x01, 0xa8, 0x87, 0x8e, 0x1b, 0x5c, 0xda, 0xf8, 0xb2, 0x55,
-	0xa4, 0xa8, 0x25, 0x53, 0x94, 0xff, 0xf8, 0xb6, 0xeb, 0x59, 0xd1, 0x61, 0xdb, 0x62, 0xd9, 0x6e,
-	0xa9, 0x07, 0x58, 0x66, 0xbb, 0xa5, 0x58, 0x92, 0xbb, 0x56, 0xd6, 0xe1, 0x53, 0xb0, 0xd0, 0x8b,
-	0x12, 0x1a, 0xa7, 0x7c, 0x0f, 0xf5, 0x96, 0x18, 0x91, 0x1f, 0xe8, 0x20, 0xdf, 0x8a, 0x3c, 0x05,
-	0xe4, 0xc1, 0x27, 0x08, 0x52, 0x83, 0x47, 0x6e, 0x6b, 0x28, 0x6e, 0xd2, 0x0e, 0x2d, 0x50, 0x8c,
-	0x0a, 0x8a, 0x01, 0x35, 0xd7, 0x49, 0x5c, 0xc7, 0xa3, 0x62, 0x3f, 0x72, 0x48, 0xde, 0x9d, 0x83,
-	0x53, 0x8a, 0xaa, 0xbd, 0x41, 0xe0, 0x96, 0x29, 0x9a, 0x18, 0x5d, 0xdc, 0x80, 0x05, 0x2f, 0x1e,
-	0xb4, 0x7a, 0x81, 0x31, 0xc7, 0x2c, 0x89, 0x79, 0x21, 0xc3, 0x26, 0xcc, 0x47, 0x71, 0x2f, 0xa0,
-	0xfc, 0x6c, 0xca, 0xc9, 0x4c, 0x84, 0x5d, 0xa8, 0x27, 0x29, 0x63, 0xa0, 0xf6, 0x80, 0x9f, 0xc8,
-	0xa5, 0x8d, 0xed, 0x67, 0xf0, 0x1d, 0xdb, 0xc9, 0x9e, 0x50, 0xd7, 0xca, 0x15, 0xe3, 0x14, 0x16,
-	0x65, 0x76, 0x27, 0x46, 0xad, 0x39, 0xb7, 0xb2, 0xb4, 0xb1, 0xfb, 0x8c, 0x56, 0xbe, 0x16, 0x31,
-	0xde, 0x54, 0x0e, 0xb6, 0xd8, 0x56, 0x61, 0x08, 0x37, 0x60, 0xb1, 0x2b, 0x4e, 0x4e, 0x62, 0xd4,
-	0x19, 0x8d, 0xb5, 0x0a, 0x01, 0x79, 0x1f, 0x41, 0xe3, 0x89, 0xa4, 0xda, 0x8b, 0x68, 0x69, 0x24,
-	0x3c, 0xa8, 0x26, 0x11, 0x75, 0x39, 0x21, 0x2c, 0x6d, 0x7c, 0x65, 0x36, 0x59, 0xc6, 0x8c, 0x0a,
-	0xf4, 0x5c, 0x3b, 0xe9, 0xc2, 0xa7, 0x95, 0xe9, 0x5d, 0x27, 0x75, 0x0f, 0xca, 0x40, 0xb1, 0xf0,
-	0xb2, 0x35, 0x1a, 0x4d, 0x65, 0x22, 0x4c, 0x60, 0x91, 0xff, 0xb8, 0x3f, 0x88, 0x74, 0x5e, 0x2a,
-	0xc4, 0xe4, 0x87, 0x08, 0x4c, 0x35, 0xe9, 0xc3, 0x4e, 0xe7, 0x1d, 0xc7, 0x3d, 0x2c, 0x37, 0x59,
-	0xf1, 0x3d, 0x6e, 0x6f, 0x6e, 0x0b, 0x98, 0xbe, 0xa3, 0xc7, 0x67, 0x2b, 0x77, 0x6e, 0xb6, 0x2a,
-	0xbe, 0xf7, 0xf1, 0x73, 0x91, 0xfc, 0x6b, 0x08, 0x88, 0x88, 0x64, 0x19, 0x10, 0x02, 0x8b, 0xc1,
-	0x48, 0x9a, 0x2e, 0xc4, 0x4f, 0x41, 0xcf, 0xcb, 0x50, 0xeb, 0xe7, 0xd7, 0x58, 0xb1, 0x48, 0x0a,
-	0x19, 0xf8, 0x76, 0x1c, 0xf6, 0x22, 0x63, 0x5e, 0xf5, 0x34, 0x17, 0x61, 0x03, 0xaa, 0x87, 0x7e,
-	0xe0, 0x19, 0x0b, 0xca, 0x14, 0x97, 0x90, 0x5f, 0x56, 0xe0, 0xec, 0x88, 0x6d, 0x4d, 0x8c, 0xeb,
-	0x73, 0xb0, 0xb7, 0x22, 0xf7, 0x6a, 0x13, 0x72, 0xaf, 0x3e, 0x3a, 0xf7, 0xfe, 0x87, 0xa0, 0x39,
-	0xc2, 0x37, 0x93, 0xc9, 0xf5, 0x39, 0x71, 0xce, 0x7e, 0x18, 0xbb, 0xd4, 0xa8, 0xe5, 0xb9, 0x8e,
-	0x5a, 0x99, 0x88, 0xfc, 0x17, 0x81, 0x21, 0x77, 0x7b, 0xc3, 0xe5, 0x7b, 0xef, 0x05, 0xcf, 0xfb,
-	0x86, 0x1b, 0xb0, 0xe0, 0xf0, 0xbd, 0x68, 0xe9, 0x20, 0x64, 0xe4, 0x47, 0x08, 0x4e, 0xeb, 0x5b,
-	0x4e, 0x76, 0xfc, 0x24, 0x95, 0x6f, 0x11, 0xec, 0x43, 0x2d, 0x5b, 0x99, 0x18, 0x88, 0xdf, 0x11,
-	0x77, 0x9e, 0x81, 0x5f, 0x75, 0x43, 0x72, 0x7b, 0x42, 0x3f, 0x79, 0x1d, 0x4e, 0x8f, 0x24, 0x1a,
-	0x81, 0xa4, 0x09, 0x75, 0x79, 0x51, 0x64, 0x31, 0x90, 0x17, 0xae, 0x94, 0x92, 0xbf, 0x55, 0x74,
-	0x8e, 0x0e, 0xbd, 0x9d, 0xb0, 0x5d, 0xf2, 0xac, 0x9c, 0x26, 0x7a, 0x06, 0xd4, 0xa2, 0xd0, 0x2b,
-	0x02, 0xd7, 0x92, 0x43, 0xf6, 0xb5, 0x1b, 0x06, 0xa9, 0xc3, 0xea, 0x11, 0x2d, 0x5e, 0x85, 0x98,
-	0xc5, 0x3e, 0xf1, 0x03, 0x97, 0xee, 0x51, 0x37, 0x0c, 0xbc, 0x84, 0x07, 0x6e, 0x4e, 0xc6, 0x5e,
-	0x9d, 0xc1, 0xb7, 0x61, 0x91, 0x8f, 0xef, 0xfb, 0x5d, 0x6a, 0x2c, 0xf0, 0x3b, 0x7f, 0xd5, 0xca,
-	0x0a, 0x1f, 0x4b, 0x2d, 0x7c, 0x0a, 0x0f, 0xb3, 0xc2, 0xc7, 0xea, 0xaf, 0x5b, 0xec, 0x8b, 0x56,
-	0xf1, 0x31, 0xc3, 0x95, 0x3a, 0x7e, 0x67, 0xc7, 0x0f, 0xf8, 0xbd, 0x5e, 0x18, 0x2c, 0xc4, 0x2c,
-	0x27, 0xf6, 0xc3, 0x4e, 0x27, 0x7c, 0xc0, 0x29, 0x20, 0xbf, 0x0e, 0x32, 0x19, 0xf9, 0x1e, 0xd4,
-	0x77, 0xc2, 0xf6, 0xad, 0x20, 0x8d, 0x07, 0x2c, 0x27, 0xd9, 0x76, 0x68, 0xa0, 0x3b, 0x5d, 0x0a,
-	0xf1, 0x3d, 0x58, 0x4c, 0xfd, 0x2e, 0xdd, 0x4b, 0x9d, 0x6e, 0x24, 0x6e, 0xe0, 0xa7, 0xc0, 0x9d,
-	0x23, 0x93, 0x2a, 0x88, 0x0d, 0xaf, 0xe6, 0xaf, 0x88, 0xfb, 0x34, 0xee, 0xfa, 0x81, 0x53, 0xca,
-	0x39, 0x64, 0x5d, 0xcb, 0x1a, 0xf6, 0x0a, 0x79, 0xdb, 0x0f, 0xbc, 0xf0, 0xc1, 0xf8, 0xb8, 0x93,
-	0x7f, 0xea, 0x55, 0x88, 0xf2, 0x4d, 0x9e, 0x6c, 0xb7, 0xe1, 0x05, 0x96, 0x96, 0x7d, 0x2a, 0x26,
-	0x44, 0xf2, 0x13, 0x2d, 0xaf, 0x47, 0xea, 0x68, 0xe9, 0x1f, 0xe2, 0x1d, 0x38, 0xee, 0x24, 0x89,
-	0xdf, 0x0e, 0xa8, 0x27, 0x75, 0x55, 0xa6, 0xd6, 0x35, 0xfc, 0x69, 0xf6, 0x7c, 0xe5, 0x2b, 0x78,
-	0x3a, 0xf2, 0xe7, 0x2b, 0x1f, 0x92, 0xef, 0x23, 0x38, 0x39, 0x52, 0x09, 0x73, 0x01, 0xa7, 0x06,
-	0xe1, 0x02, 0xc1, 0x82, 0xf5, 0xc4, 0x3d, 0xa0, 0x5e, 0xaf, 0x43, 0x65, 0x91, 0x26, 0xc7, 0x6c,
-	0xce, 0xeb, 0x65, 0x11, 0x10, 0x39, 0x9f, 0x8f, 0xf1, 0x32, 0x40, 0xd7, 0x09, 0x7a, 0x4e, 0x87,
-	0x43, 0xa8, 0x72, 0x08, 0x8a, 0x84, 0x34, 0xc0, 0x1c, 0x15, 0x3e, 0x51, 0xd8, 0xbc, 0x01, 0x2f,
-	0xca, 0x63, 0x2d, 0xc2, 0x63, 0xc1, 0x71, 0xc5, 0x0b, 0xf7, 0xf2, 0x48, 0x09, 0x5e, 0x1e, 0x9e,
-	0x24, 0x03, 0x30, 0xee, 0x3a, 0x81, 0xd3, 0xa6, 0x5e, 0xae, 0x28, 0x8f, 0xd9, 0xb7, 0x60, 0xde,
-	0x4f, 0x69, 0x57, 0xc6, 0x6a, 0x7b, 0x06, 0x44, 0x75, 0xd3, 0xdf, 0xdf, 0x6f, 0x65, 0x5a, 0x37,
-	0x3e, 0x6a, 0x00, 0x56, 0x1d, 0x4c, 0xe3, 0xbe, 0xef, 0x52, 0xfc, 0x53, 0x04, 0x55, 0xc6, 0x98,
-	0xf8, 0xcc, 0xb8, 0x78, 0xf2, 0x9d, 0x9a, 0x33, 0x7a, 0x97, 0x32, 0x53, 0xa4, 0xf1, 0xde, 0x47,
-	0xff, 0xf9, 0x79, 0xe5, 0x14, 0x7e, 0x85, 0xf7, 0x4d, 0xfa, 0xeb, 0x6a, 0x1b, 0x23, 0xc1, 0x3f,
-	0x46, 0x80, 0x05, 0x87, 0x2b, 0xd5, 0x35, 0xbe, 0x32, 0x0e, 0xdf, 0x88, 0x2a, 0xdc, 0x3c, 0xa3,
-	0x9c, 0x61, 0xcb, 0x0d, 0x63, 0xca, 0x4e, 0x2c, 0x5f, 0xc0, 0x01, 0xac, 0x72, 0x00, 0xe7, 0x31,
-	0x19, 0x05, 0xc0, 0x7e, 0xc8, 0x4e, 0xd9, 0x23, 0x9b, 0x66, 0x76, 0x7f, 0x87, 0x60, 0xfe, 0x6d,
-	0xfe, 0xf6, 0x98, 0xe0, 0xa1, 0xdd, 0xd9, 0x78, 0x88, 0xdb, 0xe2, 0x50, 0xc9, 0x39, 0x0e, 0xf3,
-	0x0c, 0x3e, 0x2d, 0x61, 0x26, 0x69, 0x4c, 0x9d, 0xae, 0x86, 0xf6, 0x1a, 0xc2, 0x1f, 0x20, 0x58,
-	0xc8, 0x8a, 0x6c, 0x7c, 0x61, 0x1c, 0x44, 0xad, 0x08, 0x37, 0x67, 0x54, 0xca, 0x92, 0xcb, 0x1c,
-	0xe0, 0x39, 0x32, 0x32, 0x90, 0x9b, 0x5a, 0x1d, 0xfe, 0x33, 0x04, 0x73, 0xdb, 0x74, 0x62, 0x9a,
-	0xcd, 0x0a, 0xd9, 0x13, 0xae, 0x1b, 0x11, 0x61, 0xfc, 0x07, 0x04, 0xaf, 0x6e, 0xd3, 0x74, 0x34,
-	0x97, 0xe2, 0x95, 0xc9, 0x04, 0x27, 0xb2, 0xed, 0xca, 0x14, 0x2b, 0x73, 0x12, 0xb1, 0x39, 0xb2,
-	0xcb, 0xf8, 0x52, 0x59, 0xee, 0x25, 0x83, 0xc0, 0x7d, 0x20, 0x70, 0xfc, 0x1d, 0xc1, 0x89, 0xe1,
-	0xf6, 0x15, 0xd6, 0xd9, 0x77, 0x64, 0x77, 0xcb, 0xfc, 0xea, 0x33, 0x31, 0x88, 0xae, 0x91, 0xdc,
-	0xe0, 0xb0, 0xbf, 0x88, 0xbf, 0x50, 0x06, 0x5b, 0xf6, 0x0e, 0x12, 0xfb, 0xa1, 0xfc, 0xf9, 0x88,
-	0x77, 0x38, 0x39, 0xe6, 0xf7, 0x10, 0x1c, 0xdb, 0xa6, 0xa9, 0xec, 0x3c, 0x25, 0xe3, 0xb3, 0x55,
-	0x6b, 0x4e, 0x99, 0x0d, 0x4b, 0x69, 0x47, 0xca, 0xa9, 0xdc, 0x9f, 0x6b, 0x1c, 0xd8, 0x25, 0x7c,
-	0xa1, 0x0c, 0x58, 0x5e, 0xa2, 0xe3, 0xbf, 0x22, 0x58, 0xc8, 0xea, 0xf2, 0xf1, 0xe6, 0xb5, 0x66,
-	0xd0, 0xcc, 0x52, 0xf2, 0x16, 0x07, 0xfa, 0xba, 0x79, 0x6d, 0x34, 0x50, 0xf5, 0x7b, 0xe9, 0x32,
-	0x8b, 0xa3, 0xd7, 0x0f, 0xd2, 0x9f, 0x10, 0x40, 0xd1, 0x58, 0xc0, 0x97, 0xcb, 0x37, 0xa1, 0x34,
-	0x1f, 0xcc, 0x19, 0xb6, 0x16, 0x88, 0xc5, 0x37, 0xb3, 0x62, 0x36, 0x4b, 0xb3, 0x38, 0xa2, 0xee,
-	0x26, 0x6f, 0x3f, 0xe0, 0xdf, 0x20, 0x98, 0xe7, 0xc5, 0x29, 0x3e, 0x3f, 0x0e, 0xb0, 0x5a, 0xbb,
-	0xce, 0xcc, 0xe9, 0x17, 0x39, 0xce, 0xe6, 0x46, 0x19, 0x0f, 0x6c, 0xa2, 0x55, 0xdc, 0x87, 0x85,
-	0xac, 0x3e, 0x1c, 0x9f, 0x15, 0x5a, 0xfd, 0x68, 0x36, 0x4b, 0xae, 0xa3, 0x2c, 0x31, 0x05, 0x05,
-	0xad, 0x96, 0x52, 0xd0, 0xef, 0x11, 0x54, 0x19, 0x4b, 0xe0, 0x73, 0x65, 0x1c, 0x32, 0x6b, 0xaf,
-	0x5c, 0xe1, 0xd0, 0x2e, 0x90, 0xe6, 0x24, 0x0e, 0x62, 0xae, 0x79, 0x1f, 0xc1, 0x89, 0xe1, 0x47,
-	0x0b, 0x3e, 0x3d, 0xc4, 0x3f, 0xea, 0xab, 0xc8, 0xd4, 0x5d, 0x38, 0xee, 0xc1, 0x43, 0xde, 0xe0,
-	0x28, 0x36, 0xf1, 0x6b, 0x13, 0x0f, 0xc4, 0x3d, 0x79, 0x88, 0x99, 0xa2, 0xb5, 0xa2, 0x1b, 0xf7,
-	0x67, 0x04, 0xc7, 0xa4, 0xde, 0xfb, 0x31, 0xa5, 0xe5, 0xb0, 0x66, 0x94, 0xff, 0xcc, 0x10, 0xf9,
-	0x12, 0xc7, 0xfe, 0x39, 0x7c, 0x7d, 0x4a, 0xec, 0x12, 0xf3, 0x5a, 0xca, 0x60, 0xfe, 0x11, 0x41,
-	0x5d, 0xb6, 0xc4, 0xf0, 0xa5, 0xb1, 0x99, 0xa4, 0x37, 0xcd, 0x66, 0x16, 0x7d, 0x71, 0x03, 0x91,
-	0xf3, 0xa5, 0x54, 0x2e, 0x8c, 0xb3, 0x0c, 0xf8, 0x05, 0x02, 0x9c, 0xbf, 0x86, 0xf3, 0xf7, 0x31,
-	0xbe, 0xa8, 0x99, 0x1a, 0x5b, 0xf6, 0x98, 0x97, 0x26, 0xae, 0xd3, 0xa9, 0x7c, 0xb5, 0x94, 0xca,
-	0xc3, 0xdc, 0xfe, 0x4f, 0x10, 0x2c, 0x6d, 0xd3, 0xfc, 0x9d, 0x58, 0xe2, 0x48, 0xbd, 0xe9, 0x67,
-	0xae, 0x4c, 0x5e, 0x28, 0x10, 0x5d, 0xe5, 0x88, 0x2e, 0xe2, 0x72, 0x57, 0x49, 0x00, 0xbf, 0x46,
-	0xf0, 0x82, 0x60, 0x31, 0x21, 0xb9, 0x3a, 0xc9, 0x92, 0x46, 0x7a, 0xd3, 0xe3, 0xfa, 0x0c, 0xc7,
-	0xb5, 0x46, 0xa6, 0xc2, 0xb5, 0x29, 0x7a, 0x67, 0xbf, 0x45, 0xf0, 0xb2, 0xfa, 0xb0, 0x16, 0xfd,
-	0x92, 0x8f, 0xeb, 0xb7, 0x92, 0xb6, 0x0b, 0xb9, 0xce, 0xf1, 0x59, 0xf8, 0xea, 0x34, 0xf8, 0x6c,
-	0xd1, 0x41, 0xc1, 0xbf, 0x42, 0xf0, 0x12, 0xef, 0x58, 0xa9, 0x8a, 0x87, 0x08, 0x79, 0x5c, 0x7f,
-	0x6b, 0x0a, 0x42, 0x16, 0x67, 0x96, 0x3c, 0x15, 0xa8, 0x4d, 0xd1, 0x69, 0x62, 0x85, 0xd2, 0x8b,
-	0xf2, 0x0a, 0x10, 0xd1, 0x5d, 0x9b, 0xe4, 0xb8, 0xa7, 0xbd, 0x32, 0x44, 0xba, 0xad, 0x4e, 0x97,
-	0x6e, 0xef, 0x22, 0xa8, 0x89, 0x26, 0x51, 0xc9, 0xad, 0xaa, 0x74, 0x91, 0xcc, 0x93, 0xda, 0x2a,
-	0xd9, 0x24, 0x21, 0x9f, 0xe7, 0x66, 0xd7, 0xb1, 0x5d, 0x66, 0x36, 0x0a, 0xbd, 0xc4, 0x7e, 0x28,
-	0xba, 0x47, 0x8f, 0xec, 0x4e, 0xd8, 0x4e, 0xae, 0xa1, 0xad, 0x37, 0x3f, 0x3c, 0x5a, 0x46, 0xff,
-	0x38, 0x5a, 0x46, 0xff, 0x3e, 0x5a, 0x46, 0xdf, 0xfc, 0xec, 0x14, 0x7f, 0x5a, 0xbb, 0x1d, 0x9f,
-	0x06, 0xa9, 0x6a, 0xe2, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x04, 0xad, 0xa4, 0xad, 0x1f,
-	0x00, 0x00,
+	proto.RegisterFile("server/application/application.proto", fileDescriptor_application_37a7979be1b47a24)
+}
+
+var fileDescriptor_application_37a7979be1b47a24 = []byte{
+	// 2072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xa7, 0xc6, 0x63, 0xcf, 0xf8, 0x39, 0xd9, 0x64, 0x6b, 0x37, 0xa1, 0xb7, 0xe3, 0x38, 0xa3,
+	0x4a, 0xe2, 0x38, 0x4e, 0xdc, 0x13, 0x9b, 0x00, 0x8b, 0x41, 0xda, 0x8d, 0x37, 0xc1, 0x0e, 0x38,
+	0xc1, 0xb4, 0xb3, 0xac, 0x84, 0x84, 0x50, 0x6f, 0x77, 0x79, 0xdc, 0x78, 0xa6, 0xbb, 0xe9, 0xee,
+	0x99, 0x68, 0x88, 0x72, 0x60, 0x41, 0x88, 0x03, 0x02, 0x21, 0x38, 0x2c, 0xdf, 0x68, 0xb9, 0x72,
+	0x43, 0x5c, 0x38, 0x70, 0x03, 0xed, 0x11, 0xb1, 0x9c, 0x23, 0x64, 0xf1, 0x07, 0x70, 0xe2, 0x8c,
+	0xaa, 0xba, 0xaa, 0xbb, 0xca, 0x99, 0xe9, 0x99, 0xc4, 0xb3, 0x87, 0xdc, 0xba, 0x5e, 0xbd, 0x7a,
+	0xef, 0xf7, 0x3e, 0xea, 0x55, 0xd5, 0x6b, 0xb8, 0x94, 0xd0, 0xb8, 0x47, 0xe3, 0xa6, 0x13, 0x45,
+	0x6d, 0xdf, 0x75, 0x52, 0x3f, 0x0c, 0xd4, 0x6f, 0x2b, 0x8a, 0xc3, 0x34, 0xc4, 0x73, 0x0a, 0xc9,
+	0x7c, 0xb5, 0x15, 0xb6, 0x42, 0x4e, 0x6f, 0xb2, 0xaf, 0x8c, 0xc5, 0x9c, 0x6f, 0x85, 0x61, 0xab,
+	0x4d, 0x9b, 0x4e, 0xe4, 0x37, 0x9d, 0x20, 0x08, 0x53, 0xce, 0x9c, 0x88, 0x59, 0x72, 0xf0, 0x7a,
+	0x62, 0xf9, 0x21, 0x9f, 0x75, 0xc3, 0x98, 0x36, 0x7b, 0xab, 0xcd, 0x16, 0x0d, 0x68, 0xec, 0xa4,
+	0xd4, 0x13, 0x3c, 0x37, 0x0b, 0x9e, 0x8e, 0xe3, 0xee, 0xfb, 0x01, 0x8d, 0xfb, 0xcd, 0xe8, 0xa0,
+	0xc5, 0x08, 0x49, 0xb3, 0x43, 0x53, 0x67, 0xd0, 0xaa, 0xbb, 0x2d, 0x3f, 0xdd, 0xef, 0xbe, 0x6b,
+	0xb9, 0x61, 0xa7, 0xe9, 0xc4, 0x1c, 0xd8, 0xb7, 0xf8, 0xc7, 0x8a, 0xeb, 0x15, 0xab, 0x55, 0xf3,
+	0x7a, 0xab, 0x4e, 0x3b, 0xda, 0x77, 0x9e, 0x16, 0xb5, 0x51, 0x26, 0x2a, 0xa6, 0x51, 0x28, 0x7c,
+	0xc5, 0x3f, 0xfd, 0x34, 0x8c, 0xfb, 0xca, 0x67, 0x26, 0x83, 0xfc, 0x05, 0xc1, 0xe9, 0x5b, 0x85,
+	0xb2, 0xaf, 0x76, 0x69, 0xdc, 0xc7, 0x18, 0xaa, 0x81, 0xd3, 0xa1, 0x06, 0x6a, 0xa0, 0xa5, 0x59,
+	0x9b, 0x7f, 0x63, 0x03, 0x6a, 0x31, 0xdd, 0x8b, 0x69, 0xb2, 0x6f, 0x54, 0x38, 0x59, 0x0e, 0xf1,
+	0x22, 0xd4, 0x98, 0x66, 0xea, 0xa6, 0xc6, 0x54, 0x63, 0x6a, 0x69, 0x76, 0xe3, 0xc4, 0xe1, 0x93,
+	0x0b, 0xf5, 0x9d, 0x8c, 0x94, 0xd8, 0x72, 0x12, 0x5b, 0x70, 0x2a, 0xa6, 0x49, 0xd8, 0x8d, 0x5d,
+	0xfa, 0x35, 0x1a, 0x27, 0x7e, 0x18, 0x18, 0x55, 0x26, 0x69, 0xa3, 0xfa, 0xe1, 0x93, 0x0b, 0x9f,
+	0xb0, 0x8f, 0x4e, 0xe2, 0x06, 0xd4, 0x13, 0xda, 0xa6, 0x6e, 0x1a, 0xc6, 0xc6, 0xb4, 0xc2, 0x98,
+	0x53, 0xc9, 0x26, 0x9c, 0xb1, 0x69, 0xcf, 0x67, 0xdc, 0xf7, 0x68, 0xea, 0x78, 0x4e, 0xea, 0x1c,
+	0x35, 0xa0, 0x92, 0x1b, 0x60, 0x42, 0x3d, 0x16, 0xcc, 0x46, 0x85, 0xd3, 0xf3, 0x31, 0xf3, 0xc2,
+	0x82, 0xe2, 0x05, 0x5b, 0x20, 0xb9, 0xd3, 0xa3, 0x41, 0x9a, 0x0c, 0x17, 0xb9, 0x06, 0x2f, 0x4b,
+	0xd0, 0xf7, 0x9d, 0x0e, 0x4d, 0x22, 0xc7, 0xa5, 0x99, 0x6c, 0x01, 0xf5, 0xe9, 0x69, 0xbc, 0x04,
+	0x27, 0x54, 0xa2, 0x31, 0xa5, 0xb0, 0x6b, 0x33, 0x78, 0x11, 0xe6, 0xe4, 0xf8, 0xed, 0xbb, 0xb7,
+	0x8d, 0xaa, 0xc2, 0xa8, 0x4e, 0x90, 0x1d, 0x30, 0x14, 0xec, 0xf7, 0x9c, 0xc0, 0xdf, 0xa3, 0x49,
+	0x3a, 0x1c, 0x75, 0x43, 0x73, 0x84, 0xe2, 0xd7, 0xdc, 0x1d, 0x67, 0xe0, 0x15, 0xdd, 0x1b, 0x51,
+	0x18, 0x24, 0x94, 0x7c, 0x80, 0x34, 0x4d, 0x6f, 0xc5, 0xd4, 0x49, 0xa9, 0x4d, 0xbf, 0xdd, 0xa5,
+	0x49, 0x8a, 0x03, 0x50, 0x37, 0x1d, 0x57, 0x38, 0xb7, 0xf6, 0x45, 0xab, 0x48, 0x51, 0x4b, 0xa6,
+	0x28, 0xff, 0xf8, 0xa6, 0xeb, 0x59, 0xd1, 0x41, 0xcb, 0x62, 0xd9, 0x6e, 0xa9, 0x1b, 0x58, 0x66,
+	0xbb, 0xa5, 0x68, 0x92, 0x56, 0x2b, 0x7c, 0xf8, 0x2c, 0xcc, 0x74, 0xa3, 0x84, 0xc6, 0x29, 0xb7,
+	0xa1, 0x6e, 0x8b, 0x11, 0xf9, 0xbe, 0x0e, 0xf2, 0xed, 0xc8, 0x53, 0x40, 0xee, 0x7f, 0x8c, 0x20,
+	0x35, 0x78, 0x64, 0x4b, 0x43, 0x71, 0x9b, 0xb6, 0x69, 0x81, 0x62, 0x50, 0x50, 0x0c, 0xa8, 0xb9,
+	0x4e, 0xe2, 0x3a, 0x1e, 0x15, 0xf6, 0xc8, 0x21, 0xf9, 0x55, 0x15, 0xce, 0x2a, 0xa2, 0x76, 0xfb,
+	0x81, 0x5b, 0x26, 0x68, 0x64, 0x74, 0xf1, 0x3c, 0xcc, 0x78, 0x71, 0xdf, 0xee, 0x06, 0xc6, 0x14,
+	0xd3, 0x24, 0xe6, 0x05, 0x0d, 0x9b, 0x30, 0x1d, 0xc5, 0xdd, 0x80, 0xf2, 0xbd, 0x29, 0x27, 0x33,
+	0x12, 0x76, 0xa1, 0x9e, 0xa4, 0xac, 0x02, 0xb5, 0xfa, 0x7c, 0x47, 0xce, 0xad, 0x6d, 0x1e, 0xc3,
+	0x77, 0xcc, 0x92, 0x5d, 0x21, 0xce, 0xce, 0x05, 0xe3, 0x14, 0x66, 0x65, 0x76, 0x27, 0x46, 0xad,
+	0x31, 0xb5, 0x34, 0xb7, 0xb6, 0x73, 0x4c, 0x2d, 0x5f, 0x89, 0x58, 0xdd, 0x54, 0x36, 0xb6, 0x30,
+	0xab, 0x50, 0x84, 0xe7, 0x61, 0xb6, 0x23, 0x76, 0x4e, 0x62, 0xd4, 0x59, 0x19, 0xb3, 0x0b, 0x02,
+	0x0e, 0xe0, 0x64, 0x4c, 0xd3, 0xb8, 0x2f, 0xe1, 0x1a, 0xb3, 0xdc, 0xfa, 0xad, 0x63, 0xe0, 0xb2,
+	0x55, 0x79, 0xb6, 0x2e, 0x9e, 0x15, 0x09, 0x3f, 0x70, 0xdb, 0x5d, 0x8f, 0x6e, 0x85, 0xe1, 0x41,
+	0x62, 0x80, 0x12, 0x0b, 0x6d, 0x86, 0xbc, 0x8f, 0x60, 0xfe, 0xa9, 0x74, 0xdf, 0x8d, 0x68, 0x69,
+	0x8e, 0x78, 0x50, 0x4d, 0x22, 0xea, 0xf2, 0x52, 0x35, 0xb7, 0xf6, 0xa5, 0xc9, 0xe4, 0x3f, 0x53,
+	0x2a, 0x20, 0x72, 0xe9, 0xa4, 0x03, 0x9f, 0x54, 0xa6, 0x77, 0x9c, 0xd4, 0xdd, 0x2f, 0x03, 0xc5,
+	0x12, 0x8f, 0xf1, 0x68, 0x05, 0x34, 0x23, 0x61, 0x02, 0xb3, 0xfc, 0xe3, 0x41, 0x3f, 0xd2, 0x2b,
+	0x66, 0x41, 0x26, 0x3f, 0x40, 0x60, 0xaa, 0xdb, 0x31, 0x6c, 0xb7, 0xdf, 0x75, 0xdc, 0x83, 0x72,
+	0x95, 0x15, 0xdf, 0xe3, 0xfa, 0xa6, 0x36, 0x80, 0xc9, 0x3b, 0x7c, 0x72, 0xa1, 0x72, 0xf7, 0xb6,
+	0x5d, 0xf1, 0xbd, 0xe7, 0xdf, 0x25, 0xe4, 0x5f, 0x47, 0x80, 0x88, 0x1c, 0x2b, 0x03, 0x42, 0x60,
+	0x36, 0x18, 0x78, 0x80, 0x14, 0xe4, 0x67, 0x38, 0x38, 0x16, 0xa0, 0xd6, 0xcb, 0x0f, 0xd8, 0x82,
+	0x49, 0x12, 0x19, 0xf8, 0x56, 0x1c, 0x76, 0x23, 0x63, 0x5a, 0xf5, 0x34, 0x27, 0x61, 0x03, 0xaa,
+	0x07, 0x7e, 0xe0, 0x19, 0x33, 0xca, 0x14, 0xa7, 0x90, 0x5f, 0x54, 0xe0, 0xc2, 0x00, 0xb3, 0x46,
+	0xc6, 0xf5, 0x05, 0xb0, 0xad, 0xc8, 0xbd, 0xda, 0x88, 0xdc, 0xab, 0x0f, 0xce, 0xbd, 0xff, 0x21,
+	0x68, 0x0c, 0xf0, 0xcd, 0xe8, 0xb2, 0xff, 0x82, 0x38, 0x67, 0x2f, 0x8c, 0x5d, 0x6a, 0xd4, 0xf2,
+	0x5c, 0x47, 0x76, 0x46, 0x22, 0xff, 0x45, 0x60, 0x48, 0x6b, 0x6f, 0xb9, 0xdc, 0xf6, 0x6e, 0xf0,
+	0xa2, 0x1b, 0x3c, 0x0f, 0x33, 0x0e, 0xb7, 0x45, 0x4b, 0x07, 0x41, 0x23, 0x3f, 0x44, 0x70, 0x4e,
+	0x37, 0x39, 0xd9, 0xf6, 0x93, 0x54, 0xde, 0x92, 0xb0, 0x0f, 0xb5, 0x8c, 0x33, 0x31, 0x10, 0x3f,
+	0xbd, 0xee, 0x1e, 0xeb, 0x94, 0x50, 0x15, 0x49, 0xf3, 0x84, 0x7c, 0xf2, 0x06, 0x9c, 0x1b, 0x58,
+	0x68, 0x04, 0x92, 0x06, 0xd4, 0xe5, 0x11, 0x96, 0xc5, 0x40, 0x5e, 0x05, 0x24, 0x95, 0xfc, 0xad,
+	0xa2, 0xd7, 0xe8, 0xd0, 0xdb, 0x0e, 0x5b, 0x25, 0x17, 0xde, 0x71, 0xa2, 0x67, 0x40, 0x2d, 0x0a,
+	0xbd, 0x22, 0x70, 0xb6, 0x1c, 0xb2, 0xd5, 0x6e, 0x18, 0xa4, 0x0e, 0x7b, 0x29, 0x69, 0xf1, 0x2a,
+	0xc8, 0x2c, 0xf6, 0x89, 0x1f, 0xb8, 0x74, 0x97, 0xba, 0x61, 0xe0, 0x25, 0x3c, 0x70, 0x53, 0x32,
+	0xf6, 0xea, 0x0c, 0xde, 0x82, 0x59, 0x3e, 0x7e, 0xe0, 0x77, 0xa8, 0x31, 0xc3, 0xcf, 0xe3, 0x65,
+	0x2b, 0x7b, 0x92, 0x59, 0xea, 0x93, 0xac, 0xf0, 0x30, 0x7b, 0x92, 0x59, 0xbd, 0x55, 0x8b, 0xad,
+	0xb0, 0x8b, 0xc5, 0x0c, 0x57, 0xea, 0xf8, 0xed, 0x6d, 0x3f, 0xe0, 0x37, 0x8e, 0x42, 0x61, 0x41,
+	0x66, 0x39, 0xb1, 0x17, 0xb6, 0xdb, 0xe1, 0x43, 0x5e, 0x02, 0xf2, 0xe3, 0x20, 0xa3, 0x91, 0xef,
+	0x40, 0x7d, 0x3b, 0x6c, 0xdd, 0x09, 0xd2, 0xb8, 0xcf, 0x72, 0x92, 0x99, 0x43, 0x03, 0xdd, 0xe9,
+	0x92, 0x88, 0xef, 0xc3, 0x6c, 0xea, 0x77, 0xe8, 0x6e, 0xea, 0x74, 0x22, 0x71, 0x02, 0x3f, 0x03,
+	0xee, 0x1c, 0x99, 0x14, 0x41, 0x9a, 0xf0, 0x5a, 0x7e, 0xbf, 0x79, 0x40, 0xe3, 0x8e, 0x1f, 0x38,
+	0xa5, 0x35, 0x87, 0xac, 0x6a, 0x59, 0xc3, 0xee, 0x47, 0xef, 0xf8, 0x81, 0x17, 0x3e, 0x1c, 0x1e,
+	0x77, 0xf2, 0x4f, 0xfd, 0x7d, 0xa4, 0xac, 0xc9, 0x93, 0x6d, 0x0b, 0x4e, 0xb2, 0xb4, 0xec, 0x51,
+	0x31, 0x21, 0x92, 0x9f, 0x68, 0x79, 0x3d, 0x50, 0x86, 0xad, 0x2f, 0xc4, 0xdb, 0x70, 0xca, 0x49,
+	0x12, 0xbf, 0x15, 0x50, 0x4f, 0xca, 0xaa, 0x8c, 0x2d, 0xeb, 0xe8, 0xd2, 0xec, 0x62, 0xcd, 0x39,
+	0x78, 0x3a, 0xf2, 0x8b, 0x35, 0x1f, 0x92, 0xef, 0x21, 0x38, 0x33, 0x50, 0x08, 0x73, 0x01, 0x2f,
+	0x0d, 0xc2, 0x05, 0xa2, 0x0a, 0xd6, 0x13, 0x77, 0x9f, 0x7a, 0xdd, 0x36, 0x95, 0xcf, 0x47, 0x39,
+	0x66, 0x73, 0x5e, 0x37, 0x8b, 0x80, 0xc8, 0xf9, 0x7c, 0x8c, 0x17, 0x00, 0x3a, 0x4e, 0xd0, 0x75,
+	0xda, 0x1c, 0x42, 0x95, 0x43, 0x50, 0x28, 0x64, 0x1e, 0xcc, 0x41, 0xe1, 0x13, 0x4f, 0xae, 0x37,
+	0xe1, 0x25, 0xb9, 0xad, 0x45, 0x78, 0x2c, 0x38, 0xa5, 0x78, 0xe1, 0x7e, 0x1e, 0x29, 0x51, 0x97,
+	0x8f, 0x4e, 0x92, 0x3e, 0x18, 0xf7, 0x9c, 0xc0, 0x69, 0x51, 0x2f, 0x17, 0x94, 0xc7, 0xec, 0x1b,
+	0x30, 0xed, 0xa7, 0xb4, 0x23, 0x63, 0xb5, 0x39, 0x81, 0x42, 0x75, 0xdb, 0xdf, 0xdb, 0xb3, 0x33,
+	0xa9, 0x6b, 0x1f, 0xcd, 0x03, 0x56, 0x1d, 0x4c, 0xe3, 0x9e, 0xef, 0x52, 0xfc, 0x13, 0x04, 0x55,
+	0x56, 0x31, 0xf1, 0xf9, 0x61, 0xf1, 0xe4, 0x96, 0x9a, 0x13, 0xba, 0x97, 0x32, 0x55, 0x64, 0xfe,
+	0xbd, 0x8f, 0xfe, 0xf3, 0xb3, 0xca, 0x59, 0xfc, 0x2a, 0xef, 0xe8, 0xf4, 0x56, 0xd5, 0x06, 0x4b,
+	0x82, 0x7f, 0x84, 0x00, 0x8b, 0x1a, 0xae, 0xbc, 0xfb, 0xf1, 0xb5, 0x61, 0xf8, 0x06, 0xf4, 0x07,
+	0xcc, 0xf3, 0xca, 0x1e, 0xb6, 0xdc, 0x30, 0xa6, 0x6c, 0xc7, 0x72, 0x06, 0x0e, 0x60, 0x99, 0x03,
+	0xb8, 0x84, 0xc9, 0x20, 0x00, 0xcd, 0x47, 0x6c, 0x97, 0x3d, 0x6e, 0xd2, 0x4c, 0xef, 0xef, 0x10,
+	0x4c, 0xbf, 0xc3, 0xef, 0x1e, 0x23, 0x3c, 0xb4, 0x33, 0x19, 0x0f, 0x71, 0x5d, 0x1c, 0x2a, 0xb9,
+	0xc8, 0x61, 0x9e, 0xc7, 0xe7, 0x24, 0xcc, 0x24, 0x8d, 0xa9, 0xd3, 0xd1, 0xd0, 0xde, 0x40, 0xf8,
+	0x03, 0x04, 0x33, 0xd9, 0xf3, 0x1f, 0x5f, 0x1e, 0x06, 0x51, 0x6b, 0x0f, 0x98, 0x13, 0x7a, 0x64,
+	0x93, 0xab, 0x1c, 0xe0, 0x45, 0x32, 0x30, 0x90, 0xeb, 0x5a, 0x87, 0xe0, 0xa7, 0x08, 0xa6, 0x36,
+	0xe9, 0xc8, 0x34, 0x9b, 0x14, 0xb2, 0xa7, 0x5c, 0x37, 0x20, 0xc2, 0xf8, 0x0f, 0x08, 0x5e, 0xdb,
+	0xa4, 0xe9, 0xe0, 0x5a, 0x8a, 0x97, 0x46, 0x17, 0x38, 0x91, 0x6d, 0xd7, 0xc6, 0xe0, 0xcc, 0x8b,
+	0x48, 0x93, 0x23, 0xbb, 0x8a, 0xaf, 0x94, 0xe5, 0x5e, 0xd2, 0x0f, 0xdc, 0x87, 0x02, 0xc7, 0xdf,
+	0x11, 0x9c, 0x3e, 0xda, 0x58, 0xc3, 0x7a, 0xf5, 0x1d, 0xd8, 0x77, 0x33, 0xbf, 0x7c, 0xac, 0x0a,
+	0xa2, 0x4b, 0x24, 0xb7, 0x38, 0xec, 0xcf, 0xe3, 0xcf, 0x95, 0xc1, 0x96, 0x5d, 0x8d, 0xa4, 0xf9,
+	0x48, 0x7e, 0x3e, 0xe6, 0xbd, 0x57, 0x8e, 0xf9, 0x3d, 0x04, 0x27, 0x36, 0x69, 0x7a, 0x2f, 0x7f,
+	0xc8, 0x0f, 0xcd, 0x56, 0xad, 0x6d, 0x66, 0xce, 0x5b, 0x4a, 0xa3, 0x54, 0x4e, 0xe5, 0xfe, 0x5c,
+	0xe1, 0xc0, 0xae, 0xe0, 0xcb, 0x65, 0xc0, 0x8a, 0xe6, 0xc1, 0x5f, 0x11, 0xcc, 0x64, 0xef, 0xf2,
+	0xe1, 0xea, 0xb5, 0x36, 0xd5, 0xc4, 0x52, 0xf2, 0x0e, 0x07, 0xfa, 0x86, 0x79, 0x63, 0x30, 0x50,
+	0x75, 0xbd, 0x74, 0x99, 0xc5, 0xd1, 0xeb, 0x1b, 0xe9, 0x4f, 0x08, 0xa0, 0x68, 0x2c, 0xe0, 0xab,
+	0xe5, 0x46, 0x28, 0xcd, 0x07, 0x73, 0x82, 0xad, 0x05, 0x62, 0x71, 0x63, 0x96, 0xcc, 0x46, 0x69,
+	0x16, 0x47, 0xd4, 0x5d, 0xe7, 0xed, 0x07, 0xfc, 0x1b, 0x04, 0xd3, 0xfc, 0x71, 0x8a, 0x2f, 0x0d,
+	0x03, 0xac, 0xbe, 0x5d, 0x27, 0xe6, 0xf4, 0x45, 0x8e, 0xb3, 0xb1, 0x56, 0x56, 0x07, 0xd6, 0xd1,
+	0x32, 0xee, 0xc1, 0x4c, 0xf6, 0x3e, 0x1c, 0x9e, 0x15, 0xda, 0xfb, 0xd1, 0x6c, 0x94, 0x1c, 0x47,
+	0x59, 0x62, 0x8a, 0x12, 0xb4, 0x5c, 0x5a, 0x82, 0x7e, 0x8f, 0xa0, 0xca, 0xaa, 0x04, 0xbe, 0x58,
+	0x56, 0x43, 0x26, 0xed, 0x95, 0x6b, 0x1c, 0xda, 0x65, 0xd2, 0x18, 0x55, 0x83, 0x98, 0x6b, 0xde,
+	0x47, 0x70, 0xfa, 0xe8, 0xa5, 0x05, 0x9f, 0x3b, 0x52, 0x7f, 0xd4, 0x5b, 0x91, 0xa9, 0xbb, 0x70,
+	0xd8, 0x85, 0x87, 0xbc, 0xc9, 0x51, 0xac, 0xe3, 0xd7, 0x47, 0x6e, 0x88, 0xfb, 0x72, 0x13, 0x33,
+	0x41, 0x2b, 0x45, 0x9f, 0xf0, 0xcf, 0x08, 0x4e, 0x48, 0xb9, 0x0f, 0x62, 0x4a, 0xcb, 0x61, 0x4d,
+	0x28, 0xff, 0x99, 0x22, 0xf2, 0x05, 0x8e, 0xfd, 0x33, 0xf8, 0xe6, 0x98, 0xd8, 0x25, 0xe6, 0x95,
+	0x94, 0xc1, 0xfc, 0x23, 0x82, 0xba, 0x6c, 0x89, 0xe1, 0x2b, 0x43, 0x33, 0x49, 0x6f, 0x9a, 0x4d,
+	0x2c, 0xfa, 0xe2, 0x04, 0x22, 0x97, 0x4a, 0x4b, 0xb9, 0x50, 0xce, 0x32, 0xe0, 0xe7, 0x08, 0x70,
+	0x7e, 0x1b, 0xce, 0xef, 0xc7, 0x78, 0x51, 0x53, 0x35, 0xf4, 0xd9, 0x63, 0x5e, 0x19, 0xc9, 0xa7,
+	0x97, 0xf2, 0xe5, 0xd2, 0x52, 0x1e, 0xe6, 0xfa, 0x7f, 0x8c, 0x60, 0x6e, 0x93, 0xe6, 0xf7, 0xc4,
+	0x12, 0x47, 0xea, 0x4d, 0x3f, 0x73, 0x69, 0x34, 0xa3, 0x40, 0x74, 0x9d, 0x23, 0x5a, 0xc4, 0xe5,
+	0xae, 0x92, 0x00, 0x7e, 0x8d, 0xe0, 0xa4, 0xa8, 0x62, 0x82, 0x72, 0x7d, 0x94, 0x26, 0xad, 0xe8,
+	0x8d, 0x8f, 0xeb, 0x53, 0x1c, 0xd7, 0x0a, 0x19, 0x0b, 0xd7, 0xba, 0xe8, 0x9d, 0xfd, 0x16, 0xc1,
+	0x2b, 0xea, 0xc5, 0x5a, 0xf4, 0x4b, 0x9e, 0xd7, 0x6f, 0x25, 0x6d, 0x17, 0x72, 0x93, 0xe3, 0xb3,
+	0xf0, 0xf5, 0x71, 0xf0, 0x35, 0x45, 0x07, 0x05, 0xff, 0x12, 0xc1, 0xcb, 0xbc, 0x63, 0xa5, 0x0a,
+	0x3e, 0x52, 0x90, 0x87, 0xf5, 0xb7, 0xc6, 0x28, 0xc8, 0x62, 0xcf, 0x92, 0x67, 0x02, 0xb5, 0x2e,
+	0x3a, 0x4d, 0xec, 0xa1, 0xf4, 0x92, 0x3c, 0x02, 0x44, 0x74, 0x57, 0x46, 0x39, 0xee, 0x59, 0x8f,
+	0x0c, 0x91, 0x6e, 0xcb, 0xe3, 0xa5, 0xdb, 0x77, 0x11, 0xd4, 0x44, 0x93, 0xa8, 0xe4, 0x54, 0x55,
+	0xba, 0x48, 0xe6, 0x19, 0x8d, 0x4b, 0x36, 0x49, 0xc8, 0x67, 0xb9, 0xda, 0x55, 0xdc, 0x2c, 0x53,
+	0x1b, 0x85, 0x5e, 0xd2, 0x7c, 0x24, 0xba, 0x47, 0x8f, 0x9b, 0xed, 0xb0, 0x95, 0xdc, 0x40, 0x1b,
+	0x6f, 0x7d, 0x78, 0xb8, 0x80, 0xfe, 0x71, 0xb8, 0x80, 0xfe, 0x7d, 0xb8, 0x80, 0xbe, 0xfe, 0xe9,
+	0x31, 0x7e, 0xa7, 0xbb, 0x6d, 0x9f, 0x06, 0xa9, 0xaa, 0xe2, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x6e, 0x3b, 0x28, 0x98, 0x47, 0x20, 0x00, 0x00,
 }
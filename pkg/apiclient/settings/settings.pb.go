@@ -83,11 +83,15 @@ type Settings struct {
 	GoogleAnalytics    *GoogleAnalyticsConfig                `protobuf:"bytes,7,opt,name=googleAnalytics" json:"googleAnalytics,omitempty"`
 	KustomizeOptions   *v1alpha1.KustomizeOptions            `protobuf:"bytes,8,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
 	// Help settings
-	Help                 *Help     `protobuf:"bytes,9,opt,name=help" json:"help,omitempty"`
-	Plugins              []*Plugin `protobuf:"bytes,10,rep,name=plugins" json:"plugins,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Help    *Help     `protobuf:"bytes,9,opt,name=help" json:"help,omitempty"`
+	Plugins []*Plugin `protobuf:"bytes,10,rep,name=plugins" json:"plugins,omitempty"`
+	// trackingMethod is the method by which the resources of applications are tracked
+	TrackingMethod string `protobuf:"bytes,11,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	// trackingValuePrefix is prepended to the application name in the value by which the resources of applications are tracked
+	TrackingValuePrefix  string   `protobuf:"bytes,12,opt,name=trackingValuePrefix,proto3" json:"trackingValuePrefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -193,6 +197,20 @@ func (m *Settings) GetPlugins() []*Plugin {
 	return nil
 }
 
+func (m *Settings) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
+func (m *Settings) GetTrackingValuePrefix() string {
+	if m != nil {
+		return m.TrackingValuePrefix
+	}
+	return ""
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -779,6 +797,18 @@ func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.TrackingMethod) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingMethod)))
+		i += copy(dAtA[i:], m.TrackingMethod)
+	}
+	if len(m.TrackingValuePrefix) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingValuePrefix)))
+		i += copy(dAtA[i:], m.TrackingValuePrefix)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1107,6 +1137,14 @@ func (m *Settings) Size() (n int) {
 			n += 1 + l + sovSettings(uint64(l))
 		}
 	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.TrackingValuePrefix)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1725,6 +1763,64 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingValuePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingValuePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -2656,59 +2752,60 @@ func init() {
 }
 
 var fileDescriptor_settings_6148d44eb33efbca = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xd6, 0xc4, 0x89, 0x7f, 0xca, 0x64, 0x9d, 0x34, 0x10, 0x0d, 0xd6, 0xca, 0x36, 0x73, 0x58,
-	0x99, 0x03, 0x33, 0xc4, 0x7b, 0x00, 0x21, 0x10, 0x60, 0x7b, 0xb5, 0x6b, 0x12, 0x14, 0xe8, 0xdd,
-	0x70, 0x40, 0x42, 0x51, 0x67, 0xa6, 0x99, 0x34, 0x9e, 0x74, 0x8f, 0xba, 0x7b, 0xcc, 0x9a, 0x23,
-	0x37, 0x8e, 0x88, 0x97, 0xe2, 0x88, 0xc4, 0xdd, 0x42, 0x16, 0x37, 0x5e, 0x02, 0x4d, 0xcf, 0x4f,
-	0x26, 0xb6, 0xb5, 0x42, 0xda, 0x5b, 0x75, 0x55, 0x7d, 0x55, 0xd5, 0xd5, 0x5f, 0x55, 0x43, 0x4f,
-	0x51, 0xb9, 0xa0, 0xd2, 0x53, 0x54, 0x6b, 0xc6, 0x43, 0x55, 0x0a, 0x6e, 0x2c, 0x85, 0x16, 0xa8,
-	0xe1, 0x47, 0x89, 0xd2, 0x54, 0x76, 0xdf, 0x0a, 0x45, 0x28, 0x8c, 0xce, 0x4b, 0xa5, 0xcc, 0xdc,
-	0x7d, 0x18, 0x0a, 0x11, 0x46, 0xd4, 0x23, 0x31, 0xf3, 0x08, 0xe7, 0x42, 0x13, 0xcd, 0x04, 0xcf,
-	0xc1, 0xdd, 0x59, 0xc8, 0xf4, 0x4d, 0x72, 0xed, 0xfa, 0xe2, 0xd6, 0x23, 0xd2, 0xc0, 0x7f, 0x34,
-	0xc2, 0xfb, 0x7e, 0xe0, 0xc5, 0xf3, 0x30, 0x85, 0x29, 0x8f, 0xc4, 0x71, 0xc4, 0x7c, 0x03, 0xf4,
-	0x16, 0xa7, 0x24, 0x8a, 0x6f, 0xc8, 0xa9, 0x17, 0x52, 0x4e, 0x25, 0xd1, 0x34, 0xc8, 0x43, 0x7d,
-	0xfa, 0xaa, 0x50, 0x9b, 0x77, 0x10, 0x2c, 0xf0, 0x3d, 0x3f, 0x22, 0xec, 0x36, 0xaf, 0xc4, 0xe9,
-	0xc0, 0xe1, 0xf3, 0xdc, 0xfa, 0x4d, 0x42, 0xe5, 0xd2, 0xf9, 0xf7, 0x00, 0x9a, 0x85, 0x06, 0xbd,
-	0x03, 0xb5, 0x44, 0x46, 0xb6, 0x35, 0xb0, 0x86, 0xad, 0x71, 0x63, 0xbd, 0xea, 0xd7, 0x2e, 0xf1,
-	0x39, 0x4e, 0x75, 0xe8, 0x03, 0x68, 0x05, 0xf4, 0xe5, 0x44, 0xf0, 0x1f, 0x58, 0x68, 0xef, 0x0d,
-	0xac, 0x61, 0x7b, 0x84, 0xdc, 0xbc, 0x27, 0xee, 0xb4, 0xb0, 0xe0, 0x3b, 0x27, 0x34, 0x01, 0x48,
-	0xf3, 0xe7, 0x90, 0x9a, 0x81, 0xbc, 0x59, 0x42, 0x2e, 0x66, 0xd3, 0x49, 0x66, 0x1a, 0x3f, 0x58,
-	0xaf, 0xfa, 0x70, 0x77, 0xc6, 0x15, 0x18, 0x1a, 0x40, 0x9b, 0xc4, 0xf1, 0x39, 0xb9, 0xa6, 0xd1,
-	0x19, 0x5d, 0xda, 0xfb, 0x69, 0x65, 0xb8, 0xaa, 0x42, 0xdf, 0xc2, 0xb1, 0xa4, 0x4a, 0x24, 0xd2,
-	0xa7, 0x17, 0x0b, 0x2a, 0x25, 0x0b, 0xa8, 0xb2, 0x0f, 0x06, 0xb5, 0x61, 0x7b, 0x34, 0x2c, 0xb3,
-	0x15, 0x37, 0x74, 0xf1, 0xa6, 0xeb, 0x13, 0xae, 0xe5, 0x12, 0x6f, 0x87, 0x40, 0x2e, 0x20, 0xa5,
-	0x89, 0x4e, 0xd4, 0x98, 0x04, 0x21, 0x7d, 0xc2, 0xc9, 0x75, 0x44, 0x03, 0xbb, 0x3e, 0xb0, 0x86,
-	0x4d, 0xbc, 0xc3, 0x82, 0x9e, 0x41, 0x27, 0xe3, 0xc0, 0x17, 0x9c, 0x44, 0x4b, 0xcd, 0x7c, 0x65,
-	0x37, 0xcc, 0x9d, 0x7b, 0x65, 0x15, 0x4f, 0xef, 0xdb, 0xf3, 0xeb, 0x6e, 0xc2, 0xd0, 0x4f, 0x70,
-	0x34, 0x4f, 0x94, 0x16, 0xb7, 0xec, 0x67, 0x7a, 0x11, 0x1b, 0x1e, 0xd9, 0x4d, 0x13, 0xea, 0xcc,
-	0xbd, 0x7b, 0x7d, 0xb7, 0x78, 0x7d, 0x23, 0x5c, 0xf9, 0x81, 0x1b, 0xcf, 0x43, 0x37, 0x25, 0x92,
-	0x5b, 0x21, 0x92, 0x5b, 0x10, 0xc9, 0x3d, 0xdb, 0x08, 0x89, 0xb7, 0x92, 0xa0, 0x77, 0x61, 0xff,
-	0x86, 0x46, 0xb1, 0xdd, 0x32, 0xc9, 0x0e, 0xcb, 0xba, 0x9f, 0xd1, 0x28, 0xc6, 0xc6, 0x84, 0xde,
-	0x83, 0x46, 0x1c, 0x25, 0x21, 0xe3, 0xca, 0x06, 0xd3, 0xe3, 0x4e, 0xe9, 0xf5, 0xb5, 0xd1, 0xe3,
-	0xc2, 0xde, 0xfd, 0xcd, 0x82, 0x93, 0xdd, 0xed, 0x46, 0x47, 0x50, 0x9b, 0xd3, 0x65, 0xc6, 0x33,
-	0x9c, 0x8a, 0x88, 0xc0, 0xc1, 0x82, 0x44, 0x09, 0xcd, 0xa9, 0xf5, 0x3a, 0x17, 0xdd, 0xcc, 0x89,
-	0xb3, 0xc8, 0x1f, 0xef, 0x7d, 0x64, 0x39, 0x57, 0xf0, 0xf6, 0xce, 0x47, 0x40, 0x3d, 0x00, 0x2d,
-	0x89, 0x3f, 0x67, 0x3c, 0x9c, 0x4d, 0xf3, 0xc2, 0x2a, 0x1a, 0xf4, 0x08, 0x1e, 0x10, 0x2e, 0xf8,
-	0x32, 0x6d, 0xd7, 0xa5, 0xa2, 0x52, 0x99, 0x42, 0x9b, 0x78, 0x43, 0xeb, 0x7c, 0x02, 0xfb, 0x69,
-	0xb7, 0x90, 0x0d, 0x0d, 0xff, 0x86, 0xe8, 0xcb, 0x62, 0x9a, 0x70, 0x71, 0x44, 0x5d, 0x68, 0xa6,
-	0xe2, 0x0b, 0xfa, 0x52, 0x9b, 0x18, 0x2d, 0x5c, 0x9e, 0x9d, 0x87, 0x50, 0xcf, 0xba, 0x88, 0x10,
-	0xec, 0x73, 0x72, 0x4b, 0x73, 0xb0, 0x91, 0x9d, 0xcf, 0xa0, 0x55, 0x0e, 0x1a, 0x1a, 0x01, 0xf8,
-	0x82, 0x73, 0xea, 0x6b, 0x21, 0x95, 0x6d, 0x99, 0xb7, 0xb8, 0x1b, 0xc8, 0x49, 0x61, 0xc2, 0x15,
-	0x2f, 0xe7, 0x31, 0xb4, 0x4a, 0xc3, 0xae, 0x0c, 0xa9, 0x4e, 0x2f, 0x63, 0x9a, 0xd7, 0x65, 0x64,
-	0xe7, 0xd7, 0x1a, 0x54, 0x86, 0x73, 0x27, 0xec, 0x04, 0xea, 0x4c, 0xa9, 0x84, 0xca, 0x1c, 0x98,
-	0x9f, 0xd0, 0x10, 0x9a, 0x7e, 0xc4, 0x28, 0xd7, 0xb3, 0xa9, 0x99, 0xff, 0xd6, 0xf8, 0x8d, 0xf5,
-	0xaa, 0xdf, 0x9c, 0xe4, 0x3a, 0x5c, 0x5a, 0xd1, 0x29, 0xb4, 0xfd, 0x88, 0x15, 0x86, 0x6c, 0xcc,
-	0xc7, 0x9d, 0xf5, 0xaa, 0xdf, 0x9e, 0x9c, 0xcf, 0x4a, 0xff, 0xaa, 0x4f, 0x9a, 0x54, 0xf9, 0x22,
-	0xce, 0x87, 0xbd, 0x85, 0xf3, 0x13, 0xba, 0x82, 0x43, 0x16, 0xbc, 0x10, 0x73, 0xca, 0x27, 0x66,
-	0xf1, 0xd9, 0x75, 0xd3, 0x9b, 0x47, 0x3b, 0x36, 0x8f, 0x3b, 0xab, 0x3a, 0x1a, 0x6a, 0x8e, 0x8f,
-	0xd7, 0xab, 0xfe, 0xe1, 0x6c, 0x5a, 0xd1, 0xe3, 0xfb, 0xf1, 0xba, 0x4b, 0x40, 0xdb, 0xb8, 0x1d,
-	0x94, 0xfe, 0xea, 0x3e, 0xa5, 0x3f, 0x7c, 0x25, 0xa5, 0xb3, 0xcd, 0xed, 0x96, 0x9f, 0x4e, 0xba,
-	0x02, 0x5d, 0x13, 0xbf, 0x42, 0xdf, 0xd1, 0xf7, 0xd0, 0x29, 0x36, 0xd9, 0x73, 0x2a, 0x17, 0xcc,
-	0xa7, 0xe8, 0x4b, 0xa8, 0x3d, 0xa5, 0x1a, 0x9d, 0x6c, 0xad, 0x3a, 0xb3, 0xde, 0xbb, 0xc7, 0x5b,
-	0x7a, 0xc7, 0xfe, 0xe5, 0xaf, 0x7f, 0x7e, 0xdf, 0x43, 0xe8, 0xc8, 0x7c, 0x56, 0x8b, 0xd3, 0xf2,
-	0xbb, 0x18, 0x7f, 0xfe, 0xc7, 0xba, 0x67, 0xfd, 0xb9, 0xee, 0x59, 0x7f, 0xaf, 0x7b, 0xd6, 0x77,
-	0xa3, 0xff, 0xf1, 0x69, 0x65, 0x0f, 0x58, 0x46, 0xb8, 0xae, 0x9b, 0x5f, 0xe6, 0xf1, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x3c, 0x78, 0x11, 0xd5, 0x4e, 0x07, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x55, 0x4b, 0x6f, 0xd3, 0x40,
+	0x10, 0x56, 0x9a, 0x36, 0x8f, 0x49, 0x43, 0xda, 0x2d, 0x54, 0x26, 0x42, 0xa5, 0xf8, 0x50, 0x95,
+	0x03, 0x36, 0x6d, 0x0f, 0x20, 0x04, 0x02, 0x92, 0x56, 0x6d, 0x68, 0x51, 0x61, 0xfb, 0x38, 0x20,
+	0xa1, 0x6a, 0x6b, 0x6f, 0x9d, 0x25, 0xae, 0xd7, 0xb2, 0x37, 0xa5, 0xe1, 0xc8, 0x8d, 0x23, 0xe2,
+	0x37, 0x21, 0x71, 0x44, 0xe2, 0x8e, 0x10, 0xe2, 0x87, 0xb0, 0x5e, 0x3f, 0xea, 0x26, 0x51, 0x85,
+	0xc4, 0xc1, 0xd6, 0xec, 0xcc, 0x7c, 0x33, 0xb3, 0x33, 0xb3, 0x33, 0xb0, 0x10, 0xd2, 0xe0, 0x8c,
+	0x06, 0x66, 0x48, 0x85, 0x60, 0x9e, 0x13, 0x66, 0x84, 0xe1, 0x07, 0x5c, 0x70, 0x54, 0xb6, 0xdc,
+	0x7e, 0x28, 0x68, 0xd0, 0xbc, 0xee, 0x70, 0x87, 0x2b, 0x9e, 0x19, 0x51, 0xb1, 0xb8, 0x79, 0xcb,
+	0xe1, 0xdc, 0x71, 0xa9, 0x49, 0x7c, 0x66, 0x12, 0xcf, 0xe3, 0x82, 0x08, 0xc6, 0xbd, 0x04, 0xdc,
+	0xec, 0x38, 0x4c, 0x74, 0xfb, 0xc7, 0x86, 0xc5, 0x4f, 0x4d, 0x12, 0x28, 0xf8, 0x3b, 0x45, 0xdc,
+	0xb3, 0x6c, 0xd3, 0xef, 0x39, 0x11, 0x2c, 0x94, 0x3f, 0xdf, 0x65, 0x96, 0x02, 0x9a, 0x67, 0x2b,
+	0xc4, 0xf5, 0xbb, 0x64, 0xc5, 0x74, 0xa8, 0x47, 0x03, 0x22, 0xa8, 0x9d, 0x98, 0x7a, 0x72, 0x95,
+	0xa9, 0xe1, 0x3b, 0x70, 0x66, 0x5b, 0xa6, 0xe5, 0x12, 0x76, 0x9a, 0x44, 0xa2, 0x37, 0xa0, 0xbe,
+	0x97, 0x48, 0x5f, 0xf7, 0x69, 0x30, 0xd0, 0xbf, 0x96, 0xa0, 0x92, 0x72, 0xd0, 0x4d, 0x28, 0xf6,
+	0x03, 0x57, 0x2b, 0x2c, 0x16, 0x96, 0xab, 0xad, 0xf2, 0xef, 0x9f, 0xb7, 0x8b, 0x07, 0x78, 0x07,
+	0x47, 0x3c, 0x74, 0x1f, 0xaa, 0x36, 0x3d, 0x6f, 0x73, 0xef, 0x84, 0x39, 0xda, 0x84, 0x54, 0xa8,
+	0xad, 0x22, 0x23, 0xc9, 0x89, 0xb1, 0x9e, 0x4a, 0xf0, 0x85, 0x12, 0x6a, 0x03, 0x44, 0xfe, 0x13,
+	0x48, 0x51, 0x41, 0xe6, 0x32, 0xc8, 0x6e, 0x67, 0xbd, 0x1d, 0x8b, 0x5a, 0xd7, 0xa4, 0x23, 0xb8,
+	0x38, 0xe3, 0x1c, 0x0c, 0x2d, 0x42, 0x4d, 0xa6, 0x65, 0x87, 0x1c, 0x53, 0x77, 0x9b, 0x0e, 0xb4,
+	0xc9, 0x28, 0x32, 0x9c, 0x67, 0xa1, 0x43, 0x98, 0x0d, 0x68, 0xc8, 0xfb, 0x81, 0x45, 0x77, 0xe5,
+	0xe5, 0x03, 0x66, 0xd3, 0x50, 0x9b, 0x5a, 0x2c, 0x4a, 0x6f, 0xcb, 0x99, 0xb7, 0xf4, 0x86, 0x06,
+	0x1e, 0x56, 0xdd, 0xf0, 0x44, 0x30, 0xc0, 0xa3, 0x26, 0x90, 0x01, 0x28, 0x94, 0x55, 0xec, 0x87,
+	0x2d, 0x62, 0x3b, 0x74, 0xc3, 0x23, 0xc7, 0x2e, 0xb5, 0xb5, 0x92, 0x0c, 0xa0, 0x82, 0xc7, 0x48,
+	0xd0, 0x16, 0x34, 0xe2, 0x1e, 0x78, 0xee, 0x11, 0x77, 0x20, 0x98, 0x15, 0x6a, 0x65, 0x75, 0xe7,
+	0x85, 0x2c, 0x8a, 0xcd, 0xcb, 0xf2, 0xe4, 0xba, 0xc3, 0x30, 0xf4, 0x1e, 0x66, 0x7a, 0x12, 0xc0,
+	0x4f, 0xd9, 0x07, 0xba, 0xeb, 0xab, 0x3e, 0xd2, 0x2a, 0xca, 0xd4, 0xb6, 0x71, 0x51, 0x7d, 0x23,
+	0xad, 0xbe, 0x22, 0x8e, 0x2c, 0xd9, 0x20, 0x3d, 0xc7, 0x88, 0x1a, 0xc9, 0xc8, 0x35, 0x92, 0x91,
+	0x36, 0x92, 0xb1, 0x3d, 0x64, 0x12, 0x8f, 0x38, 0x41, 0x77, 0x60, 0xb2, 0x4b, 0x5d, 0x5f, 0xab,
+	0x2a, 0x67, 0xf5, 0x2c, 0xee, 0x2d, 0xc9, 0xc4, 0x4a, 0x84, 0xee, 0x42, 0xd9, 0x77, 0xfb, 0x0e,
+	0x93, 0x21, 0x81, 0xca, 0x71, 0x23, 0xd3, 0x7a, 0xa5, 0xf8, 0x38, 0x95, 0xa3, 0x25, 0xb8, 0x26,
+	0x02, 0x62, 0xf5, 0x64, 0xde, 0x5f, 0x52, 0xd1, 0xe5, 0xb6, 0x56, 0x53, 0xd5, 0x1b, 0xe2, 0xca,
+	0xce, 0x9a, 0x4b, 0x39, 0x87, 0xc4, 0xed, 0xd3, 0x57, 0x01, 0x3d, 0x61, 0xe7, 0xda, 0xb4, 0x52,
+	0x1e, 0x27, 0x6a, 0x7e, 0x2e, 0xc0, 0xfc, 0xf8, 0x42, 0xa2, 0x19, 0x28, 0xf6, 0x64, 0x9f, 0xa8,
+	0x0e, 0xc6, 0x11, 0x89, 0x08, 0x4c, 0x9d, 0x45, 0xd8, 0xa4, 0x69, 0xff, 0x27, 0x85, 0xc3, 0x3e,
+	0x71, 0x6c, 0xf9, 0xd1, 0xc4, 0xc3, 0x82, 0x7e, 0x04, 0x37, 0xc6, 0x96, 0x17, 0x2d, 0x00, 0xa4,
+	0x77, 0xe8, 0xac, 0x27, 0x81, 0xe5, 0x38, 0x51, 0x9a, 0x88, 0xc7, 0xbd, 0x41, 0x54, 0x88, 0x03,
+	0xf9, 0x80, 0x43, 0x15, 0x68, 0x05, 0x0f, 0x71, 0xf5, 0xc7, 0x30, 0x19, 0xd5, 0x01, 0x69, 0x50,
+	0xb6, 0xba, 0x44, 0x1c, 0xa4, 0xef, 0x14, 0xa7, 0x47, 0xd4, 0x84, 0x4a, 0x44, 0xee, 0xd3, 0x73,
+	0xa1, 0x6c, 0x54, 0x71, 0x76, 0xd6, 0x6f, 0x41, 0x29, 0xae, 0x0f, 0x42, 0x30, 0xe9, 0x91, 0x53,
+	0x9a, 0x80, 0x15, 0xad, 0x3f, 0x85, 0x6a, 0xf6, 0x84, 0xd1, 0x2a, 0x80, 0xc5, 0x3d, 0x8f, 0x5a,
+	0x82, 0xcb, 0x60, 0x0a, 0xaa, 0xca, 0x17, 0x4f, 0xbd, 0x9d, 0x8a, 0x70, 0x4e, 0x4b, 0x5f, 0x83,
+	0x6a, 0x26, 0x18, 0xe7, 0x21, 0xe2, 0x89, 0x81, 0x4f, 0x93, 0xb8, 0x14, 0xad, 0x7f, 0x2a, 0x42,
+	0xee, 0xd9, 0x8f, 0x85, 0xcd, 0x43, 0x89, 0x85, 0xa1, 0x1c, 0x54, 0x09, 0x30, 0x39, 0xa1, 0x65,
+	0x79, 0x55, 0x97, 0x51, 0x4f, 0xc8, 0x94, 0x16, 0xd5, 0xb4, 0x9a, 0x96, 0x43, 0xa4, 0xd2, 0x4e,
+	0x78, 0x38, 0x93, 0xa2, 0x15, 0xa8, 0x49, 0x3a, 0x15, 0xc4, 0x03, 0xa4, 0xd5, 0x90, 0xca, 0xb5,
+	0xf6, 0x4e, 0x27, 0xd3, 0xcf, 0xeb, 0x44, 0x4e, 0x43, 0x8b, 0xfb, 0xc9, 0x18, 0x91, 0x4e, 0xe3,
+	0x13, 0x3a, 0x82, 0x3a, 0xb3, 0xf7, 0x79, 0x8f, 0x7a, 0x6d, 0x35, 0x52, 0xe5, 0x30, 0x88, 0x72,
+	0xb3, 0x34, 0x66, 0xa6, 0x19, 0x9d, 0xbc, 0xa2, 0x6a, 0xcd, 0xd6, 0xac, 0x74, 0x5a, 0xef, 0xac,
+	0xe7, 0xf8, 0xf8, 0xb2, 0xbd, 0xe6, 0x00, 0xd0, 0x28, 0x6e, 0x4c, 0x4b, 0xbf, 0xbc, 0xdc, 0xd2,
+	0x0f, 0xae, 0x6c, 0xe9, 0x78, 0x27, 0x18, 0xd9, 0x3a, 0x8b, 0x86, 0xab, 0xa1, 0xec, 0xe7, 0xda,
+	0x77, 0xf5, 0x2d, 0x34, 0xd2, 0x19, 0xb9, 0x27, 0x01, 0xcc, 0xa2, 0xe8, 0x05, 0x14, 0x37, 0xa9,
+	0x40, 0xf3, 0x23, 0x43, 0x54, 0x2d, 0x8e, 0xe6, 0xec, 0x08, 0x5f, 0xd7, 0x3e, 0xfe, 0xf8, 0xf3,
+	0x65, 0x02, 0xa1, 0x19, 0xb5, 0x06, 0xcf, 0x56, 0xb2, 0x45, 0xd4, 0x7a, 0xf6, 0xed, 0xf7, 0x42,
+	0xe1, 0xbb, 0xfc, 0x7e, 0xc9, 0xef, 0xcd, 0xea, 0x3f, 0xac, 0xc3, 0xb8, 0x80, 0x99, 0x85, 0xe3,
+	0x92, 0xda, 0x5f, 0x6b, 0x7f, 0x01, 0xe4, 0x37, 0x32, 0x5e, 0xa8, 0x07, 0x00, 0x00,
 }
@@ -33,7 +33,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func (m *AWSAuthConfig) Reset()      { *m = AWSAuthConfig{} }
 func (*AWSAuthConfig) ProtoMessage() {}
 func (*AWSAuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{0}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{0}
 }
 func (m *AWSAuthConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -61,7 +61,7 @@ var xxx_messageInfo_AWSAuthConfig proto.InternalMessageInfo
 func (m *AppProject) Reset()      { *m = AppProject{} }
 func (*AppProject) ProtoMessage() {}
 func (*AppProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{1}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{1}
 }
 func (m *AppProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,7 +89,7 @@ var xxx_messageInfo_AppProject proto.InternalMessageInfo
 func (m *AppProjectList) Reset()      { *m = AppProjectList{} }
 func (*AppProjectList) ProtoMessage() {}
 func (*AppProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{2}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{2}
 }
 func (m *AppProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,7 +117,7 @@ var xxx_messageInfo_AppProjectList proto.InternalMessageInfo
 func (m *AppProjectSpec) Reset()      { *m = AppProjectSpec{} }
 func (*AppProjectSpec) ProtoMessage() {}
 func (*AppProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{3}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{3}
 }
 func (m *AppProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,7 +145,7 @@ var xxx_messageInfo_AppProjectSpec proto.InternalMessageInfo
 func (m *Application) Reset()      { *m = Application{} }
 func (*Application) ProtoMessage() {}
 func (*Application) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{4}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{4}
 }
 func (m *Application) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +173,7 @@ var xxx_messageInfo_Application proto.InternalMessageInfo
 func (m *ApplicationCondition) Reset()      { *m = ApplicationCondition{} }
 func (*ApplicationCondition) ProtoMessage() {}
 func (*ApplicationCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{5}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{5}
 }
 func (m *ApplicationCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +201,7 @@ var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{6}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{6}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,10 +226,38 @@ func (m *ApplicationDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 
+func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
+func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
+func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{7}
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ApplicationDestinationServiceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.Merge(dst, src)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{7}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{8}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +285,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{8}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{9}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +313,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{9}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{10}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +341,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{10}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{11}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +369,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{11}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{12}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,7 +397,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKsonnet) Reset()      { *m = ApplicationSourceKsonnet{} }
 func (*ApplicationSourceKsonnet) ProtoMessage() {}
 func (*ApplicationSourceKsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{12}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{13}
 }
 func (m *ApplicationSourceKsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +425,7 @@ var xxx_messageInfo_ApplicationSourceKsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{13}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{14}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +453,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{14}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{15}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +481,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{15}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{16}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +509,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{16}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{17}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +537,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{17}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{18}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +565,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{18}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{19}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +593,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{19}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{20}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,10 +618,38 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *Backoff) Reset()      { *m = Backoff{} }
+func (*Backoff) ProtoMessage() {}
+func (*Backoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{21}
+}
+func (m *Backoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *Backoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backoff.Merge(dst, src)
+}
+func (m *Backoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backoff proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{20}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{22}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +677,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{21}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{23}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +705,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{22}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{24}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +733,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{23}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{25}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +761,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{24}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{26}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +789,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{25}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{27}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +817,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{26}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{28}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +845,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{27}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{29}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +873,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{28}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{30}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,10 +898,66 @@ func (m *EnvEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 
+func (m *HealthRollupPolicy) Reset()      { *m = HealthRollupPolicy{} }
+func (*HealthRollupPolicy) ProtoMessage() {}
+func (*HealthRollupPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{31}
+}
+func (m *HealthRollupPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthRollupPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *HealthRollupPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthRollupPolicy.Merge(dst, src)
+}
+func (m *HealthRollupPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthRollupPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthRollupPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthRollupPolicy proto.InternalMessageInfo
+
+func (m *HealthRollupRule) Reset()      { *m = HealthRollupRule{} }
+func (*HealthRollupRule) ProtoMessage() {}
+func (*HealthRollupRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{32}
+}
+func (m *HealthRollupRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthRollupRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *HealthRollupRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthRollupRule.Merge(dst, src)
+}
+func (m *HealthRollupRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthRollupRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthRollupRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthRollupRule proto.InternalMessageInfo
+
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{29}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{33}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +985,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{30}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{34}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,7 +1013,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{31}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{35}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +1041,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{32}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{36}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1069,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{33}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{37}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1097,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{34}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{38}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1125,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KsonnetParameter) Reset()      { *m = KsonnetParameter{} }
 func (*KsonnetParameter) ProtoMessage() {}
 func (*KsonnetParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{35}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{39}
 }
 func (m *KsonnetParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,7 +1153,7 @@ var xxx_messageInfo_KsonnetParameter proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{36}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{40}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,10 +1178,38 @@ func (m *KustomizeOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 
+func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
+func (*ManagedNamespaceMetadata) ProtoMessage() {}
+func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{41}
+}
+func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedNamespaceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ManagedNamespaceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedNamespaceMetadata.Merge(dst, src)
+}
+func (m *ManagedNamespaceMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedNamespaceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedNamespaceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{37}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{42}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,10 +1234,66 @@ func (m *Operation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Operation proto.InternalMessageInfo
 
+func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
+func (*OperationInitiator) ProtoMessage() {}
+func (*OperationInitiator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{43}
+}
+func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationInitiator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OperationInitiator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationInitiator.Merge(dst, src)
+}
+func (m *OperationInitiator) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationInitiator) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationInitiator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
+
+func (m *OperationProgress) Reset()      { *m = OperationProgress{} }
+func (*OperationProgress) ProtoMessage() {}
+func (*OperationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{44}
+}
+func (m *OperationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OperationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationProgress.Merge(dst, src)
+}
+func (m *OperationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationProgress proto.InternalMessageInfo
+
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{38}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{45}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,10 +1318,38 @@ func (m *OperationState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationState proto.InternalMessageInfo
 
+func (m *OrphanedResource) Reset()      { *m = OrphanedResource{} }
+func (*OrphanedResource) ProtoMessage() {}
+func (*OrphanedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{46}
+}
+func (m *OrphanedResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrphanedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *OrphanedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrphanedResource.Merge(dst, src)
+}
+func (m *OrphanedResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrphanedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrphanedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrphanedResource proto.InternalMessageInfo
+
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{39}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{47}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1377,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{40}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{48}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1405,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{41}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{49}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1433,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{42}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{50}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,7 +1461,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{43}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{51}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1489,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{44}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{52}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1517,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{45}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{53}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1545,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{46}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{54}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1346,10 +1570,38 @@ func (m *RepositoryList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 
+func (m *ResolvedRevisionMetadata) Reset()      { *m = ResolvedRevisionMetadata{} }
+func (*ResolvedRevisionMetadata) ProtoMessage() {}
+func (*ResolvedRevisionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{55}
+}
+func (m *ResolvedRevisionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolvedRevisionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResolvedRevisionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolvedRevisionMetadata.Merge(dst, src)
+}
+func (m *ResolvedRevisionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolvedRevisionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolvedRevisionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolvedRevisionMetadata proto.InternalMessageInfo
+
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{47}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{56}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1629,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{48}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{57}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,7 +1657,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{49}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{58}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1685,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{50}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{59}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1713,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{51}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{60}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,10 +1738,38 @@ func (m *ResourceDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 
+func (m *ResourceExclusion) Reset()      { *m = ResourceExclusion{} }
+func (*ResourceExclusion) ProtoMessage() {}
+func (*ResourceExclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{61}
+}
+func (m *ResourceExclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceExclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *ResourceExclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceExclusion.Merge(dst, src)
+}
+func (m *ResourceExclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceExclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceExclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceExclusion proto.InternalMessageInfo
+
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{52}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{62}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1517,7 +1797,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{53}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{63}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1825,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{54}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{64}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1853,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{55}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{65}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1881,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{56}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{66}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1629,7 +1909,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{57}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{67}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,7 +1937,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{58}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{68}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,10 +1962,38 @@ func (m *ResourceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 
+func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
+func (*RetryStrategy) ProtoMessage() {}
+func (*RetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{69}
+}
+func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *RetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryStrategy.Merge(dst, src)
+}
+func (m *RetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
+
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{59}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{70}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1713,7 +2021,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{60}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{71}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1738,10 +2046,38 @@ func (m *RevisionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 
+func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
+func (*SignatureKey) ProtoMessage() {}
+func (*SignatureKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{72}
+}
+func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *SignatureKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureKey.Merge(dst, src)
+}
+func (m *SignatureKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{61}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{73}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +2105,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{62}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{74}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,7 +2133,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{63}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{75}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +2161,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{64}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{76}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1853,7 +2189,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{65}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{77}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1881,7 +2217,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{66}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{78}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2245,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{67}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{79}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1937,7 +2273,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{68}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{80}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1965,7 +2301,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{69}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{81}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1993,7 +2329,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{70}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{82}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2021,7 +2357,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_6a8323e4682df88d, []int{71}
+	return fileDescriptor_generated_d1d2f47d27dea123, []int{83}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2054,6 +2390,7 @@ func init() {
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationDestination")
+	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSource")
 	proto.RegisterType((*ApplicationSourceDirectory)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSourceDirectory")
@@ -2068,6 +2405,7 @@ func init() {
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterList")
@@ -2077,6 +2415,8 @@ func init() {
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConnectionState")
 	proto.RegisterType((*EnvEntry)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.EnvEntry")
+	proto.RegisterType((*HealthRollupPolicy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthRollupPolicy")
+	proto.RegisterType((*HealthRollupRule)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthRollupRule")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmParameter")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Info")
@@ -2085,8 +2425,14 @@ func init() {
 	proto.RegisterType((*JsonnetVar)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.JsonnetVar")
 	proto.RegisterType((*KsonnetParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.KsonnetParameter")
 	proto.RegisterType((*KustomizeOptions)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.KustomizeOptions")
+	proto.RegisterType((*ManagedNamespaceMetadata)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationInitiator")
+	proto.RegisterType((*OperationProgress)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationProgress")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationState")
+	proto.RegisterType((*OrphanedResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResource")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepoCreds")
@@ -2095,11 +2441,13 @@ func init() {
 	proto.RegisterType((*RepositoryCertificate)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryCertificate")
 	proto.RegisterType((*RepositoryCertificateList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryCertificateList")
 	proto.RegisterType((*RepositoryList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryList")
+	proto.RegisterType((*ResolvedRevisionMetadata)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResolvedRevisionMetadata")
 	proto.RegisterType((*ResourceAction)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceAction")
 	proto.RegisterType((*ResourceActionDefinition)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceActionDefinition")
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceDiff")
+	proto.RegisterType((*ResourceExclusion)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceExclusion")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.LabelsEntry")
@@ -2109,8 +2457,10 @@ func init() {
 	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceResult")
 	proto.RegisterType((*ResourceStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceStatus")
+	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RevisionHistory")
 	proto.RegisterType((*RevisionMetadata)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RevisionMetadata")
+	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SignatureKey")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResult")
@@ -2325,6 +2675,33 @@ func (m *AppProjectSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.SignatureKeys) > 0 {
+		for _, msg := range m.SignatureKeys {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DestinationServiceAccounts) > 0 {
+		for _, msg := range m.DestinationServiceAccounts {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxResources))
 	return i, nil
 }
 
@@ -2439,6 +2816,40 @@ func (m *ApplicationDestination) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	return i, nil
+}
+
+func (m *ApplicationDestinationServiceAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i += copy(dAtA[i:], m.Server)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccount)))
+	i += copy(dAtA[i:], m.ServiceAccount)
 	return i, nil
 }
 
@@ -2893,21 +3304,70 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	return i, nil
-}
-
-func (m *ApplicationStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	if len(m.PassthroughAnnotations) > 0 {
+		for _, s := range m.PassthroughAnnotations {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.HealthRollupPolicy != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HealthRollupPolicy.Size()))
+		n20, err := m.HealthRollupPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if len(m.Sources) > 0 {
+		for _, msg := range m.Sources {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ResourceExclusions) > 0 {
+		for _, msg := range m.ResourceExclusions {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
@@ -2926,19 +3386,19 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-	n20, err := m.Sync.MarshalTo(dAtA[i:])
+	n21, err := m.Sync.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n21, err := m.Health.MarshalTo(dAtA[i:])
+	n22, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n22
 	if len(m.History) > 0 {
 		for _, msg := range m.History {
 			dAtA[i] = 0x22
@@ -2967,31 +3427,31 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ReconciledAt.Size()))
-		n22, err := m.ReconciledAt.MarshalTo(dAtA[i:])
+		n23, err := m.ReconciledAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	if m.OperationState != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.OperationState.Size()))
-		n23, err := m.OperationState.MarshalTo(dAtA[i:])
+		n24, err := m.OperationState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.ObservedAt != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedAt.Size()))
-		n24, err := m.ObservedAt.MarshalTo(dAtA[i:])
+		n25, err := m.ObservedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	dAtA[i] = 0x4a
 	i++
@@ -3000,11 +3460,43 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x52
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Summary.Size()))
-	n25, err := m.Summary.MarshalTo(dAtA[i:])
+	n26, err := m.Summary.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n26
+	if m.HealthRollupPolicy != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.HealthRollupPolicy.Size()))
+		n27, err := m.HealthRollupPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.NextRefreshAt != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.NextRefreshAt.Size()))
+		n28, err := m.NextRefreshAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if len(m.OrphanedResources) > 0 {
+		for _, msg := range m.OrphanedResources {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -3120,11 +3612,42 @@ func (m *ApplicationWatchEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Application.Size()))
-	n26, err := m.Application.MarshalTo(dAtA[i:])
+	n29, err := m.Application.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n29
+	return i, nil
+}
+
+func (m *Backoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backoff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	if m.Factor != nil {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Factor))
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i += copy(dAtA[i:], m.MaxDuration)
 	return i, nil
 }
 
@@ -3154,23 +3677,38 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Config.Size()))
-	n27, err := m.Config.MarshalTo(dAtA[i:])
+	n30, err := m.Config.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n30
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n28, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n31, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n31
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerVersion)))
 	i += copy(dAtA[i:], m.ServerVersion)
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -3204,20 +3742,20 @@ func (m *ClusterConfig) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.TLSClientConfig.Size()))
-	n29, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
+	n32, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n32
 	if m.AWSAuthConfig != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.AWSAuthConfig.Size()))
-		n30, err := m.AWSAuthConfig.MarshalTo(dAtA[i:])
+		n33, err := m.AWSAuthConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n33
 	}
 	return i, nil
 }
@@ -3240,11 +3778,11 @@ func (m *ClusterList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n31, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n34, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n34
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -3326,19 +3864,31 @@ func (m *ComparedTo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n32, err := m.Source.MarshalTo(dAtA[i:])
+	n35, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n35
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
-	n33, err := m.Destination.MarshalTo(dAtA[i:])
+	n36, err := m.Destination.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n36
+	if len(m.Sources) > 0 {
+		for _, msg := range m.Sources {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -3395,20 +3945,20 @@ func (m *ConfigManagementPlugin) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Init.Size()))
-		n34, err := m.Init.MarshalTo(dAtA[i:])
+		n37, err := m.Init.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n37
 	}
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Generate.Size()))
-	n35, err := m.Generate.MarshalTo(dAtA[i:])
+	n38, err := m.Generate.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n38
 	return i, nil
 }
 
@@ -3439,11 +3989,11 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ModifiedAt.Size()))
-		n36, err := m.ModifiedAt.MarshalTo(dAtA[i:])
+		n39, err := m.ModifiedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n39
 	}
 	return i, nil
 }
@@ -3474,6 +4024,109 @@ func (m *EnvEntry) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *HealthRollupPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthRollupPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i += copy(dAtA[i:], m.Mode)
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DegradedThreshold))
+	if len(m.KindPriority) > 0 {
+		for _, s := range m.KindPriority {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *HealthRollupRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthRollupRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.LabelSelector != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LabelSelector.Size()))
+		n40, err := m.LabelSelector.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	dAtA[i] = 0x30
+	i++
+	if m.Informational {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
 func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3497,6 +4150,16 @@ func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i += copy(dAtA[i:], m.Message)
+	if m.LastTransitionTime != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastTransitionTime.Size()))
+		n76, err := m.LastTransitionTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
 	return i, nil
 }
 
@@ -3696,7 +4359,7 @@ func (m *KustomizeOptions) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
+func (m *ManagedNamespaceMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3706,30 +4369,182 @@ func (m *Operation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManagedNamespaceMetadata) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Sync != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-		n37, err := m.Sync.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
 		}
-		i += n37
-	}
-	return i, nil
-}
-
-func (m *OperationState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0xa
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for _, k := range keysForAnnotations {
+			dAtA[i] = 0x12
+			i++
+			v := m.Annotations[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sync != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
+		n41, err := m.Sync.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+	n42, err := m.Retry.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n42
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n77, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n77
+	return i, nil
+}
+
+func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationInitiator) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	dAtA[i] = 0x10
+	i++
+	if m.Automated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *OperationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i += copy(dAtA[i:], m.Phase)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Wave))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Pending))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Running))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WaitingOn)))
+	i += copy(dAtA[i:], m.WaitingOn)
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ElapsedSeconds))
+	return i, nil
+}
+
+func (m *OperationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -3742,11 +4557,11 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-	n38, err := m.Operation.MarshalTo(dAtA[i:])
+	n43, err := m.Operation.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n43
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -3759,29 +4574,92 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncResult.Size()))
-		n39, err := m.SyncResult.MarshalTo(dAtA[i:])
+		n44, err := m.SyncResult.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n44
 	}
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n40, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n45, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n45
 	if m.FinishedAt != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
-		n41, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		n46, err := m.FinishedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n46
+	}
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
+	if m.NextRetryAt != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.NextRetryAt.Size()))
+		n47, err := m.NextRetryAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	if m.Progress != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Progress.Size()))
+		n48, err := m.Progress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+
+func (m *OrphanedResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.CreatedAt.Size()))
+		n, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n
 	}
 	return i, nil
 }
@@ -3942,11 +4820,11 @@ func (m *RepoCredsList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n42, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n49, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n49
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -3996,11 +4874,11 @@ func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n43, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n50, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n50
 	dAtA[i] = 0x30
 	i++
 	if m.InsecureIgnoreHostKey {
@@ -4110,11 +4988,11 @@ func (m *RepositoryCertificateList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n44, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n51, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n51
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -4148,11 +5026,11 @@ func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n45, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n52, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
+	i += n52
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -4168,6 +5046,73 @@ func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResolvedRevisionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolvedRevisionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Author)))
+	i += copy(dAtA[i:], m.Author)
+	if m.Date != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Date.Size()))
+		n53, err := m.Date.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChartName)))
+	i += copy(dAtA[i:], m.ChartName)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChartVersion)))
+	i += copy(dAtA[i:], m.ChartVersion)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChartAppVersion)))
+	i += copy(dAtA[i:], m.ChartAppVersion)
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChartDescription)))
+	i += copy(dAtA[i:], m.ChartDescription)
+	return i, nil
+}
+
 func (m *ResourceAction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4358,7 +5303,7 @@ func (m *ResourceDiff) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
+func (m *ResourceExclusion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4368,7 +5313,7 @@ func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceExclusion) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4385,7 +5330,37 @@ func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x22
+	return i, nil
+}
+
+func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i += copy(dAtA[i:], m.Namespace)
@@ -4404,6 +5379,36 @@ func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.JQPathExpressions) > 0 {
+		for _, s := range m.JQPathExpressions {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ManagedFieldsManagers) > 0 {
+		for _, s := range m.ManagedFieldsManagers {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -4526,11 +5531,11 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourceRef.Size()))
-	n46, err := m.ResourceRef.MarshalTo(dAtA[i:])
+	n54, err := m.ResourceRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n46
+	i += n54
 	if len(m.ParentRefs) > 0 {
 		for _, msg := range m.ParentRefs {
 			dAtA[i] = 0x12
@@ -4559,11 +5564,11 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.NetworkingInfo.Size()))
-		n47, err := m.NetworkingInfo.MarshalTo(dAtA[i:])
+		n55, err := m.NetworkingInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n55
 	}
 	dAtA[i] = 0x2a
 	i++
@@ -4588,12 +5593,30 @@ func (m *ResourceNode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-		n48, err := m.Health.MarshalTo(dAtA[i:])
+		n56, err := m.Health.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n56
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.CreatedAt.Size()))
+		n57, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	dAtA[i] = 0x48
+	i++
+	if m.Inactive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i++
 	return i, nil
 }
 
@@ -4624,6 +5647,14 @@ func (m *ResourceOverride) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actions)))
 	i += copy(dAtA[i:], m.Actions)
+	dAtA[i] = 0x20
+	i++
+	if m.IgnoreExtraneous {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -4724,6 +5755,14 @@ func (m *ResourceResult) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
 	i += copy(dAtA[i:], m.SyncPhase)
+	dAtA[i] = 0x58
+	i++
+	if m.Previewed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -4770,11 +5809,11 @@ func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-		n49, err := m.Health.MarshalTo(dAtA[i:])
+		n58, err := m.Health.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n58
 	}
 	dAtA[i] = 0x40
 	i++
@@ -4792,6 +5831,72 @@ func (m *ResourceStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	dAtA[i] = 0x50
+	i++
+	if m.PruneProtected {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x58
+	i++
+	if m.PendingDrift {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if len(m.ModifiedFields) > 0 {
+		for _, s := range m.ModifiedFields {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	if m.Backoff != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Backoff.Size()))
+		n59, err := m.Backoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
 	return i, nil
 }
 
@@ -4817,22 +5922,82 @@ func (m *RevisionHistory) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DeployedAt.Size()))
-	n50, err := m.DeployedAt.MarshalTo(dAtA[i:])
+	n60, err := m.DeployedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n50
+	i += n60
 	dAtA[i] = 0x28
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n51, err := m.Source.MarshalTo(dAtA[i:])
+	n61, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n51
+	i += n61
+	if m.RevisionMetadata != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.RevisionMetadata.Size()))
+		n62, err := m.RevisionMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	if m.RollbackID != nil {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RollbackID))
+	}
+	if len(m.Sources) > 0 {
+		for _, msg := range m.Sources {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DeployStartedAt != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.DeployStartedAt.Size()))
+		n78, err := m.DeployStartedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
+	}
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n79, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n79
 	return i, nil
 }
 
@@ -4858,11 +6023,11 @@ func (m *RevisionMetadata) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Date.Size()))
-	n52, err := m.Date.MarshalTo(dAtA[i:])
+	n63, err := m.Date.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n52
+	i += n63
 	if len(m.Tags) > 0 {
 		for _, s := range m.Tags {
 			dAtA[i] = 0x1a
@@ -4885,6 +6050,28 @@ func (m *RevisionMetadata) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SignatureKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyID)))
+	i += copy(dAtA[i:], m.KeyID)
+	return i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4924,11 +6111,11 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategy.Size()))
-		n53, err := m.SyncStrategy.MarshalTo(dAtA[i:])
+		n64, err := m.SyncStrategy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n64
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -4946,11 +6133,11 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-		n54, err := m.Source.MarshalTo(dAtA[i:])
+		n65, err := m.Source.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n65
 	}
 	if len(m.Manifests) > 0 {
 		for _, s := range m.Manifests {
@@ -4967,6 +6154,19 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	dAtA[i] = 0x48
+	i++
+	if m.IncludeHooks {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.RollbackID != nil {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RollbackID))
+	}
 	return i, nil
 }
 
@@ -4997,6 +6197,10 @@ func (m *SyncOperationResource) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
 	return i, nil
 }
 
@@ -5034,11 +6238,46 @@ func (m *SyncOperationResult) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n55, err := m.Source.MarshalTo(dAtA[i:])
+	n66, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n55
+	i += n66
+	dAtA[i] = 0x20
+	i++
+	if m.SignatureVerificationSkipped {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.Sources) > 0 {
+		for _, msg := range m.Sources {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -5061,24 +6300,59 @@ func (m *SyncPolicy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Automated.Size()))
-		n56, err := m.Automated.MarshalTo(dAtA[i:])
+		n67, err := m.Automated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
-	}
-	return i, nil
-}
-
-func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i += n67
 	}
-	return dAtA[:n], nil
-}
+	if m.Retry != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n68, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ManagedNamespaceMetadata != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ManagedNamespaceMetadata.Size()))
+		n69, err := m.ManagedNamespaceMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	return i, nil
+}
+
+func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
 	var i int
@@ -5126,15 +6400,50 @@ func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedTo.Size()))
-	n57, err := m.ComparedTo.MarshalTo(dAtA[i:])
+	n70, err := m.ComparedTo.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n57
+	i += n70
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
 	i += copy(dAtA[i:], m.Revision)
+	if m.RevisionMetadata != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.RevisionMetadata.Size()))
+		n71, err := m.RevisionMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.LastTransitionTime != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastTransitionTime.Size()))
+		n75, err := m.LastTransitionTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
 	return i, nil
 }
 
@@ -5157,21 +6466,21 @@ func (m *SyncStrategy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Apply.Size()))
-		n58, err := m.Apply.MarshalTo(dAtA[i:])
+		n72, err := m.Apply.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n72
 	}
 	if m.Hook != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Hook.Size()))
-		n59, err := m.Hook.MarshalTo(dAtA[i:])
+		n73, err := m.Hook.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n73
 	}
 	return i, nil
 }
@@ -5220,11 +6529,11 @@ func (m *SyncStrategyHook) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
-	n60, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
+	n74, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n60
+	i += n74
 	return i, nil
 }
 
@@ -5447,6 +6756,19 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.SignatureKeys) > 0 {
+		for _, e := range m.SignatureKeys {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DestinationServiceAccounts) > 0 {
+		for _, e := range m.DestinationServiceAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.MaxResources))
 	return n
 }
 
@@ -5487,6 +6809,20 @@ func (m *ApplicationDestination) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationDestinationServiceAccount) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -5664,6 +7000,28 @@ func (m *ApplicationSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.PassthroughAnnotations) > 0 {
+		for _, s := range m.PassthroughAnnotations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.HealthRollupPolicy != nil {
+		l = m.HealthRollupPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ResourceExclusions) > 0 {
+		for _, e := range m.ResourceExclusions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5708,6 +7066,20 @@ func (m *ApplicationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Summary.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.HealthRollupPolicy != nil {
+		l = m.HealthRollupPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NextRefreshAt != nil {
+		l = m.NextRefreshAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.OrphanedResources) > 0 {
+		for _, e := range m.OrphanedResources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5757,6 +7129,19 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *Backoff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Factor != nil {
+		n += 1 + sovGenerated(uint64(*m.Factor))
+	}
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	var l int
 	_ = l
@@ -5770,6 +7155,12 @@ func (m *Cluster) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.ServerVersion)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5830,6 +7221,12 @@ func (m *ComparedTo) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Destination.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5883,6 +7280,45 @@ func (m *EnvEntry) Size() (n int) {
 	return n
 }
 
+func (m *HealthRollupPolicy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.DegradedThreshold))
+	if len(m.KindPriority) > 0 {
+		for _, s := range m.KindPriority {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HealthRollupRule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LabelSelector != nil {
+		l = m.LabelSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Weight))
+	n += 2
+	return n
+}
+
 func (m *HealthStatus) Size() (n int) {
 	var l int
 	_ = l
@@ -5890,6 +7326,10 @@ func (m *HealthStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -5963,6 +7403,28 @@ func (m *KustomizeOptions) Size() (n int) {
 	return n
 }
 
+func (m *ManagedNamespaceMetadata) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Operation) Size() (n int) {
 	var l int
 	_ = l
@@ -5970,6 +7432,35 @@ func (m *Operation) Size() (n int) {
 		l = m.Sync.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.Retry.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OperationInitiator) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *OperationProgress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Wave))
+	n += 1 + sovGenerated(uint64(m.Pending))
+	n += 1 + sovGenerated(uint64(m.Running))
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	l = len(m.WaitingOn)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ElapsedSeconds))
 	return n
 }
 
@@ -5992,6 +7483,31 @@ func (m *OperationState) Size() (n int) {
 		l = m.FinishedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if m.NextRetryAt != nil {
+		l = m.NextRetryAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Progress != nil {
+		l = m.Progress.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OrphanedResource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6138,6 +7654,34 @@ func (m *RepositoryList) Size() (n int) {
 	return n
 }
 
+func (m *ResolvedRevisionMetadata) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Date != nil {
+		l = m.Date.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChartName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChartVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChartAppVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ChartDescription)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ResourceAction) Size() (n int) {
 	var l int
 	_ = l
@@ -6212,6 +7756,18 @@ func (m *ResourceDiff) Size() (n int) {
 	return n
 }
 
+func (m *ResourceExclusion) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ResourceIgnoreDifferences) Size() (n int) {
 	var l int
 	_ = l
@@ -6229,6 +7785,18 @@ func (m *ResourceIgnoreDifferences) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.JQPathExpressions) > 0 {
+		for _, s := range m.JQPathExpressions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ManagedFieldsManagers) > 0 {
+		for _, s := range m.ManagedFieldsManagers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6305,8 +7873,13 @@ func (m *ResourceNode) Size() (n int) {
 		l = m.Health.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	return n
-}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
 
 func (m *ResourceOverride) Size() (n int) {
 	var l int
@@ -6317,6 +7890,7 @@ func (m *ResourceOverride) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Actions)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -6361,6 +7935,7 @@ func (m *ResourceResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.SyncPhase)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -6385,6 +7960,27 @@ func (m *ResourceStatus) Size() (n int) {
 	}
 	n += 2
 	n += 2
+	n += 2
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ModifiedFields) > 0 {
+		for _, s := range m.ModifiedFields {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetryStrategy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6398,6 +7994,31 @@ func (m *RevisionHistory) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.ID))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RevisionMetadata != nil {
+		l = m.RevisionMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RollbackID != nil {
+		n += 1 + sovGenerated(uint64(*m.RollbackID))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.DeployStartedAt != nil {
+		l = m.DeployStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -6419,6 +8040,14 @@ func (m *RevisionMetadata) Size() (n int) {
 	return n
 }
 
+func (m *SignatureKey) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncOperation) Size() (n int) {
 	var l int
 	_ = l
@@ -6446,6 +8075,10 @@ func (m *SyncOperation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
+	if m.RollbackID != nil {
+		n += 1 + sovGenerated(uint64(*m.RollbackID))
+	}
 	return n
 }
 
@@ -6458,6 +8091,8 @@ func (m *SyncOperationResource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -6474,6 +8109,19 @@ func (m *SyncOperationResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6484,6 +8132,20 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.Automated.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ManagedNamespaceMetadata != nil {
+		l = m.ManagedNamespaceMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6504,6 +8166,20 @@ func (m *SyncStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Revision)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RevisionMetadata != nil {
+		l = m.RevisionMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -6647,6 +8323,9 @@ func (this *AppProjectSpec) String() string {
 		`NamespaceResourceBlacklist:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NamespaceResourceBlacklist), "GroupKind", "v1.GroupKind", 1), `&`, ``, 1) + `,`,
 		`OrphanedResources:` + strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResourcesMonitorSettings", "OrphanedResourcesMonitorSettings", 1) + `,`,
 		`SyncWindows:` + strings.Replace(fmt.Sprintf("%v", this.SyncWindows), "SyncWindow", "SyncWindow", 1) + `,`,
+		`SignatureKeys:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SignatureKeys), "SignatureKey", "SignatureKey", 1), `&`, ``, 1) + `,`,
+		`DestinationServiceAccounts:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DestinationServiceAccounts), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + `,`,
+		`MaxResources:` + fmt.Sprintf("%v", this.MaxResources) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6683,6 +8362,19 @@ func (this *ApplicationDestination) String() string {
 	s := strings.Join([]string{`&ApplicationDestination{`,
 		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDestinationServiceAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDestinationServiceAccount{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ServiceAccount:` + fmt.Sprintf("%v", this.ServiceAccount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6807,6 +8499,10 @@ func (this *ApplicationSpec) String() string {
 		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
 		`IgnoreDifferences:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IgnoreDifferences), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + `,`,
 		`Info:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Info), "Info", "Info", 1), `&`, ``, 1) + `,`,
+		`PassthroughAnnotations:` + fmt.Sprintf("%v", this.PassthroughAnnotations) + `,`,
+		`HealthRollupPolicy:` + strings.Replace(fmt.Sprintf("%v", this.HealthRollupPolicy), "HealthRollupPolicy", "HealthRollupPolicy", 1) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Sources), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`ResourceExclusions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ResourceExclusions), "ResourceExclusion", "ResourceExclusion", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6826,6 +8522,9 @@ func (this *ApplicationStatus) String() string {
 		`ObservedAt:` + strings.Replace(fmt.Sprintf("%v", this.ObservedAt), "Time", "v1.Time", 1) + `,`,
 		`SourceType:` + fmt.Sprintf("%v", this.SourceType) + `,`,
 		`Summary:` + strings.Replace(strings.Replace(this.Summary.String(), "ApplicationSummary", "ApplicationSummary", 1), `&`, ``, 1) + `,`,
+		`HealthRollupPolicy:` + strings.Replace(fmt.Sprintf("%v", this.HealthRollupPolicy), "HealthRollupPolicy", "HealthRollupPolicy", 1) + `,`,
+		`NextRefreshAt:` + strings.Replace(fmt.Sprintf("%v", this.NextRefreshAt), "Time", "v1.Time", 1) + `,`,
+		`OrphanedResources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResource", "OrphanedResource", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6863,6 +8562,18 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *Backoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Backoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + valueToStringGenerated(this.Factor) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
@@ -6873,6 +8584,7 @@ func (this *Cluster) String() string {
 		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "ClusterConfig", "ClusterConfig", 1), `&`, ``, 1) + `,`,
 		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
 		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6920,6 +8632,7 @@ func (this *ComparedTo) String() string {
 	s := strings.Join([]string{`&ComparedTo{`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Sources), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6971,6 +8684,34 @@ func (this *EnvEntry) String() string {
 	}, "")
 	return s
 }
+func (this *HealthRollupPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthRollupPolicy{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Rules:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Rules), "HealthRollupRule", "HealthRollupRule", 1), `&`, ``, 1) + `,`,
+		`DegradedThreshold:` + fmt.Sprintf("%v", this.DegradedThreshold) + `,`,
+		`KindPriority:` + fmt.Sprintf("%v", this.KindPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthRollupRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthRollupRule{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`Informational:` + fmt.Sprintf("%v", this.Informational) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HealthStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -6978,6 +8719,7 @@ func (this *HealthStatus) String() string {
 	s := strings.Join([]string{`&HealthStatus{`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7061,12 +8803,73 @@ func (this *KustomizeOptions) String() string {
 	}, "")
 	return s
 }
+func (this *ManagedNamespaceMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ManagedNamespaceMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Operation) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Operation{`,
 		`Sync:` + strings.Replace(fmt.Sprintf("%v", this.Sync), "SyncOperation", "SyncOperation", 1) + `,`,
+		`Retry:` + strings.Replace(strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1), `&`, ``, 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationInitiator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationInitiator{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Automated:` + fmt.Sprintf("%v", this.Automated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationProgress{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`WaitingOn:` + fmt.Sprintf("%v", this.WaitingOn) + `,`,
+		`ElapsedSeconds:` + fmt.Sprintf("%v", this.ElapsedSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7082,6 +8885,22 @@ func (this *OperationState) String() string {
 		`SyncResult:` + strings.Replace(fmt.Sprintf("%v", this.SyncResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
 		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`NextRetryAt:` + strings.Replace(fmt.Sprintf("%v", this.NextRetryAt), "Time", "v1.Time", 1) + `,`,
+		`Progress:` + strings.Replace(fmt.Sprintf("%v", this.Progress), "OperationProgress", "OperationProgress", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResource{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7194,6 +9013,23 @@ func (this *RepositoryList) String() string {
 	}, "")
 	return s
 }
+func (this *ResolvedRevisionMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResolvedRevisionMetadata{`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Date:` + strings.Replace(fmt.Sprintf("%v", this.Date), "Time", "v1.Time", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ChartName:` + fmt.Sprintf("%v", this.ChartName) + `,`,
+		`ChartVersion:` + fmt.Sprintf("%v", this.ChartVersion) + `,`,
+		`ChartAppVersion:` + fmt.Sprintf("%v", this.ChartAppVersion) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`ChartDescription:` + fmt.Sprintf("%v", this.ChartDescription) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceAction) String() string {
 	if this == nil {
 		return "nil"
@@ -7258,6 +9094,18 @@ func (this *ResourceDiff) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceExclusion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceExclusion{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceIgnoreDifferences) String() string {
 	if this == nil {
 		return "nil"
@@ -7268,6 +9116,8 @@ func (this *ResourceIgnoreDifferences) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
+		`JQPathExpressions:` + fmt.Sprintf("%v", this.JQPathExpressions) + `,`,
+		`ManagedFieldsManagers:` + fmt.Sprintf("%v", this.ManagedFieldsManagers) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7318,6 +9168,8 @@ func (this *ResourceNode) String() string {
 		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
 		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`Inactive:` + fmt.Sprintf("%v", this.Inactive) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7330,6 +9182,7 @@ func (this *ResourceOverride) String() string {
 		`HealthLua:` + fmt.Sprintf("%v", this.HealthLua) + `,`,
 		`IgnoreDifferences:` + fmt.Sprintf("%v", this.IgnoreDifferences) + `,`,
 		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
+		`IgnoreExtraneous:` + fmt.Sprintf("%v", this.IgnoreExtraneous) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7364,6 +9217,7 @@ func (this *ResourceResult) String() string {
 		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`Previewed:` + fmt.Sprintf("%v", this.Previewed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7382,6 +9236,21 @@ func (this *ResourceStatus) String() string {
 		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "HealthStatus", "HealthStatus", 1) + `,`,
 		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
 		`RequiresPruning:` + fmt.Sprintf("%v", this.RequiresPruning) + `,`,
+		`PruneProtected:` + fmt.Sprintf("%v", this.PruneProtected) + `,`,
+		`PendingDrift:` + fmt.Sprintf("%v", this.PendingDrift) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ModifiedFields:` + fmt.Sprintf("%v", this.ModifiedFields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "Backoff", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7395,6 +9264,12 @@ func (this *RevisionHistory) String() string {
 		`DeployedAt:` + strings.Replace(strings.Replace(this.DeployedAt.String(), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`RevisionMetadata:` + strings.Replace(fmt.Sprintf("%v", this.RevisionMetadata), "ResolvedRevisionMetadata", "ResolvedRevisionMetadata", 1) + `,`,
+		`RollbackID:` + valueToStringGenerated(this.RollbackID) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Sources), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`DeployStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeployStartedAt), "Time", "v1.Time", 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7412,6 +9287,16 @@ func (this *RevisionMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *SignatureKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignatureKey{`,
+		`KeyID:` + fmt.Sprintf("%v", this.KeyID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -7424,6 +9309,8 @@ func (this *SyncOperation) String() string {
 		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
 		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Manifests:` + fmt.Sprintf("%v", this.Manifests) + `,`,
+		`IncludeHooks:` + fmt.Sprintf("%v", this.IncludeHooks) + `,`,
+		`RollbackID:` + valueToStringGenerated(this.RollbackID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7436,6 +9323,7 @@ func (this *SyncOperationResource) String() string {
 		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7448,6 +9336,9 @@ func (this *SyncOperationResult) String() string {
 		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceResult", "ResourceResult", 1) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`SignatureVerificationSkipped:` + fmt.Sprintf("%v", this.SignatureVerificationSkipped) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Sources), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7458,6 +9349,9 @@ func (this *SyncPolicy) String() string {
 	}
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(fmt.Sprintf("%v", this.Automated), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
+		`ManagedNamespaceMetadata:` + strings.Replace(fmt.Sprintf("%v", this.ManagedNamespaceMetadata), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7481,6 +9375,9 @@ func (this *SyncStatus) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`ComparedTo:` + strings.Replace(strings.Replace(this.ComparedTo.String(), "ComparedTo", "ComparedTo", 1), `&`, ``, 1) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`RevisionMetadata:` + strings.Replace(fmt.Sprintf("%v", this.RevisionMetadata), "ResolvedRevisionMetadata", "ResolvedRevisionMetadata", 1) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8158,59 +10055,9 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Application) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Application: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8234,13 +10081,14 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignatureKeys = append(m.SignatureKeys, SignatureKey{})
+			if err := m.SignatureKeys[len(m.SignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServiceAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8264,13 +10112,143 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DestinationServiceAccounts = append(m.DestinationServiceAccounts, ApplicationDestinationServiceAccount{})
+			if err := m.DestinationServiceAccounts[len(m.DestinationServiceAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResources", wireType)
+			}
+			m.MaxResources = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResources |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8580,6 +10558,35 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8601,7 +10608,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8624,17 +10631,17 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8644,27 +10651,26 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8674,22 +10680,49 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8712,7 +10745,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8735,17 +10768,17 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8755,26 +10788,27 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8784,26 +10818,136 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10111,6 +12255,130 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassthroughAnnotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassthroughAnnotations = append(m.PassthroughAnnotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthRollupPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthRollupPolicy == nil {
+				m.HealthRollupPolicy = &HealthRollupPolicy{}
+			}
+			if err := m.HealthRollupPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceExclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceExclusions = append(m.ResourceExclusions, ResourceExclusion{})
+			if err := m.ResourceExclusions[len(m.ResourceExclusions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10472,21 +12740,118 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthRollupPolicy", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			if m.HealthRollupPolicy == nil {
+				m.HealthRollupPolicy = &HealthRollupPolicy{}
+			}
+			if err := m.HealthRollupPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRefreshAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRefreshAt == nil {
+				m.NextRefreshAt = &v1.Time{}
+			}
+			if err := m.NextRefreshAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedResources = append(m.OrphanedResources, OrphanedResource{})
+			if err := m.OrphanedResources[len(m.OrphanedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -10822,6 +13187,134 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Cluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10998,6 +13491,35 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			}
 			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11527,6 +14049,37 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12076,7 +14629,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *HealthRollupPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12099,15 +14652,15 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthRollupPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthRollupPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12132,11 +14685,61 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, HealthRollupRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DegradedThreshold", wireType)
+			}
+			m.DegradedThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DegradedThreshold |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KindPriority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12161,7 +14764,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.KindPriority = append(m.KindPriority, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12184,7 +14787,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *HealthRollupRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12207,15 +14810,15 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthRollupRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthRollupRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12240,11 +14843,11 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12269,11 +14872,92 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Informational", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12290,7 +14974,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
+			m.Informational = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12312,7 +14996,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12335,15 +15019,15 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12368,11 +15052,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12397,7 +15081,40 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12420,7 +15137,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12443,10 +15160,10 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12507,6 +15224,26 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12528,7 +15265,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12551,17 +15288,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12571,16 +15308,26 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12590,11 +15337,21 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12616,7 +15373,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12639,10 +15396,10 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12703,11 +15460,61 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var v int
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12717,12 +15524,30 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.IssuedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12744,7 +15569,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12767,15 +15592,15 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12800,11 +15625,11 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12829,13 +15654,13 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12845,21 +15670,12 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12881,7 +15697,7 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12904,15 +15720,15 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12937,7 +15753,65 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12960,7 +15834,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12983,17 +15857,17 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13003,24 +15877,20 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13043,7 +15913,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13066,15 +15936,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13098,44 +15968,103 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13145,24 +16074,163 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13186,16 +16254,16 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
 			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13219,13 +16287,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13249,10 +16317,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13277,7 +16342,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13300,17 +16365,17 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13320,15 +16385,43 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
-		default:
-			iNdEx = preIndex
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Automated = bool(v != 0)
+		default:
+			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -13348,7 +16441,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *OperationProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13371,15 +16464,15 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13404,13 +16497,13 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
 			}
-			var stringLen uint64
+			m.Wave = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13420,26 +16513,16 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Wave |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			m.Pending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13449,26 +16532,16 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Pending |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var msglen int
+			m.Running = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13478,26 +16551,52 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Running |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			iNdEx = postIndex
-		case 5:
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingOn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13522,8 +16621,27 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.WaitingOn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedSeconds", wireType)
+			}
+			m.ElapsedSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13545,7 +16663,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoCreds) Unmarshal(dAtA []byte) error {
+func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13568,17 +16686,17 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13588,24 +16706,25 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13630,11 +16749,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13659,13 +16778,13 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13675,26 +16794,30 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13704,26 +16827,27 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13733,74 +16857,47 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13824,13 +16921,16 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NextRetryAt == nil {
+				m.NextRetryAt = &v1.Time{}
+			}
+			if err := m.NextRetryAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13854,8 +16954,10 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepoCreds{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Progress == nil {
+				m.Progress = &OperationProgress{}
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13880,7 +16982,7 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13903,15 +17005,15 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13936,11 +17038,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13965,11 +17067,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13994,40 +17096,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14051,33 +17124,66 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14094,12 +17200,63 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
+			b := bool(v != 0)
+			m.Warn = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14109,15 +17266,24 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableLFS = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14142,11 +17308,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14171,13 +17337,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14187,24 +17353,26 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14229,28 +17397,8 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InheritedCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14272,7 +17420,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
+func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14295,15 +17443,15 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14328,11 +17476,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerName = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14357,11 +17505,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertType = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14386,13 +17534,13 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14402,26 +17550,24 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
-			}
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14446,7 +17592,36 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14469,7 +17644,7 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14492,10 +17667,10 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14554,7 +17729,7 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepositoryCertificate{})
+			m.Items = append(m.Items, RepoCreds{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -14580,7 +17755,7 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *Repository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14603,17 +17778,17 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14623,27 +17798,26 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14653,76 +17827,53 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14747,11 +17898,11 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14775,14 +17926,13 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14799,60 +17949,108 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			m.EnableLFS = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14877,11 +18075,11 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14906,8 +18104,28 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InheritedCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14929,7 +18147,7 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14952,15 +18170,15 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14985,11 +18203,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15014,11 +18232,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.CertType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15043,11 +18261,42 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.CertSubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15072,7 +18321,7 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
+			m.CertInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15095,7 +18344,7 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15118,17 +18367,17 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15138,24 +18387,25 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15179,8 +18429,8 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, RepositoryCertificate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15205,7 +18455,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15228,17 +18478,17 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15248,26 +18498,27 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15277,29 +18528,81 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			m.Items = append(m.Items, &Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolvedRevisionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedRevisionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedRevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -15319,11 +18622,73 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Date == nil {
+				m.Date = &v1.Time{}
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15348,11 +18713,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChartName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15377,11 +18742,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.ChartVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartAppVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15406,11 +18771,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.ChartAppVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15435,13 +18800,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartDescription", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15451,12 +18816,21 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChartDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15478,7 +18852,7 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15501,15 +18875,15 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15534,13 +18908,13 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15550,26 +18924,28 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15579,24 +18955,65 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Disabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15621,11 +19038,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15650,7 +19067,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15673,7 +19090,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15696,17 +19113,17 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15716,7 +19133,1323 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Default = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceExclusion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceExclusion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceExclusion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetLabels == nil {
+				m.TargetLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TargetLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
+			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15728,101 +20461,166 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetLabels == nil {
-				m.TargetLabels = make(map[string]string)
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.TargetLabels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15846,14 +20644,16 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
-			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15877,103 +20677,88 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
+			m.Inactive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15983,26 +20768,53 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.IgnoreDifferences = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16014,21 +20826,41 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreExtraneous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.IgnoreExtraneous = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16050,7 +20882,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16073,17 +20905,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16093,27 +20925,26 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16123,28 +20954,26 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16154,28 +20983,26 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16185,28 +21012,24 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16231,11 +21054,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16260,40 +21083,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16316,7 +21106,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16339,15 +21129,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16372,11 +21162,11 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16401,11 +21191,11 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16430,61 +21220,69 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16509,11 +21307,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Status = ResultCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16538,11 +21336,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16567,11 +21365,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.HookType = HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16596,11 +21394,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.HookPhase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16625,13 +21423,13 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SyncPhase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previewed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16641,21 +21439,12 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Previewed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16677,7 +21466,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16700,10 +21489,10 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16878,13 +21667,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ResultCode(dAtA[iNdEx:postIndex])
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16894,26 +21683,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16923,24 +21716,75 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.HookType = HookType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.RequiresPruning = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneProtected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PruneProtected = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDrift", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingDrift = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16965,11 +21809,11 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedFields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16994,7 +21838,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = string(dAtA[iNdEx:postIndex])
+			m.ModifiedFields = append(m.ModifiedFields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17017,7 +21861,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17040,17 +21884,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17060,26 +21904,16 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Limit |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17089,24 +21923,78 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17131,13 +22019,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17147,26 +22035,27 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var stringLen uint64
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17175,27 +22064,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17205,24 +22084,25 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17246,18 +22126,18 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
+			if m.RevisionMetadata == nil {
+				m.RevisionMetadata = &ResolvedRevisionMetadata{}
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RevisionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackID", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17267,17 +22147,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
+			m.RollbackID = &v
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17287,65 +22167,26 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiresPruning = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17370,11 +22211,11 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17398,32 +22239,16 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DeployStartedAt == nil {
+				m.DeployStartedAt = &v1.Time{}
+			}
+			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17447,7 +22272,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17639,6 +22464,85 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17863,6 +22767,46 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeHooks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeHooks = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackID", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RollbackID = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17969,11 +22913,40 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17998,7 +22971,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18140,6 +23113,86 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerificationSkipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureVerificationSkipped = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18223,6 +23276,101 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18451,6 +23599,101 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevisionMetadata == nil {
+				m.RevisionMetadata = &ResolvedRevisionMetadata{}
+			}
+			if err := m.RevisionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19280,310 +24523,400 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1/generated.proto", fileDescriptor_generated_6a8323e4682df88d)
-}
-
-var fileDescriptor_generated_6a8323e4682df88d = []byte{
-	// 4812 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x5b, 0x8c, 0x1c, 0xd9,
-	0x55, 0x5b, 0xdd, 0x3d, 0xfd, 0x38, 0xf3, 0xb0, 0xe7, 0xee, 0x7a, 0xd3, 0x19, 0xed, 0x7a, 0xac,
-	0xb2, 0x92, 0xec, 0x92, 0xa4, 0x87, 0xb5, 0x1c, 0x70, 0x40, 0xca, 0x32, 0x3d, 0xe3, 0xc7, 0xd8,
-	0xe3, 0xf1, 0xec, 0xed, 0xd9, 0xb5, 0xb4, 0x09, 0x61, 0xcb, 0x55, 0xb7, 0xbb, 0xcb, 0xd3, 0x5d,
-	0x55, 0x5b, 0x55, 0x3d, 0xf6, 0x2c, 0x24, 0x24, 0x40, 0x50, 0x08, 0x2c, 0x42, 0x20, 0xbe, 0x50,
-	0x78, 0x89, 0x1f, 0x22, 0x7e, 0x10, 0x12, 0xe1, 0x3b, 0x1f, 0xb0, 0x5f, 0x28, 0x44, 0x2b, 0x58,
-	0x01, 0xb2, 0x58, 0x87, 0x0f, 0x04, 0x1f, 0x80, 0x10, 0x3f, 0xfe, 0x42, 0xf7, 0x7d, 0xab, 0xba,
-	0xdb, 0x33, 0x76, 0x97, 0x1d, 0x94, 0xfc, 0x75, 0x9d, 0x73, 0xea, 0x9c, 0x7b, 0xcf, 0x3d, 0xf7,
-	0xdc, 0x73, 0xce, 0x3d, 0xd5, 0xb0, 0xd5, 0xf3, 0xd3, 0xfe, 0xe8, 0x56, 0xcb, 0x0d, 0x87, 0x6b,
-	0x4e, 0xdc, 0x0b, 0xa3, 0x38, 0xbc, 0xcd, 0x7e, 0x7c, 0xda, 0xf5, 0xd6, 0xa2, 0xfd, 0xde, 0x9a,
-	0x13, 0xf9, 0xc9, 0x9a, 0x13, 0x45, 0x03, 0xdf, 0x75, 0x52, 0x3f, 0x0c, 0xd6, 0x0e, 0x5e, 0x71,
-	0x06, 0x51, 0xdf, 0x79, 0x65, 0xad, 0x47, 0x02, 0x12, 0x3b, 0x29, 0xf1, 0x5a, 0x51, 0x1c, 0xa6,
-	0x21, 0xfa, 0xac, 0x66, 0xd5, 0x92, 0xac, 0xd8, 0x8f, 0x9f, 0x73, 0xbd, 0x56, 0xb4, 0xdf, 0x6b,
-	0x51, 0x56, 0x2d, 0x83, 0x55, 0x4b, 0xb2, 0x5a, 0xf9, 0xb4, 0x31, 0x8a, 0x5e, 0xd8, 0x0b, 0xd7,
-	0x18, 0xc7, 0x5b, 0xa3, 0x2e, 0x7b, 0x62, 0x0f, 0xec, 0x17, 0x97, 0xb4, 0x62, 0xef, 0x5f, 0x48,
-	0x5a, 0x7e, 0x48, 0xc7, 0xb6, 0xe6, 0x86, 0x31, 0x59, 0x3b, 0x18, 0x1b, 0xcd, 0xca, 0x79, 0x4d,
-	0x33, 0x74, 0xdc, 0xbe, 0x1f, 0x90, 0xf8, 0x50, 0x4f, 0x68, 0x48, 0x52, 0x67, 0xd2, 0x5b, 0x6b,
-	0xd3, 0xde, 0x8a, 0x47, 0x41, 0xea, 0x0f, 0xc9, 0xd8, 0x0b, 0x3f, 0x71, 0xd4, 0x0b, 0x89, 0xdb,
-	0x27, 0x43, 0x27, 0xff, 0x9e, 0xfd, 0x36, 0x2c, 0xae, 0xdf, 0xec, 0xac, 0x8f, 0xd2, 0xfe, 0x46,
-	0x18, 0x74, 0xfd, 0x1e, 0xfa, 0x0c, 0xcc, 0xbb, 0x83, 0x51, 0x92, 0x92, 0x78, 0xc7, 0x19, 0x92,
-	0xa6, 0x75, 0xc6, 0x7a, 0xa9, 0xd1, 0x7e, 0xf6, 0xbd, 0x7b, 0xab, 0xcf, 0xdc, 0xbf, 0xb7, 0x3a,
-	0xbf, 0xa1, 0x51, 0xd8, 0xa4, 0x43, 0x2f, 0x43, 0x2d, 0x0e, 0x07, 0x64, 0x1d, 0xef, 0x34, 0x4b,
-	0xec, 0x95, 0x13, 0xe2, 0x95, 0x1a, 0xe6, 0x60, 0x2c, 0xf1, 0xf6, 0x3f, 0x59, 0x00, 0xeb, 0x51,
-	0xb4, 0x1b, 0x87, 0xb7, 0x89, 0x9b, 0xa2, 0xb7, 0xa0, 0x4e, 0xb5, 0xe0, 0x39, 0xa9, 0xc3, 0xa4,
-	0xcd, 0x9f, 0xfb, 0xf1, 0x16, 0x9f, 0x4c, 0xcb, 0x9c, 0x8c, 0x5e, 0x39, 0x4a, 0xdd, 0x3a, 0x78,
-	0xa5, 0x75, 0xe3, 0x16, 0x7d, 0xff, 0x3a, 0x49, 0x9d, 0x36, 0x12, 0xc2, 0x40, 0xc3, 0xb0, 0xe2,
-	0x8a, 0xf6, 0xa1, 0x92, 0x44, 0xc4, 0x65, 0x03, 0x9b, 0x3f, 0xb7, 0xd5, 0x7a, 0x6c, 0xfb, 0x68,
-	0xe9, 0x61, 0x77, 0x22, 0xe2, 0xb6, 0x17, 0x84, 0xd8, 0x0a, 0x7d, 0xc2, 0x4c, 0x88, 0xfd, 0x8f,
-	0x16, 0x2c, 0x69, 0xb2, 0x6d, 0x3f, 0x49, 0xd1, 0x17, 0xc6, 0x66, 0xd8, 0x3a, 0xde, 0x0c, 0xe9,
-	0xdb, 0x6c, 0x7e, 0x27, 0x85, 0xa0, 0xba, 0x84, 0x18, 0xb3, 0xbb, 0x0d, 0x73, 0x7e, 0x4a, 0x86,
-	0x49, 0xb3, 0x74, 0xa6, 0xfc, 0xd2, 0xfc, 0xb9, 0x8b, 0x85, 0x4c, 0xaf, 0xbd, 0x28, 0x24, 0xce,
-	0x6d, 0x51, 0xde, 0x98, 0x8b, 0xb0, 0xff, 0xaa, 0x66, 0x4e, 0x8e, 0xce, 0x1a, 0xbd, 0x02, 0xf3,
-	0x49, 0x38, 0x8a, 0x5d, 0x82, 0x49, 0x14, 0x26, 0x4d, 0xeb, 0x4c, 0x99, 0x2e, 0x3e, 0xb5, 0x95,
-	0x8e, 0x06, 0x63, 0x93, 0x06, 0xfd, 0xba, 0x05, 0x0b, 0x1e, 0x49, 0x52, 0x3f, 0x60, 0xf2, 0xe5,
-	0xc8, 0x5f, 0x9b, 0x6d, 0xe4, 0x12, 0xb8, 0xa9, 0x39, 0xb7, 0x9f, 0x13, 0xb3, 0x58, 0x30, 0x80,
-	0x09, 0xce, 0x08, 0xa7, 0x06, 0xef, 0x91, 0xc4, 0x8d, 0xfd, 0x88, 0x3e, 0x37, 0xcb, 0x59, 0x83,
-	0xdf, 0xd4, 0x28, 0x6c, 0xd2, 0xa1, 0x7d, 0x98, 0xa3, 0x06, 0x9d, 0x34, 0x2b, 0x6c, 0xf0, 0x97,
-	0x66, 0x18, 0xbc, 0x50, 0x27, 0xdd, 0x28, 0x5a, 0xef, 0xf4, 0x29, 0xc1, 0x5c, 0x06, 0x7a, 0xd7,
-	0x82, 0xa6, 0xd8, 0x6d, 0x98, 0x70, 0x55, 0xde, 0xec, 0xfb, 0x29, 0x19, 0xf8, 0x49, 0xda, 0x9c,
-	0x63, 0x03, 0x58, 0x3b, 0x9e, 0x49, 0x5d, 0x8e, 0xc3, 0x51, 0x74, 0xcd, 0x0f, 0xbc, 0xf6, 0x19,
-	0x21, 0xa9, 0xb9, 0x31, 0x85, 0x31, 0x9e, 0x2a, 0x12, 0xfd, 0x8e, 0x05, 0x2b, 0x81, 0x33, 0x24,
-	0x49, 0xe4, 0xd0, 0x45, 0xe5, 0xe8, 0xf6, 0xc0, 0x71, 0xf7, 0xd9, 0x88, 0xaa, 0x8f, 0x37, 0x22,
-	0x5b, 0x8c, 0x68, 0x65, 0x67, 0x2a, 0x6b, 0xfc, 0x10, 0xb1, 0xe8, 0x0f, 0x2d, 0x58, 0x0e, 0xe3,
-	0xa8, 0xef, 0x04, 0xc4, 0x93, 0xd8, 0xa4, 0x59, 0x63, 0x3b, 0xee, 0xf3, 0x33, 0xac, 0xcf, 0x8d,
-	0x3c, 0xcf, 0xeb, 0x61, 0xe0, 0xa7, 0x61, 0xdc, 0x21, 0x69, 0xea, 0x07, 0xbd, 0xa4, 0x7d, 0xea,
-	0xfe, 0xbd, 0xd5, 0xe5, 0x31, 0x2a, 0x3c, 0x3e, 0x18, 0x74, 0x17, 0xe6, 0x93, 0xc3, 0xc0, 0xbd,
-	0xe9, 0x07, 0x5e, 0x78, 0x27, 0x69, 0xd6, 0x67, 0xde, 0xb2, 0x1d, 0xc5, 0x4d, 0x6c, 0x3a, 0xcd,
-	0x1d, 0x9b, 0xa2, 0xec, 0xbf, 0x2e, 0xc3, 0xbc, 0xb1, 0x4b, 0x9e, 0x82, 0xdb, 0x1d, 0x64, 0xdc,
-	0xee, 0xd5, 0x62, 0x76, 0xf7, 0x34, 0xbf, 0x8b, 0x52, 0xa8, 0x26, 0xa9, 0x93, 0x8e, 0x12, 0xb6,
-	0x83, 0xe7, 0xcf, 0x6d, 0x17, 0x24, 0x8f, 0xf1, 0x6c, 0x2f, 0x09, 0x89, 0x55, 0xfe, 0x8c, 0x85,
-	0x2c, 0xf4, 0x36, 0x34, 0xc2, 0x88, 0x1e, 0xa8, 0xd4, 0x75, 0x54, 0x98, 0xe0, 0xcd, 0x59, 0x2c,
-	0x4d, 0xf2, 0x6a, 0x2f, 0xde, 0xbf, 0xb7, 0xda, 0x50, 0x8f, 0x58, 0x4b, 0xb1, 0xff, 0xc1, 0x82,
-	0xe7, 0x8c, 0x01, 0x6e, 0x84, 0x81, 0xe7, 0xb3, 0x15, 0x3d, 0x03, 0x95, 0xf4, 0x30, 0x92, 0x47,
-	0xb6, 0xd2, 0xd1, 0xde, 0x61, 0x44, 0x30, 0xc3, 0xd0, 0x43, 0x7a, 0x48, 0x92, 0xc4, 0xe9, 0x91,
-	0xfc, 0x21, 0x7d, 0x9d, 0x83, 0xb1, 0xc4, 0xa3, 0x18, 0xd0, 0xc0, 0x49, 0xd2, 0xbd, 0xd8, 0x09,
-	0x12, 0xc6, 0x7e, 0xcf, 0x1f, 0x12, 0xa1, 0xda, 0x1f, 0x3b, 0x9e, 0xa1, 0xd0, 0x37, 0xda, 0xcf,
-	0xdf, 0xbf, 0xb7, 0x8a, 0xb6, 0xc7, 0x38, 0xe1, 0x09, 0xdc, 0xed, 0xb7, 0xe1, 0xf9, 0xc9, 0x7e,
-	0x1c, 0x7d, 0x1c, 0xaa, 0x09, 0x89, 0x0f, 0x48, 0x2c, 0x26, 0xa7, 0x97, 0x83, 0x41, 0xb1, 0xc0,
-	0xa2, 0x35, 0x68, 0x28, 0xff, 0x20, 0xa6, 0xb8, 0x2c, 0x48, 0x1b, 0xda, 0xa9, 0x68, 0x1a, 0xfb,
-	0x9f, 0x2d, 0x38, 0x61, 0xc8, 0x7c, 0x0a, 0xc7, 0xf5, 0x7e, 0xf6, 0xb8, 0xbe, 0x54, 0x8c, 0x99,
-	0x4e, 0x39, 0xaf, 0xff, 0xa2, 0x0a, 0xcb, 0xa6, 0x31, 0x33, 0x2f, 0xc4, 0x62, 0x35, 0x12, 0x85,
-	0xaf, 0xe3, 0x6d, 0xa1, 0x4e, 0x1d, 0xab, 0x71, 0x30, 0x96, 0x78, 0x6a, 0x53, 0x91, 0x93, 0xf6,
-	0x85, 0x2e, 0x95, 0x4d, 0xed, 0x3a, 0x69, 0x1f, 0x33, 0x0c, 0xfa, 0x1c, 0x2c, 0xa5, 0x4e, 0xdc,
-	0x23, 0x29, 0x26, 0x07, 0x7e, 0x22, 0xb7, 0x41, 0xa3, 0xfd, 0xbc, 0xa0, 0x5d, 0xda, 0xcb, 0x60,
-	0x71, 0x8e, 0x1a, 0x05, 0x50, 0xe9, 0x93, 0xc1, 0x50, 0xb8, 0xe9, 0xdd, 0x82, 0x76, 0x2d, 0x9b,
-	0xe8, 0x15, 0x32, 0x18, 0xb6, 0xeb, 0x74, 0xbc, 0xf4, 0x17, 0x66, 0x72, 0xd0, 0x2f, 0x59, 0xd0,
-	0xd8, 0x1f, 0x25, 0x69, 0x38, 0xf4, 0xdf, 0x21, 0xcd, 0x3a, 0x93, 0xfa, 0x7a, 0x91, 0x52, 0xaf,
-	0x49, 0xe6, 0x7c, 0x0f, 0xab, 0x47, 0xac, 0xc5, 0xa2, 0x77, 0xa0, 0xb6, 0x9f, 0x84, 0x41, 0x40,
-	0xd2, 0x66, 0x83, 0x8d, 0xa0, 0x53, 0xe8, 0x08, 0x38, 0xeb, 0xf6, 0x3c, 0x5d, 0x52, 0xf1, 0x80,
-	0xa5, 0x40, 0xa6, 0x00, 0xcf, 0x8f, 0x89, 0x9b, 0x86, 0xf1, 0x61, 0x13, 0x8a, 0x57, 0xc0, 0xa6,
-	0x64, 0xce, 0x15, 0xa0, 0x1e, 0xb1, 0x16, 0x8b, 0x0e, 0xa0, 0x1a, 0x0d, 0x46, 0x3d, 0x3f, 0x68,
-	0xce, 0xb3, 0x01, 0xe0, 0x22, 0x07, 0xb0, 0xcb, 0x38, 0xb7, 0x81, 0x3a, 0x08, 0xfe, 0x1b, 0x0b,
-	0x69, 0xe8, 0x2c, 0xcc, 0xb9, 0x7d, 0x27, 0x4e, 0x9b, 0x0b, 0xcc, 0x48, 0xd5, 0xae, 0xd9, 0xa0,
-	0x40, 0xcc, 0x71, 0xf6, 0xdf, 0x58, 0xb0, 0x32, 0x7d, 0x56, 0x7c, 0xfb, 0xb8, 0xa3, 0x38, 0xe1,
-	0xae, 0xb6, 0x6e, 0x6e, 0x1f, 0x06, 0xc6, 0x12, 0x8f, 0xbe, 0x0c, 0xb5, 0xdb, 0x62, 0x9d, 0x4b,
-	0xc5, 0xaf, 0xf3, 0x55, 0xb1, 0xce, 0x4a, 0xfe, 0x55, 0xb9, 0xd6, 0x42, 0xa8, 0xfd, 0x27, 0x25,
-	0x38, 0x35, 0x71, 0x5b, 0xa0, 0x16, 0xc0, 0x81, 0x33, 0x18, 0x91, 0x4b, 0x3e, 0x8d, 0x61, 0x79,
-	0xd4, 0xbe, 0x44, 0x8f, 0xf2, 0x37, 0x14, 0x14, 0x1b, 0x14, 0xe8, 0x17, 0x00, 0x22, 0x27, 0x76,
-	0x86, 0x24, 0x25, 0xb1, 0xf4, 0x5d, 0x57, 0x66, 0x98, 0x0c, 0x1d, 0xc4, 0xae, 0x64, 0xa8, 0x03,
-	0x09, 0x05, 0x4a, 0xb0, 0x21, 0x8f, 0xc6, 0xe8, 0x31, 0x19, 0x10, 0x27, 0x21, 0x2c, 0x29, 0xcd,
-	0xc5, 0xe8, 0x58, 0xa3, 0xb0, 0x49, 0x47, 0x8f, 0x0d, 0x36, 0x85, 0x44, 0xf8, 0x24, 0x75, 0x6c,
-	0xb0, 0x49, 0x26, 0x58, 0x60, 0xed, 0xff, 0xb5, 0xa0, 0x39, 0x4d, 0xbb, 0x28, 0x82, 0x1a, 0xb9,
-	0x9b, 0xbe, 0xe1, 0xc4, 0x5c, 0x4d, 0xb3, 0x85, 0x6b, 0x82, 0xe9, 0x1b, 0x4e, 0xac, 0x57, 0xed,
-	0x22, 0xe7, 0x8e, 0xa5, 0x18, 0xd4, 0x83, 0x4a, 0x3a, 0x70, 0x8a, 0x48, 0xe8, 0x0c, 0x71, 0x3a,
-	0x1e, 0xd8, 0x5e, 0x4f, 0x30, 0x13, 0x60, 0x7f, 0x6f, 0xd2, 0xbc, 0x85, 0xc3, 0xa0, 0x3a, 0x27,
-	0xc1, 0x81, 0x1f, 0x87, 0xc1, 0x90, 0x04, 0x69, 0xbe, 0x10, 0x70, 0x51, 0xa3, 0xb0, 0x49, 0x87,
-	0x7e, 0x71, 0x82, 0xa1, 0x5c, 0x9b, 0x61, 0x0a, 0x62, 0x38, 0xc7, 0xb6, 0x15, 0xfb, 0x0f, 0xca,
-	0x13, 0x76, 0xaf, 0xf2, 0xc2, 0xe8, 0x1c, 0x00, 0x3d, 0xfe, 0x77, 0x63, 0xd2, 0xf5, 0xef, 0x8a,
-	0x59, 0x29, 0x96, 0x3b, 0x0a, 0x83, 0x0d, 0x2a, 0xf9, 0x4e, 0x67, 0xd4, 0xa5, 0xef, 0x94, 0xc6,
-	0xdf, 0xe1, 0x18, 0x6c, 0x50, 0xa1, 0xf3, 0x50, 0xf5, 0x87, 0x4e, 0x8f, 0xd0, 0x78, 0x94, 0x6e,
-	0xae, 0x17, 0xa8, 0xdd, 0x6d, 0x31, 0xc8, 0x83, 0x7b, 0xab, 0x4b, 0x6a, 0x40, 0x0c, 0x84, 0x05,
-	0x2d, 0xfa, 0x23, 0x0b, 0x16, 0xdc, 0x70, 0x38, 0x0c, 0x83, 0x6d, 0xe7, 0x16, 0x19, 0xc8, 0xec,
-	0xb2, 0xf7, 0x44, 0x0e, 0xa8, 0xd6, 0x86, 0x21, 0xe9, 0x62, 0x90, 0xc6, 0x87, 0x3a, 0x61, 0x36,
-	0x51, 0x38, 0x33, 0xa4, 0x95, 0x57, 0x61, 0x79, 0xec, 0x45, 0x74, 0x12, 0xca, 0xfb, 0xe4, 0x90,
-	0xeb, 0x13, 0xd3, 0x9f, 0xe8, 0x39, 0x98, 0x63, 0xdb, 0x8b, 0xeb, 0x0b, 0xf3, 0x87, 0x9f, 0x2a,
-	0x5d, 0xb0, 0xec, 0xdf, 0xb3, 0xe0, 0x23, 0x53, 0x9c, 0x36, 0x0d, 0x38, 0x02, 0x5d, 0x77, 0x52,
-	0x46, 0xcb, 0xf6, 0x36, 0xc3, 0xa0, 0x2f, 0x42, 0x99, 0x04, 0x07, 0xc2, 0xb2, 0x36, 0x66, 0x50,
-	0xcc, 0xc5, 0xe0, 0x80, 0x4f, 0xba, 0x76, 0xff, 0xde, 0x6a, 0xf9, 0x62, 0x70, 0x80, 0x29, 0x63,
-	0xfb, 0xdb, 0x73, 0x99, 0x90, 0xb0, 0x23, 0x93, 0x0b, 0x36, 0x4a, 0x11, 0x10, 0x6e, 0x17, 0xb9,
-	0x1e, 0x46, 0x34, 0xcb, 0x8b, 0x24, 0x42, 0x16, 0xfa, 0xba, 0xc5, 0x4a, 0x13, 0x32, 0x0a, 0x16,
-	0x47, 0xc8, 0x13, 0x28, 0x93, 0x98, 0xd5, 0x0e, 0x09, 0xc4, 0xa6, 0x68, 0x7a, 0xe6, 0x45, 0xbc,
-	0x4a, 0x21, 0x9c, 0xaf, 0xf2, 0x5e, 0xb2, 0x78, 0x21, 0xf1, 0x68, 0x04, 0x40, 0xf3, 0xce, 0xdd,
-	0x70, 0xe0, 0xbb, 0x87, 0x22, 0x27, 0x9a, 0x35, 0xc3, 0xe5, 0xcc, 0xf8, 0x01, 0xa5, 0x9f, 0xb1,
-	0x21, 0x08, 0x7d, 0xd3, 0x82, 0x65, 0xbf, 0x17, 0x84, 0x31, 0xd9, 0xf4, 0xbb, 0x5d, 0x12, 0x93,
-	0x80, 0x26, 0xff, 0xbc, 0x36, 0xb2, 0x37, 0x83, 0x78, 0x99, 0xbb, 0x6f, 0xe5, 0x79, 0xb7, 0x3f,
-	0x2a, 0x54, 0xb0, 0x3c, 0x86, 0xc2, 0xe3, 0x23, 0x41, 0x0e, 0x54, 0xfc, 0xa0, 0x1b, 0x8a, 0xda,
-	0xc8, 0xab, 0x33, 0x8c, 0x68, 0x2b, 0xe8, 0x86, 0x7a, 0x67, 0xd0, 0x27, 0xcc, 0x58, 0xdb, 0xff,
-	0x53, 0xcf, 0x46, 0xfb, 0x3c, 0x45, 0x7d, 0x07, 0x1a, 0xb1, 0x2a, 0x86, 0xf0, 0x13, 0x6c, 0xab,
-	0x00, 0x7d, 0x88, 0xc4, 0x58, 0xa5, 0x57, 0xba, 0xec, 0xa1, 0xc5, 0xd1, 0x93, 0x8c, 0x2e, 0x91,
-	0xb0, 0xdc, 0x59, 0xad, 0x40, 0x88, 0xd4, 0xd9, 0xff, 0x61, 0x40, 0xb3, 0xff, 0xc3, 0xc0, 0x45,
-	0x21, 0x54, 0xfb, 0xc4, 0x19, 0xa4, 0x7d, 0x91, 0xa2, 0x5e, 0x9e, 0x29, 0x34, 0xa1, 0x8c, 0xf2,
-	0x89, 0x3f, 0x87, 0x62, 0x21, 0x06, 0x8d, 0xa0, 0xd6, 0xf7, 0x13, 0x16, 0x42, 0x73, 0x17, 0x7d,
-	0x75, 0x26, 0x9d, 0xf2, 0x64, 0xe8, 0x0a, 0xe7, 0xa8, 0x37, 0x97, 0x00, 0x60, 0x29, 0x0b, 0xfd,
-	0xb2, 0x05, 0xe0, 0xca, 0x8c, 0x5f, 0x9a, 0xf7, 0x8d, 0x62, 0x3c, 0x82, 0xaa, 0x24, 0xe8, 0xb3,
-	0x4d, 0x81, 0x12, 0x6c, 0x88, 0x45, 0x6f, 0xc1, 0x42, 0x4c, 0xdc, 0x30, 0x70, 0xfd, 0x01, 0xf1,
-	0xd6, 0xd3, 0x66, 0xf5, 0x91, 0xcb, 0x02, 0x27, 0xe9, 0x19, 0x83, 0x0d, 0x1e, 0x38, 0xc3, 0x11,
-	0x7d, 0xcd, 0x82, 0x25, 0x55, 0xf2, 0xa0, 0x4b, 0x41, 0x44, 0x82, 0xb8, 0x55, 0x44, 0x75, 0x85,
-	0x31, 0x6c, 0x23, 0x9a, 0x9d, 0x66, 0x61, 0x38, 0x27, 0x14, 0xbd, 0x09, 0x10, 0xde, 0x62, 0xc5,
-	0x05, 0x3a, 0xcf, 0xfa, 0x23, 0xcf, 0x73, 0x89, 0x57, 0xc7, 0x24, 0x07, 0x6c, 0x70, 0x43, 0xd7,
-	0x00, 0xf8, 0x3e, 0xd9, 0x3b, 0x8c, 0x08, 0xcb, 0x03, 0x1b, 0xed, 0x4f, 0x4a, 0xcd, 0x77, 0x14,
-	0xe6, 0xc1, 0xbd, 0xd5, 0xf1, 0x18, 0x9e, 0x15, 0x75, 0x8c, 0xd7, 0xd1, 0x5d, 0xa8, 0x25, 0xa3,
-	0xe1, 0xd0, 0x51, 0x29, 0xdd, 0xf5, 0x82, 0x8e, 0x28, 0xce, 0x54, 0x9b, 0xa4, 0x00, 0x60, 0x29,
-	0xce, 0x0e, 0x00, 0x8d, 0xd3, 0xa3, 0xf3, 0xb0, 0x40, 0xee, 0xa6, 0x24, 0x0e, 0x9c, 0xc1, 0xeb,
-	0x78, 0x5b, 0x66, 0x18, 0x6c, 0xd9, 0x2f, 0x1a, 0x70, 0x9c, 0xa1, 0x42, 0xb6, 0x0a, 0x9a, 0x4a,
-	0x8c, 0x1e, 0x74, 0xd0, 0x24, 0x43, 0x24, 0xfb, 0x57, 0x4b, 0x99, 0xf3, 0x79, 0x2f, 0x26, 0x04,
-	0x0d, 0x60, 0x2e, 0x08, 0x3d, 0xe5, 0xdf, 0x2e, 0x17, 0xe0, 0xdf, 0x76, 0x42, 0xcf, 0xa8, 0xc6,
-	0xd3, 0xa7, 0x04, 0x73, 0x21, 0xe8, 0x57, 0x2c, 0x58, 0x94, 0xa5, 0x5d, 0x86, 0x10, 0xc1, 0x48,
-	0x61, 0x62, 0x4f, 0x09, 0xb1, 0x8b, 0x37, 0x4c, 0x29, 0x38, 0x2b, 0xd4, 0xfe, 0xbe, 0x95, 0x49,
-	0xee, 0x6e, 0x3a, 0xa9, 0xdb, 0xbf, 0x78, 0x40, 0x63, 0xf0, 0x6b, 0x99, 0x4a, 0xe0, 0x4f, 0x9a,
-	0x95, 0xc0, 0x07, 0xf7, 0x56, 0x3f, 0x31, 0xed, 0xaa, 0xf0, 0x0e, 0xe5, 0xd0, 0x62, 0x2c, 0x8c,
-	0xa2, 0xe1, 0x97, 0x60, 0xde, 0x18, 0xb1, 0x70, 0xe5, 0x45, 0x95, 0xad, 0x54, 0xe4, 0x61, 0x00,
-	0xb1, 0x29, 0xcf, 0xfe, 0xed, 0x32, 0xd4, 0xc4, 0x0d, 0xc5, 0xb1, 0xcb, 0x80, 0x32, 0x88, 0x2c,
-	0x4d, 0x0d, 0x22, 0x23, 0xa8, 0xba, 0xec, 0xbe, 0x53, 0x9c, 0x17, 0xb3, 0xa4, 0xb2, 0x62, 0x74,
-	0xfc, 0xfe, 0x54, 0x8f, 0x89, 0x3f, 0x63, 0x21, 0x07, 0xbd, 0x6b, 0xc1, 0x09, 0x97, 0xa6, 0x32,
-	0xae, 0x76, 0x69, 0x95, 0x99, 0x2b, 0xe3, 0x1b, 0x59, 0x8e, 0xed, 0x8f, 0x08, 0xe9, 0x27, 0x72,
-	0x08, 0x9c, 0x97, 0x8d, 0x7e, 0x1a, 0x16, 0xb9, 0xb6, 0xde, 0x20, 0x31, 0x2b, 0xdb, 0xcd, 0x31,
-	0x65, 0x29, 0xd3, 0xeb, 0x98, 0x48, 0x9c, 0xa5, 0xb5, 0xff, 0xb2, 0x0c, 0x8b, 0x99, 0x69, 0xa3,
-	0x4f, 0x41, 0x7d, 0x94, 0xd0, 0x8d, 0xac, 0x62, 0x77, 0x55, 0x04, 0x7d, 0x5d, 0xc0, 0xb1, 0xa2,
-	0xa0, 0xd4, 0x91, 0x93, 0x24, 0x77, 0xc2, 0xd8, 0x13, 0x8b, 0xa4, 0xa8, 0x77, 0x05, 0x1c, 0x2b,
-	0x0a, 0x9a, 0x89, 0xde, 0x22, 0x4e, 0x4c, 0xe2, 0xbd, 0x70, 0x9f, 0x8c, 0xdd, 0xd0, 0xb5, 0x35,
-	0x0a, 0x9b, 0x74, 0x4c, 0xe3, 0xe9, 0x20, 0xd9, 0x18, 0xf8, 0x24, 0x48, 0xf9, 0x30, 0x0b, 0xd0,
-	0xf8, 0xde, 0x76, 0xc7, 0xe4, 0xa8, 0x35, 0x9e, 0x43, 0xe0, 0xbc, 0x6c, 0xf4, 0x55, 0x0b, 0x16,
-	0x9d, 0x3b, 0x89, 0xbe, 0x6b, 0x67, 0x2a, 0x9f, 0xcd, 0xf6, 0x32, 0x77, 0xf7, 0xed, 0x65, 0xba,
-	0x70, 0x19, 0x10, 0xce, 0x4a, 0xb4, 0xdf, 0xb7, 0x40, 0xde, 0xe1, 0x3f, 0x85, 0x5a, 0x77, 0x2f,
-	0x5b, 0xeb, 0x6e, 0xcf, 0xbe, 0xc9, 0xa6, 0xd4, 0xb9, 0x77, 0xa0, 0x46, 0x53, 0x52, 0x27, 0xf0,
-	0xd0, 0xc7, 0xa0, 0xe6, 0xf2, 0x9f, 0xe2, 0xcc, 0x61, 0x55, 0x50, 0x81, 0xc5, 0x12, 0x87, 0x5e,
-	0x80, 0x8a, 0x13, 0xf7, 0xe4, 0x39, 0xc3, 0x8a, 0xc4, 0xeb, 0x71, 0x2f, 0xc1, 0x0c, 0x6a, 0xbf,
-	0x5b, 0x02, 0xd8, 0x08, 0x87, 0x91, 0x13, 0x13, 0x6f, 0x2f, 0xfc, 0x91, 0x4f, 0xff, 0xec, 0xdf,
-	0xb0, 0x00, 0x51, 0x7d, 0x84, 0x01, 0x09, 0x74, 0x29, 0x06, 0xad, 0x41, 0xc3, 0x95, 0x50, 0xb1,
-	0xeb, 0x55, 0x3e, 0xa0, 0xc8, 0xb1, 0xa6, 0x39, 0x86, 0x63, 0x3e, 0x2b, 0xab, 0x06, 0xe5, 0x6c,
-	0x81, 0x96, 0x55, 0xec, 0x44, 0x11, 0xc1, 0xfe, 0xcd, 0x12, 0x3c, 0xcf, 0x0d, 0xfa, 0xba, 0x13,
-	0x38, 0x3d, 0x32, 0xa4, 0xa3, 0x3a, 0x6e, 0xfd, 0xe0, 0x2d, 0x9a, 0x88, 0xf9, 0xb2, 0x20, 0x3b,
-	0x93, 0x4d, 0x72, 0x5b, 0xe2, 0xd6, 0xb3, 0x15, 0xf8, 0x29, 0x66, 0x9c, 0x51, 0x04, 0x75, 0xd9,
-	0x66, 0x23, 0x8e, 0x97, 0x22, 0xa4, 0xa8, 0x8d, 0x76, 0x59, 0xf0, 0xc6, 0x4a, 0x8a, 0xfd, 0x1d,
-	0x0b, 0xf2, 0x1e, 0x9f, 0x1d, 0x96, 0xfc, 0x42, 0x34, 0x7f, 0x58, 0x66, 0xaf, 0x30, 0x1f, 0xe1,
-	0x52, 0xf0, 0x0b, 0x30, 0xef, 0xa4, 0x29, 0x19, 0x46, 0x29, 0x0b, 0x87, 0xcb, 0x8f, 0x17, 0x0e,
-	0x5f, 0x0f, 0x3d, 0xbf, 0xeb, 0xb3, 0x70, 0xd8, 0x64, 0x67, 0xbf, 0x06, 0x75, 0x59, 0x92, 0x39,
-	0xc6, 0x32, 0x9e, 0xcd, 0x94, 0x97, 0xa6, 0x18, 0x8a, 0x03, 0x0b, 0x66, 0x36, 0xf7, 0x04, 0x74,
-	0x62, 0xbf, 0x6b, 0xc1, 0x62, 0xa6, 0x98, 0x5d, 0xd0, 0xd8, 0xe9, 0xa9, 0xd7, 0x0d, 0x59, 0xa2,
-	0x1d, 0xfb, 0x01, 0x8f, 0x53, 0xea, 0x7a, 0xab, 0x5e, 0xd2, 0x28, 0x6c, 0xd2, 0xd9, 0xd7, 0x81,
-	0x95, 0x04, 0x8a, 0xd2, 0xe0, 0x6b, 0x50, 0xa7, 0xec, 0xa8, 0xb7, 0x2d, 0x8a, 0x65, 0x07, 0xea,
-	0x57, 0x6f, 0xee, 0xf1, 0x33, 0xda, 0x86, 0xb2, 0xef, 0x70, 0xdf, 0x51, 0xd6, 0x16, 0xbe, 0x95,
-	0x24, 0x23, 0x66, 0x1f, 0x14, 0x89, 0xce, 0x42, 0x99, 0xdc, 0x8d, 0x18, 0xcb, 0xb2, 0xf6, 0x2f,
-	0x17, 0xef, 0x46, 0x7e, 0x4c, 0x12, 0x4a, 0x44, 0xee, 0x46, 0xf6, 0x08, 0x40, 0x17, 0xbb, 0x8b,
-	0x5a, 0x82, 0x33, 0x50, 0x71, 0x43, 0x8f, 0x08, 0xdd, 0x2b, 0x36, 0x1b, 0xa1, 0x47, 0x30, 0xc3,
-	0xd8, 0xdf, 0xb0, 0xe0, 0x64, 0xbe, 0x42, 0xfd, 0x03, 0x73, 0x8b, 0xdb, 0x70, 0x52, 0xd5, 0x76,
-	0x6f, 0x44, 0x3c, 0x55, 0xbf, 0x00, 0x0b, 0xb7, 0x46, 0xfe, 0xc0, 0x13, 0xcf, 0x62, 0x38, 0xaa,
-	0xcc, 0xdb, 0x36, 0x70, 0x38, 0x43, 0x69, 0x27, 0xa0, 0xfb, 0x0f, 0x50, 0x57, 0x14, 0x72, 0xac,
-	0x99, 0x23, 0x96, 0xce, 0x61, 0xe0, 0xea, 0x36, 0x87, 0x7a, 0xb6, 0x8e, 0x63, 0xff, 0x71, 0x05,
-	0x72, 0x29, 0x39, 0x1a, 0x99, 0x2d, 0x16, 0x56, 0x81, 0x2d, 0x16, 0x6a, 0x4d, 0x26, 0xb5, 0x59,
-	0xa0, 0xcf, 0xc0, 0x5c, 0xd4, 0x77, 0x12, 0xb9, 0x28, 0xab, 0x52, 0xe3, 0xbb, 0x14, 0xf8, 0xc0,
-	0xac, 0x1c, 0x30, 0x08, 0xe6, 0xd4, 0xa6, 0xe7, 0x28, 0x1f, 0xe1, 0x4d, 0xbf, 0xcc, 0x0b, 0xa5,
-	0x98, 0x24, 0xa3, 0x41, 0x2a, 0x22, 0xd3, 0x9d, 0xa2, 0x34, 0xcb, 0xb9, 0xea, 0x8a, 0x29, 0x7f,
-	0xc6, 0x86, 0x44, 0xf4, 0x79, 0x68, 0x24, 0xa9, 0x13, 0xa7, 0x8f, 0x59, 0xc2, 0x51, 0xea, 0xeb,
-	0x48, 0x26, 0x58, 0xf3, 0x43, 0x6f, 0x02, 0x74, 0xfd, 0xc0, 0x4f, 0xfa, 0x8c, 0x7b, 0xed, 0xf1,
-	0x4e, 0x8a, 0x4b, 0x8a, 0x03, 0x36, 0xb8, 0xd9, 0x3f, 0x03, 0x67, 0x8e, 0x6a, 0xc9, 0xa2, 0xf1,
-	0xdd, 0x1d, 0x27, 0x0e, 0xc4, 0x0d, 0x2d, 0x33, 0xb3, 0x9b, 0x4e, 0x1c, 0x60, 0x06, 0xb5, 0xbf,
-	0x55, 0x82, 0x79, 0xa3, 0xeb, 0xee, 0x18, 0xfe, 0x22, 0xd7, 0x25, 0x58, 0x3a, 0x66, 0x97, 0xe0,
-	0x4b, 0x50, 0x8f, 0xc2, 0x81, 0xef, 0xfa, 0xea, 0x1e, 0x68, 0x81, 0x25, 0x39, 0x02, 0x86, 0x15,
-	0x16, 0xa5, 0xd0, 0xb8, 0x7d, 0x27, 0x65, 0x5e, 0x51, 0xde, 0xfa, 0xcc, 0x72, 0xb9, 0x21, 0x3d,
-	0xac, 0x5e, 0x26, 0x09, 0x49, 0xb0, 0x16, 0x84, 0x6c, 0xa8, 0xf6, 0xe2, 0x70, 0x14, 0xf1, 0x52,
-	0xa2, 0x28, 0xb8, 0xb0, 0x8e, 0xbc, 0x04, 0x0b, 0x8c, 0xfd, 0xbd, 0x12, 0x34, 0x30, 0x89, 0xc2,
-	0x8d, 0x98, 0x78, 0x09, 0x7a, 0x11, 0xca, 0xa3, 0x78, 0x20, 0x34, 0x35, 0x2f, 0x98, 0x97, 0x5f,
-	0xc7, 0xdb, 0x98, 0xc2, 0x33, 0x79, 0x60, 0xe9, 0x91, 0xf2, 0xc0, 0xf2, 0x91, 0x79, 0x20, 0x4d,
-	0x59, 0x93, 0xfe, 0x6e, 0xec, 0x1f, 0x38, 0x29, 0xb9, 0x46, 0x0e, 0xc5, 0xad, 0xae, 0x4e, 0x59,
-	0x3b, 0x57, 0x34, 0x12, 0x67, 0x69, 0xd1, 0x65, 0x58, 0xd6, 0x09, 0x19, 0x89, 0xd3, 0x4d, 0x9a,
-	0xf2, 0xf0, 0x9c, 0x57, 0x15, 0xf2, 0x75, 0x0a, 0x27, 0x08, 0xf0, 0xf8, 0x3b, 0x68, 0x13, 0x4e,
-	0x66, 0x80, 0x74, 0x20, 0x55, 0xc6, 0xa7, 0x29, 0xf8, 0x9c, 0xcc, 0xf0, 0xa1, 0x63, 0x19, 0x7b,
-	0xc3, 0xfe, 0xc0, 0x82, 0x45, 0xa5, 0xd4, 0xa7, 0x90, 0x8a, 0xf9, 0xd9, 0x54, 0x6c, 0x73, 0xa6,
-	0x52, 0x95, 0x18, 0xf6, 0x94, 0x64, 0xec, 0xf7, 0xab, 0x00, 0xac, 0xd1, 0xd7, 0x67, 0x25, 0xeb,
-	0x33, 0x50, 0x89, 0x49, 0x14, 0xe6, 0xf7, 0x16, 0xa5, 0xc0, 0x0c, 0xf3, 0xff, 0xd7, 0x66, 0x26,
-	0xd5, 0x6c, 0xe6, 0x7e, 0x80, 0x35, 0x9b, 0x0e, 0x9c, 0xf2, 0x83, 0x84, 0xb8, 0xa3, 0x58, 0x5c,
-	0x47, 0x5d, 0x09, 0x13, 0x65, 0x7f, 0xf5, 0xf6, 0x8b, 0x82, 0xd1, 0xa9, 0xad, 0x49, 0x44, 0x78,
-	0xf2, 0xbb, 0x54, 0x9f, 0x12, 0xc1, 0xfc, 0x74, 0xdd, 0x88, 0xc3, 0x04, 0x1c, 0x2b, 0x0a, 0x1a,
-	0xdb, 0x90, 0xc0, 0xb9, 0x35, 0x20, 0xdb, 0xdd, 0x84, 0xd5, 0xc3, 0xeb, 0x46, 0x48, 0xc6, 0x11,
-	0x97, 0x3a, 0x58, 0xd3, 0x4c, 0xde, 0x77, 0x8d, 0x82, 0xf6, 0x1d, 0x3c, 0xea, 0xbe, 0x53, 0x4d,
-	0x92, 0xf3, 0x53, 0x9b, 0x24, 0xe5, 0x59, 0xb0, 0x30, 0xf5, 0x2c, 0xf8, 0x1c, 0x2c, 0xf9, 0x41,
-	0x9f, 0xc4, 0x7e, 0x4a, 0x3c, 0xb6, 0x11, 0x9a, 0x8b, 0x4c, 0x11, 0xaa, 0xe5, 0x6d, 0x2b, 0x83,
-	0xc5, 0x39, 0x6a, 0xfb, 0xeb, 0x25, 0x38, 0xa5, 0x37, 0x08, 0x1d, 0x99, 0xdf, 0xa5, 0x56, 0xc2,
-	0x9a, 0x13, 0x78, 0xa1, 0xcd, 0xf8, 0xf6, 0x42, 0x5d, 0xc6, 0x74, 0x14, 0x06, 0x1b, 0x54, 0x74,
-	0xfd, 0x5c, 0x12, 0xb3, 0x8a, 0x6d, 0x7e, 0xf7, 0x6c, 0x08, 0x38, 0x56, 0x14, 0xec, 0xf3, 0x0e,
-	0x12, 0xa7, 0x9d, 0xd1, 0x2d, 0xf6, 0x42, 0xae, 0x96, 0xb6, 0xa1, 0x51, 0xd8, 0xa4, 0xa3, 0xe7,
-	0x98, 0x2b, 0x17, 0x8f, 0xee, 0xa0, 0x05, 0x7e, 0x8e, 0xa9, 0xf5, 0x52, 0x58, 0x39, 0x1c, 0x9a,
-	0x34, 0x08, 0xf7, 0x9a, 0x19, 0x0e, 0xbb, 0xae, 0x54, 0x14, 0xf6, 0x7f, 0x59, 0xf0, 0xd1, 0x89,
-	0xaa, 0x78, 0x0a, 0x2e, 0x71, 0x94, 0x75, 0x89, 0xbb, 0x33, 0xba, 0xc4, 0xb1, 0x29, 0x4c, 0x71,
-	0x8f, 0x7f, 0x6f, 0xc1, 0x92, 0xa6, 0x7f, 0x0a, 0xf3, 0xec, 0x16, 0xf7, 0x81, 0x88, 0x1e, 0x77,
-	0xbb, 0x31, 0x36, 0xb1, 0x0f, 0xd8, 0xc4, 0x78, 0x3c, 0xb6, 0xee, 0xca, 0x96, 0xe4, 0x23, 0xe2,
-	0xaa, 0x03, 0xa8, 0xb2, 0xde, 0x1d, 0x39, 0xba, 0x9d, 0x02, 0xee, 0x50, 0xb8, 0x70, 0x96, 0x8f,
-	0xe9, 0x0c, 0x9f, 0x3d, 0x26, 0x58, 0x48, 0xa3, 0x66, 0xea, 0xf9, 0x09, 0x75, 0x52, 0x9e, 0x48,
-	0xef, 0x94, 0x0a, 0x37, 0x05, 0x1c, 0x2b, 0x0a, 0x7b, 0x08, 0xcd, 0x2c, 0xf3, 0x4d, 0x42, 0xe3,
-	0xd1, 0x63, 0xce, 0x71, 0x0d, 0x1a, 0x0e, 0x7b, 0x6b, 0x7b, 0xe4, 0xe4, 0xbb, 0x92, 0xd7, 0x25,
-	0x02, 0x6b, 0x1a, 0xfb, 0x4f, 0x2d, 0x78, 0x76, 0xc2, 0x64, 0x0a, 0x4c, 0x6b, 0x53, 0xbd, 0xf9,
-	0xa7, 0x34, 0x8a, 0x7b, 0xa4, 0xeb, 0xc8, 0xbc, 0xc4, 0xc8, 0x62, 0x36, 0x39, 0x18, 0x4b, 0xbc,
-	0xfd, 0xef, 0x16, 0x9c, 0xc8, 0x8e, 0x35, 0x41, 0x57, 0x01, 0xf1, 0xc9, 0x6c, 0xfa, 0x89, 0x1b,
-	0x1e, 0x90, 0xf8, 0x90, 0xce, 0x9c, 0x8f, 0x7a, 0x45, 0x70, 0x42, 0xeb, 0x63, 0x14, 0x78, 0xc2,
-	0x5b, 0xe8, 0x1b, 0xac, 0x0a, 0x2a, 0xb5, 0x2d, 0xcd, 0xa4, 0x53, 0x98, 0x99, 0xe8, 0x95, 0x34,
-	0xc3, 0x79, 0x25, 0x0f, 0x9b, 0xc2, 0xed, 0xf7, 0x4b, 0xb0, 0x20, 0x5f, 0xdf, 0xf4, 0xbb, 0x5d,
-	0xaa, 0x6f, 0x16, 0x25, 0x8b, 0xc9, 0x29, 0x7d, 0xb3, 0x10, 0x1a, 0x73, 0x1c, 0xd5, 0xf7, 0xbe,
-	0x1f, 0x78, 0xf9, 0xf4, 0xfe, 0x9a, 0x1f, 0x78, 0x98, 0x61, 0xb2, 0x7d, 0xeb, 0xe5, 0xa3, 0xfb,
-	0xd6, 0x95, 0x25, 0x54, 0x1e, 0x96, 0xb0, 0xf0, 0x4e, 0x6b, 0x1d, 0xb6, 0x18, 0x8e, 0x7e, 0x4f,
-	0xa3, 0xb0, 0x49, 0x47, 0x47, 0x32, 0xf0, 0x0f, 0x08, 0x7f, 0xa9, 0x9a, 0x1d, 0xc9, 0xb6, 0x44,
-	0x60, 0x4d, 0x43, 0x47, 0xe2, 0xf9, 0xdd, 0x2e, 0x0b, 0x1d, 0x8c, 0x91, 0x50, 0xed, 0x60, 0x86,
-	0xa1, 0x14, 0xfd, 0x30, 0xdc, 0x17, 0xd1, 0x82, 0xa2, 0xb8, 0x12, 0x86, 0xfb, 0x98, 0x61, 0xec,
-	0xff, 0x60, 0xa7, 0xc0, 0x94, 0x3e, 0x9b, 0xa2, 0x74, 0x2c, 0x55, 0x56, 0x7e, 0xd8, 0x3e, 0xd5,
-	0xab, 0x50, 0x39, 0xc6, 0x2a, 0x9c, 0x87, 0x85, 0xdb, 0x49, 0x18, 0xec, 0x86, 0x7e, 0xc0, 0xba,
-	0x1d, 0xe7, 0xf4, 0x25, 0xf7, 0xd5, 0xce, 0x8d, 0x1d, 0x09, 0xc7, 0x19, 0x2a, 0xfb, 0x3b, 0x73,
-	0xf0, 0xbc, 0xba, 0xee, 0x25, 0xe9, 0x9d, 0x30, 0xde, 0xf7, 0x83, 0x1e, 0x2b, 0xda, 0x7d, 0xd3,
-	0x82, 0x05, 0xbe, 0x1a, 0xa2, 0xfd, 0x8f, 0xdf, 0x67, 0xbb, 0x45, 0x5c, 0x2c, 0x67, 0x24, 0xb5,
-	0xf6, 0x0c, 0x29, 0xb9, 0xd6, 0x3f, 0x13, 0x85, 0x33, 0xc3, 0x41, 0xef, 0x00, 0xc8, 0xf6, 0xfd,
-	0x6e, 0x11, 0x5f, 0x30, 0xc8, 0xc1, 0x61, 0xd2, 0xd5, 0x71, 0xce, 0x9e, 0x92, 0x80, 0x0d, 0x69,
-	0xe8, 0x6b, 0x16, 0x54, 0x07, 0x5c, 0x2b, 0x65, 0x26, 0xf8, 0x67, 0x8b, 0xd7, 0x8a, 0xa9, 0x0f,
-	0x75, 0x72, 0x08, 0x4d, 0x08, 0xe1, 0x08, 0x43, 0xcd, 0x0f, 0x7a, 0x31, 0x49, 0x64, 0x9a, 0xfe,
-	0x09, 0xe3, 0xac, 0x6e, 0xb9, 0x61, 0x4c, 0xd8, 0xc9, 0x1c, 0x3a, 0x5e, 0xdb, 0x19, 0x38, 0x81,
-	0x4b, 0xe2, 0x2d, 0x4e, 0xae, 0x9d, 0xa8, 0x00, 0x60, 0xc9, 0x68, 0xac, 0x5b, 0x62, 0xee, 0x38,
-	0xdd, 0x12, 0x2b, 0xaf, 0xc2, 0xf2, 0xd8, 0x32, 0x3e, 0x4a, 0x23, 0xe6, 0xca, 0x67, 0x61, 0xfe,
-	0x71, 0x7b, 0x38, 0xdf, 0x9f, 0xd3, 0x9e, 0x70, 0x27, 0xf4, 0x58, 0x9b, 0x40, 0xac, 0x57, 0x53,
-	0x84, 0x31, 0x45, 0xd9, 0x86, 0xd1, 0xea, 0xad, 0x80, 0xd8, 0x94, 0x47, 0x2d, 0x33, 0x72, 0x62,
-	0x12, 0x3c, 0x51, 0xcb, 0xdc, 0x55, 0x12, 0xb0, 0x21, 0x0d, 0x11, 0xd1, 0xda, 0x57, 0x9e, 0xb9,
-	0x6a, 0x23, 0x4b, 0xed, 0x93, 0xda, 0xfb, 0x68, 0x36, 0xba, 0x14, 0x64, 0xec, 0x55, 0x14, 0x0d,
-	0x5f, 0x2b, 0x7c, 0x23, 0xf0, 0xde, 0xa8, 0x2c, 0x0c, 0xe7, 0x84, 0xa3, 0x75, 0x38, 0x21, 0x57,
-	0x20, 0xdb, 0x43, 0xa0, 0x12, 0x5a, 0x9c, 0x45, 0xe3, 0x3c, 0xbd, 0xd1, 0xef, 0x53, 0x9d, 0xd6,
-	0xef, 0x83, 0xf6, 0x55, 0x6b, 0x5f, 0xad, 0xd8, 0xd6, 0x3e, 0x18, 0x6f, 0xeb, 0xb3, 0xbf, 0x6d,
-	0xc1, 0x49, 0x39, 0xea, 0x1b, 0x07, 0x24, 0x8e, 0x7d, 0x8f, 0x9d, 0x0b, 0x1c, 0xad, 0xa3, 0x18,
-	0x75, 0x2e, 0x5c, 0x91, 0x08, 0xac, 0x69, 0x68, 0xce, 0x3b, 0xde, 0x8a, 0x5a, 0xca, 0xe6, 0xbc,
-	0xc7, 0x6a, 0x1a, 0x7d, 0x19, 0x6a, 0x3c, 0x24, 0x4a, 0xf2, 0xd5, 0x64, 0x11, 0x6a, 0x61, 0x89,
-	0xb7, 0xff, 0xdb, 0x02, 0x73, 0x77, 0x1c, 0xef, 0xd4, 0x7c, 0x19, 0x6a, 0x07, 0x62, 0xe9, 0x72,
-	0xf7, 0x5c, 0x72, 0xc9, 0x24, 0x5e, 0x1d, 0xb0, 0xe5, 0xe3, 0x05, 0x31, 0x95, 0x47, 0x08, 0x62,
-	0xe6, 0xa6, 0x9e, 0xc8, 0x2f, 0x42, 0x79, 0xe4, 0x7b, 0x22, 0x0e, 0xd1, 0xc5, 0xc6, 0xad, 0x4d,
-	0x4c, 0xe1, 0xf6, 0xbf, 0x96, 0x75, 0xc6, 0x21, 0x8a, 0xda, 0x3f, 0x14, 0xd3, 0x3e, 0xaf, 0xae,
-	0x29, 0xf9, 0xcc, 0x5f, 0xc8, 0x5e, 0x53, 0x3e, 0xb8, 0xb7, 0x0a, 0x7c, 0xba, 0xec, 0x26, 0x6a,
-	0xc2, 0xa5, 0x65, 0xed, 0x88, 0xab, 0x87, 0x0b, 0x50, 0xa7, 0x81, 0x17, 0x2b, 0x01, 0xd4, 0x33,
-	0x22, 0xea, 0x57, 0x04, 0xfc, 0x81, 0xf1, 0x1b, 0x2b, 0x6a, 0xb4, 0x0e, 0x0d, 0xfa, 0x9b, 0xdd,
-	0x79, 0x88, 0x32, 0xce, 0x59, 0xb5, 0x17, 0x24, 0x62, 0xc2, 0xf5, 0x88, 0x7e, 0x8b, 0x2a, 0x8c,
-	0xf5, 0x6d, 0x33, 0x16, 0x90, 0x55, 0x58, 0x47, 0x22, 0xb0, 0xa6, 0xb1, 0x3f, 0x34, 0x96, 0x59,
-	0x5c, 0xe4, 0xfe, 0x50, 0x2c, 0xf3, 0x85, 0xdc, 0x32, 0x9f, 0x19, 0x5b, 0xe6, 0x25, 0xdd, 0xf6,
-	0x9c, 0x59, 0xea, 0xa7, 0xe9, 0x13, 0x8f, 0x8e, 0xdf, 0xf9, 0x49, 0xf0, 0xf6, 0xc8, 0x8f, 0x49,
-	0xb2, 0x1b, 0x8f, 0x02, 0x3f, 0xe8, 0x31, 0xd3, 0xa8, 0x9b, 0x27, 0x41, 0x06, 0x8d, 0xf3, 0xf4,
-	0xf6, 0x9f, 0x97, 0x68, 0x1a, 0x99, 0x69, 0x83, 0xa6, 0x39, 0x7a, 0x2c, 0x3f, 0x2a, 0xcd, 0x55,
-	0xb6, 0xd4, 0xe7, 0xa4, 0x8a, 0x02, 0x7d, 0x11, 0xc0, 0x23, 0xd1, 0x20, 0x3c, 0x64, 0x37, 0x4e,
-	0x95, 0x47, 0xbe, 0x71, 0x52, 0xa7, 0xfc, 0xa6, 0xe2, 0x82, 0x0d, 0x8e, 0x68, 0x05, 0x4a, 0xbe,
-	0xc7, 0x56, 0xb3, 0xdc, 0x06, 0x41, 0x5b, 0xda, 0xda, 0xc4, 0x25, 0xdf, 0x33, 0x1a, 0x84, 0xaa,
-	0x4f, 0xaf, 0x41, 0xc8, 0xfe, 0x3b, 0x76, 0x58, 0xf1, 0xe9, 0x5f, 0x97, 0xd5, 0x9e, 0x8f, 0x43,
-	0xd5, 0x19, 0xa5, 0xfd, 0x70, 0xac, 0x47, 0x72, 0x9d, 0x41, 0xb1, 0xc0, 0xa2, 0x6d, 0xa8, 0x78,
-	0x34, 0xc7, 0x2b, 0x3d, 0xb2, 0xa2, 0x74, 0x8e, 0x47, 0x53, 0x41, 0xc6, 0x05, 0xbd, 0x00, 0x95,
-	0xd4, 0xe9, 0xc9, 0x3b, 0x2e, 0x76, 0xdd, 0xb6, 0xe7, 0xf4, 0x12, 0xcc, 0xa0, 0xa6, 0x67, 0xaa,
-	0x1c, 0xd1, 0x4e, 0xf1, 0x67, 0x15, 0x58, 0xcc, 0x5c, 0x64, 0x66, 0xac, 0xc0, 0x3a, 0xd2, 0x0a,
-	0xce, 0xc2, 0x5c, 0x14, 0x8f, 0x02, 0x3e, 0xaf, 0xba, 0x76, 0x0c, 0xd4, 0xce, 0x08, 0xe6, 0x38,
-	0xaa, 0x23, 0x2f, 0x3e, 0xc4, 0xa3, 0x40, 0x94, 0x7e, 0x94, 0x8e, 0x36, 0x19, 0x14, 0x0b, 0x2c,
-	0xfa, 0x12, 0x2c, 0x24, 0x6c, 0x03, 0xc6, 0x4e, 0x4a, 0x7a, 0xf2, 0x63, 0x96, 0xcb, 0x33, 0x7f,
-	0xc6, 0xc0, 0xd9, 0xf1, 0xf8, 0xde, 0x84, 0xe0, 0x8c, 0x38, 0xf4, 0x55, 0xcb, 0xfc, 0x74, 0xa3,
-	0x3a, 0x73, 0x95, 0x32, 0x7f, 0x41, 0xcc, 0xad, 0xeb, 0xe1, 0x5f, 0x70, 0x44, 0xca, 0xb2, 0x6b,
-	0x4f, 0xc0, 0xb2, 0x61, 0x42, 0xdb, 0xdb, 0x27, 0xa1, 0x31, 0x74, 0x02, 0xbf, 0x4b, 0x92, 0x94,
-	0xff, 0x41, 0x46, 0x83, 0x7f, 0x47, 0x7c, 0x5d, 0x02, 0xb1, 0xc6, 0xdb, 0x5f, 0xb1, 0xe0, 0xd4,
-	0xc4, 0x69, 0x3d, 0xb5, 0xaa, 0x01, 0xf5, 0x5c, 0xcf, 0x4e, 0xb8, 0x7a, 0x47, 0x07, 0x4f, 0xe6,
-	0xbb, 0x1b, 0x71, 0xb1, 0xbf, 0x38, 0x75, 0xc5, 0x1e, 0xcd, 0x6b, 0x6a, 0xcf, 0x55, 0x7e, 0x8a,
-	0x9e, 0xeb, 0xd7, 0x2c, 0x30, 0xbe, 0xe3, 0x42, 0x3f, 0x0f, 0x0d, 0x67, 0x94, 0x86, 0x43, 0x27,
-	0x25, 0x9e, 0xc8, 0x1c, 0x77, 0x0a, 0xf9, 0x62, 0x6c, 0x5d, 0x72, 0xe5, 0xfa, 0x52, 0x8f, 0x58,
-	0xcb, 0xb3, 0xfb, 0x7c, 0xf9, 0x72, 0x2f, 0x68, 0x47, 0x62, 0x3d, 0xc4, 0x91, 0x7c, 0x0a, 0xea,
-	0x09, 0x19, 0x74, 0xe9, 0x81, 0x29, 0x1c, 0x8e, 0xd2, 0x75, 0x47, 0xc0, 0xb1, 0xa2, 0xb0, 0xff,
-	0x53, 0xcc, 0x5a, 0xc4, 0x30, 0x17, 0x72, 0xcd, 0x68, 0xc7, 0x3f, 0xfe, 0x0f, 0x01, 0x5c, 0xd5,
-	0x9d, 0x5a, 0xc0, 0xc7, 0x55, 0xba, 0xd5, 0xd5, 0xfc, 0xf4, 0x47, 0xc2, 0xb0, 0x21, 0x2c, 0x63,
-	0x5d, 0xe5, 0xa3, 0xac, 0xcb, 0xfe, 0x37, 0x0b, 0x32, 0x0e, 0x0e, 0x0d, 0x61, 0x8e, 0x8e, 0xe0,
-	0xb0, 0x80, 0x46, 0x5a, 0x93, 0x2f, 0xb5, 0x3c, 0x71, 0x25, 0xc1, 0x7e, 0x62, 0x2e, 0x05, 0xf9,
-	0x22, 0x74, 0xe1, 0x2a, 0xba, 0x56, 0x90, 0x34, 0x1a, 0xf9, 0x88, 0xff, 0x95, 0xd0, 0x35, 0xcc,
-	0x0b, 0xb0, 0x3c, 0x36, 0x22, 0x6a, 0x44, 0xac, 0x37, 0x2f, 0x6f, 0x44, 0xac, 0x7b, 0x0f, 0x73,
-	0x9c, 0xfd, 0x2d, 0x0b, 0x4e, 0xe6, 0xd9, 0xa3, 0xdf, 0xb5, 0x60, 0x39, 0xc9, 0xf3, 0x7b, 0x22,
-	0x5a, 0x53, 0x19, 0xe9, 0x18, 0x0a, 0x8f, 0x8f, 0xc0, 0xfe, 0xdb, 0x12, 0xb7, 0x61, 0xfe, 0xb7,
-	0x42, 0xca, 0x81, 0x5a, 0x53, 0x1d, 0x28, 0xdd, 0x22, 0x6e, 0x9f, 0x78, 0xa3, 0xc1, 0xd8, 0xf5,
-	0x64, 0x47, 0xc0, 0xb1, 0xa2, 0x60, 0xd7, 0x32, 0x23, 0xd1, 0xeb, 0x95, 0x33, 0xaf, 0x4d, 0x01,
-	0xc7, 0x8a, 0x02, 0x9d, 0x87, 0x05, 0x63, 0x92, 0xbc, 0x1e, 0x27, 0xca, 0x66, 0x86, 0x2f, 0x4a,
-	0x70, 0x86, 0x0a, 0xb5, 0xf8, 0xd7, 0xdc, 0x2c, 0x4a, 0x97, 0xa5, 0xb6, 0x25, 0xf9, 0x25, 0x37,
-	0x87, 0x62, 0x83, 0x82, 0xdd, 0x7d, 0xf2, 0xee, 0x73, 0x59, 0xa6, 0xe0, 0x77, 0x9f, 0x02, 0x86,
-	0x15, 0x16, 0x9d, 0x03, 0x18, 0x3a, 0xc1, 0xc8, 0x19, 0x50, 0x0d, 0x89, 0xcb, 0x74, 0xb5, 0xa1,
-	0xae, 0x2b, 0x0c, 0x36, 0xa8, 0xe8, 0x16, 0xc9, 0x7f, 0x3a, 0x90, 0xb9, 0x92, 0xb7, 0x8e, 0xbc,
-	0x92, 0xcf, 0x5e, 0x1a, 0x97, 0x8e, 0x75, 0x69, 0x6c, 0xde, 0xe7, 0x96, 0x1f, 0x7a, 0x9f, 0xfb,
-	0x31, 0xa8, 0xed, 0x93, 0x43, 0xe3, 0xe2, 0x97, 0xff, 0xab, 0x08, 0x07, 0x61, 0x89, 0x43, 0x36,
-	0x54, 0x5d, 0x47, 0xf5, 0xd4, 0x2c, 0xf0, 0x93, 0x7d, 0x63, 0x9d, 0x11, 0x09, 0x4c, 0xbb, 0xf5,
-	0xde, 0x87, 0xa7, 0x9f, 0xf9, 0xee, 0x87, 0xa7, 0x9f, 0xf9, 0xe0, 0xc3, 0xd3, 0xcf, 0x7c, 0xe5,
-	0xfe, 0x69, 0xeb, 0xbd, 0xfb, 0xa7, 0xad, 0xef, 0xde, 0x3f, 0x6d, 0x7d, 0x70, 0xff, 0xb4, 0xf5,
-	0x2f, 0xf7, 0x4f, 0x5b, 0xbf, 0xf5, 0xfd, 0xd3, 0xcf, 0xbc, 0x59, 0x97, 0xb6, 0xfa, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa5, 0x0a, 0x28, 0xdc, 0x14, 0x52, 0x00, 0x00,
+	proto.RegisterFile("github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1/generated.proto", fileDescriptor_generated_d1d2f47d27dea123)
+}
+
+var fileDescriptor_generated_d1d2f47d27dea123 = []byte{
+	// 6254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x3d, 0x5b, 0x8c, 0x24, 0xd7,
+	0x55, 0xee, 0xe9, 0x79, 0xde, 0x99, 0x59, 0xef, 0x5c, 0x7b, 0x37, 0xe3, 0x95, 0xe3, 0x58, 0xe5,
+	0x84, 0x84, 0x84, 0xcc, 0x62, 0xc7, 0xc0, 0x26, 0x48, 0x09, 0xd3, 0x33, 0xfb, 0x98, 0xdd, 0x99,
+	0xd9, 0xf1, 0xe9, 0xb1, 0x57, 0xca, 0xd3, 0xb5, 0xdd, 0xd5, 0xdd, 0xe5, 0xe9, 0xae, 0x6a, 0x57,
+	0x75, 0xcf, 0xee, 0x98, 0x10, 0x08, 0x90, 0x87, 0x02, 0x41, 0xbc, 0x8c, 0x90, 0xa2, 0x10, 0x90,
+	0x90, 0x80, 0x48, 0x11, 0x42, 0x48, 0xf0, 0x85, 0x22, 0x8c, 0x04, 0xfe, 0x42, 0x21, 0x0a, 0xc4,
+	0x22, 0x28, 0x82, 0xe4, 0x07, 0xf8, 0x82, 0x0f, 0x7e, 0x2c, 0x21, 0x71, 0xce, 0x7d, 0x57, 0x75,
+	0xf7, 0xce, 0xcc, 0x76, 0xcd, 0x6c, 0x14, 0xf1, 0x31, 0x76, 0xd7, 0x3d, 0xa7, 0xce, 0xb9, 0x8f,
+	0x73, 0xcf, 0x39, 0xf7, 0x9c, 0x73, 0x6b, 0xd9, 0x46, 0x33, 0xec, 0xb5, 0xfa, 0xb7, 0x57, 0x6a,
+	0x71, 0xe7, 0xa2, 0x9f, 0x34, 0xe3, 0x6e, 0x12, 0xbf, 0x24, 0x7e, 0xbc, 0xb7, 0x56, 0xbf, 0xd8,
+	0xdd, 0x6b, 0x5e, 0xf4, 0xbb, 0x61, 0x8a, 0xff, 0xe9, 0xb6, 0xc3, 0x9a, 0xdf, 0x0b, 0xe3, 0xe8,
+	0xe2, 0xfe, 0xd3, 0x7e, 0xbb, 0xdb, 0xf2, 0x9f, 0xbe, 0xd8, 0x0c, 0xa2, 0x20, 0xf1, 0x7b, 0x41,
+	0x7d, 0x05, 0x5f, 0xea, 0xc5, 0xfc, 0xfd, 0x96, 0xd4, 0x8a, 0x26, 0x25, 0x7e, 0x7c, 0xa2, 0x86,
+	0x28, 0x7b, 0xcd, 0x15, 0x22, 0xb5, 0xe2, 0x90, 0x5a, 0xd1, 0xa4, 0x2e, 0xbc, 0xd7, 0xe9, 0x45,
+	0x33, 0x6e, 0xc6, 0x17, 0x05, 0xc5, 0xdb, 0xfd, 0x86, 0x78, 0x12, 0x0f, 0xe2, 0x97, 0xe4, 0x74,
+	0xc1, 0xdb, 0xbb, 0x94, 0xae, 0x84, 0x31, 0xf5, 0xed, 0x62, 0x2d, 0x4e, 0x02, 0xec, 0x53, 0xbe,
+	0x37, 0x17, 0x9e, 0xb5, 0x38, 0x1d, 0xbf, 0xd6, 0x0a, 0x11, 0x7a, 0x60, 0x07, 0xd4, 0x09, 0x7a,
+	0xfe, 0xb0, 0xb7, 0x2e, 0x8e, 0x7a, 0x2b, 0xe9, 0x47, 0xbd, 0xb0, 0x13, 0x0c, 0xbc, 0xf0, 0x93,
+	0x87, 0xbd, 0x90, 0xd6, 0x5a, 0x41, 0xc7, 0xcf, 0xbf, 0xe7, 0xbd, 0xcc, 0x16, 0x57, 0x6f, 0x55,
+	0x57, 0xfb, 0xbd, 0xd6, 0x5a, 0x1c, 0x35, 0xc2, 0x26, 0xff, 0x09, 0x36, 0x5f, 0x6b, 0xf7, 0xd3,
+	0x5e, 0x90, 0x6c, 0xfb, 0x9d, 0x60, 0xb9, 0xf4, 0x64, 0xe9, 0x5d, 0x73, 0x95, 0x47, 0x5e, 0xff,
+	0xee, 0xdb, 0x1e, 0xfa, 0xde, 0x77, 0xdf, 0x36, 0xbf, 0x66, 0x41, 0xe0, 0xe2, 0xf1, 0x1f, 0x65,
+	0x33, 0x49, 0xdc, 0x0e, 0x56, 0x61, 0x7b, 0x79, 0x42, 0xbc, 0xf2, 0xb0, 0x7a, 0x65, 0x06, 0x64,
+	0x33, 0x68, 0xb8, 0xf7, 0x9d, 0x12, 0x63, 0xab, 0xdd, 0xee, 0x0e, 0x2e, 0x4b, 0x50, 0xeb, 0xf1,
+	0x17, 0xd9, 0x2c, 0xcd, 0x42, 0xdd, 0xef, 0xf9, 0x82, 0xdb, 0xfc, 0x33, 0x3f, 0xbe, 0x22, 0x07,
+	0xb3, 0xe2, 0x0e, 0xc6, 0xae, 0x1c, 0x61, 0xe3, 0x92, 0xad, 0xdc, 0xbc, 0x4d, 0xef, 0x6f, 0xe1,
+	0x53, 0x85, 0x2b, 0x66, 0xcc, 0xb6, 0x81, 0xa1, 0xca, 0xf7, 0xd8, 0x64, 0xda, 0x0d, 0x6a, 0xa2,
+	0x63, 0xf3, 0xcf, 0x6c, 0xac, 0xdc, 0xb7, 0x7c, 0xac, 0xd8, 0x6e, 0x57, 0x91, 0x60, 0x65, 0x41,
+	0xb1, 0x9d, 0xa4, 0x27, 0x10, 0x4c, 0xbc, 0x7f, 0x2e, 0xb1, 0x33, 0x16, 0x6d, 0x33, 0x4c, 0x7b,
+	0xfc, 0xa3, 0x03, 0x23, 0x5c, 0x39, 0xda, 0x08, 0xe9, 0x6d, 0x31, 0xbe, 0xb3, 0x8a, 0xd1, 0xac,
+	0x6e, 0x71, 0x46, 0xf7, 0x12, 0x9b, 0x0a, 0x7b, 0x41, 0x27, 0xc5, 0xe1, 0x95, 0x91, 0xf4, 0xe5,
+	0x42, 0x86, 0x57, 0x59, 0x54, 0x1c, 0xa7, 0x36, 0x88, 0x36, 0x48, 0x16, 0xde, 0xd7, 0x99, 0x3b,
+	0x38, 0x1a, 0x35, 0x7f, 0x9a, 0xcd, 0xa7, 0x71, 0x3f, 0xa9, 0x05, 0x10, 0x74, 0xe3, 0x14, 0xc7,
+	0x57, 0xa6, 0xc5, 0x27, 0x59, 0xa9, 0xda, 0x66, 0x70, 0x71, 0xf8, 0xaf, 0x94, 0xd8, 0x42, 0x3d,
+	0x48, 0x7b, 0x61, 0x24, 0xf8, 0xeb, 0x9e, 0x3f, 0x37, 0x5e, 0xcf, 0x75, 0xe3, 0xba, 0xa5, 0x5c,
+	0x79, 0x54, 0x8d, 0x62, 0xc1, 0x69, 0x4c, 0x21, 0xc3, 0x9c, 0x04, 0x1e, 0x9f, 0x6b, 0x49, 0xd8,
+	0xa5, 0xe7, 0xe5, 0x72, 0x56, 0xe0, 0xd7, 0x2d, 0x08, 0x5c, 0x3c, 0x14, 0xaa, 0x29, 0x12, 0xe8,
+	0x74, 0x79, 0x52, 0x74, 0xfe, 0xca, 0x18, 0x9d, 0x57, 0xd3, 0x49, 0x1b, 0xc5, 0xce, 0x3b, 0x3d,
+	0xe1, 0xbc, 0x0b, 0x1e, 0xfc, 0x8b, 0x25, 0xb6, 0xac, 0x76, 0x1b, 0x04, 0x72, 0x2a, 0x6f, 0xb5,
+	0x70, 0x49, 0xda, 0x28, 0x0e, 0xcb, 0x53, 0xa2, 0x03, 0x17, 0x8f, 0x26, 0x52, 0x57, 0x93, 0xb8,
+	0xdf, 0xbd, 0x11, 0x46, 0xf5, 0xca, 0x93, 0x8a, 0xd3, 0xf2, 0xda, 0x08, 0xc2, 0x30, 0x92, 0x25,
+	0xff, 0xad, 0x12, 0xbb, 0x10, 0xe1, 0xb6, 0x4f, 0xbb, 0x3e, 0x2d, 0xaa, 0x04, 0x57, 0xda, 0x7e,
+	0x6d, 0x4f, 0xf4, 0x68, 0xfa, 0xfe, 0x7a, 0xe4, 0xa9, 0x1e, 0x5d, 0xd8, 0x1e, 0x49, 0x1a, 0xee,
+	0xc1, 0x96, 0xff, 0x7e, 0x89, 0x2d, 0xc5, 0x09, 0x4e, 0x69, 0x14, 0xd4, 0x35, 0x34, 0x5d, 0x9e,
+	0x11, 0x3b, 0xee, 0x23, 0x63, 0xac, 0xcf, 0xcd, 0x3c, 0xcd, 0xad, 0x38, 0x0a, 0x7b, 0x71, 0x52,
+	0x0d, 0x7a, 0x28, 0x46, 0xcd, 0xb4, 0x72, 0x0e, 0x3b, 0xbd, 0x34, 0x80, 0x05, 0x83, 0x9d, 0xe1,
+	0x77, 0x71, 0xb7, 0x1c, 0x44, 0xb5, 0x5b, 0x38, 0xdc, 0xf8, 0x4e, 0xba, 0x3c, 0x3b, 0xf6, 0x96,
+	0xad, 0x1a, 0x6a, 0x6a, 0xd3, 0x59, 0xea, 0xe0, 0xb2, 0xe2, 0xbf, 0x5c, 0x62, 0x8b, 0x69, 0xd8,
+	0x44, 0xa9, 0xef, 0x27, 0xc1, 0x8d, 0xe0, 0x20, 0x5d, 0x9e, 0x13, 0xcc, 0xaf, 0x8e, 0xc3, 0xdc,
+	0xa1, 0x57, 0x39, 0xa7, 0x56, 0x6f, 0xd1, 0x6d, 0x4d, 0x21, 0xcb, 0x94, 0xff, 0x0d, 0x4a, 0x8e,
+	0xb3, 0xfd, 0xaa, 0x41, 0xb2, 0x1f, 0xd6, 0x82, 0xd5, 0x5a, 0x2d, 0x46, 0x3b, 0x95, 0x2e, 0x33,
+	0xd1, 0xa7, 0x4f, 0x14, 0xae, 0x09, 0xb2, 0x7c, 0xac, 0xa4, 0x8d, 0x44, 0x49, 0xe1, 0x1e, 0xdd,
+	0xe4, 0x97, 0xd8, 0x42, 0xc7, 0xbf, 0x6b, 0x65, 0x6c, 0x1e, 0x65, 0xac, 0x6c, 0xb5, 0xcd, 0x96,
+	0x03, 0x83, 0x0c, 0xa6, 0xf7, 0xb7, 0x65, 0x36, 0xef, 0x74, 0xf1, 0x14, 0xac, 0x5f, 0x3b, 0x63,
+	0xfd, 0xae, 0x17, 0x33, 0xb5, 0xa3, 0xcc, 0x1f, 0xef, 0xb1, 0xe9, 0xb4, 0x87, 0xcb, 0x9d, 0x0a,
+	0x45, 0x3a, 0xff, 0xcc, 0x66, 0x41, 0xfc, 0x04, 0xcd, 0xca, 0x19, 0xc5, 0x71, 0x5a, 0x3e, 0x83,
+	0xe2, 0xc5, 0x5f, 0x66, 0x73, 0x71, 0x97, 0xfc, 0x1a, 0xd2, 0xe0, 0x93, 0x82, 0xf1, 0xfa, 0x38,
+	0x1b, 0x5e, 0xd3, 0xaa, 0x2c, 0x22, 0xb3, 0x39, 0xf3, 0x08, 0x96, 0x8b, 0xf7, 0xed, 0x12, 0x7b,
+	0xd4, 0xe9, 0x20, 0x7a, 0x4f, 0xf5, 0x50, 0xac, 0xe8, 0x93, 0x6c, 0xb2, 0x77, 0xd0, 0xd5, 0x9e,
+	0x93, 0x99, 0xa3, 0x5d, 0x6c, 0x03, 0x01, 0x21, 0x5f, 0x09, 0x75, 0x58, 0xea, 0x37, 0x83, 0xbc,
+	0xaf, 0xb4, 0x25, 0x9b, 0x41, 0xc3, 0x79, 0xc2, 0x78, 0xdb, 0x4f, 0x7b, 0xbb, 0x89, 0x1f, 0xa5,
+	0x82, 0xfc, 0x2e, 0xfa, 0x72, 0x6a, 0x6a, 0xdf, 0x7d, 0x34, 0x41, 0xa1, 0x37, 0x2a, 0xe7, 0x91,
+	0x3a, 0xdf, 0x1c, 0xa0, 0x04, 0x43, 0xa8, 0x7b, 0xa8, 0xdc, 0xcf, 0x0f, 0xdf, 0x45, 0xfc, 0x47,
+	0x70, 0x75, 0x71, 0x2b, 0x04, 0x89, 0x1a, 0x9d, 0x5d, 0x0f, 0xd1, 0x0a, 0x0a, 0xca, 0x2f, 0xb2,
+	0x39, 0xa3, 0xa7, 0xd5, 0x18, 0x97, 0x14, 0xea, 0x9c, 0x55, 0xee, 0x16, 0x87, 0x26, 0x8d, 0x1e,
+	0x94, 0xf5, 0x35, 0x93, 0x26, 0xfc, 0x4c, 0x01, 0xf1, 0xbe, 0x5e, 0x62, 0x6f, 0x3f, 0xca, 0xde,
+	0x3e, 0xb9, 0x3e, 0x7e, 0x90, 0x9d, 0x49, 0x33, 0xac, 0x54, 0x6f, 0xcf, 0xab, 0xb7, 0xce, 0x64,
+	0x3b, 0x02, 0x39, 0x6c, 0xef, 0x5f, 0x4a, 0xec, 0x61, 0x67, 0x04, 0xa7, 0xe0, 0x1a, 0xee, 0x65,
+	0x5d, 0xc3, 0x2b, 0xc5, 0xec, 0xc5, 0x11, 0xbe, 0xe1, 0x9f, 0x4f, 0xb3, 0x25, 0x77, 0xc7, 0x0a,
+	0x85, 0x27, 0xce, 0x05, 0xe8, 0xf4, 0x3d, 0x0f, 0x9b, 0x6a, 0x39, 0xec, 0xb9, 0x40, 0x36, 0x83,
+	0x86, 0x93, 0x0c, 0x74, 0xfd, 0x5e, 0x4b, 0xad, 0x85, 0x91, 0x81, 0x1d, 0x6c, 0x03, 0x01, 0xa1,
+	0x15, 0xe8, 0x61, 0x77, 0x83, 0x1e, 0x04, 0xfb, 0x61, 0xaa, 0xf7, 0xba, 0xb3, 0x02, 0xbb, 0x19,
+	0x28, 0xe4, 0xb0, 0x79, 0xc4, 0x26, 0x5b, 0x41, 0xbb, 0xa3, 0x5c, 0x82, 0x9d, 0x82, 0x54, 0x93,
+	0x18, 0xe8, 0x35, 0xa4, 0x5b, 0x99, 0xa5, 0xfe, 0xd2, 0x2f, 0x10, 0x7c, 0xf8, 0x2f, 0x96, 0xd8,
+	0xdc, 0x1e, 0xba, 0x50, 0x71, 0x27, 0x7c, 0x25, 0x40, 0x63, 0x4f, 0x5c, 0x9f, 0x2f, 0x92, 0xeb,
+	0x0d, 0x4d, 0x5c, 0x2a, 0x2a, 0xf3, 0x08, 0x96, 0x2d, 0x7f, 0x85, 0xcd, 0xec, 0xa5, 0x71, 0x14,
+	0x05, 0x3d, 0xb4, 0xf8, 0xd4, 0x83, 0x6a, 0xa1, 0x3d, 0x90, 0xa4, 0x2b, 0xf3, 0xb4, 0xa4, 0xea,
+	0x01, 0x34, 0x43, 0x31, 0x01, 0xf5, 0x30, 0x41, 0xa3, 0x14, 0x27, 0x07, 0x68, 0xdc, 0x0b, 0x9f,
+	0x80, 0x75, 0x4d, 0x5c, 0x4e, 0x80, 0x79, 0x04, 0xcb, 0x96, 0xef, 0xb3, 0xe9, 0x6e, 0xbb, 0xdf,
+	0x0c, 0x23, 0x61, 0xa6, 0xe7, 0x9f, 0x81, 0x22, 0x3b, 0xb0, 0x23, 0x28, 0x57, 0x18, 0x29, 0x18,
+	0xf9, 0x1b, 0x14, 0x37, 0xfe, 0x14, 0x9b, 0xaa, 0xb5, 0xfc, 0xa4, 0xb7, 0xbc, 0x20, 0x84, 0xd4,
+	0xec, 0x9a, 0x35, 0x6a, 0x04, 0x09, 0xf3, 0xfe, 0x0e, 0xfd, 0xa1, 0xd1, 0xa3, 0x92, 0xdb, 0xa7,
+	0xd6, 0x4f, 0x52, 0x69, 0x4f, 0x66, 0xdd, 0xed, 0x23, 0x9a, 0x41, 0xc3, 0xf9, 0xa7, 0xd8, 0xcc,
+	0x4b, 0x6a, 0x9d, 0x27, 0x8a, 0x5f, 0xe7, 0xeb, 0x6a, 0x9d, 0x0d, 0xff, 0xeb, 0x7a, 0xad, 0x15,
+	0x53, 0xef, 0x0f, 0x27, 0xd8, 0xb9, 0xa1, 0xdb, 0x82, 0xaf, 0x30, 0xb6, 0xef, 0xb7, 0xfb, 0xc1,
+	0x95, 0x90, 0xce, 0x4b, 0xf2, 0x84, 0x78, 0x86, 0xfc, 0x95, 0x17, 0x4c, 0x2b, 0x38, 0x18, 0xfc,
+	0x93, 0x8c, 0x75, 0xfd, 0x04, 0xf5, 0x2e, 0x9e, 0x3d, 0xb4, 0xee, 0xba, 0x36, 0xc6, 0x60, 0xa8,
+	0x13, 0x3b, 0x9a, 0xa0, 0xf5, 0x96, 0x4c, 0x13, 0x72, 0xb7, 0xfc, 0xe8, 0x3c, 0x98, 0x04, 0xed,
+	0xc0, 0x4f, 0x83, 0x6d, 0x6b, 0x91, 0xcc, 0x79, 0x10, 0x2c, 0x08, 0x5c, 0x3c, 0x32, 0x3b, 0x62,
+	0x08, 0xa9, 0xd2, 0x49, 0xc6, 0xec, 0x88, 0x41, 0xa2, 0xab, 0x22, 0xa1, 0xde, 0xff, 0xe0, 0x51,
+	0x6e, 0xd4, 0xec, 0xf2, 0x2e, 0x9b, 0x09, 0xee, 0xf6, 0x5e, 0xf0, 0x13, 0x39, 0x4d, 0xe3, 0x1d,
+	0x0d, 0x14, 0x51, 0xa4, 0x66, 0x57, 0xed, 0xb2, 0xa4, 0x0e, 0x9a, 0x0d, 0x6f, 0xa2, 0xb7, 0x82,
+	0x3e, 0x40, 0x01, 0xc1, 0x03, 0x87, 0x9d, 0x75, 0x7a, 0x36, 0x57, 0x53, 0x10, 0x0c, 0xbc, 0x6f,
+	0x0e, 0x1b, 0xb7, 0x52, 0x18, 0x34, 0xe7, 0x41, 0xb4, 0x1f, 0x26, 0x71, 0xd4, 0x09, 0xd0, 0xae,
+	0xe6, 0x82, 0x4e, 0x97, 0x2d, 0x08, 0x5c, 0x3c, 0xfe, 0xf3, 0x43, 0x04, 0xe5, 0xc6, 0x18, 0x43,
+	0x50, 0xdd, 0x39, 0xb2, 0xac, 0x78, 0x5f, 0x29, 0x0f, 0xd9, 0xbd, 0x46, 0x0b, 0xf3, 0x67, 0x18,
+	0x23, 0xf7, 0x61, 0x27, 0x09, 0x1a, 0xe1, 0x5d, 0x35, 0x2a, 0x43, 0x72, 0xdb, 0x40, 0xc0, 0xc1,
+	0xd2, 0xef, 0x54, 0xfb, 0x0d, 0x7a, 0x67, 0x62, 0xf0, 0x1d, 0x09, 0x01, 0x07, 0x8b, 0x3f, 0xcb,
+	0xa6, 0xd1, 0x57, 0x68, 0x06, 0xe4, 0x74, 0xd3, 0xe6, 0x7a, 0x9c, 0xe4, 0x6e, 0x43, 0xb4, 0xbc,
+	0x89, 0x56, 0xd1, 0x74, 0x48, 0x34, 0x81, 0xc2, 0xe5, 0x7f, 0x50, 0x62, 0x0b, 0x38, 0x49, 0x1d,
+	0x74, 0x45, 0xfc, 0xdb, 0x41, 0x5b, 0x47, 0x32, 0x9a, 0x27, 0x62, 0xa0, 0x56, 0xd6, 0x1c, 0x4e,
+	0x97, 0xa3, 0x1e, 0x6a, 0x6c, 0x73, 0x5c, 0x72, 0x41, 0x90, 0xe9, 0xd2, 0x85, 0x0f, 0xb1, 0xa5,
+	0x81, 0x17, 0xf9, 0x59, 0x56, 0xde, 0x0b, 0x0e, 0xe4, 0x7c, 0x02, 0xfd, 0xe4, 0x8f, 0xb2, 0x29,
+	0xb1, 0xbd, 0xe4, 0x7c, 0x81, 0x7c, 0xf8, 0xc0, 0xc4, 0xa5, 0x92, 0xf7, 0xa5, 0x12, 0x7b, 0xcb,
+	0x08, 0xa5, 0x6d, 0x9c, 0xce, 0xd2, 0x28, 0xa7, 0x93, 0x7f, 0x9c, 0x95, 0x51, 0xde, 0x94, 0x64,
+	0xad, 0x8d, 0x31, 0x31, 0x28, 0xc2, 0x72, 0xd0, 0x33, 0xc8, 0xa1, 0x8c, 0x4f, 0x40, 0x84, 0xbd,
+	0xbf, 0x9c, 0xcb, 0xb8, 0x84, 0x55, 0x7d, 0x82, 0x12, 0xbd, 0x54, 0x0e, 0xe1, 0x66, 0x91, 0xeb,
+	0xe1, 0x78, 0xc3, 0x32, 0x20, 0xa7, 0x78, 0xf1, 0xcf, 0x97, 0x44, 0x18, 0x4c, 0xfb, 0xd4, 0xca,
+	0x84, 0x9c, 0x40, 0x48, 0xce, 0x8d, 0xac, 0xe9, 0x46, 0x70, 0x59, 0x93, 0xcd, 0xeb, 0xca, 0x88,
+	0x98, 0x52, 0xbe, 0x46, 0x7b, 0xe9, 0x40, 0x99, 0x86, 0xf3, 0x3e, 0x63, 0x14, 0xe3, 0xd8, 0x89,
+	0x91, 0xd3, 0x81, 0x3a, 0xf8, 0x8d, 0x1b, 0x4d, 0x91, 0xc4, 0xa4, 0x81, 0xb2, 0xcf, 0xe0, 0x30,
+	0xe2, 0x5f, 0x2e, 0xb1, 0xa5, 0xb0, 0x19, 0xc5, 0x09, 0x5a, 0xea, 0x46, 0x23, 0x48, 0x82, 0x88,
+	0x82, 0x00, 0x32, 0x0e, 0xb7, 0x3b, 0x06, 0x7b, 0x1d, 0x26, 0xd8, 0xc8, 0xd3, 0xae, 0x3c, 0xa6,
+	0xa6, 0x60, 0x69, 0x00, 0x04, 0x83, 0x3d, 0xe1, 0x3e, 0x9b, 0x0c, 0xa3, 0x46, 0xac, 0xe2, 0x70,
+	0x1f, 0x1a, 0xa3, 0x47, 0x1b, 0x48, 0xc6, 0xee, 0x0c, 0x7a, 0x02, 0x41, 0x9a, 0x03, 0x3b, 0xdf,
+	0xf5, 0xd3, 0xb4, 0xd7, 0x4a, 0xe2, 0x7e, 0xb3, 0xb5, 0x1a, 0x45, 0x71, 0x4f, 0x05, 0x73, 0x67,
+	0x84, 0x0a, 0xba, 0x80, 0xf8, 0xe7, 0x77, 0x86, 0x62, 0xc0, 0x88, 0x37, 0xf9, 0xab, 0x25, 0xc6,
+	0x5b, 0x81, 0xdf, 0x46, 0x7f, 0x3f, 0x6e, 0xb7, 0xfb, 0x5d, 0xb5, 0xac, 0xd2, 0x6f, 0xde, 0x1a,
+	0xcb, 0x01, 0xc8, 0x13, 0x95, 0x07, 0xe2, 0xc1, 0x76, 0x18, 0xd2, 0x01, 0x7e, 0x87, 0xcd, 0xe8,
+	0x40, 0x8f, 0x8c, 0x99, 0x15, 0xbb, 0x25, 0x8d, 0x78, 0x57, 0x55, 0xc4, 0x48, 0x73, 0xe3, 0xbf,
+	0x8b, 0x13, 0x92, 0x28, 0x99, 0xb8, 0x7c, 0x97, 0xa2, 0xb1, 0x62, 0x86, 0xd9, 0xd8, 0x9d, 0x80,
+	0x3c, 0xd1, 0xca, 0x05, 0xd5, 0x09, 0x3e, 0x00, 0x4a, 0x61, 0x48, 0x1f, 0xbc, 0xcf, 0x2d, 0x64,
+	0x4f, 0x7b, 0x32, 0x0e, 0xf3, 0x0a, 0x9b, 0x4b, 0x4c, 0x50, 0x4c, 0x7a, 0x30, 0x1b, 0x05, 0x74,
+	0x53, 0x45, 0x7f, 0xcc, 0xf1, 0xdc, 0x06, 0xd7, 0x2c, 0x3b, 0xf2, 0x64, 0x68, 0x8b, 0x2a, 0xcd,
+	0x35, 0xae, 0x16, 0x50, 0x2c, 0x6d, 0x88, 0x0b, 0xdb, 0x40, 0x30, 0xe0, 0x31, 0x9b, 0x96, 0x42,
+	0xa2, 0xe2, 0x30, 0x57, 0xc7, 0x96, 0xcc, 0x7c, 0x74, 0x4b, 0xc9, 0xa5, 0x62, 0x83, 0x5a, 0x6e,
+	0xa6, 0x85, 0x87, 0x7b, 0x3a, 0x42, 0x49, 0x13, 0x7d, 0x7d, 0xac, 0x39, 0x95, 0x87, 0xe1, 0x6b,
+	0x92, 0xa2, 0x95, 0x3e, 0xd5, 0x00, 0x9a, 0x17, 0xff, 0xa5, 0x12, 0x63, 0x35, 0x1d, 0xd6, 0xd2,
+	0xea, 0xed, 0x66, 0x31, 0xa2, 0x6f, 0xc2, 0x65, 0xd6, 0xb7, 0x31, 0x4d, 0xe8, 0x62, 0x59, 0xb6,
+	0xfc, 0x45, 0xb6, 0x80, 0x27, 0x9c, 0x38, 0xaa, 0xe1, 0xd1, 0xa0, 0xbe, 0x4a, 0xb9, 0x85, 0xe3,
+	0xc6, 0xbe, 0xce, 0x92, 0x8f, 0x01, 0x0e, 0x0d, 0xc8, 0x50, 0xe4, 0x9f, 0x29, 0xb1, 0x33, 0x26,
+	0xae, 0x47, 0x4b, 0x11, 0xa8, 0x00, 0xc1, 0x46, 0x11, 0x21, 0x44, 0x41, 0xb0, 0xc2, 0x29, 0x3a,
+	0x91, 0x6d, 0x83, 0x1c, 0x53, 0xfe, 0x61, 0xc6, 0xe2, 0xdb, 0x22, 0x38, 0x45, 0xe3, 0x9c, 0x3d,
+	0xf6, 0x38, 0xcf, 0xc8, 0x10, 0xb0, 0xa6, 0x00, 0x0e, 0x35, 0x7e, 0x03, 0x0d, 0xa5, 0xd8, 0x27,
+	0x14, 0x86, 0x14, 0x71, 0x80, 0xb9, 0xca, 0x7b, 0xf4, 0xcc, 0x57, 0x0d, 0x04, 0xbd, 0xc5, 0xc1,
+	0x33, 0x9c, 0x88, 0x5c, 0x3a, 0xaf, 0xf3, 0xbb, 0xa8, 0x0f, 0xfb, 0x9d, 0x8e, 0x6f, 0x8e, 0xf4,
+	0x5b, 0x05, 0xe9, 0x43, 0x49, 0xd4, 0x51, 0x88, 0xb2, 0x01, 0x34, 0xbb, 0x51, 0x16, 0x62, 0xfe,
+	0x41, 0x5b, 0x88, 0x1a, 0x5b, 0x8c, 0xf0, 0x44, 0x05, 0x41, 0x03, 0xf5, 0x51, 0x6b, 0x55, 0x1e,
+	0xf9, 0x8f, 0xb7, 0x7a, 0x4b, 0x94, 0x3a, 0xd9, 0x76, 0x89, 0x40, 0x96, 0x26, 0xff, 0xed, 0xa1,
+	0xe9, 0xad, 0xc5, 0xb1, 0x4f, 0x3d, 0xf9, 0xc4, 0x95, 0x75, 0x36, 0x8e, 0x92, 0xd2, 0xf2, 0x22,
+	0xc6, 0x07, 0xd7, 0x10, 0x8f, 0x24, 0x0b, 0xd8, 0xf9, 0x20, 0x89, 0xfc, 0xf6, 0xf3, 0xb0, 0xa9,
+	0x4f, 0xfd, 0x62, 0x2b, 0x5e, 0x76, 0xda, 0x21, 0x83, 0xc5, 0x3d, 0x73, 0x90, 0x99, 0x10, 0xf8,
+	0xcc, 0x1e, 0x64, 0xf4, 0xb1, 0xc5, 0xfb, 0xec, 0x44, 0xc6, 0x67, 0xde, 0x4d, 0x82, 0x80, 0xb7,
+	0xd9, 0x54, 0x14, 0xd7, 0x8d, 0xcd, 0xb9, 0x5a, 0x80, 0xcd, 0xd9, 0x46, 0x7a, 0x36, 0x66, 0x43,
+	0x4f, 0x29, 0x48, 0x26, 0x22, 0x95, 0xa6, 0xe7, 0x41, 0x00, 0xd4, 0x01, 0xa1, 0x30, 0xb6, 0x26,
+	0x95, 0x76, 0xd3, 0xe5, 0x02, 0x59, 0xa6, 0xde, 0xf7, 0x4b, 0x99, 0x80, 0xcb, 0x2d, 0xbf, 0x57,
+	0x6b, 0x5d, 0xde, 0xa7, 0x73, 0xf1, 0x8d, 0x4c, 0x0a, 0xe2, 0xa7, 0xdc, 0x14, 0x04, 0xee, 0xf0,
+	0x77, 0x8e, 0x2a, 0x15, 0xb9, 0x43, 0x14, 0x56, 0x04, 0x09, 0x27, 0x5b, 0xf1, 0x73, 0x6c, 0xde,
+	0xe9, 0xb1, 0x32, 0xaf, 0x45, 0x85, 0x92, 0xcd, 0x69, 0xc0, 0x69, 0x04, 0x97, 0x9f, 0xf7, 0x9b,
+	0x25, 0x36, 0x53, 0xf1, 0x6b, 0x7b, 0x71, 0xa3, 0xc1, 0x7f, 0x8c, 0xcd, 0xd6, 0xfb, 0x2a, 0xcb,
+	0x23, 0xc7, 0x66, 0xa2, 0xdf, 0xeb, 0xaa, 0x1d, 0x0c, 0x06, 0x09, 0x53, 0xc3, 0xa7, 0x30, 0x9a,
+	0xe8, 0x73, 0x59, 0x0a, 0xd3, 0x15, 0xd1, 0x02, 0x0a, 0x42, 0x81, 0x87, 0x8e, 0x7f, 0x57, 0xbf,
+	0x9c, 0x0f, 0xf6, 0x6c, 0x59, 0x10, 0xb8, 0x78, 0xde, 0x6b, 0x65, 0x36, 0xa3, 0xd2, 0xe6, 0x47,
+	0xce, 0x37, 0xe8, 0xd3, 0xe6, 0xc4, 0xc8, 0xd3, 0x66, 0x97, 0x4d, 0xd7, 0x44, 0x11, 0x8e, 0x72,
+	0x2c, 0xc6, 0x89, 0x79, 0xa9, 0xde, 0xc9, 0xa2, 0x1e, 0xdb, 0x27, 0xf9, 0x0c, 0x8a, 0x0f, 0xd5,
+	0x15, 0x3c, 0x5c, 0xa3, 0x98, 0x47, 0xcd, 0xda, 0xbe, 0xc9, 0xb1, 0xf3, 0x84, 0x6b, 0x59, 0x8a,
+	0x95, 0xb7, 0x28, 0xee, 0x0f, 0xe7, 0x00, 0x90, 0xe7, 0xcd, 0x7f, 0x9a, 0x2d, 0xca, 0xd9, 0x7a,
+	0x21, 0x48, 0x44, 0x7c, 0x7f, 0x4a, 0x4c, 0x96, 0x4d, 0x2d, 0xbb, 0x40, 0xc8, 0xe2, 0x52, 0x98,
+	0xd1, 0x24, 0x6b, 0x52, 0x71, 0xf6, 0x51, 0x61, 0x46, 0x93, 0xcd, 0x49, 0xc1, 0xc1, 0xf0, 0xfe,
+	0xa2, 0xcc, 0x16, 0x33, 0xd3, 0x44, 0xf2, 0xd5, 0x4f, 0x49, 0x1b, 0x99, 0xa0, 0x80, 0x91, 0xaf,
+	0xe7, 0x55, 0x3b, 0x18, 0x0c, 0xc2, 0xa6, 0x83, 0xcc, 0x9d, 0x38, 0xa9, 0xab, 0x45, 0x35, 0xd8,
+	0x3b, 0xaa, 0x1d, 0x0c, 0x06, 0x49, 0xda, 0xed, 0xc0, 0x4f, 0x82, 0x64, 0x37, 0xde, 0x0b, 0x06,
+	0x24, 0xad, 0x62, 0x41, 0xe0, 0xe2, 0x89, 0x15, 0xea, 0xb5, 0xd3, 0xb5, 0x76, 0x88, 0xbb, 0x52,
+	0x76, 0xb3, 0x80, 0x15, 0xda, 0xdd, 0xac, 0xba, 0x14, 0xed, 0x0a, 0xe5, 0x00, 0x90, 0xe7, 0xcd,
+	0x3f, 0x8d, 0xba, 0xcf, 0xbf, 0x93, 0xda, 0x82, 0x31, 0xb1, 0x44, 0xe3, 0xc9, 0x6a, 0xa6, 0x00,
+	0x4d, 0x1a, 0xc2, 0x4c, 0x13, 0x64, 0x39, 0x7a, 0xdf, 0x2a, 0x31, 0x5d, 0x88, 0x76, 0x0a, 0x49,
+	0xb4, 0x66, 0x36, 0x89, 0x56, 0x19, 0x7f, 0x53, 0x8e, 0x48, 0xa0, 0x6d, 0xa3, 0x4e, 0x89, 0xd1,
+	0x7a, 0x46, 0x75, 0xfe, 0x0e, 0x36, 0x53, 0x93, 0x3f, 0x95, 0xe1, 0x14, 0xe9, 0x15, 0x05, 0x05,
+	0x0d, 0xe3, 0x8f, 0xb3, 0x49, 0x64, 0xac, 0x8d, 0xa5, 0xc8, 0x3e, 0xad, 0xe2, 0x33, 0x88, 0x56,
+	0xef, 0xb3, 0x65, 0x86, 0x4e, 0x75, 0xa7, 0x8b, 0xc2, 0x54, 0xdf, 0x8d, 0xff, 0x3f, 0xae, 0xe4,
+	0x1c, 0xe3, 0xcb, 0xa7, 0x79, 0x8c, 0xf7, 0x7e, 0x15, 0xbd, 0x56, 0x5a, 0x88, 0x38, 0xc2, 0x7d,
+	0x64, 0x22, 0xc9, 0x94, 0x80, 0xae, 0xe9, 0x56, 0xa5, 0x6e, 0xcc, 0x09, 0xd7, 0xa0, 0x83, 0xc5,
+	0x39, 0x82, 0x05, 0x79, 0x4a, 0xc7, 0x41, 0xcb, 0xd9, 0x94, 0x93, 0xc8, 0x41, 0xa8, 0xb0, 0xa8,
+	0xf7, 0x6b, 0x13, 0xec, 0xbc, 0xdc, 0x49, 0x5b, 0x7e, 0x84, 0x2e, 0x15, 0x85, 0xd2, 0x8f, 0x1c,
+	0x11, 0x7d, 0x91, 0x42, 0x4b, 0xa1, 0x4e, 0x31, 0x8d, 0xb5, 0x19, 0xa4, 0x10, 0x4b, 0xb1, 0xdd,
+	0x40, 0x9a, 0x20, 0x28, 0xa3, 0x15, 0x9c, 0xd5, 0x45, 0xaa, 0xca, 0x0e, 0x16, 0xc1, 0xc5, 0xec,
+	0xf0, 0xab, 0x8a, 0x36, 0x18, 0x2e, 0xde, 0x6b, 0xa8, 0x63, 0x73, 0xa6, 0x49, 0x58, 0x75, 0x59,
+	0xc7, 0x92, 0xb7, 0xea, 0xd9, 0xca, 0x93, 0x63, 0xd4, 0x72, 0x7c, 0x14, 0x1d, 0xa9, 0x1e, 0xee,
+	0xf4, 0x6e, 0x4f, 0x1c, 0xf0, 0xca, 0xf7, 0x77, 0xc0, 0xdb, 0x8a, 0xeb, 0x61, 0x23, 0x14, 0x07,
+	0x3c, 0x97, 0x9c, 0xf7, 0x1c, 0x9b, 0xd5, 0x41, 0xe6, 0x23, 0x2c, 0xe3, 0x53, 0x99, 0x80, 0xf9,
+	0x08, 0x41, 0xf9, 0xa3, 0x09, 0x36, 0xe4, 0x00, 0x44, 0xd4, 0x3b, 0xe8, 0x80, 0xe6, 0xa9, 0x63,
+	0xc7, 0x90, 0x3a, 0x41, 0x70, 0x09, 0xa7, 0x92, 0x7e, 0x3b, 0x28, 0x22, 0x25, 0xe3, 0xf2, 0x87,
+	0x7e, 0xa6, 0x40, 0xb2, 0x2f, 0x0b, 0x24, 0xe9, 0x7f, 0xfc, 0x2a, 0x5b, 0xaa, 0x07, 0xcd, 0xc4,
+	0xaf, 0xa3, 0xaa, 0x6b, 0xd1, 0x79, 0x29, 0x6e, 0xd7, 0xc5, 0x0c, 0x97, 0xed, 0x69, 0x66, 0x3d,
+	0x8f, 0x00, 0x83, 0xef, 0xd0, 0xb9, 0x65, 0x2f, 0x8c, 0xea, 0x3b, 0x49, 0x18, 0x27, 0x61, 0x4f,
+	0x06, 0x5c, 0xd4, 0xb9, 0xe5, 0x86, 0xd3, 0x0e, 0x19, 0x2c, 0xef, 0xef, 0x27, 0xd8, 0xd9, 0x7c,
+	0x4f, 0x69, 0x8e, 0x9b, 0x54, 0xdb, 0xa8, 0x26, 0xca, 0x74, 0x5c, 0x14, 0x3c, 0x82, 0x84, 0xd1,
+	0x64, 0x12, 0xa5, 0xfc, 0x9e, 0x26, 0x5e, 0x20, 0x20, 0x87, 0x97, 0xc6, 0xe0, 0xe9, 0x67, 0xb1,
+	0x4d, 0xe9, 0x91, 0x6a, 0xd0, 0x16, 0x69, 0x63, 0xe5, 0x20, 0xbc, 0xef, 0x88, 0x46, 0xd0, 0x7d,
+	0x55, 0x5a, 0xdf, 0x4c, 0x13, 0x64, 0x89, 0xd3, 0xce, 0xb8, 0x13, 0x84, 0xcd, 0x56, 0x4f, 0x58,
+	0xfe, 0xb2, 0xdd, 0x19, 0xb7, 0x44, 0x2b, 0x28, 0x28, 0xf9, 0x72, 0x14, 0x29, 0x4e, 0x3a, 0x62,
+	0x45, 0xfd, 0xb6, 0x88, 0xdc, 0xcc, 0x5a, 0x5f, 0x6e, 0xc3, 0x05, 0x42, 0x16, 0xd7, 0xfb, 0xc7,
+	0x12, 0x5b, 0x70, 0x63, 0x63, 0x27, 0xb1, 0x1f, 0x1f, 0x44, 0x6d, 0x15, 0xba, 0x73, 0x8b, 0x99,
+	0x74, 0x74, 0x41, 0x7b, 0x95, 0xdc, 0x4b, 0x9c, 0x3f, 0x0a, 0x95, 0x26, 0x61, 0x24, 0x0f, 0x10,
+	0xb3, 0xd6, 0x26, 0x5e, 0xb1, 0x20, 0x70, 0xf1, 0xbc, 0x2d, 0x26, 0x82, 0xfa, 0x45, 0x69, 0x0c,
+	0x54, 0x42, 0x44, 0x8e, 0xdc, 0x9a, 0xa2, 0x48, 0x56, 0xd9, 0xec, 0xf5, 0x5b, 0xbb, 0xd2, 0x19,
+	0xf6, 0x58, 0x39, 0xf4, 0xa5, 0xad, 0x2c, 0x5b, 0x8d, 0xbe, 0x91, 0xa6, 0x7d, 0xa1, 0x0f, 0x09,
+	0x88, 0x44, 0xcb, 0xc1, 0xdd, 0xae, 0x3a, 0xf2, 0x19, 0x7b, 0x7a, 0xf9, 0x6e, 0x37, 0xc4, 0x2d,
+	0x4e, 0x48, 0x08, 0xf5, 0xfa, 0x8c, 0xd9, 0x74, 0x75, 0x51, 0x4b, 0x80, 0x64, 0x6a, 0xa4, 0x17,
+	0xe5, 0xdc, 0x1b, 0x32, 0x6b, 0x42, 0x2f, 0x12, 0xc4, 0xfb, 0x42, 0x89, 0x9d, 0xcd, 0xe7, 0x98,
+	0x1f, 0x98, 0x1b, 0xb0, 0x89, 0x7d, 0xd1, 0xd9, 0xd9, 0x9b, 0x5d, 0x19, 0x6c, 0xbd, 0xc4, 0x16,
+	0x6e, 0xf7, 0xc3, 0x76, 0x5d, 0x3d, 0xab, 0xee, 0x98, 0x44, 0x6d, 0xc5, 0x81, 0x41, 0x06, 0xd3,
+	0xfb, 0xab, 0x32, 0x5b, 0x96, 0xee, 0x44, 0xdd, 0x1c, 0xb7, 0xb6, 0xb4, 0x0b, 0xfd, 0xb9, 0x12,
+	0x9b, 0x6e, 0xcb, 0x1c, 0x73, 0x69, 0xec, 0x02, 0xdf, 0x51, 0x5c, 0x56, 0xdc, 0xdc, 0xb2, 0x51,
+	0x0f, 0x2a, 0xab, 0xac, 0xd8, 0xf3, 0x2f, 0xa1, 0x3b, 0xea, 0x3b, 0xc9, 0x2a, 0x69, 0xa0, 0xea,
+	0x27, 0xd1, 0x1d, 0x27, 0xb3, 0x25, 0xfb, 0x64, 0x63, 0x1d, 0x4e, 0x2e, 0xcc, 0xed, 0xcd, 0x85,
+	0xf7, 0xb3, 0xf9, 0xfb, 0xcc, 0x73, 0x5f, 0xf8, 0x20, 0x3b, 0x9b, 0x67, 0x78, 0xac, 0x3c, 0xf9,
+	0x7f, 0x4c, 0x30, 0x5b, 0xe7, 0xca, 0x1b, 0x2a, 0x97, 0x52, 0x1a, 0xfb, 0x6c, 0x47, 0x79, 0x13,
+	0x5b, 0x4e, 0x3b, 0x9b, 0x4b, 0xa5, 0x74, 0xd0, 0x51, 0x08, 0xb0, 0xab, 0xca, 0x9d, 0xbc, 0x36,
+	0x56, 0x00, 0x0d, 0xe9, 0xa0, 0x56, 0x43, 0xe7, 0xad, 0x79, 0xe0, 0x78, 0x09, 0xd4, 0x0c, 0x92,
+	0x0b, 0x05, 0xee, 0xe6, 0xc9, 0xc7, 0x0c, 0xe9, 0x02, 0x54, 0xe5, 0x40, 0xe9, 0xfa, 0xad, 0x22,
+	0xc2, 0xfc, 0x1b, 0x92, 0x2c, 0x5a, 0x50, 0xb3, 0xcc, 0x1b, 0x96, 0x13, 0xb8, 0x6c, 0xbd, 0x94,
+	0xf1, 0xc1, 0xf7, 0x8e, 0x19, 0x7c, 0x40, 0xad, 0xe1, 0xf7, 0x71, 0xf3, 0x12, 0x49, 0x31, 0x7b,
+	0xb3, 0x56, 0x6b, 0xac, 0x6a, 0x00, 0x58, 0x1c, 0xef, 0xcd, 0x09, 0xb6, 0x64, 0xb8, 0xee, 0x24,
+	0x71, 0x13, 0xd5, 0x61, 0x4a, 0x9a, 0x02, 0xc7, 0x91, 0x06, 0x79, 0x1f, 0x65, 0x87, 0x1a, 0x41,
+	0xc2, 0x48, 0xe1, 0xdc, 0xf1, 0xf7, 0x03, 0xa5, 0x53, 0x8d, 0xc2, 0xb9, 0x85, 0x6d, 0x20, 0x20,
+	0x22, 0x65, 0x1f, 0x44, 0x75, 0x6d, 0x79, 0xca, 0x4e, 0xca, 0x5e, 0x36, 0x83, 0x86, 0x8b, 0x8a,
+	0xb6, 0x7e, 0x14, 0x11, 0xea, 0x64, 0x16, 0x15, 0x64, 0x33, 0x68, 0x38, 0x8d, 0x31, 0xed, 0xd7,
+	0x6a, 0x41, 0x80, 0x1e, 0x9a, 0x72, 0x36, 0xcc, 0x18, 0xab, 0x1a, 0x00, 0x16, 0x87, 0x9c, 0x84,
+	0x86, 0x4f, 0x59, 0x1d, 0xe1, 0x6b, 0x38, 0xae, 0xc9, 0x15, 0xd1, 0x0a, 0x0a, 0x4a, 0x84, 0xef,
+	0xf8, 0x21, 0xdd, 0xdd, 0xb8, 0x19, 0x89, 0x5c, 0x8f, 0xa3, 0x72, 0x6f, 0x69, 0x00, 0x58, 0x1c,
+	0x2a, 0x3c, 0x0d, 0xda, 0x7e, 0x37, 0x0d, 0xea, 0x55, 0xca, 0x1c, 0xd5, 0x53, 0x91, 0x9e, 0x29,
+	0xdb, 0xc2, 0xd3, 0xcb, 0x19, 0x28, 0xe4, 0xb0, 0xbd, 0xaf, 0x4d, 0xb3, 0x5c, 0xf6, 0x87, 0xf7,
+	0xdd, 0x92, 0xf5, 0x52, 0x81, 0x25, 0xeb, 0x66, 0x24, 0xc3, 0xca, 0xd6, 0xd1, 0x4f, 0x50, 0x0b,
+	0x2e, 0xad, 0xc7, 0xdb, 0x32, 0x0b, 0xfe, 0xa6, 0x9b, 0xa4, 0xca, 0x88, 0x80, 0xe3, 0x56, 0x95,
+	0x0f, 0x71, 0xab, 0x3e, 0x25, 0x6b, 0x32, 0x20, 0x48, 0xfb, 0xed, 0x9e, 0x72, 0x45, 0xb7, 0x8b,
+	0xd2, 0x20, 0x92, 0xaa, 0x2d, 0xce, 0x90, 0xcf, 0xe0, 0x70, 0xe4, 0x1f, 0x41, 0xa9, 0xe9, 0xf9,
+	0x49, 0xef, 0x3e, 0xb3, 0x85, 0x56, 0xc2, 0x34, 0x11, 0xb0, 0xf4, 0x28, 0x47, 0xd7, 0xc0, 0xad,
+	0x9c, 0xb6, 0x04, 0xf5, 0x99, 0xfb, 0x3b, 0xc2, 0x5d, 0x31, 0x14, 0xc0, 0xa1, 0x46, 0x95, 0x5f,
+	0x42, 0x4d, 0xad, 0x89, 0xda, 0x72, 0x29, 0x60, 0x26, 0x3b, 0x0a, 0x06, 0x02, 0x0e, 0x16, 0xff,
+	0x18, 0x9b, 0x97, 0x49, 0x22, 0x6c, 0x59, 0xd5, 0x05, 0xbe, 0xc7, 0xe9, 0x90, 0xb8, 0x34, 0xb4,
+	0x6d, 0x49, 0x80, 0x4b, 0x8f, 0xef, 0xb3, 0xd9, 0xae, 0x52, 0x15, 0x2a, 0xd5, 0xb7, 0x59, 0x84,
+	0x8c, 0x6a, 0xf5, 0x53, 0x59, 0x10, 0xc1, 0x52, 0xf5, 0x04, 0x86, 0x17, 0x45, 0xf8, 0xce, 0xe6,
+	0x93, 0x4f, 0xa7, 0x77, 0x9e, 0xba, 0x85, 0x1e, 0x59, 0x12, 0xf8, 0x52, 0x82, 0x26, 0x8f, 0x3d,
+	0xa5, 0xa2, 0x12, 0x79, 0x4d, 0x13, 0x00, 0x4b, 0xcb, 0xfb, 0x19, 0xf6, 0xe4, 0x61, 0x77, 0xc9,
+	0x28, 0xa6, 0x77, 0xc7, 0x4f, 0x22, 0x55, 0xee, 0x3b, 0x2b, 0x15, 0x6d, 0x12, 0x81, 0x68, 0xf5,
+	0xbe, 0x3a, 0xc1, 0xe6, 0x9d, 0xeb, 0x82, 0x47, 0x70, 0x5d, 0x73, 0xd7, 0x1b, 0x27, 0x8e, 0x78,
+	0xbd, 0xf1, 0x5d, 0xb8, 0xf2, 0x74, 0xdc, 0x0f, 0x4d, 0x51, 0xa1, 0x5c, 0x2b, 0xd5, 0x06, 0x06,
+	0xca, 0x7b, 0x6c, 0xee, 0xa5, 0x3b, 0x3d, 0xe1, 0xa0, 0xeb, 0x12, 0xc2, 0x71, 0x2a, 0xe5, 0xb4,
+	0xb3, 0x6f, 0x37, 0xa2, 0x6e, 0x49, 0xc1, 0x32, 0xa2, 0xe4, 0x8e, 0x58, 0x70, 0x59, 0x97, 0xa0,
+	0x32, 0x85, 0x42, 0x12, 0xd0, 0xd9, 0x93, 0x10, 0xef, 0x9b, 0xe8, 0xd3, 0xd0, 0x2d, 0x03, 0x5c,
+	0x8b, 0x7a, 0xca, 0xdf, 0xca, 0xca, 0xfd, 0xa4, 0xad, 0x66, 0x6a, 0x5e, 0x11, 0x2f, 0xd3, 0x0d,
+	0x04, 0x6a, 0xcf, 0x98, 0xdf, 0x89, 0x63, 0xc5, 0xfe, 0xcb, 0x87, 0xc6, 0xfe, 0x29, 0xad, 0x91,
+	0xb6, 0x76, 0x92, 0x70, 0x1f, 0x05, 0xe1, 0x46, 0x70, 0xa0, 0x4a, 0x84, 0x6d, 0x5a, 0xa3, 0x7a,
+	0xcd, 0x02, 0x21, 0x8b, 0x4b, 0xa1, 0x0d, 0x1b, 0x84, 0x0f, 0x92, 0xde, 0x3a, 0x85, 0xb9, 0x65,
+	0x5e, 0xc4, 0x84, 0x36, 0x6c, 0xd8, 0x5e, 0x21, 0xc0, 0xe0, 0x3b, 0x7c, 0x9d, 0x9d, 0xcd, 0x34,
+	0x52, 0x47, 0xa6, 0x05, 0x9d, 0x65, 0x45, 0xe7, 0x6c, 0x86, 0x0e, 0xf5, 0x65, 0xe0, 0x0d, 0xef,
+	0x0d, 0x3c, 0xc1, 0x9a, 0x49, 0x3d, 0x85, 0xf0, 0x7b, 0x98, 0x0d, 0xbf, 0xaf, 0x8f, 0xe5, 0x22,
+	0xaa, 0x6e, 0x8f, 0x08, 0xc0, 0xff, 0xde, 0x34, 0x63, 0xe2, 0x86, 0x72, 0x28, 0xea, 0x5f, 0x70,
+	0x6f, 0xd1, 0xd5, 0x94, 0xfc, 0xde, 0x22, 0x0c, 0x10, 0x90, 0x1f, 0x5c, 0x99, 0x19, 0x96, 0xd7,
+	0x9b, 0x7a, 0x80, 0x79, 0xbd, 0x2a, 0x3b, 0x17, 0x46, 0x29, 0x5d, 0x54, 0x50, 0xb5, 0x8d, 0xd7,
+	0xe2, 0xd4, 0xc8, 0xdf, 0x6c, 0xe5, 0xad, 0x8a, 0xd0, 0xb9, 0x8d, 0x61, 0x48, 0x30, 0xfc, 0x5d,
+	0x9a, 0x4f, 0x0d, 0x10, 0x96, 0x78, 0xd6, 0x09, 0x09, 0xa8, 0x76, 0x30, 0x18, 0xe4, 0xf3, 0x05,
+	0x91, 0x7f, 0xbb, 0x1d, 0x6c, 0x36, 0xa4, 0xf7, 0xe6, 0x38, 0xcc, 0x97, 0x25, 0xe0, 0x4a, 0x15,
+	0x2c, 0xce, 0xf0, 0x7d, 0x37, 0x57, 0xd0, 0xbe, 0x63, 0xc7, 0xdd, 0x77, 0xe6, 0x5a, 0xe1, 0xfc,
+	0xc8, 0x6b, 0x85, 0xda, 0x16, 0x2c, 0x8c, 0xb4, 0x05, 0xe8, 0xc6, 0x86, 0x51, 0x2b, 0x48, 0x50,
+	0xdc, 0xeb, 0x62, 0x23, 0x2c, 0x2f, 0x8a, 0x89, 0x30, 0x6e, 0xec, 0x46, 0x06, 0x0a, 0x39, 0x6c,
+	0xef, 0xf3, 0x13, 0xec, 0x9c, 0xdd, 0x20, 0xd4, 0xb3, 0xb0, 0x41, 0x52, 0x22, 0x2a, 0xdd, 0x65,
+	0x32, 0xd6, 0xf9, 0x68, 0x84, 0xf1, 0x5d, 0xaa, 0x06, 0x02, 0x0e, 0x16, 0xad, 0x5f, 0x0d, 0x49,
+	0x88, 0x8a, 0xa4, 0xdc, 0xee, 0x59, 0x53, 0xed, 0x60, 0x30, 0xc4, 0x77, 0x29, 0xf0, 0x77, 0xb5,
+	0x7f, 0x5b, 0xbc, 0x90, 0xcb, 0x9f, 0xae, 0x59, 0x10, 0xb8, 0x78, 0x64, 0xc7, 0x6a, 0x7a, 0xf1,
+	0x68, 0x07, 0x2d, 0x48, 0x3b, 0x66, 0xd6, 0xcb, 0x40, 0x75, 0x77, 0x28, 0x7e, 0xa5, 0xd4, 0x6b,
+	0xa6, 0x3b, 0xa2, 0xf6, 0xd5, 0x60, 0x78, 0xff, 0x55, 0x62, 0x8f, 0x0d, 0x9d, 0x8a, 0x53, 0x50,
+	0x89, 0xfd, 0xac, 0x4a, 0xdc, 0x19, 0x53, 0x25, 0x0e, 0x0c, 0x61, 0x84, 0x7a, 0xfc, 0xa7, 0x12,
+	0x3b, 0x63, 0xf1, 0x4f, 0x61, 0x9c, 0x8d, 0xe2, 0xbe, 0x6c, 0x61, 0xfb, 0x5d, 0x99, 0x1b, 0x18,
+	0xd8, 0x9f, 0x96, 0xd9, 0x32, 0xf9, 0x63, 0xed, 0x7d, 0xf2, 0xcb, 0x64, 0x79, 0xa4, 0x89, 0x5d,
+	0xe1, 0x99, 0x12, 0x0f, 0xd1, 0xad, 0x78, 0xa0, 0xbc, 0x63, 0x55, 0xb4, 0x82, 0x82, 0xf2, 0x6b,
+	0x6c, 0xb2, 0x4e, 0x6a, 0x76, 0xe2, 0xd8, 0xfe, 0xa2, 0xf0, 0xf1, 0xd6, 0x49, 0x6f, 0x0a, 0x0a,
+	0xc7, 0x39, 0x6b, 0x51, 0xec, 0x90, 0xae, 0x91, 0x89, 0x5d, 0x37, 0x99, 0x8b, 0x1d, 0x6a, 0x00,
+	0x58, 0x1c, 0x0a, 0xf0, 0x89, 0x87, 0x6c, 0x7d, 0x85, 0xbd, 0x89, 0xe1, 0xc0, 0x20, 0x83, 0xc9,
+	0x57, 0xd1, 0xa2, 0xd0, 0xf3, 0x6a, 0xb7, 0xab, 0x5f, 0x96, 0xce, 0x83, 0xb5, 0x02, 0x59, 0x30,
+	0xe4, 0xf1, 0xc9, 0xb5, 0xed, 0xf9, 0x4d, 0x5d, 0x21, 0x2e, 0x86, 0xbd, 0xeb, 0x53, 0xba, 0x9a,
+	0x5a, 0xf9, 0xbb, 0xd9, 0x59, 0xf1, 0x82, 0xe3, 0x92, 0x0a, 0x3d, 0x3d, 0x07, 0x03, 0xed, 0xe4,
+	0x84, 0x9c, 0xd1, 0x1e, 0xf4, 0x6a, 0x4d, 0x5f, 0xbb, 0x3e, 0xc4, 0x13, 0xa6, 0x7b, 0x80, 0x14,
+	0x75, 0xd5, 0xf2, 0xb4, 0x5d, 0x40, 0xb9, 0x96, 0x64, 0x2e, 0x82, 0xb9, 0x56, 0x32, 0xc4, 0x23,
+	0xba, 0xa1, 0x92, 0x9b, 0xa8, 0x5a, 0x0a, 0x53, 0x32, 0x2b, 0x75, 0x15, 0x1b, 0xb6, 0x55, 0x4b,
+	0xaa, 0x1d, 0x0c, 0x86, 0xd7, 0x91, 0xb2, 0x68, 0x89, 0xaf, 0x07, 0x0d, 0x11, 0x3c, 0x3a, 0xd2,
+	0x18, 0x29, 0x2c, 0x24, 0xde, 0xda, 0xec, 0xfb, 0xf9, 0x4b, 0xcd, 0xab, 0x1a, 0x00, 0x16, 0xc7,
+	0xfb, 0x93, 0x12, 0x7b, 0x64, 0xc8, 0x60, 0x0a, 0x8c, 0x89, 0xf7, 0xac, 0xba, 0x1e, 0x71, 0x19,
+	0xbe, 0x1e, 0x34, 0x7c, 0x1d, 0x2b, 0x70, 0xa4, 0x7d, 0x5d, 0x36, 0x83, 0x86, 0x7b, 0xff, 0x89,
+	0x5e, 0x4d, 0xb6, 0xaf, 0x29, 0xbf, 0xce, 0xb8, 0x1c, 0x0c, 0x4e, 0x65, 0x2d, 0x46, 0xd3, 0x72,
+	0x40, 0x23, 0x97, 0xbd, 0x36, 0x35, 0xed, 0xab, 0x03, 0x18, 0x30, 0xe4, 0x2d, 0xfe, 0x05, 0x51,
+	0xab, 0xa0, 0x67, 0x5b, 0x8b, 0x49, 0xb5, 0x30, 0x31, 0xb1, 0x2b, 0xe9, 0x1e, 0xc0, 0x0c, 0x3f,
+	0x70, 0x99, 0x7b, 0xdf, 0x9a, 0x60, 0x0b, 0xfa, 0x75, 0xba, 0xdb, 0x51, 0xd4, 0xf1, 0x37, 0x73,
+	0xed, 0xbd, 0x7c, 0x8c, 0xab, 0xf9, 0x93, 0xf7, 0x3a, 0x62, 0xca, 0x8b, 0xd6, 0xd6, 0xd1, 0x74,
+	0x4c, 0xf3, 0xae, 0x05, 0x81, 0x8b, 0x47, 0x3d, 0x69, 0x87, 0xfb, 0x81, 0x7c, 0x69, 0x3a, 0xdb,
+	0x93, 0x4d, 0x0d, 0x00, 0x8b, 0x43, 0x3d, 0xa9, 0xe3, 0x4c, 0xa8, 0x88, 0x9d, 0xe9, 0x09, 0xcd,
+	0x0e, 0x08, 0x08, 0x61, 0xb4, 0xe2, 0x78, 0x4f, 0xf9, 0x77, 0x06, 0xe3, 0x1a, 0xb6, 0x81, 0x80,
+	0x78, 0x9f, 0x64, 0x4b, 0x03, 0x37, 0x1c, 0x4e, 0x2d, 0xb2, 0xe0, 0x7d, 0xba, 0x4c, 0x5e, 0xc3,
+	0x88, 0x4b, 0x3e, 0xa7, 0x17, 0xe0, 0xc8, 0xc8, 0xc0, 0xe4, 0x11, 0x64, 0xe0, 0x59, 0xb6, 0x40,
+	0xd7, 0x7c, 0x77, 0xe2, 0x30, 0x12, 0x57, 0x2d, 0xa7, 0x6c, 0x56, 0xfc, 0x7a, 0xf5, 0xe6, 0xb6,
+	0x6e, 0x87, 0x0c, 0x16, 0x5f, 0x63, 0x4b, 0x2f, 0xbd, 0x4c, 0xd7, 0xf7, 0x2f, 0xdf, 0xed, 0x52,
+	0x58, 0x47, 0x6c, 0x2a, 0x59, 0x97, 0x27, 0xbe, 0x98, 0x73, 0xfd, 0xb9, 0x1c, 0x10, 0x06, 0xf1,
+	0xf9, 0x4d, 0x76, 0xae, 0x23, 0x53, 0x2c, 0x57, 0xc2, 0xa0, 0x5d, 0x4f, 0x65, 0xbe, 0x25, 0xd1,
+	0x56, 0xe4, 0x31, 0x3a, 0x36, 0x6c, 0x0d, 0x43, 0x80, 0xe1, 0xef, 0x79, 0xaf, 0x4d, 0xb1, 0xf3,
+	0xa6, 0xda, 0x36, 0xe8, 0xe1, 0x61, 0x0b, 0x67, 0xad, 0x29, 0xb2, 0xa0, 0x5f, 0x2e, 0xb1, 0x05,
+	0x29, 0xa1, 0x9b, 0x6e, 0xb6, 0xaa, 0x56, 0x44, 0x5d, 0x6f, 0x86, 0xd3, 0xca, 0xae, 0xc3, 0x25,
+	0x77, 0x1b, 0xd2, 0x05, 0x41, 0xa6, 0x3b, 0xfc, 0x15, 0xc6, 0xf4, 0x17, 0x0d, 0x1a, 0x45, 0x7c,
+	0xd4, 0x41, 0x77, 0x0e, 0xc9, 0x59, 0x6f, 0x7d, 0xd7, 0x70, 0x00, 0x87, 0x1b, 0xdd, 0x92, 0xd0,
+	0x39, 0x3c, 0x59, 0x3d, 0xf5, 0xb1, 0xe2, 0x67, 0xe5, 0x28, 0x19, 0x3c, 0x60, 0x33, 0x88, 0x2e,
+	0x22, 0x92, 0x32, 0xd8, 0xf4, 0x4e, 0xc7, 0xd5, 0x5a, 0xa1, 0xaf, 0xf0, 0x09, 0xff, 0x32, 0xf6,
+	0xeb, 0x15, 0xbf, 0xed, 0xe3, 0xbe, 0x4a, 0x36, 0x24, 0xba, 0x35, 0x2c, 0xaa, 0x01, 0x34, 0xa1,
+	0x81, 0x62, 0xf5, 0xa9, 0xa3, 0x14, 0xab, 0xd3, 0xdd, 0xd4, 0x81, 0x65, 0x3c, 0x56, 0xce, 0xee,
+	0xfe, 0xd3, 0x7d, 0xde, 0x77, 0xa6, 0xad, 0x75, 0xa0, 0x6a, 0x70, 0xaa, 0xd2, 0x4e, 0xec, 0x6a,
+	0x2a, 0x67, 0xbc, 0x28, 0xd9, 0x70, 0x6e, 0xbf, 0x9b, 0x46, 0x70, 0xf9, 0x91, 0x64, 0x52, 0x9d,
+	0x61, 0x74, 0xa2, 0x92, 0xb9, 0x63, 0x38, 0x80, 0xc3, 0x8d, 0x07, 0xea, 0xb6, 0x63, 0x79, 0xec,
+	0xd8, 0xa3, 0xae, 0x5d, 0x18, 0x7a, 0xe3, 0xf1, 0x8b, 0xe8, 0x73, 0x46, 0x19, 0x79, 0x55, 0xb1,
+	0xe1, 0xe7, 0x0a, 0xdf, 0x08, 0xf2, 0xba, 0x50, 0xb6, 0x0d, 0x72, 0xcc, 0xc9, 0x21, 0xd7, 0x2b,
+	0x90, 0xf5, 0xe6, 0x8d, 0x43, 0x0e, 0x59, 0x30, 0xe4, 0xf1, 0x9d, 0xeb, 0x16, 0xd3, 0xa3, 0xae,
+	0x5b, 0xf0, 0x3d, 0x73, 0xdb, 0x6d, 0xa6, 0xd8, 0xdb, 0x6e, 0x6c, 0xc8, 0x4d, 0xb7, 0x4c, 0xe4,
+	0x7d, 0xb6, 0xb8, 0xc8, 0xbb, 0x8c, 0x15, 0x91, 0xcb, 0xb7, 0x2f, 0x6f, 0x3f, 0x65, 0x62, 0x45,
+	0xb2, 0x1d, 0x0c, 0x86, 0xf7, 0xbf, 0x25, 0x76, 0x56, 0x4f, 0xde, 0x4d, 0xf4, 0x0e, 0x93, 0xb0,
+	0x2e, 0x8c, 0xa6, 0xec, 0xa5, 0x75, 0x30, 0x8d, 0xd1, 0xbc, 0xa6, 0x01, 0x60, 0x71, 0x28, 0x80,
+	0x34, 0x78, 0x49, 0x78, 0x22, 0x1b, 0x40, 0x3a, 0xd2, 0x75, 0x5e, 0x74, 0x91, 0xa5, 0xb7, 0x9a,
+	0xe6, 0x0f, 0x84, 0xca, 0x0b, 0x06, 0x0d, 0xa7, 0x58, 0x93, 0x7c, 0x1f, 0xf5, 0x56, 0xe2, 0x47,
+	0x41, 0xdc, 0x97, 0xdf, 0xa3, 0x98, 0xb5, 0xb1, 0xa6, 0x8d, 0x1c, 0x1c, 0x06, 0xde, 0xf0, 0xfe,
+	0x1b, 0x1d, 0x61, 0x67, 0x07, 0x1e, 0xcd, 0x31, 0xc1, 0x5e, 0xee, 0x2b, 0x39, 0xcc, 0x55, 0x5e,
+	0x69, 0xf9, 0xd3, 0x70, 0xe3, 0xc3, 0x94, 0x8f, 0xe6, 0xa5, 0x4e, 0x1e, 0xc3, 0x4b, 0x9d, 0x1a,
+	0xe9, 0xf4, 0x50, 0xfc, 0x3f, 0xac, 0x2b, 0x47, 0xd3, 0xc6, 0xff, 0x37, 0xd6, 0x81, 0xda, 0xbd,
+	0x57, 0x27, 0xed, 0x91, 0x52, 0x65, 0x12, 0x7f, 0x28, 0x86, 0xfd, 0xac, 0x29, 0x9c, 0x93, 0x23,
+	0x7f, 0x3c, 0x5b, 0x38, 0xf7, 0xa6, 0xc8, 0x2d, 0xd2, 0x70, 0x45, 0x9d, 0xd2, 0x90, 0x32, 0xba,
+	0x99, 0x43, 0x62, 0x10, 0x97, 0xd8, 0x2c, 0x79, 0xd6, 0x22, 0x2a, 0x37, 0x9b, 0x61, 0x31, 0x7b,
+	0x4d, 0xb5, 0xbf, 0xe9, 0xfc, 0x06, 0x83, 0x8d, 0x1a, 0x6c, 0x8e, 0x7e, 0x8b, 0x44, 0xb3, 0x8a,
+	0xac, 0x3e, 0x65, 0x76, 0x94, 0x06, 0x0c, 0xc9, 0x49, 0xdb, 0xb7, 0x44, 0x89, 0x00, 0xdd, 0xcb,
+	0x17, 0x24, 0x58, 0x76, 0xc2, 0xaa, 0x1a, 0x00, 0x16, 0x87, 0x5e, 0x40, 0xdf, 0x72, 0x3f, 0x0c,
+	0xee, 0xe0, 0x69, 0x7c, 0x3e, 0x1b, 0x06, 0xde, 0xd1, 0x00, 0xb0, 0x38, 0xe4, 0x2e, 0x9e, 0xc9,
+	0xde, 0x43, 0xfe, 0xe1, 0x90, 0x8b, 0x4b, 0x39, 0xb9, 0x78, 0x72, 0x40, 0x2e, 0xce, 0xd8, 0x7b,
+	0xd0, 0x19, 0xd9, 0x38, 0x55, 0x8b, 0x70, 0xe8, 0x89, 0x4e, 0xda, 0xc1, 0x97, 0xfb, 0x54, 0xde,
+	0xb7, 0x93, 0xf4, 0x45, 0x61, 0x89, 0xd4, 0xf0, 0x8e, 0x1d, 0xcc, 0x80, 0x21, 0x8f, 0x4f, 0x71,
+	0xf1, 0x2e, 0xfe, 0x0c, 0x76, 0x92, 0xb8, 0x17, 0xd4, 0xa8, 0xa2, 0x86, 0x65, 0xe3, 0xe2, 0x3b,
+	0x19, 0x28, 0xe4, 0xb0, 0x29, 0xaa, 0xa6, 0xca, 0x5b, 0xd6, 0x93, 0xb0, 0xd1, 0x53, 0x72, 0x65,
+	0x3c, 0xfa, 0x1d, 0x07, 0x06, 0x19, 0x4c, 0x77, 0x9f, 0x2d, 0x1c, 0xb2, 0xcf, 0x3e, 0xc0, 0xce,
+	0x74, 0x54, 0xed, 0xb7, 0x3c, 0xd1, 0x88, 0xab, 0x9f, 0x73, 0xd2, 0x57, 0xd8, 0xca, 0x40, 0x20,
+	0x87, 0xe9, 0x7d, 0x45, 0x24, 0xed, 0x9c, 0x02, 0x29, 0x92, 0xe1, 0x76, 0xd8, 0x09, 0x75, 0x31,
+	0xa5, 0x91, 0xe1, 0x4d, 0x6a, 0x04, 0x09, 0xe3, 0x21, 0x9b, 0xb9, 0x2d, 0xaf, 0xde, 0x15, 0x50,
+	0xef, 0xaf, 0x2e, 0xf1, 0xc9, 0xab, 0x2c, 0xea, 0x01, 0x34, 0x7d, 0xef, 0x37, 0x66, 0x28, 0xb6,
+	0x93, 0xb9, 0x9a, 0x4e, 0x46, 0x3b, 0xd1, 0x1f, 0x7a, 0xcb, 0x25, 0x08, 0xcc, 0x27, 0xde, 0x0c,
+	0x06, 0xff, 0x38, 0x63, 0xf5, 0xa0, 0xdb, 0x8e, 0x0f, 0xee, 0x33, 0x6d, 0x6f, 0xdc, 0xcc, 0x75,
+	0x43, 0x05, 0x1c, 0x8a, 0xfc, 0x02, 0x9b, 0x08, 0x75, 0x19, 0x12, 0x53, 0xb8, 0x13, 0x68, 0x3d,
+	0xb0, 0xd5, 0xb9, 0x5b, 0x33, 0x7d, 0x8a, 0x77, 0x6b, 0x5e, 0x45, 0x37, 0x25, 0xc9, 0xc5, 0xab,
+	0xd5, 0x9e, 0x1c, 0x37, 0x68, 0x35, 0x2c, 0x14, 0x5e, 0x79, 0x94, 0xdc, 0x87, 0x7c, 0x2b, 0x0c,
+	0x74, 0x81, 0x2e, 0xe2, 0x25, 0x71, 0xbb, 0x4d, 0x4b, 0xbb, 0xb1, 0xae, 0x0a, 0x59, 0x44, 0xe1,
+	0x0b, 0x98, 0x56, 0x70, 0x30, 0x1e, 0xdc, 0xf7, 0x35, 0xde, 0x43, 0x9f, 0xab, 0x90, 0x9d, 0x97,
+	0x5f, 0xd5, 0x98, 0x93, 0x2e, 0xa4, 0x1e, 0xa3, 0xf8, 0xbe, 0x84, 0xfa, 0x89, 0x9b, 0xe1, 0x61,
+	0x29, 0x0d, 0xa6, 0x30, 0x48, 0xdd, 0x3b, 0x3f, 0x8e, 0x90, 0x3d, 0x42, 0xfa, 0x68, 0x3d, 0x4b,
+	0x06, 0xf2, 0x74, 0x07, 0xea, 0x14, 0x17, 0x1e, 0x4c, 0x9d, 0xe2, 0x3f, 0x08, 0x37, 0xf8, 0x3e,
+	0xf3, 0x21, 0x9b, 0xf7, 0x9d, 0x0f, 0xb1, 0x81, 0x3d, 0x9b, 0x13, 0xd1, 0xa9, 0x83, 0xf2, 0xd0,
+	0xd4, 0x81, 0xa3, 0x45, 0x27, 0xef, 0xad, 0x45, 0xbd, 0xf7, 0xb1, 0x05, 0xf7, 0xfb, 0xc4, 0xa4,
+	0x07, 0xf1, 0xa4, 0x8d, 0x62, 0x9a, 0xb3, 0xe5, 0x37, 0xa8, 0x11, 0x24, 0xcc, 0xfb, 0x9d, 0x29,
+	0xb6, 0x98, 0x29, 0x43, 0xcb, 0xa8, 0xa6, 0xd2, 0xa1, 0xaa, 0x89, 0xaa, 0x2c, 0xc9, 0x62, 0xa8,
+	0x42, 0x4d, 0x5b, 0x65, 0x49, 0x8d, 0x20, 0x61, 0x34, 0xb1, 0xf5, 0xe4, 0x00, 0xfa, 0x91, 0x4a,
+	0x12, 0x98, 0x89, 0x5d, 0x17, 0xad, 0xa0, 0xa0, 0x78, 0xd2, 0x5f, 0x48, 0x85, 0x61, 0x96, 0x9a,
+	0x5c, 0x69, 0xba, 0xab, 0x63, 0x7f, 0xef, 0x44, 0x55, 0xce, 0x8a, 0xa8, 0x87, 0xdb, 0x02, 0x19,
+	0x76, 0x74, 0x01, 0xd4, 0xf9, 0xc6, 0xcb, 0xf4, 0xd8, 0x19, 0xc8, 0x7c, 0x79, 0x9f, 0xdc, 0xb3,
+	0xf7, 0xfe, 0xd4, 0x4b, 0xd7, 0xa8, 0xdb, 0x99, 0x13, 0x50, 0xb7, 0x6c, 0x88, 0xaa, 0x45, 0x4d,
+	0xd1, 0xf1, 0xa3, 0xb0, 0x11, 0xa4, 0x3d, 0xf9, 0xd5, 0x6e, 0xa5, 0x29, 0xb6, 0x74, 0x23, 0x58,
+	0x38, 0xb9, 0x03, 0x61, 0x54, 0x6b, 0xf7, 0xeb, 0x01, 0xb9, 0x29, 0xa9, 0x72, 0x47, 0x8c, 0x3b,
+	0xb0, 0xe1, 0xc0, 0x20, 0x83, 0x99, 0xd3, 0x9c, 0xec, 0x30, 0xcd, 0xe9, 0xfd, 0x59, 0x89, 0x9d,
+	0x1b, 0x3a, 0x81, 0x3f, 0xb8, 0xb1, 0x64, 0xef, 0xaf, 0x27, 0xd9, 0x23, 0x43, 0x6a, 0x3a, 0xf9,
+	0xfe, 0xc9, 0x7c, 0x3b, 0x48, 0x55, 0x8c, 0x2e, 0x8e, 0x14, 0xa6, 0xe3, 0x79, 0x19, 0xd6, 0xd2,
+	0x97, 0x4f, 0xd1, 0xd2, 0xb7, 0xd8, 0xe3, 0xe6, 0x33, 0xea, 0x78, 0x7c, 0x90, 0x79, 0x7a, 0x7a,
+	0x6d, 0x2f, 0xec, 0x76, 0xd1, 0x5d, 0x95, 0x47, 0xfc, 0xb7, 0xab, 0xb7, 0x1f, 0xaf, 0xde, 0x03,
+	0x17, 0xee, 0x49, 0xc9, 0xb5, 0xc5, 0x53, 0x0f, 0xce, 0x16, 0x4f, 0xdf, 0xdb, 0x16, 0x7b, 0xdf,
+	0x2e, 0x33, 0xe7, 0xdb, 0x6c, 0xfc, 0x67, 0xdd, 0x62, 0xf8, 0x52, 0x21, 0x15, 0xc7, 0x92, 0xb2,
+	0xa9, 0xa4, 0x97, 0x7d, 0x19, 0x56, 0x58, 0x4f, 0x05, 0x6a, 0x27, 0x73, 0x87, 0x61, 0x6e, 0xe0,
+	0xfe, 0xc2, 0xd3, 0xf2, 0x5f, 0x0f, 0xd0, 0xb7, 0x73, 0xca, 0xce, 0xbf, 0xb5, 0x61, 0x9b, 0xc1,
+	0xc5, 0xe1, 0x5f, 0x2b, 0xb1, 0xe5, 0xce, 0x88, 0x2b, 0x2a, 0xca, 0x74, 0x54, 0x4f, 0xe0, 0xf6,
+	0x8b, 0xf8, 0x04, 0xe5, 0xc8, 0x0b, 0x41, 0x30, 0xb2, 0x4b, 0x5e, 0x4b, 0x2a, 0x87, 0xdc, 0xf4,
+	0x5b, 0x0b, 0x5a, 0xba, 0x87, 0x05, 0xc5, 0x9d, 0x9c, 0x06, 0xed, 0x06, 0x9d, 0x20, 0x95, 0xa5,
+	0x35, 0x3b, 0xb9, 0xaa, 0xda, 0xc1, 0x60, 0x78, 0x7f, 0x3c, 0x29, 0x65, 0x48, 0x1d, 0xea, 0x2f,
+	0xe5, 0x2e, 0x18, 0x1e, 0xfd, 0x3c, 0x7c, 0x40, 0x9f, 0xc9, 0xd2, 0xd7, 0xec, 0x0b, 0xf8, 0xfc,
+	0x98, 0xbd, 0xb3, 0xef, 0x7e, 0x1c, 0x4b, 0xb7, 0x81, 0xc3, 0x2c, 0xa3, 0xbb, 0xca, 0x87, 0xea,
+	0xae, 0xa1, 0xe7, 0x85, 0xc9, 0x07, 0x7f, 0x5e, 0xc8, 0x6c, 0xfd, 0xa9, 0x43, 0xdc, 0xf0, 0xe1,
+	0xb7, 0x36, 0xa7, 0x4f, 0xf4, 0xd6, 0xe6, 0xbf, 0x97, 0x58, 0xc6, 0x25, 0xa2, 0x7b, 0x4b, 0x34,
+	0x15, 0x07, 0x05, 0x7c, 0x4a, 0xc1, 0xa5, 0x4b, 0xfa, 0x52, 0xed, 0x7b, 0xf1, 0x13, 0x24, 0x17,
+	0x54, 0x31, 0x32, 0x08, 0x22, 0x65, 0xeb, 0x46, 0x41, 0xdc, 0xc8, 0xe5, 0x50, 0x9f, 0x2c, 0xb7,
+	0xf9, 0xf1, 0x4b, 0x6c, 0x69, 0xa0, 0x47, 0xb4, 0xfb, 0xc4, 0xa5, 0xd1, 0xfc, 0xee, 0x13, 0xd7,
+	0x4a, 0x41, 0xc2, 0xbc, 0xaf, 0xa2, 0x74, 0xe5, 0xc9, 0x93, 0xc8, 0x2d, 0xa5, 0x79, 0x7a, 0x27,
+	0x32, 0x6b, 0x26, 0xa4, 0x3e, 0x00, 0x82, 0xc1, 0x1e, 0xd0, 0x85, 0x6d, 0x66, 0xff, 0xa9, 0x14,
+	0xe3, 0x08, 0x95, 0x46, 0x3a, 0x42, 0xa4, 0x5b, 0x6a, 0xad, 0xa0, 0xde, 0x6f, 0x0f, 0x14, 0x2b,
+	0x56, 0x55, 0x3b, 0x18, 0x8c, 0xcc, 0x87, 0x8a, 0xca, 0x87, 0x7e, 0xa8, 0xe8, 0x59, 0xb6, 0xe0,
+	0x0c, 0x32, 0x75, 0xef, 0x9c, 0x3b, 0x16, 0x14, 0x7d, 0x45, 0x17, 0x2b, 0xf7, 0xb9, 0x9b, 0xa9,
+	0xc3, 0x3e, 0x77, 0x23, 0x2a, 0x21, 0xe5, 0xf7, 0x47, 0xb4, 0x7d, 0x95, 0x95, 0x90, 0xaa, 0x0d,
+	0x0c, 0x94, 0x8a, 0x39, 0x51, 0x3f, 0xf7, 0xfd, 0x36, 0xcd, 0x90, 0x2a, 0xad, 0x35, 0x9a, 0x68,
+	0xcb, 0x40, 0xc0, 0xc1, 0xa2, 0x2d, 0x92, 0xff, 0x78, 0x4c, 0xa6, 0x40, 0xb7, 0x74, 0x68, 0x81,
+	0x6e, 0xb6, 0x84, 0x74, 0xe2, 0x48, 0x25, 0xa4, 0x6e, 0x75, 0x67, 0xf9, 0x9e, 0xd5, 0x9d, 0xef,
+	0x60, 0x33, 0x78, 0x96, 0x73, 0xca, 0x40, 0xe5, 0x07, 0xeb, 0x65, 0x13, 0x68, 0x18, 0x65, 0xc4,
+	0x6a, 0xbe, 0xa9, 0xb0, 0x5f, 0x90, 0x67, 0x81, 0xb5, 0x55, 0x81, 0xa4, 0x20, 0x95, 0x95, 0xd7,
+	0xff, 0xed, 0x89, 0x87, 0xbe, 0x81, 0x7f, 0x6f, 0xe0, 0xdf, 0x2f, 0x7c, 0xef, 0x89, 0xd2, 0xeb,
+	0xf8, 0xf7, 0x0d, 0xfc, 0x7b, 0x03, 0xff, 0xfe, 0x15, 0xff, 0x7e, 0xfd, 0xfb, 0x4f, 0x3c, 0xf4,
+	0xe1, 0x59, 0x2d, 0xab, 0xff, 0x07, 0x89, 0x0f, 0xaf, 0xc4, 0xdb, 0x6e, 0x00, 0x00,
 }
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -20,6 +21,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
@@ -49,7 +51,7 @@ type Application struct {
 // ApplicationSpec represents desired application state. Contains link to repository with application definition and additional parameters link definition revision.
 type ApplicationSpec struct {
 	// Source is a reference to the location ksonnet application definition
-	Source ApplicationSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination overrides the kubernetes server and namespace defined in the environment ksonnet app.yaml
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a application project name. Empty name means that application belongs to 'default' project.
@@ -60,6 +62,41 @@ type ApplicationSpec struct {
 	IgnoreDifferences []ResourceIgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,5,name=ignoreDifferences"`
 	// Infos contains a list of useful information (URLs, email addresses, and plain text) that relates to the application
 	Info []Info `json:"info,omitempty" protobuf:"bytes,6,name=info"`
+	// PassthroughAnnotations is a list of annotation key patterns (wildcards are supported) of annotations which are
+	// added to live resources out-of-band. Matching annotations are preserved on sync and ignored during comparison.
+	PassthroughAnnotations []string `json:"passthroughAnnotations,omitempty" protobuf:"bytes,7,name=passthroughAnnotations"`
+	// HealthRollupPolicy controls how the health of the application resources is aggregated into the application health.
+	// By default the worst resource health becomes the application health.
+	HealthRollupPolicy *HealthRollupPolicy `json:"healthRollupPolicy,omitempty" protobuf:"bytes,8,opt,name=healthRollupPolicy"`
+	// Sources is a list of references to the locations of the application manifests. If set, the manifests of all
+	// sources are combined and Source is ignored.
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,9,opt,name=sources"`
+	// ResourceExclusions selects resources which are excluded from the application in addition to the resource
+	// exclusions of the settings. Excluded resources are neither compared, synced nor pruned.
+	ResourceExclusions []ResourceExclusion `json:"resourceExclusions,omitempty" protobuf:"bytes,10,opt,name=resourceExclusions"`
+}
+
+// IsExcludedResource returns true if the resource with the given group, kind and name is excluded from the application
+func (a *ApplicationSpec) IsExcludedResource(group string, kind string, name string) bool {
+	for _, exclusion := range a.ResourceExclusions {
+		if exclusion.Matches(group, kind, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMultipleSources returns whether the application is deployed from the list of sources instead of a single source
+func (a *ApplicationSpec) HasMultipleSources() bool {
+	return len(a.Sources) > 0
+}
+
+// GetSources returns the sources of the application, which is a single element list for single source applications
+func (a *ApplicationSpec) GetSources() []ApplicationSource {
+	if a.HasMultipleSources() {
+		return a.Sources
+	}
+	return []ApplicationSource{a.Source}
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -69,6 +106,29 @@ type ResourceIgnoreDifferences struct {
 	Name         string   `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 	Namespace    string   `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
 	JSONPointers []string `json:"jsonPointers" protobuf:"bytes,5,opt,name=jsonPointers"`
+	// JQPathExpressions are jq path expressions of fields which should be ignored, e.g. to select the elements of a list
+	// by a key
+	JQPathExpressions []string `json:"jqPathExpressions,omitempty" protobuf:"bytes,6,opt,name=jqPathExpressions"`
+	// ManagedFieldsManagers are the names of the managers of the live resource (as recorded in its managedFields) whose
+	// fields should be ignored, e.g. to ignore fields which are updated by other controllers
+	ManagedFieldsManagers []string `json:"managedFieldsManagers,omitempty" protobuf:"bytes,7,opt,name=managedFieldsManagers"`
+}
+
+// ResourceExclusion selects resources which are excluded from an application
+type ResourceExclusion struct {
+	// Group is the resource group (wildcards are supported). Matches any group if empty.
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the resource kind (wildcards are supported). Matches any kind if empty.
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the resource name (wildcards are supported). Matches any name if empty.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+}
+
+// Matches returns true if the exclusion selects the resource with the given group, kind and name
+func (e ResourceExclusion) Matches(group string, kind string, name string) bool {
+	return (e.Group == "" || globMatch(e.Group, group)) &&
+		(e.Kind == "" || globMatch(e.Kind, kind)) &&
+		(e.Name == "" || globMatch(e.Name, name))
 }
 
 type EnvEntry struct {
@@ -333,6 +393,8 @@ type ApplicationDestination struct {
 	Server string `json:"server,omitempty" protobuf:"bytes,1,opt,name=server"`
 	// Namespace overrides the environment namespace value in the ksonnet app.yaml
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Name is the name of the cluster which is resolved to the server URL, it can be used instead of Server
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
 // ApplicationStatus contains information about application sync, health status
@@ -347,26 +409,136 @@ type ApplicationStatus struct {
 	ObservedAt     *metav1.Time           `json:"observedAt,omitempty" protobuf:"bytes,8,opt,name=observedAt"`
 	SourceType     ApplicationSourceType  `json:"sourceType,omitempty" protobuf:"bytes,9,opt,name=sourceType"`
 	Summary        ApplicationSummary     `json:"summary,omitempty" protobuf:"bytes,10,opt,name=summary"`
+	// HealthRollupPolicy is the effective health rollup policy used to calculate the application health
+	HealthRollupPolicy *HealthRollupPolicy `json:"healthRollupPolicy,omitempty" protobuf:"bytes,11,opt,name=healthRollupPolicy"`
+	// NextRefreshAt is the time of the next scheduled comparison of the application with the target state
+	NextRefreshAt *metav1.Time `json:"nextRefreshAt,omitempty" protobuf:"bytes,12,opt,name=nextRefreshAt"`
+	// OrphanedResources is the list of resources of the destination namespace which are not managed by any application
+	OrphanedResources []OrphanedResource `json:"orphanedResources,omitempty" protobuf:"bytes,13,opt,name=orphanedResources"`
 }
 
 // Operation contains requested operation parameters.
 type Operation struct {
 	Sync *SyncOperation `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	// Retry controls failed sync retry behavior
+	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
+	// InitiatedBy is the user or the automated sync which requested the operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,3,opt,name=initiatedBy"`
+}
+
+// OperationInitiator identifies who requested an operation
+type OperationInitiator struct {
+	// Username is the name of the user who requested the operation
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Automated is true if the operation was requested by the automated sync of the application
+	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
+}
+
+// RetryStrategy controls the retry behavior of a failed operation
+type RetryStrategy struct {
+	// Limit is the maximum number of attempts when retrying a failed sync. A negative value means unlimited retries.
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
+	// Backoff controls how to backoff on subsequent retries of failed syncs
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// Backoff is the backoff strategy to use on subsequent retries of a failed sync
+type Backoff struct {
+	// Duration is the amount to back off. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+	// Factor is a factor to multiply the base duration after each failed retry
+	Factor *int64 `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
+	// MaxDuration is the maximum amount of time allowed for the backoff strategy
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+}
+
+const (
+	// DefaultSyncRetryDuration is the default backoff duration of a failed sync retry
+	DefaultSyncRetryDuration = 5 * time.Second
+	// DefaultSyncRetryMaxDuration is the default maximum backoff duration of a failed sync retry
+	DefaultSyncRetryMaxDuration = 3 * time.Minute
+	// DefaultSyncRetryFactor is the default factor the backoff duration is multiplied with after each failed retry
+	DefaultSyncRetryFactor = int64(2)
+)
+
+// IsRetryAllowed returns true if an operation which has already been retried the given number of times may be retried again
+func (r *RetryStrategy) IsRetryAllowed(retryCount int64) bool {
+	return r.Limit < 0 || retryCount < r.Limit
+}
+
+func parseStringToDuration(durationString string) (time.Duration, error) {
+	// default to seconds if no unit is provided
+	if seconds, err := strconv.ParseInt(durationString, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	duration, err := time.ParseDuration(durationString)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse %s as a duration", durationString)
+	}
+	return duration, nil
+}
+
+// NextRetryDelay returns the time to wait before performing the next retry, given the number of retries already made
+func (r *RetryStrategy) NextRetryDelay(retryCount int64) (time.Duration, error) {
+	duration := DefaultSyncRetryDuration
+	maxDuration := DefaultSyncRetryMaxDuration
+	factor := DefaultSyncRetryFactor
+	var err error
+	if r.Backoff != nil {
+		if r.Backoff.Duration != "" {
+			if duration, err = parseStringToDuration(r.Backoff.Duration); err != nil {
+				return 0, err
+			}
+		}
+		if r.Backoff.MaxDuration != "" {
+			if maxDuration, err = parseStringToDuration(r.Backoff.MaxDuration); err != nil {
+				return 0, err
+			}
+		}
+		if r.Backoff.Factor != nil {
+			factor = *r.Backoff.Factor
+		}
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("backoff duration %s must be positive", duration)
+	}
+	if maxDuration <= 0 {
+		return 0, fmt.Errorf("backoff max duration %s must be positive", maxDuration)
+	}
+	if factor < 1 {
+		return 0, fmt.Errorf("backoff factor %d must be at least 1", factor)
+	}
+	// Formula: duration * factor^retryCount, bounded by maxDuration. The delay is clamped before it is multiplied, so
+	// that large factors cannot overflow.
+	delay := duration
+	for i := int64(0); i < retryCount && delay < maxDuration; i++ {
+		if delay > maxDuration/time.Duration(factor) {
+			delay = maxDuration
+			break
+		}
+		delay = delay * time.Duration(factor)
+	}
+	if delay > maxDuration {
+		delay = maxDuration
+	}
+	return delay, nil
 }
 
 // SyncOperationResource contains resources to sync.
 type SyncOperationResource struct {
 	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
 	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
-	Name  string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Name is the name of the resource, glob patterns are supported
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Namespace is the namespace of the resource. If omitted, resources in any namespace are matched
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
 }
 
 // HasIdentity determines whether a sync operation is identified by a manifest.
-func (r SyncOperationResource) HasIdentity(name string, gvk schema.GroupVersionKind) bool {
-	if name == r.Name && gvk.Kind == r.Kind && gvk.Group == r.Group {
-		return true
-	}
-	return false
+func (r SyncOperationResource) HasIdentity(name string, namespace string, gvk schema.GroupVersionKind) bool {
+	return gvk.Kind == r.Kind && gvk.Group == r.Group &&
+		(r.Namespace == "" || r.Namespace == namespace) &&
+		(r.Name == name || globMatch(r.Name, name))
 }
 
 // SyncOperation contains sync operation details.
@@ -376,7 +548,8 @@ type SyncOperation struct {
 	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
 	// Prune deletes resources that are no longer tracked in git
 	Prune bool `json:"prune,omitempty" protobuf:"bytes,2,opt,name=prune"`
-	// DryRun will perform a `kubectl apply --dry-run` without actually performing the sync
+	// DryRun will preview the sync without changing the cluster. All tasks of all phases and waves are applied using
+	// a server-side dry-run (`kubectl apply --server-dry-run`) and the results are recorded in the operation state.
 	DryRun bool `json:"dryRun,omitempty" protobuf:"bytes,3,opt,name=dryRun"`
 	// SyncStrategy describes how to perform the sync
 	SyncStrategy *SyncStrategy `json:"syncStrategy,omitempty" protobuf:"bytes,4,opt,name=syncStrategy"`
@@ -387,6 +560,12 @@ type SyncOperation struct {
 	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
 	// Manifests is an optional field that overrides sync source with a local directory for development
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
+	// IncludeHooks runs all hooks of the application during a sync of selected resources. Otherwise only the hooks
+	// which are selected explicitly are run.
+	IncludeHooks bool `json:"includeHooks,omitempty" protobuf:"bytes,9,opt,name=includeHooks"`
+	// RollbackID is the ID of the revision history entry to roll back to. The application is synced to the revision
+	// and source recorded by the entry, and automated sync is suspended until the next sync which is not a rollback.
+	RollbackID *int64 `json:"rollbackID,omitempty" protobuf:"bytes,10,opt,name=rollbackID"`
 }
 
 func (o *SyncOperation) IsApplyStrategy() bool {
@@ -437,6 +616,32 @@ type OperationState struct {
 	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
 	// FinishedAt contains time of operation completion
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
+	// RetryCount contains the number of times the operation has been retried
+	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// NextRetryAt contains the time at which a failed operation will be retried
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty" protobuf:"bytes,9,opt,name=nextRetryAt"`
+	// Progress contains the progress of a running sync operation
+	Progress *OperationProgress `json:"progress,omitempty" protobuf:"bytes,10,opt,name=progress"`
+}
+
+// OperationProgress contains the progress of a sync operation across its phases and waves
+type OperationProgress struct {
+	// Phase is the sync phase which is currently run or waited on
+	Phase SyncPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// Wave is the sync wave which is currently run or waited on
+	Wave int64 `json:"wave" protobuf:"bytes,2,opt,name=wave"`
+	// Pending is the number of resources which have not been synced yet
+	Pending int64 `json:"pending" protobuf:"bytes,3,opt,name=pending"`
+	// Running is the number of resources which have been synced and are not healthy or completed yet
+	Running int64 `json:"running" protobuf:"bytes,4,opt,name=running"`
+	// Succeeded is the number of resources which have been synced successfully
+	Succeeded int64 `json:"succeeded" protobuf:"bytes,5,opt,name=succeeded"`
+	// Failed is the number of resources which failed to sync
+	Failed int64 `json:"failed" protobuf:"bytes,6,opt,name=failed"`
+	// WaitingOn is the <kind>/<name> of the resource the operation is waiting on, if any
+	WaitingOn string `json:"waitingOn,omitempty" protobuf:"bytes,7,opt,name=waitingOn"`
+	// ElapsedSeconds is the time elapsed since the start of the operation when the progress was reported
+	ElapsedSeconds int64 `json:"elapsedSeconds" protobuf:"bytes,8,opt,name=elapsedSeconds"`
 }
 
 type Info struct {
@@ -448,6 +653,38 @@ type Info struct {
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
 	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// Retry controls failed sync retry behavior
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
+	// SyncOptions allow to specify options which apply to every sync of the application (e.g. RespectIgnoreDifferences=true)
+	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,3,opt,name=syncOptions"`
+	// ManagedNamespaceMetadata controls the metadata of the destination namespace created by the CreateNamespace=true
+	// sync option
+	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+}
+
+// IsZero returns true if the sync policy is empty
+func (p *SyncPolicy) IsZero() bool {
+	return p == nil || (p.Automated == nil && p.Retry == nil && len(p.SyncOptions) == 0 && p.ManagedNamespaceMetadata == nil)
+}
+
+// ManagedNamespaceMetadata holds the labels and annotations which are applied to the managed destination namespace.
+// Labels and annotations which are not declared are left untouched.
+type ManagedNamespaceMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
+}
+
+// SyncOptions is a list of options in the form of `Name=value` which control sync behavior
+type SyncOptions []string
+
+// HasOption returns true if the given option is set
+func (o SyncOptions) HasOption(option string) bool {
+	for _, i := range o {
+		if option == i {
+			return true
+		}
+	}
+	return false
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
@@ -520,13 +757,17 @@ const (
 	HookDeletePolicyHookSucceeded      HookDeletePolicy = "HookSucceeded"
 	HookDeletePolicyHookFailed         HookDeletePolicy = "HookFailed"
 	HookDeletePolicyBeforeHookCreation HookDeletePolicy = "BeforeHookCreation"
+	// HookDeletePolicyHookRetryOnConflict deletes and re-creates the hook if it cannot be created because an object with
+	// the same name exists which cannot be updated, e.g. since the object is not tracked by the application
+	HookDeletePolicyHookRetryOnConflict HookDeletePolicy = "HookRetryOnConflict"
 )
 
 func NewHookDeletePolicy(p string) (HookDeletePolicy, bool) {
 	return HookDeletePolicy(p),
 		p == string(HookDeletePolicyHookSucceeded) ||
 			p == string(HookDeletePolicyHookFailed) ||
-			p == string(HookDeletePolicyBeforeHookCreation)
+			p == string(HookDeletePolicyBeforeHookCreation) ||
+			p == string(HookDeletePolicyHookRetryOnConflict)
 }
 
 // data about a specific revision within a repo
@@ -546,6 +787,26 @@ type RevisionMetadata struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
+// ResolvedRevisionMetadata is a compact summary of the revision the manifests of an application were generated from
+type ResolvedRevisionMetadata struct {
+	// Author of the commit
+	Author string `json:"author,omitempty" protobuf:"bytes,1,opt,name=author"`
+	// Date the commit was authored
+	Date *metav1.Time `json:"date,omitempty" protobuf:"bytes,2,opt,name=date"`
+	// Message is the first line of the commit message, truncated to 64 characters
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// ChartName is the name of the Helm chart
+	ChartName string `json:"chartName,omitempty" protobuf:"bytes,4,opt,name=chartName"`
+	// ChartVersion is the version of the Helm chart
+	ChartVersion string `json:"chartVersion,omitempty" protobuf:"bytes,5,opt,name=chartVersion"`
+	// ChartAppVersion is the version of the application packaged by the Helm chart
+	ChartAppVersion string `json:"chartAppVersion,omitempty" protobuf:"bytes,6,opt,name=chartAppVersion"`
+	// Tags are the tags pointing to the commit
+	Tags []string `json:"tags,omitempty" protobuf:"bytes,7,rep,name=tags"`
+	// ChartDescription is the description of the Helm chart
+	ChartDescription string `json:"chartDescription,omitempty" protobuf:"bytes,8,opt,name=chartDescription"`
+}
+
 // SyncOperationResult represent result of sync operation
 type SyncOperationResult struct {
 	// Resources holds the sync result of each individual resource
@@ -554,6 +815,13 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// SignatureVerificationSkipped was set if the project requires signed revisions but the sync used local manifests.
+	// It is deprecated since such syncs are rejected, because local manifests bypass signature verification
+	SignatureVerificationSkipped bool `json:"signatureVerificationSkipped,omitempty" protobuf:"varint,4,opt,name=signatureVerificationSkipped"`
+	// Sources records the application sources of the sync of an application with multiple sources
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,5,opt,name=sources"`
+	// Revisions holds the revision of each source of the sync of an application with multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,6,opt,name=revisions"`
 }
 
 type ResultCode string
@@ -563,6 +831,12 @@ const (
 	ResultCodeSyncFailed   ResultCode = "SyncFailed"
 	ResultCodePruned       ResultCode = "Pruned"
 	ResultCodePruneSkipped ResultCode = "PruneSkipped"
+	// ResultCodeDryRunUnsupported indicates that the resource could not be previewed since the API server cannot
+	// perform a dry-run of it
+	ResultCodeDryRunUnsupported ResultCode = "DryRunUnsupported"
+	// ResultCodeBlocked indicates that the resource was not synced since it is denied by the resource rules of the
+	// project
+	ResultCodeBlocked ResultCode = "Blocked"
 )
 
 type SyncPhase = string
@@ -592,6 +866,8 @@ type ResourceResult struct {
 	HookPhase OperationPhase `json:"hookPhase,omitempty" protobuf:"bytes,9,opt,name=hookPhase"`
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
+	// indicates that the result was produced by a dry-run (preview) of the sync and the resource was not changed
+	Previewed bool `json:"previewed,omitempty" protobuf:"bytes,11,opt,name=previewed"`
 }
 
 func (r *ResourceResult) GroupVersionKind() schema.GroupVersionKind {
@@ -637,6 +913,19 @@ type RevisionHistory struct {
 	DeployedAt metav1.Time       `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID         int64             `json:"id" protobuf:"bytes,5,opt,name=id"`
 	Source     ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
+	// RevisionMetadata summarizes the deployed revision
+	RevisionMetadata *ResolvedRevisionMetadata `json:"revisionMetadata,omitempty" protobuf:"bytes,7,opt,name=revisionMetadata"`
+	// RollbackID is the ID of the history entry which was rolled back to, it is set if the deployment was a rollback
+	RollbackID *int64 `json:"rollbackID,omitempty" protobuf:"bytes,8,opt,name=rollbackID"`
+	// Sources are the deployed sources of an application with multiple sources
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,9,opt,name=sources"`
+	// Revisions are the deployed revisions of the sources of an application with multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,10,opt,name=revisions"`
+	// DeployStartedAt is the time the sync operation which deployed the revision started
+	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,11,opt,name=deployStartedAt"`
+	// InitiatedBy is the user or the automated sync which requested the deployment, it is empty for deployments which
+	// were recorded before the initiator was tracked
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,12,opt,name=initiatedBy"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -691,6 +980,12 @@ const (
 	ApplicationConditionComparisonError = "ComparisonError"
 	// ApplicationConditionSyncError indicates controller failed to automatically sync the application
 	ApplicationConditionSyncError = "SyncError"
+	// ApplicationConditionSignatureVerificationError indicates that the revision is not signed with a key allowed by the project
+	ApplicationConditionSignatureVerificationError = "SignatureVerificationError"
+	// ApplicationConditionNamespaceRestrictionError indicates that application targets a namespace which is not managed on a namespace scoped cluster
+	ApplicationConditionNamespaceRestrictionError = "NamespaceRestrictionError"
+	// ApplicationConditionSchemaValidationError indicates that application has resources which do not match the schema of the destination cluster
+	ApplicationConditionSchemaValidationError = "SchemaValidationError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
 	ApplicationConditionUnknownError = "UnknownError"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
@@ -701,6 +996,34 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionClusterPermissionWarning indicates that application has cluster-scoped resources which cannot be managed on a namespace scoped cluster
+	ApplicationConditionClusterPermissionWarning = "ClusterPermissionWarning"
+	// ApplicationConditionLocalManifestsWarning indicates that application is compared with local manifests instead of the manifests of the source repository
+	ApplicationConditionLocalManifestsWarning = "LocalManifestsWarning"
+	// ApplicationConditionUnknownResourceKindWarning indicates that application has resources whose kind is not registered in the destination cluster
+	ApplicationConditionUnknownResourceKindWarning = "UnknownResourceKindWarning"
+	// ApplicationConditionAPIDiscoveryWarning indicates that some APIs of the destination cluster could not be discovered, but the application does not use them
+	ApplicationConditionAPIDiscoveryWarning = "APIDiscoveryWarning"
+	// ApplicationConditionLegacyInstanceLabelWarning indicates that application has resources which are only labeled with a legacy app instance label key
+	ApplicationConditionLegacyInstanceLabelWarning = "LegacyInstanceLabelWarning"
+	// ApplicationConditionLegacyTrackingValueWarning indicates that application has resources which are tracked by the bare application name instead of the configured tracking value format
+	ApplicationConditionLegacyTrackingValueWarning = "LegacyTrackingValueWarning"
+	// ApplicationConditionForeignManagerWarning indicates that application has resources which appear to be managed by another tool as well
+	ApplicationConditionForeignManagerWarning = "ForeignManagerWarning"
+	// ApplicationConditionStaleSettingsWarning indicates that application was compared with previously loaded settings because the settings failed to load
+	ApplicationConditionStaleSettingsWarning = "StaleSettingsWarning"
+	// ApplicationConditionResourcePermissionError indicates that application has namespaced resources which are denied by the resource rules of its project
+	ApplicationConditionResourcePermissionError = "ResourcePermissionError"
+	// ApplicationConditionClusterResourceWarning indicates that application has cluster-scoped resources which are not whitelisted by its project
+	ApplicationConditionClusterResourceWarning = "ClusterResourceWarning"
+	// ApplicationConditionNamespacePermissionError indicates that application has resources in namespaces which are not destinations of its project
+	ApplicationConditionNamespacePermissionError = "NamespacePermissionError"
+	// ApplicationConditionResourceLimitError indicates that application has more resources than the limit of its project or the settings
+	ApplicationConditionResourceLimitError = "ResourceLimitError"
+	// ApplicationConditionReconcilePausedWarning indicates that the reconciliation of application is paused, the condition is added when the pause is observed
+	ApplicationConditionReconcilePausedWarning = "ReconcilePausedWarning"
+	// ApplicationConditionServerSideDiffWarning indicates that some resources of application were diffed client-side because their server-side dry-run failed
+	ApplicationConditionServerSideDiffWarning = "ServerSideDiffWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -717,6 +1040,8 @@ type ApplicationCondition struct {
 type ComparedTo struct {
 	Source      ApplicationSource      `json:"source" protobuf:"bytes,1,opt,name=source"`
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// Sources are the sources of an application with multiple sources which were used for the comparison
+	Sources []ApplicationSource `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 }
 
 // SyncStatus is a comparison result of application spec and deployed application.
@@ -724,11 +1049,21 @@ type SyncStatus struct {
 	Status     SyncStatusCode `json:"status" protobuf:"bytes,1,opt,name=status,casttype=SyncStatusCode"`
 	ComparedTo ComparedTo     `json:"comparedTo,omitempty" protobuf:"bytes,2,opt,name=comparedTo"`
 	Revision   string         `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// RevisionMetadata summarizes the revision the application was compared to
+	RevisionMetadata *ResolvedRevisionMetadata `json:"revisionMetadata,omitempty" protobuf:"bytes,4,opt,name=revisionMetadata"`
+	// Revisions are the revisions of the sources of an application with multiple sources which were compared to
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
+	// LastTransitionTime is the time the sync status last changed, changes from and to Unknown are not considered
+	// transitions
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,6,opt,name=lastTransitionTime"`
 }
 
 type HealthStatus struct {
 	Status  HealthStatusCode `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
 	Message string           `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// LastTransitionTime is the time the health status of the application last changed, changes from and to Unknown
+	// are not considered transitions. It is not set for the health statuses of resources.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 type HealthStatusCode = string
@@ -742,6 +1077,94 @@ const (
 	HealthStatusMissing     HealthStatusCode = "Missing"
 )
 
+type HealthRollupMode = string
+
+const (
+	// HealthRollupWorstOf uses the worst health of the resources as application health
+	HealthRollupWorstOf HealthRollupMode = "WorstOf"
+	// HealthRollupWeightedThreshold marks the application as Degraded only if the weighted percentage of degraded
+	// resources exceeds the threshold. Otherwise the worst health of the remaining resources is used.
+	HealthRollupWeightedThreshold HealthRollupMode = "WeightedThreshold"
+	// HealthRollupKindPriority uses the worst health of the resources of the first kind in the priority list which has
+	// any resources. If there are no resources of the listed kinds the worst health of all resources is used.
+	HealthRollupKindPriority HealthRollupMode = "KindPriority"
+)
+
+// HealthRollupPolicy controls how the health of resources is aggregated into the application health
+type HealthRollupPolicy struct {
+	// Mode is the rollup mode: WorstOf (default), WeightedThreshold or KindPriority
+	Mode HealthRollupMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode"`
+	// Rules assign weights to resources or exclude them from the rollup. The first matching rule applies.
+	Rules []HealthRollupRule `json:"rules,omitempty" protobuf:"bytes,2,opt,name=rules"`
+	// DegradedThreshold is the percentage of the weighted resources which have to be Degraded for the application to be
+	// Degraded when using the WeightedThreshold mode
+	DegradedThreshold int64 `json:"degradedThreshold,omitempty" protobuf:"bytes,3,opt,name=degradedThreshold"`
+	// KindPriority is the list of kinds which determine the application health when using the KindPriority mode
+	KindPriority []string `json:"kindPriority,omitempty" protobuf:"bytes,4,opt,name=kindPriority"`
+}
+
+// HealthRollupRule selects resources and controls how they contribute to the application health
+type HealthRollupRule struct {
+	// Group is the resource group (wildcards are supported). Matches any group if empty.
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the resource kind (wildcards are supported). Matches any kind if empty.
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the resource name (wildcards are supported). Matches any name if empty.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// LabelSelector selects resources by labels
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,opt,name=labelSelector"`
+	// Weight of the selected resources when using the WeightedThreshold mode (default: 1)
+	Weight int64 `json:"weight,omitempty" protobuf:"bytes,5,opt,name=weight"`
+	// Informational excludes the selected resources from the application health
+	Informational bool `json:"informational,omitempty" protobuf:"bytes,6,opt,name=informational"`
+}
+
+// Matches returns true if the rule selects the given resource
+func (r HealthRollupRule) Matches(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	if r.Group != "" && !globMatch(r.Group, gvk.Group) {
+		return false
+	}
+	if r.Kind != "" && !globMatch(r.Kind, gvk.Kind) {
+		return false
+	}
+	if r.Name != "" && !globMatch(r.Name, obj.GetName()) {
+		return false
+	}
+	if r.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(r.LabelSelector)
+		if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetWeight returns the weight of the selected resources
+func (r HealthRollupRule) GetWeight() int64 {
+	if r.Weight <= 0 {
+		return 1
+	}
+	return r.Weight
+}
+
+// Effective returns a copy of the policy with defaults applied
+func (p *HealthRollupPolicy) Effective() *HealthRollupPolicy {
+	if p == nil {
+		return nil
+	}
+	effective := p.DeepCopy()
+	if effective.Mode == "" {
+		effective.Mode = HealthRollupWorstOf
+	}
+	for i := range effective.Rules {
+		if !effective.Rules[i].Informational {
+			effective.Rules[i].Weight = effective.Rules[i].GetWeight()
+		}
+	}
+	return effective
+}
+
 // InfoItem contains human readable information about object
 type InfoItem struct {
 	// Name is a human readable title for this piece of information.
@@ -785,27 +1208,40 @@ func (t *ApplicationTree) FindNode(group string, kind string, namespace string,
 }
 
 func (t *ApplicationTree) GetSummary() ApplicationSummary {
-	urlsSet := make(map[string]bool)
-	imagesSet := make(map[string]bool)
+	var urls []string
+	var images []string
 	for _, node := range t.Nodes {
 		if node.NetworkingInfo != nil {
-			for _, url := range node.NetworkingInfo.ExternalURLs {
-				urlsSet[url] = true
-			}
-		}
-		for _, image := range node.Images {
-			imagesSet[image] = true
+			urls = append(urls, node.NetworkingInfo.ExternalURLs...)
 		}
+		images = append(images, node.Images...)
 	}
-	urls := make([]string, 0)
-	for url := range urlsSet {
-		urls = append(urls, url)
+	return NewApplicationSummary(urls, images)
+}
+
+// MaxApplicationSummaryItems is the maximum number of external URLs and images reported in the application summary
+const MaxApplicationSummaryItems = 100
+
+// NewApplicationSummary returns a summary of the given external URLs and images, which are deduplicated, sorted and
+// capped to MaxApplicationSummaryItems
+func NewApplicationSummary(urls []string, images []string) ApplicationSummary {
+	return ApplicationSummary{ExternalURLs: summaryItems(urls), Images: summaryItems(images)}
+}
+
+func summaryItems(items []string) []string {
+	itemsSet := make(map[string]bool)
+	res := make([]string, 0)
+	for _, item := range items {
+		if item != "" && !itemsSet[item] {
+			itemsSet[item] = true
+			res = append(res, item)
+		}
 	}
-	images := make([]string, 0)
-	for image := range imagesSet {
-		images = append(images, image)
+	sort.Strings(res)
+	if len(res) > MaxApplicationSummaryItems {
+		res = res[:MaxApplicationSummaryItems]
 	}
-	return ApplicationSummary{ExternalURLs: urls, Images: images}
+	return res
 }
 
 // ResourceRef includes fields which unique identify resource
@@ -827,6 +1263,10 @@ type ResourceNode struct {
 	ResourceVersion string                  `json:"resourceVersion,omitempty" protobuf:"bytes,5,opt,name=resourceVersion"`
 	Images          []string                `json:"images,omitempty" protobuf:"bytes,6,opt,name=images"`
 	Health          *HealthStatus           `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
+	// CreatedAt is the creation time of the live resource
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,8,opt,name=createdAt"`
+	// Inactive is true if the resource is a superseded rollout revision, e.g. a ReplicaSet without desired and actual replicas
+	Inactive bool `json:"inactive,omitempty" protobuf:"varint,9,opt,name=inactive"`
 }
 
 func (n *ResourceNode) GroupKindVersion() schema.GroupVersionKind {
@@ -848,6 +1288,15 @@ type ResourceStatus struct {
 	Health          *HealthStatus  `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
 	Hook            bool           `json:"hook,omitempty" protobuf:"bytes,8,opt,name=hook"`
 	RequiresPruning bool           `json:"requiresPruning,omitempty" protobuf:"bytes,9,opt,name=requiresPruning"`
+	PruneProtected  bool           `json:"pruneProtected,omitempty" protobuf:"bytes,10,opt,name=pruneProtected"`
+	// PendingDrift indicates that the resource differs from the target state, but is reported as synced until the drift
+	// grace period since its last sync elapses
+	PendingDrift bool `json:"pendingDrift,omitempty" protobuf:"bytes,11,opt,name=pendingDrift"`
+	// Message explains the sync status of the resource, e.g. the project rule which denies the resource
+	Message string `json:"message,omitempty" protobuf:"bytes,12,opt,name=message"`
+	// ModifiedFields holds the paths of the fields which make the resource OutOfSync, e.g.
+	// spec.template.spec.containers[0].image, the paths beyond the limit are summarized by a "+N more" entry
+	ModifiedFields []string `json:"modifiedFields,omitempty" protobuf:"bytes,13,rep,name=modifiedFields"`
 }
 
 func (r *ResourceStatus) GroupVersionKind() schema.GroupVersionKind {
@@ -872,6 +1321,7 @@ type ConnectionStatus = string
 const (
 	ConnectionStatusSuccessful = "Successful"
 	ConnectionStatusFailed     = "Failed"
+	ConnectionStatusUnknown    = "Unknown"
 )
 
 // ConnectionState contains information about remote resource connection state
@@ -893,6 +1343,27 @@ type Cluster struct {
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,4,opt,name=connectionState"`
 	// The server version
 	ServerVersion string `json:"serverVersion,omitempty" protobuf:"bytes,5,opt,name=serverVersion"`
+	// Namespaces restricts Argo CD to the given namespaces of the cluster. If omitted, Argo CD watches the whole cluster.
+	// Cluster-scoped resources are not managed if namespaces are specified.
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,6,rep,name=namespaces"`
+}
+
+// IsNamespaceScoped returns true if Argo CD is restricted to a subset of the cluster namespaces
+func (c *Cluster) IsNamespaceScoped() bool {
+	return len(c.Namespaces) > 0
+}
+
+// IsNamespaceAllowed returns true if Argo CD may manage resources in the given namespace of the cluster
+func (c *Cluster) IsNamespaceAllowed(namespace string) bool {
+	if !c.IsNamespaceScoped() {
+		return true
+	}
+	for _, ns := range c.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
 }
 
 // ClusterList is a collection of Clusters.
@@ -953,6 +1424,19 @@ type ResourceOverride struct {
 	HealthLua         string `json:"health.lua,omitempty" protobuf:"bytes,1,opt,name=healthLua"`
 	Actions           string `json:"actions,omitempty" protobuf:"bytes,3,opt,name=actions"`
 	IgnoreDifferences string `json:"ignoreDifferences,omitempty" protobuf:"bytes,2,opt,name=ignoreDifferences"`
+	// IgnoreExtraneous controls whether resources of the kind which require pruning affect the sync status of the
+	// application, like resources with the IgnoreExtraneous compare option
+	IgnoreExtraneous bool `json:"ignoreExtraneous,omitempty" protobuf:"bytes,4,opt,name=ignoreExtraneous"`
+}
+
+// ResourceOverrideKeyMatches returns true if the key of a resource override selects resources of the given group and
+// kind. Keys have the form <group>/<kind>, or <kind> for the core group, and both parts support wildcards.
+func ResourceOverrideKeyMatches(key string, group string, kind string) bool {
+	keyGroup, keyKind := "", key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		keyGroup, keyKind = key[:i], key[i+1:]
+	}
+	return globMatch(keyGroup, group) && globMatch(keyKind, kind)
 }
 
 func (o *ResourceOverride) GetActions() (ResourceActions, error) {
@@ -1244,6 +1728,15 @@ func (p *AppProject) ValidateProject() error {
 		}
 		destKeys[key] = true
 	}
+	for _, item := range p.Spec.DestinationServiceAccounts {
+		if item.Server == "" || item.Namespace == "" || item.ServiceAccount == "" {
+			return status.Errorf(codes.InvalidArgument, "destination service account requires server, namespace and serviceAccount")
+		}
+		parts := strings.Split(item.ServiceAccount, ":")
+		if len(parts) > 2 || parts[0] == "" || parts[len(parts)-1] == "" {
+			return status.Errorf(codes.InvalidArgument, "invalid service account '%s', expected <name> or <namespace>:<name>", item.ServiceAccount)
+		}
+	}
 	srcRepos := make(map[string]bool)
 	for _, src := range p.Spec.SourceRepos {
 		if _, ok := srcRepos[src]; ok {
@@ -1442,6 +1935,15 @@ func (p *AppProject) normalizePolicy(policy string) string {
 	return normalizedPolicy
 }
 
+// OrphanedResource is a resource of the destination namespace of an application which is not managed by any application
+type OrphanedResource struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Name  string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// CreatedAt is the creation timestamp of the resource
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,4,opt,name=createdAt"`
+}
+
 // OrphanedResourcesMonitorSettings holds settings of orphaned resources monitoring
 type OrphanedResourcesMonitorSettings struct {
 	// Warn indicates if warning condition should be created for apps which have orphaned resources
@@ -1470,6 +1972,51 @@ type AppProjectSpec struct {
 	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,7,opt,name=orphanedResources"`
 	// SyncWindows controls when syncs can be run for apps in this project
 	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,8,opt,name=syncWindows"`
+	// SignatureKeys contains the GPG keys which must have signed the revisions synced by apps in this project.
+	// Signature verification is disabled if no keys are configured.
+	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,9,rep,name=signatureKeys"`
+	// DestinationServiceAccounts are the service accounts impersonated when syncing apps of this project to a destination.
+	// Apps whose destination matches none of the entries are synced with the credentials of the cluster.
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,10,rep,name=destinationServiceAccounts"`
+	// MaxResources is the maximum number of resources which an app of this project can have. It overrides the limit
+	// of the settings if it is greater than zero.
+	MaxResources int64 `json:"maxResources,omitempty" protobuf:"varint,11,opt,name=maxResources"`
+}
+
+// ApplicationDestinationServiceAccount is the service account impersonated when syncing to a destination
+type ApplicationDestinationServiceAccount struct {
+	// Server is the URL of the destination cluster, glob patterns are supported
+	Server string `json:"server" protobuf:"bytes,1,name=server"`
+	// Namespace is the destination namespace, glob patterns are supported
+	Namespace string `json:"namespace" protobuf:"bytes,2,name=namespace"`
+	// ServiceAccount is the name of the service account in the destination namespace, or <namespace>:<name> for a
+	// service account of another namespace
+	ServiceAccount string `json:"serviceAccount" protobuf:"bytes,3,name=serviceAccount"`
+}
+
+// SignatureKey is a GPG key allowed to sign the revisions synced by apps of a project
+type SignatureKey struct {
+	// KeyID is the ID of the GPG key, either the short, long or full fingerprint form
+	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
+}
+
+// IsSignatureKeyAllowed returns whether the given key ID matches one of the signature keys of the project.
+// Key IDs are compared case-insensitively, and short or long key IDs match the fingerprint they are a suffix of.
+func (spec AppProjectSpec) IsSignatureKeyAllowed(keyID string) bool {
+	keyID = strings.ToUpper(strings.TrimSpace(keyID))
+	if keyID == "" {
+		return false
+	}
+	for _, key := range spec.SignatureKeys {
+		allowed := strings.ToUpper(strings.TrimSpace(key.KeyID))
+		if allowed == "" {
+			continue
+		}
+		if strings.HasSuffix(allowed, keyID) || strings.HasSuffix(keyID, allowed) {
+			return true
+		}
+	}
+	return false
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -1843,6 +2390,21 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// IsReconcilePaused returns whether the reconciliation of the application is paused by the reconcile annotation
+func (app *Application) IsReconcilePaused() bool {
+	return app.GetAnnotations()[common.AnnotationKeyReconcile] == common.AnnotationValueReconcilePaused
+}
+
+// ReconcilePausedMessage returns the message which explains that the reconciliation of the application is paused, and
+// who paused it if it is known
+func (app *Application) ReconcilePausedMessage() string {
+	message := fmt.Sprintf("Reconciliation is paused by the %s annotation", common.AnnotationKeyReconcile)
+	if pausedBy := app.GetAnnotations()[common.AnnotationKeyReconcilePausedBy]; pausedBy != "" {
+		message = fmt.Sprintf("%s, which was set by %s", message, pausedBy)
+	}
+	return message
+}
+
 // SetCascadedDeletion sets or remove resources finalizer
 func (app *Application) SetCascadedDeletion(prune bool) {
 	index := app.getFinalizerIndex(common.ResourcesFinalizerName)
@@ -1860,6 +2422,8 @@ func (app *Application) SetCascadedDeletion(prune bool) {
 // If the application has a pre-existing condition of a type that is not in the evaluated list,
 // it will be preserved. If the application has a pre-existing condition of a type that
 // is in the evaluated list, but not in the incoming conditions list, it will be removed.
+// An incoming condition which has the same type and message as a pre-existing condition keeps the
+// LastTransitionTime of the pre-existing condition, so the time reflects when the condition first appeared.
 func (status *ApplicationStatus) SetConditions(conditions []ApplicationCondition, evaluatedTypes map[ApplicationConditionType]bool) {
 	appConditions := make([]ApplicationCondition, 0)
 	now := metav1.Now()
@@ -1877,11 +2441,16 @@ func (status *ApplicationStatus) SetConditions(conditions []ApplicationCondition
 		if condition.LastTransitionTime == nil {
 			condition.LastTransitionTime = &now
 		}
-		eci := findConditionIndexByType(status.Conditions, condition.Type)
-		if eci >= 0 && status.Conditions[eci].Message == condition.Message {
-			// If we already have a condition of this type, only update the timestamp if something
-			// has changed.
-			appConditions = append(appConditions, status.Conditions[eci])
+		eci := findConditionIndex(status.Conditions, condition.Type, condition.Message)
+		if eci >= 0 {
+			// If we already have the same condition, only update the timestamp if something
+			// has changed. Several conditions of the same type, e.g. one per shared resource, are
+			// matched by their message.
+			existing := status.Conditions[eci]
+			if existing.LastTransitionTime == nil {
+				existing.LastTransitionTime = condition.LastTransitionTime
+			}
+			appConditions = append(appConditions, existing)
 		} else {
 			// Otherwise we use the new incoming condition with an updated timestamp:
 			appConditions = append(appConditions, condition)
@@ -1890,9 +2459,9 @@ func (status *ApplicationStatus) SetConditions(conditions []ApplicationCondition
 	status.Conditions = appConditions
 }
 
-func findConditionIndexByType(conditions []ApplicationCondition, t ApplicationConditionType) int {
+func findConditionIndex(conditions []ApplicationCondition, t ApplicationConditionType, message string) int {
 	for i := range conditions {
-		if conditions[i].Type == t {
+		if conditions[i].Type == t && conditions[i].Message == message {
 			return i
 		}
 	}
@@ -1965,26 +2534,40 @@ func (spec ApplicationSpec) GetProject() string {
 	return spec.Project
 }
 
-func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
+// findResourceInList returns the first item of the list which matches the given resource group/kind
+func findResourceInList(res metav1.GroupKind, list []metav1.GroupKind) (metav1.GroupKind, bool) {
 	for _, item := range list {
 		ok, err := filepath.Match(item.Kind, res.Kind)
 		if ok && err == nil {
 			ok, err = filepath.Match(item.Group, res.Group)
 			if ok && err == nil {
-				return true
+				return item, true
 			}
 		}
 	}
-	return false
+	return metav1.GroupKind{}, false
 }
 
 // IsResourcePermitted validates if the given resource group/kind is permitted to be deployed in the project
 func (proj AppProject) IsResourcePermitted(res metav1.GroupKind, namespaced bool) bool {
+	_, denied := proj.GetResourceDenyingRule(res, namespaced)
+	return !denied
+}
+
+// GetResourceDenyingRule returns the rule of the project which denies the given resource group/kind, e.g.
+// "namespaceResourceBlacklist apps/Deployment" for a blacklisted namespaced resource or "clusterResourceWhitelist" for
+// a cluster level resource which is not whitelisted. Returns false if the resource is permitted.
+func (proj AppProject) GetResourceDenyingRule(res metav1.GroupKind, namespaced bool) (string, bool) {
 	if namespaced {
-		return !isResourceInList(res, proj.Spec.NamespaceResourceBlacklist)
-	} else {
-		return isResourceInList(res, proj.Spec.ClusterResourceWhitelist)
+		if item, ok := findResourceInList(res, proj.Spec.NamespaceResourceBlacklist); ok {
+			return fmt.Sprintf("namespaceResourceBlacklist %s/%s", item.Group, item.Kind), true
+		}
+		return "", false
+	}
+	if _, ok := findResourceInList(res, proj.Spec.ClusterResourceWhitelist); !ok {
+		return "clusterResourceWhitelist", true
 	}
+	return "", false
 }
 
 func globMatch(pattern string, val string) bool {
@@ -2019,6 +2602,29 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	return false
 }
 
+// GetMaxResources returns the maximum number of resources of the apps of the project, which is the given limit of the
+// settings unless the project overrides it. Zero means there is no limit.
+func (proj AppProject) GetMaxResources(settingsMaxResources int64) int64 {
+	if proj.Spec.MaxResources > 0 {
+		return proj.Spec.MaxResources
+	}
+	return settingsMaxResources
+}
+
+// GetDestinationServiceAccount returns the <namespace>:<name> of the service account which has to be impersonated when
+// syncing to the given destination. The first matching entry wins. Returns false if no entry matches.
+func (proj AppProject) GetDestinationServiceAccount(dst ApplicationDestination) (string, bool) {
+	for _, item := range proj.Spec.DestinationServiceAccounts {
+		if globMatch(item.Server, dst.Server) && globMatch(item.Namespace, dst.Namespace) {
+			if strings.Contains(item.ServiceAccount, ":") {
+				return item.ServiceAccount, true
+			}
+			return fmt.Sprintf("%s:%s", dst.Namespace, item.ServiceAccount), true
+		}
+	}
+	return "", false
+}
+
 // RESTConfig returns a go-client REST config from cluster
 func (c *Cluster) RESTConfig() *rest.Config {
 	var config *rest.Config
@@ -1,12 +1,16 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"math"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -379,6 +383,126 @@ func TestAppDestinationEquality(t *testing.T) {
 	assert.False(t, left.Equals(*right))
 }
 
+func TestAppProjectSpec_IsSignatureKeyAllowed(t *testing.T) {
+	spec := AppProjectSpec{SignatureKeys: []SignatureKey{{KeyID: "4AEE18F83AFDEB23"}, {KeyID: "  "}}}
+	assert.True(t, spec.IsSignatureKeyAllowed("4AEE18F83AFDEB23"))
+	assert.True(t, spec.IsSignatureKeyAllowed("4aee18f83afdeb23"))
+	// full fingerprint of the long key ID
+	assert.True(t, spec.IsSignatureKeyAllowed("5DE3E0509C47EA3CF04A42D34AEE18F83AFDEB23"))
+	// short key ID
+	assert.True(t, spec.IsSignatureKeyAllowed("3AFDEB23"))
+	assert.False(t, spec.IsSignatureKeyAllowed("D56C4FCA57A46444"))
+	assert.False(t, spec.IsSignatureKeyAllowed(""))
+	assert.False(t, AppProjectSpec{}.IsSignatureKeyAllowed("4AEE18F83AFDEB23"))
+}
+
+func TestAppProject_GetDestinationServiceAccount(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{DestinationServiceAccounts: []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-*", ServiceAccount: "deployer"},
+		{Server: "*", Namespace: "shared", ServiceAccount: "argocd:shared-deployer"},
+	}}}
+
+	sa, ok := proj.GetDestinationServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"})
+	assert.True(t, ok)
+	assert.Equal(t, "team-a:deployer", sa)
+
+	sa, ok = proj.GetDestinationServiceAccount(ApplicationDestination{Server: "https://remote", Namespace: "shared"})
+	assert.True(t, ok)
+	assert.Equal(t, "argocd:shared-deployer", sa)
+
+	_, ok = proj.GetDestinationServiceAccount(ApplicationDestination{Server: "https://remote", Namespace: "team-a"})
+	assert.False(t, ok)
+	_, ok = AppProject{}.GetDestinationServiceAccount(ApplicationDestination{Server: "https://remote", Namespace: "team-a"})
+	assert.False(t, ok)
+}
+
+func TestApplication_IsReconcilePaused(t *testing.T) {
+	app := Application{}
+	assert.False(t, app.IsReconcilePaused())
+	app.Annotations = map[string]string{common.AnnotationKeyReconcile: "resumed"}
+	assert.False(t, app.IsReconcilePaused())
+	app.Annotations[common.AnnotationKeyReconcile] = common.AnnotationValueReconcilePaused
+	assert.True(t, app.IsReconcilePaused())
+	assert.Equal(t, "Reconciliation is paused by the argocd.argoproj.io/reconcile annotation", app.ReconcilePausedMessage())
+	app.Annotations[common.AnnotationKeyReconcilePausedBy] = "alice"
+	assert.Equal(t, "Reconciliation is paused by the argocd.argoproj.io/reconcile annotation, which was set by alice", app.ReconcilePausedMessage())
+}
+
+func TestApplicationSpec_GetSources(t *testing.T) {
+	spec := ApplicationSpec{Source: ApplicationSource{RepoURL: "https://example.com/single.git"}}
+	assert.False(t, spec.HasMultipleSources())
+	assert.Equal(t, []ApplicationSource{spec.Source}, spec.GetSources())
+	spec.Sources = []ApplicationSource{{RepoURL: "https://example.com/a.git"}, {RepoURL: "https://example.com/b.git"}}
+	assert.True(t, spec.HasMultipleSources())
+	assert.Equal(t, spec.Sources, spec.GetSources())
+}
+
+func TestApplicationSpec_IsExcludedResource(t *testing.T) {
+	spec := ApplicationSpec{ResourceExclusions: []ResourceExclusion{
+		{Group: "monitoring.coreos.com", Kind: "ServiceMonitor"},
+		{Kind: "ConfigMap", Name: "generated-*"},
+	}}
+	assert.True(t, spec.IsExcludedResource("monitoring.coreos.com", "ServiceMonitor", "my-app"))
+	assert.False(t, spec.IsExcludedResource("monitoring.coreos.com", "PrometheusRule", "my-app"))
+	assert.True(t, spec.IsExcludedResource("", "ConfigMap", "generated-config"))
+	assert.False(t, spec.IsExcludedResource("", "ConfigMap", "config"))
+	assert.False(t, (&ApplicationSpec{}).IsExcludedResource("", "ConfigMap", "config"))
+}
+
+func TestResourceOverrideKeyMatches(t *testing.T) {
+	assert.True(t, ResourceOverrideKeyMatches("ConfigMap", "", "ConfigMap"))
+	assert.False(t, ResourceOverrideKeyMatches("ConfigMap", "example.com", "ConfigMap"))
+	assert.True(t, ResourceOverrideKeyMatches("apps/Deployment", "apps", "Deployment"))
+	assert.False(t, ResourceOverrideKeyMatches("apps/Deployment", "apps", "StatefulSet"))
+	assert.True(t, ResourceOverrideKeyMatches("*/ConfigMap", "", "ConfigMap"))
+	assert.True(t, ResourceOverrideKeyMatches("*.coreos.com/*", "monitoring.coreos.com", "ServiceMonitor"))
+	assert.False(t, ResourceOverrideKeyMatches("*.coreos.com/*", "apps", "Deployment"))
+}
+
+func TestAppProject_GetMaxResources(t *testing.T) {
+	assert.Equal(t, int64(0), AppProject{}.GetMaxResources(0))
+	assert.Equal(t, int64(5000), AppProject{}.GetMaxResources(5000))
+	proj := AppProject{Spec: AppProjectSpec{MaxResources: 100}}
+	assert.Equal(t, int64(100), proj.GetMaxResources(5000))
+	assert.Equal(t, int64(100), proj.GetMaxResources(0))
+}
+
+func TestAppProject_GetResourceDenyingRule(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{
+		ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "*"}},
+		NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "*", Kind: "ResourceQuota"}},
+	}}
+
+	rule, denied := proj.GetResourceDenyingRule(metav1.GroupKind{Kind: "ResourceQuota"}, true)
+	assert.True(t, denied)
+	assert.Equal(t, "namespaceResourceBlacklist */ResourceQuota", rule)
+	assert.False(t, proj.IsResourcePermitted(metav1.GroupKind{Kind: "ResourceQuota"}, true))
+
+	_, denied = proj.GetResourceDenyingRule(metav1.GroupKind{Group: "apps", Kind: "Deployment"}, true)
+	assert.False(t, denied)
+
+	rule, denied = proj.GetResourceDenyingRule(metav1.GroupKind{Kind: "Namespace"}, false)
+	assert.True(t, denied)
+	assert.Equal(t, "clusterResourceWhitelist", rule)
+
+	_, denied = proj.GetResourceDenyingRule(metav1.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, false)
+	assert.False(t, denied)
+	assert.True(t, proj.IsResourcePermitted(metav1.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, false))
+}
+
+func TestAppProject_ValidateDestinationServiceAccounts(t *testing.T) {
+	for _, sa := range []string{"deployer", "team-a:deployer"} {
+		p := newTestProject()
+		p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", ServiceAccount: sa}}
+		assert.NoError(t, p.ValidateProject())
+	}
+	for _, sa := range []string{"", ":deployer", "team-a:", "a:b:c"} {
+		p := newTestProject()
+		p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", ServiceAccount: sa}}
+		assert.Error(t, p.ValidateProject(), sa)
+	}
+}
+
 func TestAppProjectSpec_DestinationClusters(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1581,6 +1705,54 @@ func TestSetConditions(t *testing.T) {
 				assert.Equal(t, fiveMinsAgo.Time, a.Status.Conditions[1].LastTransitionTime.Time)
 			},
 		},
+		{
+			name: "conditions of the same type matched by message",
+			existing: []ApplicationCondition{
+				testCond(ApplicationConditionSharedResourceWarning, "foo", tenMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "bar", tenMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "baz", tenMinsAgo),
+			},
+			incoming: []ApplicationCondition{
+				testCond(ApplicationConditionSharedResourceWarning, "foo", fiveMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "bar changed message", fiveMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "baz", fiveMinsAgo),
+			},
+			evaluatedTypes: map[ApplicationConditionType]bool{
+				ApplicationConditionSharedResourceWarning: true,
+			},
+			expected: []ApplicationCondition{
+				testCond(ApplicationConditionSharedResourceWarning, "foo", tenMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "bar changed message", fiveMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "baz", tenMinsAgo),
+			},
+			validate: func(t *testing.T, a *Application) {
+				assert.Equal(t, tenMinsAgo.Time, a.Status.Conditions[0].LastTransitionTime.Time)
+				assert.Equal(t, fiveMinsAgo.Time, a.Status.Conditions[1].LastTransitionTime.Time)
+				assert.Equal(t, tenMinsAgo.Time, a.Status.Conditions[2].LastTransitionTime.Time)
+			},
+		},
+		{
+			name: "removed condition of the same type",
+			existing: []ApplicationCondition{
+				testCond(ApplicationConditionSharedResourceWarning, "foo", fiveMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "bar", tenMinsAgo),
+				testCond(ApplicationConditionSyncError, "sync failed", tenMinsAgo),
+			},
+			incoming: []ApplicationCondition{
+				testCond(ApplicationConditionSharedResourceWarning, "bar", nil),
+			},
+			evaluatedTypes: map[ApplicationConditionType]bool{
+				ApplicationConditionSharedResourceWarning: true,
+			},
+			expected: []ApplicationCondition{
+				testCond(ApplicationConditionSyncError, "sync failed", tenMinsAgo),
+				testCond(ApplicationConditionSharedResourceWarning, "bar", tenMinsAgo),
+			},
+			validate: func(t *testing.T, a *Application) {
+				assert.Equal(t, tenMinsAgo.Time, a.Status.Conditions[0].LastTransitionTime.Time)
+				assert.Equal(t, tenMinsAgo.Time, a.Status.Conditions[1].LastTransitionTime.Time)
+			},
+		},
 		{
 			name: "unevaluated condition types preserved",
 			existing: []ApplicationCondition{
@@ -1622,3 +1794,119 @@ func assertConditions(t *testing.T, expected []ApplicationCondition, actual []Ap
 		assert.Equal(t, expected[i].Message, actual[i].Message)
 	}
 }
+
+func TestRetryStrategy_NextRetryDelay(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		retry := RetryStrategy{Limit: 3}
+		delay, err := retry.NextRetryDelay(0)
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultSyncRetryDuration, delay)
+		delay, err = retry.NextRetryDelay(1)
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultSyncRetryDuration*2, delay)
+	})
+	t.Run("Custom", func(t *testing.T) {
+		factor := int64(3)
+		retry := RetryStrategy{Limit: 3, Backoff: &Backoff{Duration: "10", Factor: &factor, MaxDuration: "1m"}}
+		delay, err := retry.NextRetryDelay(1)
+		assert.NoError(t, err)
+		assert.Equal(t, 30*time.Second, delay)
+		delay, err = retry.NextRetryDelay(5)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, delay)
+	})
+	t.Run("InvalidDuration", func(t *testing.T) {
+		retry := RetryStrategy{Limit: 3, Backoff: &Backoff{Duration: "foo"}}
+		_, err := retry.NextRetryDelay(1)
+		assert.Error(t, err)
+	})
+}
+
+func TestRetryStrategy_NextRetryDelayBackoff(t *testing.T) {
+	factor := func(f int64) *int64 {
+		return &f
+	}
+	tests := []struct {
+		name       string
+		backoff    Backoff
+		retryCount int64
+		expected   time.Duration
+		err        string
+	}{{
+		name:    "ZeroFactor",
+		backoff: Backoff{Duration: "5s", Factor: factor(0)},
+		err:     "backoff factor 0 must be at least 1",
+	}, {
+		name:    "NegativeFactor",
+		backoff: Backoff{Duration: "5s", Factor: factor(-2)},
+		err:     "backoff factor -2 must be at least 1",
+	}, {
+		name:       "HugeFactor",
+		backoff:    Backoff{Duration: "5s", Factor: factor(math.MaxInt64), MaxDuration: "1h"},
+		retryCount: 3,
+		expected:   time.Hour,
+	}, {
+		name:       "HugeFactorWithoutMaxDuration",
+		backoff:    Backoff{Duration: "5s", Factor: factor(1 << 40)},
+		retryCount: 2,
+		expected:   DefaultSyncRetryMaxDuration,
+	}, {
+		name:       "FactorOne",
+		backoff:    Backoff{Duration: "5s", Factor: factor(1)},
+		retryCount: 10,
+		expected:   5 * time.Second,
+	}, {
+		name:    "ZeroDuration",
+		backoff: Backoff{Duration: "0"},
+		err:     "backoff duration 0s must be positive",
+	}, {
+		name:    "NegativeMaxDuration",
+		backoff: Backoff{MaxDuration: "-1m"},
+		err:     "backoff max duration -1m0s must be positive",
+	}}
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			retry := RetryStrategy{Limit: 5, Backoff: &test.backoff}
+			delay, err := retry.NextRetryDelay(test.retryCount)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, delay)
+		})
+	}
+}
+
+func TestRetryStrategy_IsRetryAllowed(t *testing.T) {
+	assert.False(t, (&RetryStrategy{}).IsRetryAllowed(0))
+	assert.True(t, (&RetryStrategy{Limit: 2}).IsRetryAllowed(1))
+	assert.False(t, (&RetryStrategy{Limit: 2}).IsRetryAllowed(2))
+	assert.True(t, (&RetryStrategy{Limit: -1}).IsRetryAllowed(100))
+}
+
+func TestApplicationTree_GetSummary(t *testing.T) {
+	tree := ApplicationTree{Nodes: []ResourceNode{{
+		Images:         []string{"nginx:1.17", "busybox:1.31"},
+		NetworkingInfo: &ResourceNetworkingInfo{ExternalURLs: []string{"https://b.example.com/"}},
+	}, {
+		Images:         []string{"busybox:1.31"},
+		NetworkingInfo: &ResourceNetworkingInfo{ExternalURLs: []string{"https://a.example.com/", "https://b.example.com/"}},
+	}}}
+	assert.Equal(t, ApplicationSummary{
+		ExternalURLs: []string{"https://a.example.com/", "https://b.example.com/"},
+		Images:       []string{"busybox:1.31", "nginx:1.17"},
+	}, tree.GetSummary())
+}
+
+func TestNewApplicationSummary_Capped(t *testing.T) {
+	var images []string
+	for i := MaxApplicationSummaryItems; i >= 0; i-- {
+		images = append(images, fmt.Sprintf("image:%03d", i), "")
+	}
+	summary := NewApplicationSummary(nil, images)
+	assert.Len(t, summary.Images, MaxApplicationSummaryItems)
+	assert.Equal(t, "image:000", summary.Images[0])
+	assert.Empty(t, summary.ExternalURLs)
+}
@@ -13,6 +13,10 @@ import (
 	argogrpc "github.com/argoproj/argo-cd/util/grpc"
 )
 
+// RevisionTrailerKey is the key of the gRPC trailer which holds the revision resolved by a repo server request. It is
+// sent even if the request fails, so that clients know which revision the failure refers to.
+const RevisionTrailerKey = "argocd-revision"
+
 // Clientset represets repository server api clients
 type Clientset interface {
 	NewRepoServerClient() (util.Closer, RepoServerServiceClient, error)
@@ -29,27 +29,38 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // ManifestRequest is a query for manifest generation.
 type ManifestRequest struct {
-	Repo                 *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Revision             string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
-	NoCache              bool                               `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
-	AppLabelKey          string                             `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
-	AppLabelValue        string                             `protobuf:"bytes,5,opt,name=appLabelValue,proto3" json:"appLabelValue,omitempty"`
-	Namespace            string                             `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ApplicationSource    *v1alpha1.ApplicationSource        `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
-	Repos                []*v1alpha1.Repository             `protobuf:"bytes,11,rep,name=repos" json:"repos,omitempty"`
-	Plugins              []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=plugins" json:"plugins,omitempty"`
-	KustomizeOptions     *v1alpha1.KustomizeOptions         `protobuf:"bytes,13,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
-	KubeVersion          string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+	Repo              *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision          string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	NoCache           bool                               `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	AppLabelKey       string                             `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	AppLabelValue     string                             `protobuf:"bytes,5,opt,name=appLabelValue,proto3" json:"appLabelValue,omitempty"`
+	Namespace         string                             `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApplicationSource *v1alpha1.ApplicationSource        `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
+	Repos             []*v1alpha1.Repository             `protobuf:"bytes,11,rep,name=repos" json:"repos,omitempty"`
+	Plugins           []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=plugins" json:"plugins,omitempty"`
+	KustomizeOptions  *v1alpha1.KustomizeOptions         `protobuf:"bytes,13,opt,name=kustomizeOptions" json:"kustomizeOptions,omitempty"`
+	KubeVersion       string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	// verifySignature requests the GPG signature verification of the revision
+	VerifySignature bool `protobuf:"varint,15,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	// env holds the well-known application environment variables passed to config management plugins
+	Env []*v1alpha1.EnvEntry `protobuf:"bytes,16,rep,name=env" json:"env,omitempty"`
+	// apiVersions holds the sorted group/versions served by the destination cluster
+	ApiVersions []string `protobuf:"bytes,17,rep,name=apiVersions" json:"apiVersions,omitempty"`
+	// trackingMethod is the method by which the generated resources are marked as part of the application
+	TrackingMethod string `protobuf:"bytes,18,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	// appName is the name of the application, which is used as the Helm release name and passed to config management
+	// plugins, whereas appLabelValue is the value by which the generated resources are tracked
+	AppName              string   `protobuf:"bytes,19,opt,name=appName,proto3" json:"appName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
 func (m *ManifestRequest) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequest) ProtoMessage()    {}
 func (*ManifestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{0}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{0}
 }
 func (m *ManifestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,22 +166,63 @@ func (m *ManifestRequest) GetKubeVersion() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetVerifySignature() bool {
+	if m != nil {
+		return m.VerifySignature
+	}
+	return false
+}
+
+func (m *ManifestRequest) GetEnv() []*v1alpha1.EnvEntry {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetApiVersions() []string {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
+func (m *ManifestRequest) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
 type ManifestResponse struct {
-	Manifests            []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Server               string   `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
-	Revision             string   `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
-	SourceType           string   `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Manifests  []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
+	Namespace  string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Server     string   `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	Revision   string   `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	SourceType string   `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
+	// healthScripts are the custom Lua health checks defined in the application source
+	HealthScripts []*HealthScript `protobuf:"bytes,7,rep,name=healthScripts" json:"healthScripts,omitempty"`
+	// verifyResult is the result of the GPG signature verification of the revision, only set if requested
+	VerifyResult *SignatureVerification `protobuf:"bytes,8,opt,name=verifyResult" json:"verifyResult,omitempty"`
+	// revisionMetadata summarizes the revision the manifests were generated from
+	RevisionMetadata     *v1alpha1.ResolvedRevisionMetadata `protobuf:"bytes,9,opt,name=revisionMetadata" json:"revisionMetadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{1}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{1}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,6 +286,232 @@ func (m *ManifestResponse) GetSourceType() string {
 	return ""
 }
 
+func (m *ManifestResponse) GetHealthScripts() []*HealthScript {
+	if m != nil {
+		return m.HealthScripts
+	}
+	return nil
+}
+
+func (m *ManifestResponse) GetVerifyResult() *SignatureVerification {
+	if m != nil {
+		return m.VerifyResult
+	}
+	return nil
+}
+
+func (m *ManifestResponse) GetRevisionMetadata() *v1alpha1.ResolvedRevisionMetadata {
+	if m != nil {
+		return m.RevisionMetadata
+	}
+	return nil
+}
+
+// ManifestResponseChunk is a message of the GenerateManifestStream response stream. All messages but the last one hold
+// a batch of manifests, and the last one holds the response metadata.
+type ManifestResponseChunk struct {
+	Manifests []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
+	// metadata is the manifest response without manifests, only set in the last message of the stream
+	Metadata             *ManifestResponse `protobuf:"bytes,2,opt,name=metadata" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ManifestResponseChunk) Reset()         { *m = ManifestResponseChunk{} }
+func (m *ManifestResponseChunk) String() string { return proto.CompactTextString(m) }
+func (*ManifestResponseChunk) ProtoMessage()    {}
+func (*ManifestResponseChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_7febe72a3e051f03, []int{2}
+}
+func (m *ManifestResponseChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestResponseChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestResponseChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ManifestResponseChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestResponseChunk.Merge(dst, src)
+}
+func (m *ManifestResponseChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestResponseChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestResponseChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestResponseChunk proto.InternalMessageInfo
+
+func (m *ManifestResponseChunk) GetManifests() []string {
+	if m != nil {
+		return m.Manifests
+	}
+	return nil
+}
+
+func (m *ManifestResponseChunk) GetMetadata() *ManifestResponse {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// SignatureVerification is the result of the GPG signature verification of a revision
+type SignatureVerification struct {
+	// validity of the signature as reported by git, e.g. Good, Bad, MissingKey or Unsigned
+	Validity string `protobuf:"bytes,1,opt,name=validity,proto3" json:"validity,omitempty"`
+	// valid is true if the revision carries a good signature
+	Valid                bool     `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	KeyID                string   `protobuf:"bytes,3,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	Signer               string   `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignatureVerification) Reset()         { *m = SignatureVerification{} }
+func (m *SignatureVerification) String() string { return proto.CompactTextString(m) }
+func (*SignatureVerification) ProtoMessage()    {}
+func (*SignatureVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_7febe72a3e051f03, []int{3}
+}
+func (m *SignatureVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SignatureVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureVerification.Merge(dst, src)
+}
+func (m *SignatureVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureVerification proto.InternalMessageInfo
+
+func (m *SignatureVerification) GetValidity() string {
+	if m != nil {
+		return m.Validity
+	}
+	return ""
+}
+
+func (m *SignatureVerification) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *SignatureVerification) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *SignatureVerification) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// HealthScript is a custom Lua health check of a resource kind defined in the application source
+type HealthScript struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// path of the script relative to the application path
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Script               string   `protobuf:"bytes,4,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthScript) Reset()         { *m = HealthScript{} }
+func (m *HealthScript) String() string { return proto.CompactTextString(m) }
+func (*HealthScript) ProtoMessage()    {}
+func (*HealthScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_7febe72a3e051f03, []int{4}
+}
+func (m *HealthScript) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthScript.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *HealthScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthScript.Merge(dst, src)
+}
+func (m *HealthScript) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthScript proto.InternalMessageInfo
+
+func (m *HealthScript) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *HealthScript) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *HealthScript) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HealthScript) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
 // ListAppsRequest requests a repository directory structure
 type ListAppsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
@@ -247,7 +525,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{2}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{5}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +580,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{3}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{6}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +631,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{4}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{7}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +704,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{5}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{8}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,7 +782,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{6}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{9}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +840,7 @@ func (m *KsonnetAppSpec) Reset()         { *m = KsonnetAppSpec{} }
 func (m *KsonnetAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppSpec) ProtoMessage()    {}
 func (*KsonnetAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{7}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{10}
 }
 func (m *KsonnetAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +907,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{8}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{11}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +977,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{9}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{12}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +1029,7 @@ func (m *KsonnetEnvironment) Reset()         { *m = KsonnetEnvironment{} }
 func (m *KsonnetEnvironment) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironment) ProtoMessage()    {}
 func (*KsonnetEnvironment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{10}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{13}
 }
 func (m *KsonnetEnvironment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +1093,7 @@ func (m *KsonnetEnvironmentDestination) Reset()         { *m = KsonnetEnvironmen
 func (m *KsonnetEnvironmentDestination) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironmentDestination) ProtoMessage()    {}
 func (*KsonnetEnvironmentDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{11}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{14}
 }
 func (m *KsonnetEnvironmentDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +1147,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{12}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{15}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1187,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{13}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{16}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -957,7 +1235,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{14}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{17}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1011,7 +1289,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_71f9012dc2cfcf2b, []int{15}
+	return fileDescriptor_repository_7febe72a3e051f03, []int{18}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,6 +1328,9 @@ func (m *HelmChartsResponse) GetItems() []*HelmChart {
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ManifestResponseChunk)(nil), "repository.ManifestResponseChunk")
+	proto.RegisterType((*SignatureVerification)(nil), "repository.SignatureVerification")
+	proto.RegisterType((*HealthScript)(nil), "repository.HealthScript")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
 	proto.RegisterType((*AppList)(nil), "repository.AppList")
 	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
@@ -1081,6 +1362,9 @@ const _ = grpc.SupportPackageIsVersion4
 type RepoServerServiceClient interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and streams the
+	// manifests in batches followed by a message with the response metadata
+	GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error)
 	// ListApps returns a list of apps in the repo
 	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error)
 	// Generate manifest for application in specified repo name and revision
@@ -1108,6 +1392,38 @@ func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *Mani
 	return out, nil
 }
 
+func (c *repoServerServiceClient) GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[0], "/repository.RepoServerService/GenerateManifestStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repoServerServiceGenerateManifestStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepoServerService_GenerateManifestStreamClient interface {
+	Recv() (*ManifestResponseChunk, error)
+	grpc.ClientStream
+}
+
+type repoServerServiceGenerateManifestStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamClient) Recv() (*ManifestResponseChunk, error) {
+	m := new(ManifestResponseChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *repoServerServiceClient) ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error) {
 	out := new(AppList)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ListApps", in, out, opts...)
@@ -1149,6 +1465,9 @@ func (c *repoServerServiceClient) GetHelmCharts(ctx context.Context, in *HelmCha
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and streams the
+	// manifests in batches followed by a message with the response metadata
+	GenerateManifestStream(*ManifestRequest, RepoServerService_GenerateManifestStreamServer) error
 	// ListApps returns a list of apps in the repo
 	ListApps(context.Context, *ListAppsRequest) (*AppList, error)
 	// Generate manifest for application in specified repo name and revision
@@ -1181,6 +1500,27 @@ func _RepoServerService_GenerateManifest_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_GenerateManifestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepoServerServiceServer).GenerateManifestStream(m, &repoServerServiceGenerateManifestStreamServer{stream})
+}
+
+type RepoServerService_GenerateManifestStreamServer interface {
+	Send(*ManifestResponseChunk) error
+	grpc.ServerStream
+}
+
+type repoServerServiceGenerateManifestStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamServer) Send(m *ManifestResponseChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RepoServerService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAppsRequest)
 	if err := dec(in); err != nil {
@@ -1278,7 +1618,13 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _RepoServerService_GetHelmCharts_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateManifestStream",
+			Handler:       _RepoServerService_GenerateManifestStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "reposerver/repository/repository.proto",
 }
 
@@ -1391,6 +1737,63 @@ func (m *ManifestRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.KubeVersion)))
 		i += copy(dAtA[i:], m.KubeVersion)
 	}
+	if m.VerifySignature {
+		dAtA[i] = 0x78
+		i++
+		if m.VerifySignature {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Env) > 0 {
+		for _, msg := range m.Env {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.TrackingMethod) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TrackingMethod)))
+		i += copy(dAtA[i:], m.TrackingMethod)
+	}
+	if len(m.AppName) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppName)))
+		i += copy(dAtA[i:], m.AppName)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1451,14 +1854,186 @@ func (m *ManifestResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceType)))
 		i += copy(dAtA[i:], m.SourceType)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.HealthScripts) > 0 {
+		for _, msg := range m.HealthScripts {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return i, nil
-}
-
-func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.VerifyResult != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.VerifyResult.Size()))
+		n4, err := m.VerifyResult.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.RevisionMetadata != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.RevisionMetadata.Size()))
+		n5, err := m.RevisionMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ManifestResponseChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestResponseChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Metadata != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SignatureVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureVerification) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validity) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Validity)))
+		i += copy(dAtA[i:], m.Validity)
+	}
+	if m.Valid {
+		dAtA[i] = 0x10
+		i++
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.KeyID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.KeyID)))
+		i += copy(dAtA[i:], m.KeyID)
+	}
+	if len(m.Signer) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Signer)))
+		i += copy(dAtA[i:], m.Signer)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HealthScript) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthScript) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Script) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Script)))
+		i += copy(dAtA[i:], m.Script)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
 	if err != nil {
@@ -1476,11 +2051,11 @@ func (m *ListAppsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n4, err := m.Repo.MarshalTo(dAtA[i:])
+		n7, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n7
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1551,21 +2126,21 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n5, err := m.Repo.MarshalTo(dAtA[i:])
+		n8, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n8
 	}
 	if m.Source != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Source.Size()))
-		n6, err := m.Source.MarshalTo(dAtA[i:])
+		n9, err := m.Source.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n9
 	}
 	if len(m.Repos) > 0 {
 		for _, msg := range m.Repos {
@@ -1583,11 +2158,11 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.KustomizeOptions.Size()))
-		n7, err := m.KustomizeOptions.MarshalTo(dAtA[i:])
+		n10, err := m.KustomizeOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1620,41 +2195,41 @@ func (m *RepoAppDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
-		n8, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		n11, err := m.Ksonnet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	if m.Helm != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
-		n9, err := m.Helm.MarshalTo(dAtA[i:])
+		n12, err := m.Helm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	if m.Kustomize != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Kustomize.Size()))
-		n10, err := m.Kustomize.MarshalTo(dAtA[i:])
+		n13, err := m.Kustomize.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n13
 	}
 	if m.Directory != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Directory.Size()))
-		n11, err := m.Directory.MarshalTo(dAtA[i:])
+		n14, err := m.Directory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n14
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1681,11 +2256,11 @@ func (m *RepoServerRevisionMetadataRequest) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n12, err := m.Repo.MarshalTo(dAtA[i:])
+		n15, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1740,11 +2315,11 @@ func (m *KsonnetAppSpec) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintRepository(dAtA, i, uint64(v.Size()))
-				n13, err := v.MarshalTo(dAtA[i:])
+				n16, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n13
+				i += n16
 			}
 		}
 	}
@@ -1893,11 +2468,11 @@ func (m *KsonnetEnvironment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Destination.Size()))
-		n14, err := m.Destination.MarshalTo(dAtA[i:])
+		n17, err := m.Destination.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n17
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1978,11 +2553,11 @@ func (m *HelmChartsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n15, err := m.Repo.MarshalTo(dAtA[i:])
+		n18, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2124,6 +2699,29 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.VerifySignature {
+		n += 2
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppName)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2155,6 +2753,88 @@ func (m *ManifestResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if len(m.HealthScripts) > 0 {
+		for _, e := range m.HealthScripts {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.VerifyResult != nil {
+		l = m.VerifyResult.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.RevisionMetadata != nil {
+		l = m.RevisionMetadata.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestResponseChunk) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignatureVerification) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Validity)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HealthScript) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Script)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2806,25 +3486,163 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.KubeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifySignature", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.VerifySignature = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &v1alpha1.EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersions = append(m.ApiVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -3002,6 +3820,541 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.SourceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthScripts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthScripts = append(m.HealthScripts, &HealthScript{})
+			if err := m.HealthScripts[len(m.HealthScripts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerifyResult == nil {
+				m.VerifyResult = &SignatureVerification{}
+			}
+			if err := m.VerifyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevisionMetadata == nil {
+				m.RevisionMetadata = &v1alpha1.ResolvedRevisionMetadata{}
+			}
+			if err := m.RevisionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestResponseChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestResponseChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestResponseChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ManifestResponse{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4978,82 +6331,99 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_71f9012dc2cfcf2b)
-}
-
-var fileDescriptor_repository_71f9012dc2cfcf2b = []byte{
-	// 1160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0xce, 0x8f, 0x8f, 0xfb, 0xe3, 0x4c, 0x4b, 0x59, 0x4c, 0x6a, 0x99, 0x15, 0xa0,
-	0x42, 0xe9, 0x9a, 0x84, 0x4a, 0x44, 0x45, 0xaa, 0x64, 0x92, 0x90, 0x22, 0x27, 0x6a, 0xba, 0x81,
-	0x4a, 0xfc, 0x48, 0xd5, 0x64, 0x3d, 0x5d, 0x0f, 0x5e, 0xef, 0x0e, 0x3b, 0x63, 0xa3, 0xf4, 0x05,
-	0xe0, 0x1e, 0x71, 0xc3, 0x25, 0x8f, 0xc0, 0x2b, 0xc0, 0x05, 0x97, 0x3c, 0x02, 0xca, 0x1d, 0x3c,
-	0x05, 0x9a, 0xd9, 0xbf, 0xf1, 0x7a, 0x93, 0x1b, 0xf7, 0xe7, 0xc6, 0x9e, 0x39, 0x73, 0xe6, 0x9c,
-	0x99, 0xef, 0x7c, 0xe7, 0x9c, 0x59, 0x78, 0x37, 0x22, 0x2c, 0xe4, 0x24, 0x9a, 0x92, 0xa8, 0xab,
-	0x86, 0x54, 0x84, 0xd1, 0xa9, 0x36, 0xb4, 0x59, 0x14, 0x8a, 0x10, 0x41, 0x2e, 0x69, 0x5d, 0xf7,
-	0x42, 0x2f, 0x54, 0xe2, 0xae, 0x1c, 0xc5, 0x1a, 0xad, 0x0d, 0x2f, 0x0c, 0x3d, 0x9f, 0x74, 0x31,
-	0xa3, 0x5d, 0x1c, 0x04, 0xa1, 0xc0, 0x82, 0x86, 0x01, 0x4f, 0x56, 0xad, 0xd1, 0x36, 0xb7, 0x69,
-	0xa8, 0x56, 0xdd, 0x30, 0x22, 0xdd, 0xe9, 0x66, 0xd7, 0x23, 0x01, 0x89, 0xb0, 0x20, 0x83, 0x44,
-	0xe7, 0x73, 0x8f, 0x8a, 0xe1, 0xe4, 0xc4, 0x76, 0xc3, 0x71, 0x17, 0x47, 0xca, 0xc5, 0x77, 0x6a,
-	0x70, 0xc7, 0x1d, 0x74, 0xd9, 0xc8, 0x93, 0x9b, 0x79, 0x17, 0x33, 0xe6, 0x53, 0x57, 0x19, 0xef,
-	0x4e, 0x37, 0xb1, 0xcf, 0x86, 0x78, 0xce, 0x94, 0xf5, 0xef, 0x32, 0x5c, 0x3d, 0xc4, 0x01, 0x7d,
-	0x4a, 0xb8, 0x70, 0xc8, 0xf7, 0x13, 0xc2, 0x05, 0xfa, 0x0a, 0x6a, 0xf2, 0x12, 0xa6, 0xd1, 0x31,
-	0x6e, 0x35, 0xb6, 0xf6, 0xec, 0xdc, 0x9b, 0x9d, 0x7a, 0x53, 0x83, 0x27, 0xee, 0xc0, 0x66, 0x23,
-	0xcf, 0x96, 0xde, 0x6c, 0xcd, 0x9b, 0x9d, 0x7a, 0xb3, 0x9d, 0x0c, 0x0b, 0x47, 0x99, 0x44, 0x2d,
-	0x58, 0x8b, 0xc8, 0x94, 0x72, 0x1a, 0x06, 0x66, 0xa5, 0x63, 0xdc, 0xaa, 0x3b, 0xd9, 0x1c, 0x99,
-	0xb0, 0x1a, 0x84, 0x3b, 0xd8, 0x1d, 0x12, 0xb3, 0xda, 0x31, 0x6e, 0xad, 0x39, 0xe9, 0x14, 0x75,
-	0xa0, 0x81, 0x19, 0x3b, 0xc0, 0x27, 0xc4, 0xef, 0x93, 0x53, 0xb3, 0xa6, 0x36, 0xea, 0x22, 0xf4,
-	0x36, 0x5c, 0x4e, 0xa7, 0x8f, 0xb1, 0x3f, 0x21, 0xe6, 0xb2, 0xd2, 0x99, 0x15, 0xa2, 0x0d, 0xa8,
-	0x07, 0x78, 0x4c, 0x38, 0xc3, 0x2e, 0x31, 0xd7, 0x94, 0x46, 0x2e, 0x40, 0xcf, 0x60, 0x5d, 0xbb,
-	0xc4, 0x71, 0x38, 0x89, 0x5c, 0x62, 0x82, 0xc2, 0xe0, 0x60, 0x01, 0x0c, 0x7a, 0x45, 0x9b, 0xce,
-	0xbc, 0x1b, 0xf4, 0x0d, 0x2c, 0x2b, 0xde, 0x98, 0x8d, 0x4e, 0xf5, 0xf9, 0x61, 0x1e, 0xdb, 0x44,
-	0x23, 0x58, 0x65, 0xfe, 0xc4, 0xa3, 0x01, 0x37, 0x2f, 0x29, 0xf3, 0x8f, 0x16, 0x30, 0xbf, 0x13,
-	0x06, 0x4f, 0xa9, 0x77, 0x88, 0x03, 0xec, 0x91, 0x31, 0x09, 0xc4, 0x91, 0xb2, 0xec, 0xa4, 0x1e,
-	0xd0, 0x0f, 0xd0, 0x1c, 0x4d, 0xb8, 0x08, 0xc7, 0xf4, 0x19, 0x79, 0xc8, 0x14, 0xb3, 0xcd, 0xcb,
-	0x0a, 0xc4, 0xfe, 0x02, 0x5e, 0xfb, 0x05, 0x93, 0xce, 0x9c, 0x13, 0x49, 0x92, 0xd1, 0xe4, 0x84,
-	0x3c, 0x26, 0x91, 0x62, 0xd7, 0x95, 0x98, 0x24, 0x9a, 0xc8, 0xfa, 0xcd, 0x80, 0x66, 0xce, 0x75,
-	0xce, 0xc2, 0x80, 0x2b, 0x4e, 0x8c, 0x13, 0x19, 0x37, 0x8d, 0x4e, 0x55, 0x72, 0x22, 0x13, 0xcc,
-	0x32, 0xa6, 0x52, 0x64, 0xcc, 0x0d, 0x58, 0x89, 0x2b, 0x82, 0x22, 0x6c, 0xdd, 0x49, 0x66, 0x33,
-	0x2c, 0xaf, 0x15, 0x58, 0xde, 0x06, 0xe0, 0x2a, 0xe6, 0x5f, 0x9c, 0x32, 0x62, 0xae, 0xa8, 0x55,
-	0x4d, 0x62, 0xfd, 0x64, 0xc0, 0xd5, 0x03, 0xca, 0x45, 0x8f, 0x31, 0xfe, 0x6a, 0x13, 0xd2, 0x9a,
-	0xc0, 0x6a, 0x8f, 0x31, 0x79, 0x18, 0xb4, 0x09, 0x35, 0xcc, 0x58, 0x0c, 0x50, 0x63, 0xeb, 0xa6,
-	0xad, 0x95, 0xbd, 0x44, 0x45, 0xfe, 0xf3, 0xbd, 0x40, 0x48, 0xcb, 0x52, 0xb5, 0xf5, 0x31, 0xd4,
-	0x33, 0x11, 0x6a, 0x42, 0x75, 0x44, 0x4e, 0xd5, 0x05, 0xea, 0x8e, 0x1c, 0xa2, 0xeb, 0xb0, 0x3c,
-	0x55, 0x99, 0x1a, 0x7b, 0x8d, 0x27, 0xf7, 0x2a, 0xdb, 0x86, 0xf5, 0x7b, 0x15, 0xde, 0x90, 0xe7,
-	0x3c, 0x56, 0x60, 0xf6, 0x18, 0xdb, 0x25, 0x02, 0x53, 0x9f, 0x3f, 0x9a, 0x90, 0xe8, 0xf4, 0x45,
-	0x62, 0x31, 0x80, 0x95, 0x38, 0x10, 0xea, 0x4c, 0xcf, 0x3b, 0xeb, 0x13, 0xdb, 0x79, 0xaa, 0x57,
-	0x5f, 0x40, 0xaa, 0x97, 0x65, 0x5f, 0xed, 0x25, 0x64, 0x9f, 0xf5, 0x63, 0x05, 0x6e, 0xc8, 0xe3,
-	0xe4, 0xe1, 0xca, 0x32, 0x0c, 0x41, 0x4d, 0x48, 0xae, 0xc7, 0xc1, 0x57, 0x63, 0x74, 0x17, 0x56,
-	0x47, 0x3c, 0x0c, 0x02, 0x22, 0x12, 0xac, 0x5b, 0x3a, 0xa5, 0xfa, 0xf1, 0x52, 0x8f, 0xb1, 0x63,
-	0x46, 0x5c, 0x27, 0x55, 0x45, 0xb7, 0xa1, 0x36, 0x24, 0xfe, 0x58, 0x65, 0x5b, 0x63, 0xeb, 0x75,
-	0x7d, 0xcb, 0x03, 0xe2, 0x8f, 0x53, 0x7d, 0xa5, 0x84, 0xee, 0x41, 0x3d, 0x3b, 0x65, 0x82, 0xc1,
-	0xc6, 0x8c, 0x93, 0x74, 0x31, 0xdd, 0x96, 0xab, 0xcb, 0xbd, 0x03, 0x1a, 0x11, 0x57, 0x2a, 0xaa,
-	0x56, 0x52, 0xd8, 0xbb, 0x9b, 0x2e, 0x66, 0x7b, 0x33, 0x75, 0xeb, 0x57, 0x03, 0xde, 0xca, 0xe9,
-	0xeb, 0x24, 0xc9, 0x74, 0x48, 0x04, 0x1e, 0x60, 0x81, 0x5f, 0x71, 0x4a, 0xff, 0x59, 0x81, 0x2b,
-	0xb3, 0xe8, 0xca, 0xf0, 0xc8, 0x8a, 0x96, 0x86, 0x47, 0x8e, 0xd1, 0x11, 0x5c, 0x22, 0xc1, 0x94,
-	0x46, 0x61, 0x20, 0x4b, 0x7c, 0x4a, 0xd5, 0x0f, 0xce, 0x8f, 0x91, 0xbd, 0xa7, 0xa9, 0xc7, 0x55,
-	0x60, 0xc6, 0x02, 0x1a, 0x01, 0x30, 0x1c, 0xe1, 0x31, 0x11, 0x24, 0x92, 0x94, 0xac, 0x2e, 0x4a,
-	0xc9, 0xd8, 0xfd, 0x51, 0x6a, 0xd3, 0xd1, 0xcc, 0xb7, 0x9e, 0xc0, 0xfa, 0xdc, 0x79, 0x4a, 0x4a,
-	0xd0, 0x5d, 0xbd, 0x04, 0x35, 0xb6, 0xda, 0x25, 0xd7, 0xd3, 0xcc, 0xe8, 0x25, 0xea, 0x0f, 0x03,
-	0x1a, 0x1a, 0xe3, 0x4a, 0x31, 0x6c, 0x03, 0xa8, 0x0d, 0x9f, 0x51, 0x9f, 0xc4, 0x08, 0xd6, 0x1d,
-	0x4d, 0x82, 0x86, 0x25, 0x88, 0x3c, 0x58, 0x00, 0x11, 0x79, 0x9e, 0x52, 0x38, 0x64, 0x9b, 0x52,
-	0x7e, 0x79, 0xf2, 0x2a, 0x4a, 0x66, 0xd6, 0xfb, 0xd0, 0x2c, 0x26, 0x81, 0xd4, 0xa5, 0x63, 0xec,
-	0x65, 0x27, 0x4e, 0x66, 0xd6, 0x2f, 0x06, 0xa0, 0x79, 0x4c, 0xce, 0xbb, 0xf8, 0x68, 0x9b, 0xa7,
-	0x7d, 0x38, 0x66, 0xa0, 0x26, 0x41, 0x7d, 0x68, 0x0c, 0x08, 0x17, 0x34, 0x50, 0x17, 0x48, 0x52,
-	0xf3, 0xbd, 0x8b, 0xc1, 0xdf, 0xcd, 0x37, 0x38, 0xfa, 0x6e, 0xeb, 0x4b, 0xb8, 0x79, 0xa1, 0xb6,
-	0xd6, 0xa3, 0x8d, 0x99, 0x1e, 0x7d, 0x61, 0x67, 0xb7, 0x10, 0x34, 0x8b, 0x39, 0x6e, 0x05, 0xb0,
-	0x2e, 0x31, 0xde, 0x19, 0xe2, 0x48, 0xbc, 0x84, 0xd6, 0x6c, 0x7d, 0x02, 0xf5, 0xcc, 0x5f, 0x29,
-	0xd0, 0x2d, 0x58, 0x9b, 0xc6, 0x98, 0x72, 0xb3, 0xa2, 0xa2, 0x95, 0xcd, 0xad, 0x1e, 0x20, 0xfd,
-	0xb0, 0x49, 0x29, 0xbe, 0x0d, 0xcb, 0x54, 0x90, 0x71, 0xda, 0xc7, 0x5f, 0x2b, 0x56, 0x50, 0xa5,
-	0xee, 0xc4, 0x3a, 0x5b, 0xff, 0x55, 0x61, 0x3d, 0x2f, 0x64, 0xf2, 0x97, 0xba, 0x04, 0x3d, 0x84,
-	0xe6, 0x7e, 0xf2, 0x0d, 0x91, 0xbe, 0xa5, 0xd0, 0x9b, 0xba, 0x9d, 0xc2, 0xd7, 0x44, 0x6b, 0xa3,
-	0x7c, 0x31, 0x3e, 0x91, 0xb5, 0x84, 0xee, 0xc3, 0x5a, 0xfa, 0xde, 0x99, 0x35, 0x54, 0x78, 0x05,
-	0xb5, 0xae, 0x95, 0xbc, 0x3a, 0xac, 0x25, 0xf4, 0x2d, 0x5c, 0xde, 0x57, 0x75, 0x28, 0xe9, 0x3b,
-	0xe8, 0x1d, 0x5d, 0xef, 0xdc, 0x87, 0x44, 0xcb, 0x2a, 0xaa, 0xcd, 0xb7, 0x2e, 0x6b, 0x09, 0xfd,
-	0x6c, 0xc0, 0xb5, 0x7d, 0x22, 0x8a, 0x65, 0x1c, 0xdd, 0x29, 0x77, 0x72, 0x4e, 0xb9, 0x6f, 0xf5,
-	0x17, 0x22, 0xc6, 0xac, 0x4d, 0x6b, 0x09, 0x1d, 0xa9, 0x3b, 0xe7, 0x01, 0x46, 0x37, 0x4b, 0x23,
-	0x99, 0x41, 0xd7, 0x3e, 0x6f, 0x39, 0xbd, 0xe7, 0xa7, 0xf7, 0xff, 0x3a, 0x6b, 0x1b, 0x7f, 0x9f,
-	0xb5, 0x8d, 0x7f, 0xce, 0xda, 0xc6, 0xd7, 0x1f, 0x5e, 0xf4, 0x81, 0xa9, 0x7d, 0x08, 0x63, 0x46,
-	0x5d, 0x9f, 0x92, 0x40, 0x9c, 0xac, 0xa8, 0xcf, 0xc9, 0x8f, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0xf0, 0x7a, 0xca, 0x89, 0x27, 0x0f, 0x00, 0x00,
+	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_7febe72a3e051f03)
+}
+
+var fileDescriptor_repository_7febe72a3e051f03 = []byte{
+	// 1435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x58, 0x5b, 0x6f, 0x1b, 0x45,
+	0x14, 0xae, 0x2f, 0x69, 0xe2, 0xe3, 0xa4, 0x71, 0xa6, 0x4d, 0x58, 0x4c, 0x1b, 0xa5, 0x2b, 0xa8,
+	0x0a, 0xa5, 0x76, 0x1b, 0x2a, 0x11, 0x15, 0xa9, 0x52, 0x49, 0x42, 0x5b, 0xa5, 0xa5, 0xe9, 0x9a,
+	0x56, 0xe2, 0x22, 0x55, 0x1b, 0x7b, 0xba, 0x1e, 0xbc, 0xde, 0x5d, 0x76, 0x67, 0x5d, 0xa5, 0x2f,
+	0x3c, 0xc2, 0x3b, 0xe2, 0x85, 0x9f, 0xc0, 0x23, 0x7f, 0x01, 0x1e, 0xfa, 0x84, 0xf8, 0x09, 0x88,
+	0x5f, 0xc2, 0x99, 0xb3, 0x57, 0xaf, 0x1d, 0xf3, 0x60, 0xda, 0x3e, 0xd8, 0x9e, 0x39, 0x7b, 0xe6,
+	0x3b, 0x67, 0xce, 0x7d, 0x0d, 0x97, 0x7c, 0xee, 0xb9, 0x01, 0xf7, 0x47, 0xdc, 0x6f, 0xd3, 0x52,
+	0x48, 0xd7, 0x3f, 0xce, 0x2d, 0x5b, 0x9e, 0xef, 0x4a, 0x97, 0x41, 0x46, 0x69, 0x9e, 0xb3, 0x5c,
+	0xcb, 0x25, 0x72, 0x5b, 0xad, 0x22, 0x8e, 0xe6, 0x79, 0xcb, 0x75, 0x2d, 0x9b, 0xb7, 0x4d, 0x4f,
+	0xb4, 0x4d, 0xc7, 0x71, 0xa5, 0x29, 0x85, 0xeb, 0x04, 0xf1, 0x53, 0x7d, 0xb0, 0x13, 0xb4, 0x84,
+	0x4b, 0x4f, 0xbb, 0xae, 0xcf, 0xdb, 0xa3, 0xeb, 0x6d, 0x8b, 0x3b, 0xdc, 0x37, 0x25, 0xef, 0xc5,
+	0x3c, 0xf7, 0x2c, 0x21, 0xfb, 0xe1, 0x51, 0xab, 0xeb, 0x0e, 0xdb, 0xa6, 0x4f, 0x22, 0xbe, 0xa5,
+	0xc5, 0xd5, 0x6e, 0xaf, 0xed, 0x0d, 0x2c, 0x75, 0x38, 0xc0, 0x2f, 0xcf, 0x16, 0x5d, 0x02, 0x47,
+	0x10, 0xd3, 0xf6, 0xfa, 0xe6, 0x04, 0x94, 0xfe, 0xe7, 0x22, 0xac, 0x3e, 0x30, 0x1d, 0xf1, 0x8c,
+	0x07, 0xd2, 0xe0, 0xdf, 0x85, 0xf8, 0xc3, 0xbe, 0x84, 0xaa, 0xba, 0x84, 0x56, 0xda, 0x2a, 0x5d,
+	0xae, 0x6f, 0xef, 0xb7, 0x32, 0x69, 0xad, 0x44, 0x1a, 0x2d, 0x9e, 0x76, 0x11, 0x65, 0x60, 0xb5,
+	0x94, 0xb4, 0x56, 0x4e, 0x5a, 0x2b, 0x91, 0xd6, 0x32, 0x52, 0x5b, 0x18, 0x04, 0xc9, 0x9a, 0xb0,
+	0xe4, 0xf3, 0x91, 0x08, 0x90, 0x4b, 0x2b, 0x23, 0x7c, 0xcd, 0x48, 0xf7, 0x4c, 0x83, 0x45, 0xc7,
+	0xdd, 0x35, 0xbb, 0x7d, 0xae, 0x55, 0xf0, 0xd1, 0x92, 0x91, 0x6c, 0xd9, 0x16, 0xd4, 0x11, 0xfe,
+	0xbe, 0x79, 0xc4, 0xed, 0x03, 0x7e, 0xac, 0x55, 0xe9, 0x60, 0x9e, 0xc4, 0xde, 0x85, 0x95, 0x64,
+	0xfb, 0xc4, 0xb4, 0x43, 0xae, 0x2d, 0x10, 0xcf, 0x38, 0x91, 0x9d, 0x87, 0x9a, 0x63, 0x0e, 0x79,
+	0xe0, 0x99, 0x5d, 0xae, 0x2d, 0x11, 0x47, 0x46, 0x60, 0x2f, 0x60, 0x2d, 0x77, 0x89, 0x8e, 0x1b,
+	0xfa, 0xc8, 0x05, 0x64, 0x83, 0xfb, 0x73, 0xd8, 0xe0, 0x76, 0x11, 0xd3, 0x98, 0x14, 0xc3, 0xbe,
+	0x86, 0x05, 0x8a, 0x1b, 0xad, 0xbe, 0x55, 0xf9, 0xff, 0x6c, 0x1e, 0x61, 0xb2, 0x01, 0x2c, 0x7a,
+	0x76, 0x68, 0x09, 0x27, 0xd0, 0x96, 0x09, 0xfe, 0xd1, 0x1c, 0xf0, 0xbb, 0xae, 0xf3, 0x4c, 0x58,
+	0x18, 0x32, 0xa6, 0xc5, 0x87, 0xdc, 0x91, 0x87, 0x84, 0x6c, 0x24, 0x12, 0xd8, 0x73, 0x68, 0x0c,
+	0xc2, 0x40, 0xba, 0x43, 0xf1, 0x82, 0x3f, 0xf4, 0x28, 0xb2, 0xb5, 0x15, 0x32, 0xe2, 0xc1, 0x1c,
+	0x52, 0x0f, 0x0a, 0x90, 0xc6, 0x84, 0x10, 0x15, 0x24, 0x83, 0xf0, 0x88, 0x3f, 0xe1, 0x3e, 0x45,
+	0xd7, 0x99, 0x28, 0x48, 0x72, 0x24, 0x76, 0x19, 0x56, 0x31, 0x7b, 0xc5, 0xb3, 0xe3, 0x8e, 0xb0,
+	0x1c, 0x53, 0x86, 0x3e, 0xd7, 0x56, 0x29, 0xd0, 0x8a, 0x64, 0xf6, 0x18, 0x2a, 0xdc, 0x19, 0x69,
+	0x0d, 0xb2, 0xd6, 0xee, 0x1c, 0x7a, 0xef, 0x3b, 0xa3, 0x7d, 0x47, 0xa2, 0x2b, 0x14, 0x5e, 0x14,
+	0xc7, 0x22, 0x56, 0x27, 0xd0, 0xd6, 0x10, 0x9e, 0xe2, 0x38, 0x25, 0xb1, 0x4b, 0x70, 0x46, 0xfa,
+	0x66, 0x77, 0x20, 0x1c, 0xeb, 0x01, 0x97, 0x7d, 0xb7, 0xa7, 0x31, 0xba, 0x47, 0x81, 0xaa, 0x72,
+	0x05, 0xe5, 0x7d, 0x8e, 0xb1, 0xab, 0x9d, 0x25, 0x86, 0x64, 0xab, 0xff, 0x5a, 0x81, 0x46, 0x96,
+	0xd0, 0x81, 0x87, 0xa8, 0x14, 0xf8, 0xc3, 0x98, 0x16, 0x60, 0x5a, 0x2b, 0xb1, 0x19, 0x61, 0x3c,
+	0x2d, 0xca, 0xc5, 0xb4, 0xd8, 0x80, 0xd3, 0x51, 0xd9, 0xa3, 0xac, 0xac, 0x19, 0xf1, 0x6e, 0x2c,
+	0x95, 0xab, 0x85, 0x54, 0xde, 0x04, 0x08, 0x28, 0xb0, 0xbf, 0x38, 0xf6, 0xb8, 0x76, 0x9a, 0x9e,
+	0xe6, 0x28, 0xec, 0x16, 0xac, 0xf4, 0xb9, 0x69, 0xcb, 0x7e, 0xa7, 0xeb, 0x0b, 0x0f, 0x75, 0x5a,
+	0x24, 0x4b, 0x6b, 0xad, 0x5c, 0x39, 0xbd, 0x9b, 0x63, 0x30, 0xc6, 0xd9, 0xd9, 0x3e, 0x2c, 0x47,
+	0x2e, 0xc3, 0x1b, 0x86, 0xb6, 0xa4, 0x5c, 0xae, 0x6f, 0x5f, 0xcc, 0x1f, 0x4f, 0x9d, 0xf9, 0x44,
+	0x31, 0xc6, 0x8e, 0x31, 0xc6, 0x8e, 0xb1, 0xef, 0xa1, 0x91, 0xa8, 0x8c, 0x76, 0x35, 0x7b, 0xa6,
+	0x34, 0xb5, 0x1a, 0x41, 0x75, 0xe6, 0x4a, 0xc0, 0xc0, 0xb5, 0x47, 0xbc, 0x67, 0x14, 0xa0, 0x8d,
+	0x09, 0x61, 0xba, 0x0b, 0xeb, 0x45, 0x5f, 0xed, 0xf6, 0x43, 0x67, 0xf0, 0x1f, 0x0e, 0xdb, 0x81,
+	0xa5, 0x61, 0xa2, 0x6f, 0x99, 0xf4, 0x3d, 0x9f, 0xbf, 0x7a, 0x11, 0xd2, 0x48, 0xb9, 0xf5, 0xe7,
+	0xb0, 0x3e, 0xd5, 0x30, 0xca, 0x9b, 0x23, 0xd3, 0x16, 0x3d, 0x21, 0x8f, 0xa9, 0xee, 0xa3, 0x37,
+	0x93, 0x3d, 0x3b, 0x07, 0x0b, 0xb4, 0x26, 0x59, 0x4b, 0x46, 0xb4, 0x51, 0xd4, 0x01, 0x3f, 0xbe,
+	0xb7, 0x17, 0x87, 0x45, 0xb4, 0xa1, 0x68, 0x41, 0x01, 0x18, 0x2d, 0xd5, 0x38, 0x5a, 0x68, 0xa7,
+	0xf7, 0x60, 0x39, 0xef, 0x50, 0x75, 0xda, 0xf2, 0xdd, 0xd0, 0x8b, 0x85, 0x45, 0x1b, 0xc6, 0xa0,
+	0x8a, 0x41, 0xde, 0x8b, 0x83, 0x90, 0xd6, 0x8a, 0xe6, 0x99, 0xb2, 0x1f, 0x8b, 0xa1, 0x35, 0x49,
+	0x21, 0x9c, 0x54, 0x0a, 0xed, 0xf4, 0x1f, 0x4b, 0xb0, 0x7a, 0x5f, 0x04, 0x12, 0x6b, 0x6e, 0xf0,
+	0x66, 0xbb, 0x99, 0x1e, 0xc2, 0x22, 0x6a, 0xa1, 0x94, 0x61, 0xd7, 0xa1, 0x8a, 0x78, 0x91, 0x1f,
+	0xeb, 0xdb, 0x17, 0xf2, 0xae, 0x8a, 0x59, 0xd4, 0x6f, 0x10, 0x15, 0x0a, 0x62, 0x6d, 0x7e, 0x0c,
+	0xb5, 0x94, 0xc4, 0x1a, 0x50, 0x41, 0xe3, 0xc6, 0x96, 0x52, 0xcb, 0xd8, 0x23, 0x61, 0x92, 0xad,
+	0xd1, 0xe6, 0x66, 0x79, 0xa7, 0xa4, 0xff, 0x56, 0x81, 0xb7, 0x95, 0x9e, 0x1d, 0x4a, 0x52, 0xc4,
+	0xd8, 0x43, 0xd7, 0x0b, 0x3b, 0x78, 0x14, 0x72, 0x44, 0x7a, 0x85, 0xb6, 0xe8, 0xa1, 0x4b, 0xa2,
+	0x96, 0x59, 0x7e, 0x05, 0x2d, 0x33, 0xc6, 0xce, 0xfa, 0x64, 0xe5, 0x15, 0xf4, 0xc9, 0x69, 0xad,
+	0xab, 0xfa, 0x1a, 0x5a, 0x97, 0xfe, 0x43, 0x19, 0x36, 0x94, 0x3a, 0x99, 0xbb, 0xd2, 0xca, 0x8d,
+	0xd1, 0x2f, 0x55, 0x0d, 0x8d, 0x9c, 0x4f, 0x6b, 0x76, 0x03, 0x16, 0x07, 0x81, 0xeb, 0x38, 0x5c,
+	0xc6, 0xb6, 0x6e, 0xe6, 0x43, 0xea, 0x20, 0x7a, 0x84, 0x58, 0x1d, 0x8f, 0x77, 0x8d, 0x84, 0x95,
+	0x5d, 0x81, 0x6a, 0x9f, 0xdb, 0x43, 0xca, 0xa3, 0xfa, 0xf6, 0x5b, 0xe3, 0xa5, 0xd6, 0x1e, 0x26,
+	0xfc, 0xc4, 0xc4, 0x6e, 0x42, 0x2d, 0xd5, 0x32, 0xb6, 0xc1, 0x58, 0x89, 0x49, 0x2f, 0x95, 0x1c,
+	0xcb, 0xd8, 0xd5, 0xd9, 0x9e, 0xf0, 0x79, 0x57, 0x31, 0xd2, 0x1c, 0x56, 0x38, 0xbb, 0x97, 0x3c,
+	0x4c, 0xcf, 0xa6, 0xec, 0xfa, 0x2f, 0x25, 0xb8, 0x98, 0x85, 0xef, 0x44, 0x05, 0x7d, 0xb3, 0x29,
+	0xfd, 0x47, 0x19, 0xce, 0x8c, 0x5b, 0x57, 0xb9, 0x47, 0x75, 0xca, 0xc4, 0x3d, 0x6a, 0xcd, 0x0e,
+	0x61, 0x19, 0x9b, 0xbd, 0xf0, 0x5d, 0x47, 0xcd, 0x47, 0x49, 0xa8, 0x7e, 0x78, 0xb2, 0x8f, 0xd4,
+	0x94, 0x90, 0xb2, 0x47, 0x55, 0x60, 0x0c, 0x01, 0x07, 0x38, 0xf0, 0x4c, 0x1f, 0xb1, 0x25, 0xce,
+	0x09, 0xe8, 0x8e, 0xca, 0xbc, 0x21, 0x19, 0x89, 0x3f, 0x4c, 0x30, 0x8d, 0x1c, 0x7c, 0xf3, 0x29,
+	0xac, 0x4d, 0xe8, 0x33, 0xa5, 0x04, 0xdd, 0xc8, 0x97, 0xa0, 0xfa, 0xf6, 0xe6, 0x94, 0xeb, 0xe5,
+	0x60, 0xf2, 0x25, 0xea, 0xf7, 0x12, 0xd4, 0x73, 0x11, 0x37, 0xd5, 0x86, 0x38, 0x40, 0xd0, 0x81,
+	0xcf, 0x84, 0xcd, 0x23, 0x0b, 0xe2, 0x00, 0x91, 0x51, 0x58, 0x7f, 0x8a, 0x45, 0xee, 0xce, 0x61,
+	0x11, 0xa5, 0xcf, 0x54, 0x73, 0xa8, 0x56, 0x43, 0x72, 0x83, 0xf8, 0x95, 0x22, 0xde, 0xe9, 0x1f,
+	0x40, 0xa3, 0x98, 0x04, 0x8a, 0x57, 0x0c, 0x71, 0x2e, 0x4e, 0x34, 0x8e, 0x77, 0xfa, 0xcf, 0x25,
+	0x60, 0x93, 0x36, 0x39, 0xe9, 0xe2, 0xf8, 0x02, 0x98, 0x0c, 0xb1, 0x51, 0x04, 0xe6, 0x28, 0xec,
+	0x00, 0xea, 0x3d, 0x4c, 0x01, 0xe1, 0xd0, 0x05, 0xe2, 0xd4, 0x7c, 0x7f, 0xb6, 0xf1, 0xf7, 0xb2,
+	0x03, 0x46, 0xfe, 0xb4, 0xfe, 0x18, 0x2e, 0xcc, 0xe4, 0xce, 0xcd, 0x7e, 0xa5, 0xb1, 0xd9, 0x6f,
+	0xe6, 0xc4, 0xa8, 0x33, 0x68, 0x14, 0x73, 0x5c, 0x77, 0x60, 0x4d, 0xd9, 0x78, 0xb7, 0x6f, 0xfa,
+	0xf2, 0x35, 0xb4, 0x66, 0xfd, 0x13, 0xa8, 0xa5, 0xf2, 0xa6, 0x1a, 0x5a, 0x0d, 0x3c, 0xc9, 0x20,
+	0x5e, 0x26, 0x6f, 0xa5, 0x7b, 0xfd, 0x36, 0xb0, 0xbc, 0xb2, 0x71, 0x29, 0xbe, 0x02, 0x0b, 0x42,
+	0xf2, 0x61, 0xd2, 0xc7, 0xd7, 0x8b, 0x15, 0x94, 0xd8, 0x8d, 0x88, 0x67, 0xfb, 0x65, 0x15, 0xd6,
+	0xb2, 0x42, 0xa6, 0xbe, 0x05, 0xb6, 0xaf, 0x87, 0xd0, 0xb8, 0x13, 0xbf, 0x80, 0x27, 0x43, 0x1a,
+	0x7b, 0x67, 0xfa, 0xe8, 0x46, 0x16, 0x6a, 0xce, 0x9c, 0xeb, 0xf4, 0x53, 0xec, 0x1b, 0xd8, 0x28,
+	0x02, 0x76, 0xa4, 0xcf, 0xcd, 0xe1, 0x6c, 0xd8, 0x8b, 0xb3, 0x60, 0x69, 0x02, 0xd5, 0x4f, 0x5d,
+	0x2b, 0xe1, 0x98, 0xbe, 0x94, 0x4c, 0x53, 0xe3, 0x78, 0x85, 0x19, 0xab, 0x79, 0x76, 0xca, 0x4c,
+	0x43, 0xda, 0xad, 0xdc, 0xa1, 0x2a, 0x17, 0x77, 0x35, 0xf6, 0x5e, 0x9e, 0xef, 0xc4, 0x31, 0xa5,
+	0xa9, 0x17, 0xd9, 0x26, 0x1b, 0x23, 0xa2, 0xff, 0x54, 0x82, 0xb3, 0x08, 0x5f, 0x6c, 0x12, 0xec,
+	0xea, 0x74, 0x21, 0x27, 0x34, 0x93, 0xe6, 0xc1, 0x5c, 0x61, 0x57, 0x18, 0xe8, 0x4f, 0x61, 0xf5,
+	0x57, 0x77, 0xce, 0xc2, 0x87, 0x5d, 0x98, 0x1a, 0x27, 0xa9, 0xe9, 0x36, 0x4f, 0x7a, 0x9c, 0xdc,
+	0xf3, 0xd3, 0x5b, 0x2f, 0xff, 0xd9, 0x2c, 0xfd, 0x85, 0x9f, 0xbf, 0xf1, 0xf3, 0xd5, 0xb5, 0x59,
+	0xff, 0xfd, 0xe4, 0xfe, 0xa3, 0x42, 0xa5, 0xbb, 0xb6, 0xc0, 0x7c, 0x3e, 0x3a, 0x4d, 0xff, 0xf4,
+	0x7c, 0xf4, 0x2f, 0xb6, 0xd5, 0x91, 0x79, 0xc2, 0x12, 0x00, 0x00,
 }
@@ -3,6 +3,7 @@ package cache
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -63,16 +64,22 @@ func (c *Cache) SetApps(repoUrl, revision string, apps map[string]string) error
 	return c.cache.SetItem(listApps(repoUrl, revision), apps, c.repoCacheExpiration, apps == nil)
 }
 
-func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string) string {
-	return fmt.Sprintf("mfst|%s|%s|%s|%s|%d", appLabelKey, appLabelValue, revision, namespace, appSourceKey(appSrc))
+// capabilitiesKey returns the hash of the capabilities of the destination cluster which the manifests are rendered for.
+// The API versions are expected to be sorted.
+func capabilitiesKey(kubeVersion string, apiVersions []string) uint32 {
+	return hash.FNVa(kubeVersion + "|" + strings.Join(apiVersions, ","))
 }
 
-func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, res interface{}) error {
-	return c.cache.GetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appLabelValue), res)
+func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, trackingMethod string, kubeVersion string, apiVersions []string) string {
+	return fmt.Sprintf("mfst|%s|%s|%s|%s|%s|%d|%d", appLabelKey, appLabelValue, trackingMethod, revision, namespace, appSourceKey(appSrc), capabilitiesKey(kubeVersion, apiVersions))
 }
 
-func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, res interface{}) error {
-	return c.cache.SetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appLabelValue), res, c.repoCacheExpiration, res == nil)
+func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, trackingMethod string, kubeVersion string, apiVersions []string, res interface{}) error {
+	return c.cache.GetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appLabelValue, trackingMethod, kubeVersion, apiVersions), res)
+}
+
+func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appLabelValue string, trackingMethod string, kubeVersion string, apiVersions []string, res interface{}) error {
+	return c.cache.SetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appLabelValue, trackingMethod, kubeVersion, apiVersions), res, c.repoCacheExpiration, res == nil)
 }
 
 func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource) string {
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -17,7 +18,9 @@ import (
 	"github.com/google/go-jsonnet"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -41,8 +44,14 @@ import (
 )
 
 const (
-	PluginEnvAppName      = "ARGOCD_APP_NAME"
-	PluginEnvAppNamespace = "ARGOCD_APP_NAMESPACE"
+	PluginEnvAppName      = common.EnvAppName
+	PluginEnvAppNamespace = common.EnvAppNamespace
+	// healthScriptsDir is the directory of the application source which contains custom Lua health checks in the
+	// form of <group>/<Kind>/health.lua
+	healthScriptsDir = ".argocd-health"
+	healthScriptFile = "health.lua"
+	// manifestChunkSize is the maximum size in bytes of the manifests sent in a single message of GenerateManifestStream
+	manifestChunkSize = 1024 * 1024
 )
 
 // Service implements ManifestService interface
@@ -108,13 +117,14 @@ type operationSettings struct {
 	noCache bool
 }
 
-// runRepoOperation downloads either git folder or helm chart and executes specified operation
+// runRepoOperation downloads either git folder or helm chart and executes specified operation. The git client is nil
+// if the source is a helm chart.
 func (s *Service) runRepoOperation(
 	c context.Context,
 	repo *v1alpha1.Repository,
 	source *v1alpha1.ApplicationSource,
 	getCached func(revision string) bool,
-	operation func(appPath string, revision string) error,
+	operation func(appPath string, revision string, gitClient git.Client) error,
 	settings operationSettings) error {
 
 	var gitClient git.Client
@@ -126,6 +136,8 @@ func (s *Service) runRepoOperation(
 			return err
 		}
 	}
+	// the trailer cannot be set if the service is not called by a gRPC server, e.g. in tests
+	_ = grpc.SetTrailer(c, metadata.Pairs(apiclient.RevisionTrailerKey, revision))
 
 	if !settings.noCache && getCached(revision) {
 		return nil
@@ -152,7 +164,7 @@ func (s *Service) runRepoOperation(
 			return err
 		}
 		defer util.Close(closer)
-		return operation(chartPath, revision)
+		return operation(chartPath, revision, nil)
 	}
 	s.repoLock.Lock(gitClient.Root())
 	defer s.repoLock.Unlock(gitClient.Root())
@@ -169,15 +181,20 @@ func (s *Service) runRepoOperation(
 	if err != nil {
 		return err
 	}
-	return operation(appPath, revision)
+	return operation(appPath, revision, gitClient)
 }
 
 func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
 	var res *apiclient.ManifestResponse
 
 	getCached := func(revision string) bool {
-		err := s.cache.GetManifests(revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+		err := s.cache.GetManifests(revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, q.TrackingMethod, q.KubeVersion, q.ApiVersions, &res)
 		if err == nil {
+			if q.VerifySignature && !q.ApplicationSource.IsHelm() && res.VerifyResult == nil {
+				// the cached manifests were generated without verifying the signature of the revision
+				log.Infof("manifest cache hit without signature verification: %s/%s", q.ApplicationSource.String(), revision)
+				return false
+			}
 			log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), revision)
 			return true
 		}
@@ -188,14 +205,27 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 		}
 		return false
 	}
-	err := s.runRepoOperation(c, q.Repo, q.ApplicationSource, getCached, func(appPath string, revision string) error {
+	err := s.runRepoOperation(c, q.Repo, q.ApplicationSource, getCached, func(appPath string, revision string, gitClient git.Client) error {
 		var err error
-		res, err = GenerateManifests(appPath, q)
+		res, err = GenerateManifests(appPath, revision, q)
 		if err != nil {
 			return err
 		}
 		res.Revision = revision
-		err = s.cache.SetManifests(revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+		res.RevisionMetadata = getResolvedRevisionMetadata(appPath, revision, q.ApplicationSource, gitClient)
+		if q.VerifySignature && gitClient != nil {
+			signature, err := gitClient.VerifyCommitSignature(revision)
+			if err != nil {
+				return err
+			}
+			res.VerifyResult = &apiclient.SignatureVerification{
+				Validity: signature.Validity,
+				Valid:    signature.Valid(),
+				KeyID:    signature.KeyID,
+				Signer:   signature.Signer,
+			}
+		}
+		err = s.cache.SetManifests(revision, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, q.TrackingMethod, q.KubeVersion, q.ApiVersions, &res)
 		if err != nil {
 			log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), revision, err)
 		}
@@ -204,6 +234,112 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 	return res, err
 }
 
+// getResolvedRevisionMetadata summarizes the revision the manifests were generated from. The commit details are only
+// available for Git sources, and the chart details for Helm charts. Failures are logged and result in missing details,
+// since the metadata is informational only.
+func getResolvedRevisionMetadata(appPath string, revision string, source *v1alpha1.ApplicationSource, gitClient git.Client) *v1alpha1.ResolvedRevisionMetadata {
+	metadata := &v1alpha1.ResolvedRevisionMetadata{}
+	if gitClient != nil {
+		m, err := gitClient.RevisionMetadata(revision)
+		if err != nil {
+			log.Warnf("failed to get metadata of revision %s: %v", revision, err)
+		} else {
+			metadata.Author = m.Author
+			metadata.Date = &metav1.Time{Time: m.Date}
+			metadata.Message = truncateRevisionMessage(m.Message)
+			metadata.Tags = m.Tags
+		}
+	}
+	chart, err := readChartMetadata(appPath)
+	if err != nil {
+		log.Warnf("failed to read chart metadata of %s: %v", source.String(), err)
+	}
+	if chart != nil {
+		metadata.ChartName = chart.Name
+		metadata.ChartVersion = chart.Version
+		metadata.ChartAppVersion = chart.AppVersion
+		metadata.ChartDescription = chart.Description
+	}
+	if source.IsHelm() {
+		if metadata.ChartName == "" {
+			metadata.ChartName = source.Chart
+		}
+		if metadata.ChartVersion == "" {
+			metadata.ChartVersion = revision
+		}
+	}
+	if reflect.DeepEqual(*metadata, v1alpha1.ResolvedRevisionMetadata{}) {
+		return nil
+	}
+	return metadata
+}
+
+// chartMetadata holds the fields of Chart.yaml which are recorded in the revision metadata
+type chartMetadata struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion"`
+	Description string `json:"description"`
+}
+
+// readChartMetadata reads the Chart.yaml of the Helm chart in the given directory. Nil is returned if the directory is
+// not a Helm chart.
+func readChartMetadata(appPath string) (*chartMetadata, error) {
+	data, err := ioutil.ReadFile(filepath.Join(appPath, "Chart.yaml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var chart chartMetadata
+	if err := yaml.Unmarshal(data, &chart); err != nil {
+		return nil, err
+	}
+	return &chart, nil
+}
+
+// truncateRevisionMessage discards anything after the first new line of the message and then truncates it to 64 chars
+func truncateRevisionMessage(message string) string {
+	return text.Trunc(strings.SplitN(message, "\n", 2)[0], 64)
+}
+
+// GenerateManifestStream generates manifests like GenerateManifest, but streams them in batches so that the size of the
+// response is not limited by the maximum gRPC message size
+func (s *Service) GenerateManifestStream(q *apiclient.ManifestRequest, stream apiclient.RepoServerService_GenerateManifestStreamServer) error {
+	res, err := s.GenerateManifest(stream.Context(), q)
+	if err != nil {
+		return err
+	}
+	return sendManifestChunks(res, stream.Send)
+}
+
+// sendManifestChunks sends the manifests of the response in batches of up to manifestChunkSize bytes, followed by the
+// response metadata. Manifests larger than manifestChunkSize are sent in a batch of their own.
+func sendManifestChunks(res *apiclient.ManifestResponse, send func(*apiclient.ManifestResponseChunk) error) error {
+	var manifests []string
+	size := 0
+	for _, manifest := range res.Manifests {
+		if len(manifests) > 0 && size+len(manifest) > manifestChunkSize {
+			if err := send(&apiclient.ManifestResponseChunk{Manifests: manifests}); err != nil {
+				return err
+			}
+			manifests = nil
+			size = 0
+		}
+		manifests = append(manifests, manifest)
+		size += len(manifest)
+	}
+	if len(manifests) > 0 {
+		if err := send(&apiclient.ManifestResponseChunk{Manifests: manifests}); err != nil {
+			return err
+		}
+	}
+	metadata := *res
+	metadata.Manifests = nil
+	return send(&apiclient.ManifestResponseChunk{Metadata: &metadata})
+}
+
 func getHelmRepos(repositories []*v1alpha1.Repository) []helm.HelmRepository {
 	repos := make([]helm.HelmRepository, 0)
 	for _, repo := range repositories {
@@ -212,11 +348,20 @@ func getHelmRepos(repositories []*v1alpha1.Repository) []helm.HelmRepository {
 	return repos
 }
 
+// getAppName returns the name of the application of the request, older clients only send it as the app label value
+func getAppName(q *apiclient.ManifestRequest) string {
+	if q.AppName != "" {
+		return q.AppName
+	}
+	return q.AppLabelValue
+}
+
 func helmTemplate(appPath string, q *apiclient.ManifestRequest) ([]*unstructured.Unstructured, error) {
 	templateOpts := &helm.TemplateOpts{
-		Name:        q.AppLabelValue,
+		Name:        getAppName(q),
 		Namespace:   q.Namespace,
 		KubeVersion: text.SemVer(q.KubeVersion),
+		APIVersions: q.ApiVersions,
 		Set:         map[string]string{},
 		SetString:   map[string]string{},
 	}
@@ -248,7 +393,7 @@ func helmTemplate(appPath string, q *apiclient.ManifestRequest) ([]*unstructured
 		}
 	}
 	if templateOpts.Name == "" {
-		templateOpts.Name = q.AppLabelValue
+		templateOpts.Name = getAppName(q)
 	}
 
 	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos))
@@ -277,8 +422,8 @@ func helmTemplate(appPath string, q *apiclient.ManifestRequest) ([]*unstructured
 	return kube.SplitYAML(out)
 }
 
-// GenerateManifests generates manifests from a path
-func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+// GenerateManifests generates manifests from a path checked out at the given revision
+func GenerateManifests(appPath, revision string, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
 	var targetObjs []*unstructured.Unstructured
 	var dest *v1alpha1.ApplicationDestination
 
@@ -296,7 +441,7 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 		k := kustomize.NewKustomizeApp(appPath, q.Repo.GetGitCreds(), repoURL)
 		targetObjs, _, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions)
 	case v1alpha1.ApplicationSourceTypePlugin:
-		targetObjs, err = runConfigManagementPlugin(appPath, q, q.Repo.GetGitCreds())
+		targetObjs, err = runConfigManagementPlugin(appPath, revision, q, q.Repo.GetGitCreds())
 	case v1alpha1.ApplicationSourceTypeDirectory:
 		var directory *v1alpha1.ApplicationSourceDirectory
 		if directory = q.ApplicationSource.Directory; directory == nil {
@@ -307,6 +452,10 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := kube.ParseTrackingMethod(q.TrackingMethod)
+	if err != nil {
+		return nil, err
+	}
 
 	manifests := make([]string, 0)
 	for _, obj := range targetObjs {
@@ -331,7 +480,7 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 
 		for _, target := range targets {
 			if q.AppLabelKey != "" && q.AppLabelValue != "" && !kube.IsCRD(target) {
-				err = kube.SetAppInstanceLabel(target, q.AppLabelKey, q.AppLabelValue)
+				err = kube.SetAppInstance(target, trackingMethod, q.AppLabelKey, q.AppLabelValue)
 				if err != nil {
 					return nil, err
 				}
@@ -344,9 +493,15 @@ func GenerateManifests(appPath string, q *apiclient.ManifestRequest) (*apiclient
 		}
 	}
 
+	healthScripts, err := findHealthScripts(appPath)
+	if err != nil {
+		return nil, err
+	}
+
 	res := apiclient.ManifestResponse{
-		Manifests:  manifests,
-		SourceType: string(appSourceType),
+		Manifests:     manifests,
+		SourceType:    string(appSourceType),
+		HealthScripts: healthScripts,
 	}
 	if dest != nil {
 		res.Namespace = dest.Namespace
@@ -426,6 +581,51 @@ func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSource
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
+// findHealthScripts returns the custom Lua health checks of the application, which are located at
+// .argocd-health/<group>/<Kind>/health.lua (or .argocd-health/<Kind>/health.lua for the core group)
+func findHealthScripts(appPath string) ([]*apiclient.HealthScript, error) {
+	scriptsPath := filepath.Join(appPath, healthScriptsDir)
+	if info, err := os.Stat(scriptsPath); os.IsNotExist(err) || (err == nil && !info.IsDir()) {
+		return nil, nil
+	}
+	var scripts []*apiclient.HealthScript
+	err := filepath.Walk(scriptsPath, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// symlinks are not followed, so that files outside of the repository cannot be read
+		if !f.Mode().IsRegular() || f.Name() != healthScriptFile {
+			return nil
+		}
+		relPath, err := filepath.Rel(scriptsPath, path)
+		if err != nil {
+			return err
+		}
+		script := apiclient.HealthScript{Path: filepath.Join(healthScriptsDir, relPath)}
+		parts := strings.Split(filepath.ToSlash(relPath), "/")
+		switch len(parts) {
+		case 2:
+			script.Kind = parts[0]
+		case 3:
+			script.Group, script.Kind = parts[0], parts[1]
+		default:
+			log.Warnf("Ignoring health script %s: expected path %s/<group>/<Kind>/%s", script.Path, healthScriptsDir, healthScriptFile)
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		script.Script = string(data)
+		scripts = append(scripts, &script)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scripts, nil
+}
+
 func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory) ([]*unstructured.Unstructured, error) {
 	var objs []*unstructured.Unstructured
 	err := filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
@@ -539,12 +739,15 @@ func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alph
 	return nil
 }
 
-func runConfigManagementPlugin(appPath string, q *apiclient.ManifestRequest, creds git.Creds) ([]*unstructured.Unstructured, error) {
+func runConfigManagementPlugin(appPath, revision string, q *apiclient.ManifestRequest, creds git.Creds) ([]*unstructured.Unstructured, error) {
 	plugin := findPlugin(q.Plugins, q.ApplicationSource.Plugin.Name)
 	if plugin == nil {
 		return nil, fmt.Errorf("Config management plugin with name '%s' is not supported.", q.ApplicationSource.Plugin.Name)
 	}
-	env := append(os.Environ(), fmt.Sprintf("%s=%s", PluginEnvAppName, q.AppLabelValue), fmt.Sprintf("%s=%s", PluginEnvAppNamespace, q.Namespace))
+	env := append(os.Environ(), fmt.Sprintf("%s=%s", PluginEnvAppName, getAppName(q)), fmt.Sprintf("%s=%s", PluginEnvAppNamespace, q.Namespace))
+	env = append(env, v1alpha1.Env(q.Env).Environ()...)
+	env = append(env, fmt.Sprintf("%s=%s", common.EnvAppRevision, revision))
+	env = append(env, fmt.Sprintf("%s=%s", common.EnvKubeVersion, text.SemVer(q.KubeVersion)), fmt.Sprintf("%s=%s", common.EnvKubeAPIVersions, strings.Join(q.ApiVersions, ",")))
 	if creds != nil {
 		closer, environ, err := creds.Environ()
 		if err != nil {
@@ -584,7 +787,7 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 		return false
 	}
 
-	err := s.runRepoOperation(ctx, q.Repo, q.Source, getCached, func(appPath string, revision string) error {
+	err := s.runRepoOperation(ctx, q.Repo, q.Source, getCached, func(appPath string, revision string, _ git.Client) error {
 		appSourceType, err := GetAppSourceType(q.Source, appPath)
 		if err != nil {
 			return err
@@ -702,9 +905,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	if err != nil {
 		return nil, err
 	}
-	// discard anything after the first new line and then truncate to 64 chars
-	message := text.Trunc(strings.SplitN(m.Message, "\n", 2)[0], 64)
-	metadata = &v1alpha1.RevisionMetadata{Author: m.Author, Date: metav1.Time{Time: m.Date}, Tags: m.Tags, Message: message}
+	metadata = &v1alpha1.RevisionMetadata{Author: m.Author, Date: metav1.Time{Time: m.Date}, Tags: m.Tags, Message: truncateRevisionMessage(m.Message)}
 	_ = s.cache.SetRevisionMetadata(q.Repo.Repo, q.Revision, metadata)
 	return metadata, nil
 }
@@ -12,9 +12,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/reposerver/metrics"
@@ -28,6 +30,14 @@ import (
 	helmmocks "github.com/argoproj/argo-cd/util/helm/mocks"
 )
 
+// mockRevisionMetadata is the metadata of all revisions of the mocked Git client
+var mockRevisionMetadata = &git.RevisionMetadata{
+	Message: strings.Repeat("a", 100) + "\n" + "second line",
+	Author:  "author",
+	Date:    time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC),
+	Tags:    []string{"tag1", "tag2"},
+}
+
 func newServiceWithMocks(root string) (*Service, *gitmocks.Client, *helmmocks.Client) {
 	service := NewService(metrics.NewMetricsServer(), cache.NewCache(
 		cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Minute)),
@@ -45,6 +55,7 @@ func newServiceWithMocks(root string) (*Service, *gitmocks.Client, *helmmocks.Cl
 	gitClient.On("LsRemote", mock.Anything).Return(mock.Anything, nil)
 	gitClient.On("CommitSHA").Return(mock.Anything, nil)
 	gitClient.On("Root").Return(root)
+	gitClient.On("RevisionMetadata", mock.Anything).Return(mockRevisionMetadata, nil)
 
 	helmClient.On("ExtractChart", mock.Anything, mock.Anything).Return(func(chart string, version string) string {
 		return path.Join(root, chart)
@@ -85,7 +96,7 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 			assert.Equal(t, countOfManifests, len(res1.Manifests))
 
 			// this will test concatenated manifests to verify we split YAMLs correctly
-			res2, err := GenerateManifests("./testdata/concatenated", &q)
+			res2, err := GenerateManifests("./testdata/concatenated", "", &q)
 			assert.Nil(t, err)
 			assert.Equal(t, 3, len(res2.Manifests))
 		})
@@ -109,6 +120,27 @@ func TestRecurseManifestsInDir(t *testing.T) {
 	}
 }
 
+func TestGenerateManifestsWithHealthScripts(t *testing.T) {
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &argoappv1.ApplicationSource{}}
+
+	res, err := GenerateManifests("./testdata/health-scripts", "", &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 1)
+	if assert.Len(t, res.HealthScripts, 2) {
+		assert.Equal(t, "", res.HealthScripts[0].Group)
+		assert.Equal(t, "ConfigMap", res.HealthScripts[0].Kind)
+		assert.Equal(t, ".argocd-health/ConfigMap/health.lua", res.HealthScripts[0].Path)
+		assert.Equal(t, "example.com", res.HealthScripts[1].Group)
+		assert.Equal(t, "Widget", res.HealthScripts[1].Kind)
+		assert.Equal(t, ".argocd-health/example.com/Widget/health.lua", res.HealthScripts[1].Path)
+		assert.Contains(t, res.HealthScripts[1].Script, `hs.status = "Healthy"`)
+	}
+
+	res, err = GenerateManifests("./testdata/concatenated", "", &q)
+	assert.NoError(t, err)
+	assert.Empty(t, res.HealthScripts)
+}
+
 func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	service := newService(".")
 
@@ -217,6 +249,43 @@ func TestGenerateHelmWithValuesDirectoryTraversal(t *testing.T) {
 	assert.Error(t, err, "should be on or under current directory")
 }
 
+func TestGenerateManifestsTrackingMethod(t *testing.T) {
+	service := newService(".")
+
+	for method, expected := range map[string]struct {
+		label      string
+		annotation string
+	}{
+		"":                 {label: "test-app"},
+		"label":            {label: "test-app"},
+		"annotation":       {annotation: "test-app"},
+		"annotation+label": {label: "test-app", annotation: "test-app"},
+	} {
+		res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:              &argoappv1.Repository{},
+			AppLabelKey:       common.LabelKeyAppInstance,
+			AppLabelValue:     "test-app",
+			TrackingMethod:    method,
+			ApplicationSource: &argoappv1.ApplicationSource{Path: "./testdata/null-list"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Manifests, 1)
+		obj := unstructured.Unstructured{}
+		assert.NoError(t, json.Unmarshal([]byte(res.Manifests[0]), &obj))
+		assert.Equal(t, expected.label, obj.GetLabels()[common.LabelKeyAppInstance], method)
+		assert.Equal(t, expected.annotation, obj.GetAnnotations()[common.AnnotationKeyAppInstance], method)
+	}
+
+	_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		AppLabelKey:       common.LabelKeyAppInstance,
+		AppLabelValue:     "test-app",
+		TrackingMethod:    "invalid",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "./testdata/null-list"},
+	})
+	assert.Error(t, err)
+}
+
 func TestGenerateNullList(t *testing.T) {
 	service := newService(".")
 
@@ -294,11 +363,106 @@ func TestRunCustomTool(t *testing.T) {
 	assert.Equal(t, "bar", obj.GetAnnotations()["GIT_PASSWORD"])
 }
 
+func TestRunCustomToolWithTrackingValue(t *testing.T) {
+	service := newService(".")
+
+	res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		AppName:       "test-app",
+		AppLabelKey:   common.LabelKeyAppInstance,
+		AppLabelValue: "argocd_test-app",
+		Namespace:     "test-namespace",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Plugin: &argoappv1.ApplicationSourcePlugin{
+				Name: "test",
+			},
+		},
+		Plugins: []*argoappv1.ConfigManagementPlugin{{
+			Name: "test",
+			Generate: argoappv1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\"}}"`},
+			},
+		}},
+		Repo: &argoappv1.Repository{},
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, res.Manifests, 1) {
+		obj := &unstructured.Unstructured{}
+		assert.NoError(t, json.Unmarshal([]byte(res.Manifests[0]), obj))
+		// plugins get the name of the application, the resources are labeled with the tracking value
+		assert.Equal(t, "test-app", obj.GetName())
+		assert.Equal(t, "argocd_test-app", obj.GetLabels()[common.LabelKeyAppInstance])
+	}
+}
+
+func TestRunCustomToolWithAppEnv(t *testing.T) {
+	q := &apiclient.ManifestRequest{
+		AppLabelValue: "test-app",
+		Namespace:     "test-namespace",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Plugin: &argoappv1.ApplicationSourcePlugin{
+				Name: "test",
+				Env:  argoappv1.Env{{Name: "FOO", Value: "bar"}},
+			},
+		},
+		Plugins: []*argoappv1.ConfigManagementPlugin{{
+			Name: "test",
+			Generate: argoappv1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"annotations\": {\"repo\": \"$ARGOCD_APP_SOURCE_REPO_URL\", \"targetRevision\": \"$ARGOCD_APP_SOURCE_TARGET_REVISION\", \"revision\": \"$ARGOCD_APP_REVISION\", \"foo\": \"$FOO\"}}}"`},
+			},
+		}},
+		Env: []*argoappv1.EnvEntry{
+			{Name: "ARGOCD_APP_NAME", Value: "test-app"},
+			{Name: "ARGOCD_APP_SOURCE_REPO_URL", Value: "https://github.com/argoproj/argocd-example-apps.git"},
+			{Name: "ARGOCD_APP_SOURCE_TARGET_REVISION", Value: "HEAD"},
+		},
+	}
+
+	objs, err := runConfigManagementPlugin(".", "abc123", q, nil)
+	assert.Nil(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, "test-app", objs[0].GetName())
+	assert.Equal(t, map[string]string{
+		"repo":           "https://github.com/argoproj/argocd-example-apps.git",
+		"targetRevision": "HEAD",
+		"revision":       "abc123",
+		"foo":            "bar",
+	}, objs[0].GetAnnotations())
+}
+
+func TestRunCustomToolWithCapabilities(t *testing.T) {
+	q := &apiclient.ManifestRequest{
+		AppLabelValue: "test-app",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Plugin: &argoappv1.ApplicationSourcePlugin{Name: "test"},
+		},
+		Plugins: []*argoappv1.ConfigManagementPlugin{{
+			Name: "test",
+			Generate: argoappv1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"annotations\": {\"kubeVersion\": \"$KUBE_VERSION\", \"apiVersions\": \"$KUBE_API_VERSIONS\"}}}"`},
+			},
+		}},
+		KubeVersion: "1.14+",
+		ApiVersions: []string{"apps/v1", "v1"},
+	}
+
+	objs, err := runConfigManagementPlugin(".", "abc123", q, nil)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, map[string]string{
+		"kubeVersion": "1.14",
+		"apiVersions": "apps/v1,v1",
+	}, objs[0].GetAnnotations())
+}
+
 func TestGenerateFromUTF16(t *testing.T) {
 	q := apiclient.ManifestRequest{
 		ApplicationSource: &argoappv1.ApplicationSource{},
 	}
-	res1, err := GenerateManifests("./testdata/utf-16", &q)
+	res1, err := GenerateManifests("./testdata/utf-16", "", &q)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(res1.Manifests))
 }
@@ -387,15 +551,7 @@ func TestGetHelmCharts(t *testing.T) {
 }
 
 func TestGetRevisionMetadata(t *testing.T) {
-	service, gitClient, _ := newServiceWithMocks("../..")
-	now := time.Now()
-
-	gitClient.On("RevisionMetadata", mock.Anything).Return(&git.RevisionMetadata{
-		Message: strings.Repeat("a", 100) + "\n" + "second line",
-		Author:  "author",
-		Date:    now,
-		Tags:    []string{"tag1", "tag2"},
-	}, nil)
+	service := newService("../..")
 
 	res, err := service.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
 		Repo:     &argoappv1.Repository{},
@@ -404,8 +560,107 @@ func TestGetRevisionMetadata(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, strings.Repeat("a", 61)+"...", res.Message)
-	assert.Equal(t, now, res.Date.Time)
+	assert.Equal(t, mockRevisionMetadata.Date, res.Date.Time)
 	assert.Equal(t, "author", res.Author)
 	assert.EqualValues(t, []string{"tag1", "tag2"}, res.Tags)
 
 }
+
+func TestGenerateManifestRevisionMetadata(t *testing.T) {
+	service := newService(".")
+
+	res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "./testdata/recurse"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &argoappv1.ResolvedRevisionMetadata{
+		Author:  "author",
+		Date:    &metav1.Time{Time: mockRevisionMetadata.Date},
+		Message: strings.Repeat("a", 61) + "...",
+		Tags:    []string{"tag1", "tag2"},
+	}, res.RevisionMetadata)
+}
+
+func TestGetResolvedRevisionMetadata(t *testing.T) {
+	_, gitClient, _ := newServiceWithMocks(".")
+
+	t.Run("GitHelmChart", func(t *testing.T) {
+		metadata := getResolvedRevisionMetadata("../../util/helm/testdata/redis", "abc123", &argoappv1.ApplicationSource{Path: "redis"}, gitClient)
+		assert.Equal(t, "author", metadata.Author)
+		assert.Equal(t, "redis", metadata.ChartName)
+		assert.Equal(t, "3.6.5", metadata.ChartVersion)
+		assert.Equal(t, "4.0.10", metadata.ChartAppVersion)
+		assert.Equal(t, []string{"tag1", "tag2"}, metadata.Tags)
+	})
+
+	t.Run("Helm", func(t *testing.T) {
+		metadata := getResolvedRevisionMetadata("../../util/helm/testdata/redis", "3.6.5", &argoappv1.ApplicationSource{Chart: "redis", TargetRevision: "3.6.5"}, nil)
+		assert.Equal(t, &argoappv1.ResolvedRevisionMetadata{
+			ChartName:        "redis",
+			ChartVersion:     "3.6.5",
+			ChartAppVersion:  "4.0.10",
+			ChartDescription: "Open source, advanced key-value store. It is often referred to as a data structure server since keys can contain strings, hashes, lists, sets and sorted sets.",
+		}, metadata)
+	})
+
+	t.Run("HelmWithoutChartMetadata", func(t *testing.T) {
+		metadata := getResolvedRevisionMetadata("./testdata/recurse", "1.0.0", &argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: "1.0.0"}, nil)
+		assert.Equal(t, &argoappv1.ResolvedRevisionMetadata{ChartName: "my-chart", ChartVersion: "1.0.0"}, metadata)
+	})
+
+	t.Run("NoMetadata", func(t *testing.T) {
+		assert.Nil(t, getResolvedRevisionMetadata("./testdata/recurse", "", &argoappv1.ApplicationSource{}, nil))
+	})
+}
+
+func TestGenerateManifestVerifySignature(t *testing.T) {
+	service, gitClient, _ := newServiceWithMocks(".")
+	gitClient.On("VerifyCommitSignature", mock.Anything).Return(&git.SignatureInfo{
+		Validity: git.SignatureValidityGood,
+		KeyID:    "4AEE18F83AFDEB23",
+		Signer:   "test <test@example.com>",
+	}, nil)
+	src := argoappv1.ApplicationSource{Path: "./testdata/recurse"}
+
+	res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src})
+	assert.NoError(t, err)
+	assert.Nil(t, res.VerifyResult)
+	gitClient.AssertNotCalled(t, "VerifyCommitSignature", mock.Anything)
+
+	// manifests cached without signature verification must be regenerated
+	res, err = service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true})
+	assert.NoError(t, err)
+	assert.Equal(t, &apiclient.SignatureVerification{
+		Validity: git.SignatureValidityGood,
+		Valid:    true,
+		KeyID:    "4AEE18F83AFDEB23",
+		Signer:   "test <test@example.com>",
+	}, res.VerifyResult)
+	gitClient.AssertNumberOfCalls(t, "VerifyCommitSignature", 1)
+}
+
+func TestSendManifestChunks(t *testing.T) {
+	large := strings.Repeat("a", manifestChunkSize)
+	res := &apiclient.ManifestResponse{
+		Manifests:  []string{"small-1", "small-2", large, "small-3"},
+		Revision:   "abc123",
+		SourceType: "Directory",
+	}
+	var chunks []*apiclient.ManifestResponseChunk
+	err := sendManifestChunks(res, func(chunk *apiclient.ManifestResponseChunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, chunks, 4) {
+		assert.Equal(t, []string{"small-1", "small-2"}, chunks[0].Manifests)
+		assert.Equal(t, []string{large}, chunks[1].Manifests)
+		assert.Equal(t, []string{"small-3"}, chunks[2].Manifests)
+		assert.Nil(t, chunks[2].Metadata)
+		assert.Empty(t, chunks[3].Manifests)
+		assert.Equal(t, &apiclient.ManifestResponse{Revision: "abc123", SourceType: "Directory"}, chunks[3].Metadata)
+	}
+	// the response itself is left untouched
+	assert.Len(t, res.Manifests, 4)
+}
@@ -182,6 +182,14 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := s.settingsMgr.GetAppResourceTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	trackingValue, err := s.settingsMgr.GetAppResourceTrackingValue()
+	if err != nil {
+		return nil, err
+	}
 	helmRepos, err := s.db.ListHelmRepositories(ctx)
 	if err != nil {
 		return nil, err
@@ -211,7 +219,9 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		Repo:              repo,
 		Revision:          revision,
 		AppLabelKey:       appInstanceLabelKey,
-		AppLabelValue:     a.Name,
+		AppLabelValue:     trackingValue.Format(a.Name),
+		AppName:           a.Name,
+		TrackingMethod:    string(trackingMethod),
 		Namespace:         a.Spec.Destination.Namespace,
 		ApplicationSource: &a.Spec.Source,
 		Repos:             helmRepos,
@@ -939,6 +949,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.IsReconcilePaused() {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync: %s", a.ReconcilePausedMessage())
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.Revision != "" && syncReq.Revision != util.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
@@ -961,7 +974,12 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			SyncStrategy: syncReq.Strategy,
 			Resources:    syncReq.Resources,
 			Manifests:    syncReq.Manifests,
+			IncludeHooks: syncReq.IncludeHooks,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
+	}
+	if syncReq.RetryStrategy != nil {
+		op.Retry = *syncReq.RetryStrategy
 	}
 	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
 	if err == nil {
@@ -986,8 +1004,8 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
+	if a.IsReconcilePaused() {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot rollback: %s", a.ReconcilePausedMessage())
 	}
 
 	var deploymentInfo *appv1.RevisionHistory
@@ -1006,7 +1024,7 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
 	}
 
-	// Rollback is just a convenience around Sync
+	// Rollback is a sync to the revision and source of the history entry, which suspends auto-sync until the next sync
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Revision:     deploymentInfo.Revision,
@@ -1014,7 +1032,9 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 			Prune:        rollbackReq.Prune,
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
 			Source:       &deploymentInfo.Source,
+			RollbackID:   &rollbackReq.ID,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
 	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
 	if err == nil {
@@ -337,6 +337,30 @@ func TestRollbackApp(t *testing.T) {
 	assert.NotNil(t, updatedApp.Operation.Sync)
 	assert.NotNil(t, updatedApp.Operation.Sync.Source)
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+	if assert.NotNil(t, updatedApp.Operation.Sync.RollbackID) {
+		assert.Equal(t, int64(1), *updatedApp.Operation.Sync.RollbackID)
+	}
+}
+
+func TestSyncAndRollbackPausedApp(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Annotations = map[string]string{
+		common.AnnotationKeyReconcile:         common.AnnotationValueReconcilePaused,
+		common.AnnotationKeyReconcilePausedBy: "alice",
+	}
+	testApp.Status.History = []appsv1.RevisionHistory{{ID: 1, Revision: "abc", Source: *testApp.Spec.Source.DeepCopy()}}
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Reconciliation is paused by the argocd.argoproj.io/reconcile annotation, which was set by alice")
+
+	_, err = appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{Name: &testApp.Name, ID: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(testApp.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
 }
 
 func TestUpdateAppProject(t *testing.T) {
@@ -58,14 +58,8 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
-func clusterConnectionStateKey(server string) string {
-	return fmt.Sprintf("cluster|%s|connection-state", server)
-}
-
 func (c *Cache) GetClusterConnectionState(server string) (appv1.ConnectionState, error) {
-	res := appv1.ConnectionState{}
-	err := c.cache.GetItem(clusterConnectionStateKey(server), &res)
-	return res, err
+	return c.cache.GetClusterConnectionState(server)
 }
 
 func (c *Cache) SetRepoConnectionState(repo string, state *appv1.ConnectionState) error {
@@ -83,7 +77,7 @@ func (c *Cache) GetRepoConnectionState(repo string) (appv1.ConnectionState, erro
 }
 
 func (c *Cache) SetClusterConnectionState(server string, state *appv1.ConnectionState) error {
-	return c.cache.SetItem(clusterConnectionStateKey(server), &state, c.connectionStatusCacheExpiration, state == nil)
+	return c.cache.SetItem(appstatecache.ClusterConnectionStateKey(server), &state, c.connectionStatusCacheExpiration, state == nil)
 }
 
 func oidcStateKey(key string) string {
@@ -23,6 +23,9 @@ import (
 	"github.com/argoproj/argo-cd/util/rbac"
 )
 
+// clusterTestTimeout is the maximum duration of the connection and permissions check of an added cluster
+const clusterTestTimeout = 30 * time.Second
+
 // Server provides a Cluster service
 type Server struct {
 	db      db.ArgoDB
@@ -123,10 +126,15 @@ func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*
 		return nil, err
 	}
 	c := q.Cluster
-	err := kube.TestConfig(q.Cluster.RESTConfig())
+	testCtx, cancel := context.WithTimeout(ctx, clusterTestTimeout)
+	defer cancel()
+	report, err := kube.TestConfigWithContext(testCtx, q.Cluster.RESTConfig(), &kube.PreflightOptions{Namespaces: c.Namespaces})
 	if err != nil {
 		return nil, err
 	}
+	for _, missing := range report.Missing {
+		log.WithField("cluster", c.Server).Warnf("Cluster credentials might be insufficient: %s", missing)
+	}
 
 	c.ConnectionState = appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful}
 	clust, err := s.db.CreateCluster(ctx, c)
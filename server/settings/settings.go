@@ -36,6 +36,14 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := s.mgr.GetAppResourceTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	trackingValue, err := s.mgr.GetAppResourceTrackingValue()
+	if err != nil {
+		return nil, err
+	}
 	argoCDSettings, err := s.mgr.GetSettings()
 	if err != nil {
 		return nil, err
@@ -54,10 +62,12 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	}
 
 	set := settingspkg.Settings{
-		URL:                argoCDSettings.URL,
-		AppLabelKey:        appInstanceLabelKey,
-		ResourceOverrides:  overrides,
-		StatusBadgeEnabled: argoCDSettings.StatusBadgeEnabled,
+		URL:                 argoCDSettings.URL,
+		AppLabelKey:         appInstanceLabelKey,
+		TrackingMethod:      string(trackingMethod),
+		TrackingValuePrefix: trackingValue.Prefix,
+		ResourceOverrides:   overrides,
+		StatusBadgeEnabled:  argoCDSettings.StatusBadgeEnabled,
 		KustomizeOptions: &v1alpha1.KustomizeOptions{
 			BuildOptions: argoCDSettings.KustomizeBuildOptions,
 		},
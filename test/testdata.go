@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 
 	"github.com/ghodss/yaml"
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+	"github.com/googleapis/gnostic/compiler"
+	yamlv2 "gopkg.in/yaml.v2"
 	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -260,3 +263,93 @@ func NewFakeProjLister(objects ...runtime.Object) applister.AppProjectNamespaceL
 	defer cancel()
 	return factory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(FakeArgoCDNamespace)
 }
+
+// OpenAPISchemaManifest is an OpenAPI document which only holds the models of pods
+var OpenAPISchemaManifest = []byte(`
+swagger: "2.0"
+info:
+  title: Kubernetes
+  version: v1.14.0
+paths: {}
+definitions:
+  io.k8s.api.core.v1.Pod:
+    type: object
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      metadata:
+        $ref: "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"
+      spec:
+        $ref: "#/definitions/io.k8s.api.core.v1.PodSpec"
+    x-kubernetes-group-version-kind:
+    - group: ""
+      kind: Pod
+      version: v1
+  io.k8s.api.core.v1.PodSpec:
+    type: object
+    required:
+    - containers
+    properties:
+      containers:
+        type: array
+        items:
+          $ref: "#/definitions/io.k8s.api.core.v1.Container"
+      restartPolicy:
+        type: string
+  io.k8s.api.core.v1.Container:
+    type: object
+    required:
+    - name
+    properties:
+      name:
+        type: string
+      image:
+        type: string
+      resources:
+        $ref: "#/definitions/io.k8s.api.core.v1.ResourceRequirements"
+  io.k8s.api.core.v1.ResourceRequirements:
+    type: object
+    properties:
+      limits:
+        type: object
+        additionalProperties:
+          type: string
+      requests:
+        type: object
+        additionalProperties:
+          type: string
+  io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta:
+    type: object
+    properties:
+      name:
+        type: string
+      namespace:
+        type: string
+      uid:
+        type: string
+      creationTimestamp:
+        type: string
+      labels:
+        type: object
+        additionalProperties:
+          type: string
+      annotations:
+        type: object
+        additionalProperties:
+          type: string
+`)
+
+// NewOpenAPIDocument returns the parsed OpenAPISchemaManifest
+func NewOpenAPIDocument() *openapi_v2.Document {
+	var info yamlv2.MapSlice
+	if err := yamlv2.Unmarshal(OpenAPISchemaManifest, &info); err != nil {
+		panic(err)
+	}
+	doc, err := openapi_v2.NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		panic(err)
+	}
+	return doc
+}
@@ -228,7 +228,17 @@ func ValidateRepo(
 
 	enrichSpec(spec, appDetails)
 
-	cluster, err := db.GetCluster(context.Background(), spec.Destination.Server)
+	server := spec.Destination.Server
+	if spec.Destination.Name != "" {
+		var clusters *argoappv1.ClusterList
+		if clusters, err = db.ListClusters(ctx); err == nil {
+			server, err = ResolveDestinationServer(spec.Destination, clusters.Items)
+		}
+	}
+	var cluster *argoappv1.Cluster
+	if err == nil {
+		cluster, err = db.GetCluster(context.Background(), server)
+	}
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -250,7 +260,7 @@ func enrichSpec(spec *argoappv1.ApplicationSpec, appDetails *apiclient.RepoAppDe
 		env, ok := appDetails.Ksonnet.Environments[spec.Source.Ksonnet.Environment]
 		if ok {
 			// If server and namespace are not supplied, pull it from the app.yaml
-			if spec.Destination.Server == "" {
+			if spec.Destination.Server == "" && spec.Destination.Name == "" {
 				spec.Destination.Server = env.Destination.Server
 			}
 			if spec.Destination.Namespace == "" {
@@ -263,42 +273,54 @@ func enrichSpec(spec *argoappv1.ApplicationSpec, appDetails *apiclient.RepoAppDe
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
-	if spec.Source.RepoURL == "" || (spec.Source.Path == "" && spec.Source.Chart == "") {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.repoURL and spec.source.path either spec.source.chart are required",
-		})
-		return conditions, nil
-	}
-	if spec.Source.Chart != "" && spec.Source.TargetRevision == "" {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.targetRevision is required if the manifest source is a helm chart",
-		})
-		return conditions, nil
+	if spec.HasMultipleSources() {
+		for i := range spec.Sources {
+			sourceConditions, incomplete := validateSource(spec.Sources[i], fmt.Sprintf("spec.sources[%d]", i), proj, spec.Project)
+			conditions = append(conditions, sourceConditions...)
+			if incomplete {
+				return conditions, nil
+			}
+		}
+	} else {
+		sourceConditions, incomplete := validateSource(spec.Source, "spec.source", proj, spec.Project)
+		conditions = append(conditions, sourceConditions...)
+		if incomplete {
+			return conditions, nil
+		}
 	}
 
-	if !proj.IsSourcePermitted(spec.Source) {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.Source.RepoURL, spec.Project),
-		})
+	// the permissions of a destination which references the cluster by name are validated for the resolved server
+	dest := spec.Destination
+	if dest.Name != "" {
+		clusters, err := db.ListClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+		server, err := ResolveDestinationServer(dest, clusters.Items)
+		if err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: err.Error(),
+			})
+			return conditions, nil
+		}
+		dest.Server = server
 	}
 
-	if spec.Destination.Server != "" && spec.Destination.Namespace != "" {
-		if !proj.IsDestinationPermitted(spec.Destination) {
+	if dest.Server != "" && dest.Namespace != "" {
+		if !proj.IsDestinationPermitted(dest) {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", spec.Destination, spec.Project),
+				Message: fmt.Sprintf("application destination %v is not permitted in project '%s'", dest, spec.Project),
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err := db.GetCluster(ctx, spec.Destination.Server)
+		_, err := db.GetCluster(ctx, dest.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
 				conditions = append(conditions, argoappv1.ApplicationCondition{
 					Type:    argoappv1.ApplicationConditionInvalidSpecError,
-					Message: fmt.Sprintf("cluster '%s' has not been configured", spec.Destination.Server),
+					Message: fmt.Sprintf("cluster '%s' has not been configured", dest.Server),
 				})
 			} else {
 				return nil, err
@@ -310,6 +332,53 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	return conditions, nil
 }
 
+// validateSource returns the conditions of an invalid source at the given field of the application spec, e.g.
+// spec.source. The returned bool is true if the source is incomplete, the rest of the spec is not validated then.
+func validateSource(source argoappv1.ApplicationSource, field string, proj *argoappv1.AppProject, project string) ([]argoappv1.ApplicationCondition, bool) {
+	if source.RepoURL == "" || (source.Path == "" && source.Chart == "") {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("%[1]s.repoURL and %[1]s.path either %[1]s.chart are required", field),
+		}}, true
+	}
+	if source.Chart != "" && source.TargetRevision == "" {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("%s.targetRevision is required if the manifest source is a helm chart", field),
+		}}, true
+	}
+	if !proj.IsSourcePermitted(source) {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, project),
+		}}, false
+	}
+	return nil, false
+}
+
+// ResolveDestinationServer returns the server URL of the given destination. A destination name is resolved to the
+// server of the only cluster with that name, which has to match the server of the destination if both are set.
+func ResolveDestinationServer(dest argoappv1.ApplicationDestination, clusters []argoappv1.Cluster) (string, error) {
+	if dest.Name == "" {
+		return dest.Server, nil
+	}
+	var servers []string
+	for _, cluster := range clusters {
+		if cluster.Name == dest.Name {
+			servers = append(servers, cluster.Server)
+		}
+	}
+	switch {
+	case len(servers) == 0:
+		return "", fmt.Errorf("unable to find destination server: there are no clusters with the name %s", dest.Name)
+	case len(servers) > 1:
+		return "", fmt.Errorf("unable to find destination server: there are %d clusters with the name %s: %s", len(servers), dest.Name, strings.Join(servers, ", "))
+	case dest.Server != "" && dest.Server != servers[0]:
+		return "", fmt.Errorf("application destination can't have both name %s and server %s defined, the cluster %s has the server %s", dest.Name, dest.Server, dest.Name, servers[0])
+	}
+	return servers[0], nil
+}
+
 // GetAppProject returns a project from an application
 func GetAppProject(spec *argoappv1.ApplicationSpec, projLister applicationsv1.AppProjectLister, ns string) (*argoappv1.AppProject, error) {
 	return projLister.AppProjects(ns).Get(spec.GetProject())
@@ -393,9 +462,9 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 }
 
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
-func ContainsSyncResource(name string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
+func ContainsSyncResource(name string, namespace string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
-		if r.HasIdentity(name, gvk) {
+		if r.HasIdentity(name, namespace, gvk) {
 			return true
 		}
 	}
@@ -414,17 +483,25 @@ func NormalizeApplicationSpec(spec *argoappv1.ApplicationSpec) *argoappv1.Applic
 	// 3. If any app sources are their zero values, then nil out the pointers to the source spec.
 	// This makes it easier for users to switch between app source types if they are not using
 	// any of the source-specific parameters.
-	if spec.Source.Kustomize != nil && spec.Source.Kustomize.IsZero() {
-		spec.Source.Kustomize = nil
+	normalizeSource(&spec.Source)
+	for i := range spec.Sources {
+		normalizeSource(&spec.Sources[i])
 	}
-	if spec.Source.Helm != nil && spec.Source.Helm.IsZero() {
-		spec.Source.Helm = nil
+	return spec
+}
+
+// normalizeSource nils out the source type specific options of the source which are their zero values
+func normalizeSource(source *argoappv1.ApplicationSource) {
+	if source.Kustomize != nil && source.Kustomize.IsZero() {
+		source.Kustomize = nil
 	}
-	if spec.Source.Ksonnet != nil && spec.Source.Ksonnet.IsZero() {
-		spec.Source.Ksonnet = nil
+	if source.Helm != nil && source.Helm.IsZero() {
+		source.Helm = nil
 	}
-	if spec.Source.Directory != nil && spec.Source.Directory.IsZero() {
-		spec.Source.Directory = nil
+	if source.Ksonnet != nil && source.Ksonnet.IsZero() {
+		source.Ksonnet = nil
+	}
+	if source.Directory != nil && source.Directory.IsZero() {
+		source.Directory = nil
 	}
-	return spec
 }
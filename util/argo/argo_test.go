@@ -18,6 +18,7 @@ import (
 	"github.com/argoproj/argo-cd/pkg/client/informers/externalversions/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/test"
 )
 
 func TestRefreshApp(t *testing.T) {
@@ -88,7 +89,9 @@ func TestContainsSyncResource(t *testing.T) {
 		blankUnstructured unstructured.Unstructured
 		blankResource     argoappv1.SyncOperationResource
 		helloResource     = argoappv1.SyncOperationResource{Name: "hello"}
+		namespacedPod     = test.NewPod()
 	)
+	namespacedPod.SetNamespace(test.FakeArgoCDNamespace)
 	tables := []struct {
 		u        *unstructured.Unstructured
 		rr       []argoappv1.SyncOperationResource
@@ -97,10 +100,17 @@ func TestContainsSyncResource(t *testing.T) {
 		{&blankUnstructured, []argoappv1.SyncOperationResource{}, false},
 		{&blankUnstructured, []argoappv1.SyncOperationResource{blankResource}, true},
 		{&blankUnstructured, []argoappv1.SyncOperationResource{helloResource}, false},
+		{test.NewPod(), []argoappv1.SyncOperationResource{{Kind: "Pod", Name: "my-pod"}}, true},
+		{test.NewPod(), []argoappv1.SyncOperationResource{{Kind: "Pod", Name: "my-*"}}, true},
+		{test.NewPod(), []argoappv1.SyncOperationResource{{Kind: "Pod", Name: "other-*"}}, false},
+		{test.NewPod(), []argoappv1.SyncOperationResource{{Kind: "Pod", Namespace: test.FakeArgoCDNamespace, Name: "my-pod"}}, false},
+		{test.NewPod(), []argoappv1.SyncOperationResource{{Kind: "Service", Name: "my-pod"}}, false},
+		{namespacedPod, []argoappv1.SyncOperationResource{{Kind: "Pod", Namespace: test.FakeArgoCDNamespace, Name: "my-pod"}}, true},
+		{namespacedPod, []argoappv1.SyncOperationResource{{Kind: "Pod", Name: "my-pod"}}, true},
 	}
 
 	for _, table := range tables {
-		if out := ContainsSyncResource(table.u.GetName(), table.u.GroupVersionKind(), table.rr); out != table.expected {
+		if out := ContainsSyncResource(table.u.GetName(), table.u.GetNamespace(), table.u.GroupVersionKind(), table.rr); out != table.expected {
 			t.Errorf("Expected %t for slice %+v conains resource %+v; instead got %t", table.expected, table.rr, table.u, out)
 		}
 	}
@@ -154,6 +164,62 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server and/or namespace missing from app spec"}})
 }
 
+func TestValidatePermissionsMultipleSources(t *testing.T) {
+	conditions, err := ValidatePermissions(context.Background(), &argoappv1.ApplicationSpec{
+		Sources: []argoappv1.ApplicationSource{
+			{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+			{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+		},
+	}, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: "spec.sources[1].repoURL and spec.sources[1].path either spec.sources[1].chart are required",
+	}})
+}
+
+func TestResolveDestinationServer(t *testing.T) {
+	clusters := []argoappv1.Cluster{
+		{Name: "in-cluster", Server: "https://kubernetes.default.svc"},
+		{Name: "prod", Server: "https://prod.example.com"},
+		{Name: "staging", Server: "https://staging-1.example.com"},
+		{Name: "staging", Server: "https://staging-2.example.com"},
+	}
+
+	t.Run("Server", func(t *testing.T) {
+		server, err := ResolveDestinationServer(argoappv1.ApplicationDestination{Server: "https://other.example.com"}, clusters)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://other.example.com", server)
+	})
+	t.Run("Name", func(t *testing.T) {
+		server, err := ResolveDestinationServer(argoappv1.ApplicationDestination{Name: "prod"}, clusters)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://prod.example.com", server)
+	})
+	t.Run("NameAndMatchingServer", func(t *testing.T) {
+		server, err := ResolveDestinationServer(argoappv1.ApplicationDestination{Name: "prod", Server: "https://prod.example.com"}, clusters)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://prod.example.com", server)
+	})
+	t.Run("NameAndConflictingServer", func(t *testing.T) {
+		_, err := ResolveDestinationServer(argoappv1.ApplicationDestination{Name: "prod", Server: "https://kubernetes.default.svc"}, clusters)
+		assert.EqualError(t, err, "application destination can't have both name prod and server https://kubernetes.default.svc defined, the cluster prod has the server https://prod.example.com")
+	})
+	t.Run("UnknownName", func(t *testing.T) {
+		_, err := ResolveDestinationServer(argoappv1.ApplicationDestination{Name: "dev"}, clusters)
+		assert.EqualError(t, err, "unable to find destination server: there are no clusters with the name dev")
+	})
+	t.Run("AmbiguousName", func(t *testing.T) {
+		_, err := ResolveDestinationServer(argoappv1.ApplicationDestination{Name: "staging"}, clusters)
+		assert.EqualError(t, err, "unable to find destination server: there are 2 clusters with the name staging: https://staging-1.example.com, https://staging-2.example.com")
+	})
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	conditions, err := ValidatePermissions(context.Background(), &argoappv1.ApplicationSpec{
 		Source: argoappv1.ApplicationSource{RepoURL: "https://kubernetes-charts-incubator.storage.googleapis.com/", Chart: "myChart", TargetRevision: ""},
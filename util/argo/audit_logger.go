@@ -8,6 +8,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -17,6 +19,10 @@ type AuditLogger struct {
 	kIf       kubernetes.Interface
 	component string
 	ns        string
+	// aggregationWindow is the period in which identical events are aggregated into a single event, zero disables the aggregation
+	aggregationWindow time.Duration
+	recentEvents      map[string]*v1.Event
+	lock              *sync.Mutex
 }
 
 type EventInfo struct {
@@ -30,7 +36,11 @@ const (
 	EventReasonResourceUpdated    = "ResourceUpdated"
 	EventReasonResourceDeleted    = "ResourceDeleted"
 	EventReasonOperationStarted   = "OperationStarted"
-	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonOperationRunning   = "OperationRunning"
+	EventReasonOperationSucceeded = "OperationSucceeded"
+	EventReasonOperationFailed    = "OperationFailed"
+	EventReasonSynced             = "Synced"
+	EventReasonOutOfSync          = "OutOfSync"
 )
 
 func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string) {
@@ -51,6 +61,10 @@ func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersio
 		logCtx = logCtx.WithField("name", objMeta.Name)
 	}
 	t := metav1.Time{Time: time.Now()}
+	key := strings.Join([]string{gvk.Kind, objMeta.Namespace, objMeta.Name, info.Type, info.Reason, message}, "/")
+	if l.aggregationWindow > 0 && l.aggregateEvent(key, t, logCtx) {
+		return
+	}
 	event := v1.Event{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: fmt.Sprintf("%v.%x", objMeta.Name, t.UnixNano()),
@@ -74,11 +88,44 @@ func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersio
 		Reason:         info.Reason,
 	}
 	logCtx.Info(message)
-	_, err := l.kIf.CoreV1().Events(l.ns).Create(&event)
+	created, err := l.kIf.CoreV1().Events(l.ns).Create(&event)
 	if err != nil {
 		logCtx.Errorf("Unable to create audit event: %v", err)
 		return
 	}
+	if l.aggregationWindow > 0 {
+		l.lock.Lock()
+		l.recentEvents[key] = created
+		l.lock.Unlock()
+	}
+}
+
+// aggregateEvent increments the count of an identical event which was recorded within the aggregation window.
+// Returns false if there is no such event and a new one has to be created.
+func (l *AuditLogger) aggregateEvent(key string, t metav1.Time, logCtx *log.Entry) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for k, event := range l.recentEvents {
+		if t.Sub(event.LastTimestamp.Time) > l.aggregationWindow {
+			delete(l.recentEvents, k)
+		}
+	}
+	event, ok := l.recentEvents[key]
+	if !ok {
+		return false
+	}
+	event = event.DeepCopy()
+	event.Count++
+	event.LastTimestamp = t
+	updated, err := l.kIf.CoreV1().Events(l.ns).Update(event)
+	if err != nil {
+		logCtx.Warnf("Unable to aggregate audit event %s, creating new one: %v", event.Name, err)
+		delete(l.recentEvents, key)
+		return false
+	}
+	logCtx.Debugf("%s (x%d)", event.Message, event.Count)
+	l.recentEvents[key] = updated
+	return true
 }
 
 func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, message string) {
@@ -93,9 +140,18 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 }
 
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string) *AuditLogger {
+	return NewAggregatingAuditLogger(ns, kIf, component, 0)
+}
+
+// NewAggregatingAuditLogger returns an audit logger which increments the count of an existing event instead of creating a new
+// one if an identical event was recorded within the aggregation window. This prevents flapping objects from flooding etcd.
+func NewAggregatingAuditLogger(ns string, kIf kubernetes.Interface, component string, aggregationWindow time.Duration) *AuditLogger {
 	return &AuditLogger{
-		ns:        ns,
-		kIf:       kIf,
-		component: component,
+		ns:                ns,
+		kIf:               kIf,
+		component:         component,
+		aggregationWindow: aggregationWindow,
+		recentEvents:      make(map[string]*v1.Event),
+		lock:              &sync.Mutex{},
 	}
 }
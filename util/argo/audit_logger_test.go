@@ -0,0 +1,53 @@
+package argo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func listEvents(t *testing.T, kubeClient *fake.Clientset) []v1.Event {
+	list, err := kubeClient.CoreV1().Events("default").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	return list.Items
+}
+
+func TestAuditLoggerAggregation(t *testing.T) {
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	outOfSync := EventInfo{Reason: EventReasonOutOfSync, Type: v1.EventTypeWarning}
+	synced := EventInfo{Reason: EventReasonSynced, Type: v1.EventTypeNormal}
+
+	kubeClient := fake.NewSimpleClientset()
+	logger := NewAggregatingAuditLogger("default", kubeClient, "test", time.Minute)
+	for i := 0; i < 3; i++ {
+		logger.LogAppEvent(app, outOfSync, "Updated sync status: Synced -> OutOfSync")
+		logger.LogAppEvent(app, synced, "Updated sync status: OutOfSync -> Synced")
+	}
+	events := listEvents(t, kubeClient)
+	if assert.Len(t, events, 2) {
+		for _, event := range events {
+			assert.Equal(t, int32(3), event.Count)
+			assert.Equal(t, "guestbook", event.InvolvedObject.Name)
+		}
+	}
+
+	// identical events are no longer aggregated once the window has passed
+	logger.recentEvents[events[0].InvolvedObject.Kind+"/default/guestbook/Warning/OutOfSync/Updated sync status: Synced -> OutOfSync"].LastTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	logger.LogAppEvent(app, outOfSync, "Updated sync status: Synced -> OutOfSync")
+	assert.Len(t, listEvents(t, kubeClient), 3)
+}
+
+func TestAuditLoggerWithoutAggregation(t *testing.T) {
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	kubeClient := fake.NewSimpleClientset()
+	logger := NewAuditLogger("default", kubeClient, "test")
+	logger.LogAppEvent(app, EventInfo{Reason: EventReasonResourceUpdated, Type: v1.EventTypeNormal}, "updated application")
+	logger.LogAppEvent(app, EventInfo{Reason: EventReasonResourceUpdated, Type: v1.EventTypeNormal}, "updated application")
+	assert.Len(t, listEvents(t, kubeClient), 2)
+}
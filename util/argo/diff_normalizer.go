@@ -2,8 +2,10 @@ package argo
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
+	"github.com/argoproj/argo-cd/pkg/apis/application"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/diff"
 
@@ -19,18 +21,61 @@ type normalizerPatch struct {
 	namespace string
 	name      string
 	patch     jsonpatch.Patch
+	// jqExpression is set instead of the patch for jq path expressions, which may remove several fields at once
+	jqExpression *jqPathExpression
+}
+
+// normalizerManagers holds the managedFields managers whose fields are ignored for matching resources
+type normalizerManagers struct {
+	groupKind schema.GroupKind
+	namespace string
+	name      string
+	managers  []string
 }
 
 type normalizer struct {
-	patches []normalizerPatch
+	patches  []normalizerPatch
+	managers []normalizerManagers
+	// defaulters holds built-in normalizers which apply spec defaulting to resources of the given group/kind
+	defaulters map[schema.GroupKind]defaulter
+	// resolveServer resolves the destination cluster names of Application resources, it may be nil
+	resolveServer DestinationServerResolver
 }
 
+// defaulter applies spec defaulting to a resource
+type defaulter func(un *unstructured.Unstructured, resolveServer DestinationServerResolver) error
+
+// DestinationServerResolver returns the server of the cluster an application destination references by name
+type DestinationServerResolver func(dest v1alpha1.ApplicationDestination) (string, error)
+
 type overrideIgnoreDiff struct {
-	JSONPointers []string `yaml:"jsonPointers"`
+	JSONPointers      []string `yaml:"jsonPointers"`
+	JQPathExpressions []string `yaml:"jqPathExpressions"`
+	// ManagedFieldsManagers holds the managers of the live resource whose fields are ignored
+	ManagedFieldsManagers []string `yaml:"managedFieldsManagers"`
+	// NormalizeDefaults enables the built-in defaulting normalizer for the resource kind (if one exists)
+	NormalizeDefaults bool `yaml:"normalizeDefaults"`
+	// IgnoredMetadataKeys holds the patterns of annotation and label keys whose changes don't make the resource OutOfSync
+	IgnoredMetadataKeys []string `yaml:"ignoredMetadataKeys"`
+}
+
+// builtinDefaulters holds the built-in normalizers which apply the same spec defaulting as the API server/controller
+var builtinDefaulters = map[schema.GroupKind]defaulter{
+	{Group: application.Group, Kind: application.ApplicationKind}: normalizeApplicationDefaults,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:     normalizeAggregatedClusterRole,
 }
 
 // NewDiffNormalizer creates diff normalizer which removes ignored fields according to given application spec and resource overrides
+// The normalizer holds no mutable state, so it is safe for concurrent use, e.g. by the parallel diff of a comparison.
 func NewDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride) (diff.Normalizer, error) {
+	return NewDiffNormalizerWithResolver(ignore, overrides, nil)
+}
+
+// NewDiffNormalizerWithResolver creates diff normalizer like NewDiffNormalizer, which additionally resolves the
+// destination cluster names of Application resources if their defaults are normalized, so that a destination which
+// references the cluster by name is not reported as different from the same destination referencing its server.
+func NewDiffNormalizerWithResolver(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride, resolveServer DestinationServerResolver) (diff.Normalizer, error) {
+	defaulters := make(map[schema.GroupKind]defaulter)
 	for key, override := range overrides {
 		parts := strings.Split(key, "/")
 		if len(parts) < 2 {
@@ -44,16 +89,31 @@ func NewDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides ma
 			if err != nil {
 				return nil, err
 			}
+			groupKind := schema.GroupKind{Group: group, Kind: kind}
+			if defaulter, ok := builtinDefaulters[groupKind]; ok && ignoreSettings.NormalizeDefaults {
+				defaulters[groupKind] = defaulter
+			}
 
 			ignore = append(ignore, v1alpha1.ResourceIgnoreDifferences{
-				Group:        group,
-				Kind:         kind,
-				JSONPointers: ignoreSettings.JSONPointers,
+				Group:                 group,
+				Kind:                  kind,
+				JSONPointers:          ignoreSettings.JSONPointers,
+				JQPathExpressions:     ignoreSettings.JQPathExpressions,
+				ManagedFieldsManagers: ignoreSettings.ManagedFieldsManagers,
 			})
 		}
 	}
 	patches := make([]normalizerPatch, 0)
+	managers := make([]normalizerManagers, 0)
 	for i := range ignore {
+		if len(ignore[i].ManagedFieldsManagers) > 0 {
+			managers = append(managers, normalizerManagers{
+				groupKind: schema.GroupKind{Group: ignore[i].Group, Kind: ignore[i].Kind},
+				name:      ignore[i].Name,
+				namespace: ignore[i].Namespace,
+				managers:  ignore[i].ManagedFieldsManagers,
+			})
+		}
 		for _, path := range ignore[i].JSONPointers {
 			patchData, err := json.Marshal([]map[string]string{{"op": "remove", "path": path}})
 			if err != nil {
@@ -70,23 +130,48 @@ func NewDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides ma
 				patch:     patch,
 			})
 		}
-
+		for _, expression := range ignore[i].JQPathExpressions {
+			jqExpression, err := compileJQPathExpression(expression)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jq path expression '%s' of %s/%s: %v", expression, ignore[i].Group, ignore[i].Kind, err)
+			}
+			patches = append(patches, normalizerPatch{
+				groupKind:    schema.GroupKind{Group: ignore[i].Group, Kind: ignore[i].Kind},
+				name:         ignore[i].Name,
+				namespace:    ignore[i].Namespace,
+				jqExpression: jqExpression,
+			})
+		}
 	}
-	return &normalizer{patches: patches}, nil
+	return &normalizer{patches: patches, managers: managers, defaulters: defaulters, resolveServer: resolveServer}, nil
 }
 
 // Normalize removes fields from supplied resource using json paths from matching items of specified resources ignored differences list
 func (n *normalizer) Normalize(un *unstructured.Unstructured) error {
+	groupKind := un.GroupVersionKind().GroupKind()
+	if defaulter, ok := n.defaulters[groupKind]; ok {
+		if err := defaulter(un, n.resolveServer); err != nil {
+			return err
+		}
+	}
 	matched := make([]normalizerPatch, 0)
+	jqExpressions := make([]*jqPathExpression, 0)
 	for _, patch := range n.patches {
-		groupKind := un.GroupVersionKind().GroupKind()
 		if groupKind == patch.groupKind &&
 			(patch.name == "" || patch.name == un.GetName()) &&
 			(patch.namespace == "" || patch.namespace == un.GetNamespace()) {
 
-			matched = append(matched, patch)
+			if patch.jqExpression != nil {
+				jqExpressions = append(jqExpressions, patch.jqExpression)
+			} else {
+				matched = append(matched, patch)
+			}
 		}
 	}
+	// jq path expressions which select nothing leave the resource unchanged
+	for _, expression := range jqExpressions {
+		expression.deletePaths(un.Object)
+	}
 	if len(matched) == 0 {
 		return nil
 	}
@@ -111,3 +196,112 @@ func (n *normalizer) Normalize(un *unstructured.Unstructured) error {
 	}
 	return nil
 }
+
+// NormalizeWithLiveState removes the fields of the supplied resource which are owned by the ignored managers of the
+// matching items of the ignored differences list, as recorded in the managed fields of the live resource. Live resources
+// without managed fields (e.g. of clusters which don't track them) are left unchanged.
+func (n *normalizer) NormalizeWithLiveState(un, live *unstructured.Unstructured) error {
+	if len(n.managers) == 0 {
+		return nil
+	}
+	groupKind := live.GroupVersionKind().GroupKind()
+	ignoredManagers := make(map[string]bool)
+	for _, managers := range n.managers {
+		if groupKind == managers.groupKind &&
+			(managers.name == "" || managers.name == live.GetName()) &&
+			(managers.namespace == "" || managers.namespace == live.GetNamespace()) {
+
+			for _, manager := range managers.managers {
+				ignoredManagers[manager] = true
+			}
+		}
+	}
+	if len(ignoredManagers) == 0 {
+		return nil
+	}
+	fieldSets, err := getManagedFieldSets(live, ignoredManagers)
+	if err != nil {
+		return err
+	}
+	for _, fieldSet := range fieldSets {
+		removeManagedFields(un.Object, fieldSet)
+	}
+	return nil
+}
+
+// normalizeApplicationDefaults applies the same spec defaulting to an Application resource which is applied by the
+// API server and the controller, so that a minimal Application manifest is not reported as different from its
+// defaulted live state. A destination cluster name which can be resolved is replaced by the server of the cluster.
+func normalizeApplicationDefaults(un *unstructured.Unstructured, resolveServer DestinationServerResolver) error {
+	specObj, ok, err := unstructured.NestedMap(un.Object, "spec")
+	if err != nil || !ok {
+		return err
+	}
+	specData, err := json.Marshal(specObj)
+	if err != nil {
+		return err
+	}
+	var spec v1alpha1.ApplicationSpec
+	if err = json.Unmarshal(specData, &spec); err != nil {
+		return err
+	}
+	spec = *NormalizeApplicationSpec(&spec)
+	if spec.SyncPolicy != nil && spec.SyncPolicy.IsZero() {
+		spec.SyncPolicy = nil
+	}
+	if spec.Destination.Name != "" && resolveServer != nil {
+		// unknown cluster names are compared as they are, the comparison of the child application reports them
+		if server, err := resolveServer(spec.Destination); err == nil {
+			spec.Destination.Server = server
+			spec.Destination.Name = ""
+		}
+	}
+	specData, err = json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	specObj = make(map[string]interface{})
+	if err = json.Unmarshal(specData, &specObj); err != nil {
+		return err
+	}
+	return unstructured.SetNestedMap(un.Object, specObj, "spec")
+}
+
+// normalizeAggregatedClusterRole removes the rules of a ClusterRole which has an aggregation rule, since the rules
+// of an aggregated ClusterRole are populated by the controller manager.
+func normalizeAggregatedClusterRole(un *unstructured.Unstructured, _ DestinationServerResolver) error {
+	if _, ok, err := unstructured.NestedFieldNoCopy(un.Object, "aggregationRule"); err != nil || !ok {
+		return err
+	}
+	unstructured.RemoveNestedField(un.Object, "rules")
+	return nil
+}
+
+type compositeNormalizer []diff.Normalizer
+
+// NewCompositeNormalizer creates diff normalizer which applies all given normalizers in order
+func NewCompositeNormalizer(normalizers ...diff.Normalizer) diff.Normalizer {
+	return compositeNormalizer(normalizers)
+}
+
+// Normalize applies all normalizers to the supplied resource
+func (n compositeNormalizer) Normalize(un *unstructured.Unstructured) error {
+	for _, normalizer := range n {
+		if err := normalizer.Normalize(un); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NormalizeWithLiveState applies all normalizers which depend on the live state to the supplied resource
+func (n compositeNormalizer) NormalizeWithLiveState(un, live *unstructured.Unstructured) error {
+	for _, normalizer := range n {
+		if liveStateNormalizer, ok := normalizer.(diff.LiveStateNormalizer); ok {
+			if err := liveStateNormalizer.NormalizeWithLiveState(un, live); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package argo
 
 import (
+	"fmt"
+	"io/ioutil"
 	"testing"
 
 	"github.com/ghodss/yaml"
@@ -9,6 +11,7 @@ import (
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/kube"
 )
 
@@ -75,6 +78,200 @@ func TestNormalizeMatchedResourceOverrides(t *testing.T) {
 	assert.False(t, has)
 }
 
+func TestNormalizeJQPathExpressions(t *testing.T) {
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:             "apps",
+		Kind:              "Deployment",
+		JQPathExpressions: []string{`.spec.template.spec.containers[] | select(.name == "missing")`},
+	}}, map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {
+			IgnoreDifferences: `jqPathExpressions: [".spec.template.spec.containers[] | select(.name == \"demo\") | .image"]`,
+		},
+	})
+	assert.NoError(t, err)
+
+	deployment := kube.MustToUnstructured(test.DemoDeployment())
+	err = normalizer.Normalize(deployment)
+	assert.NoError(t, err)
+	containers, has, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	assert.NoError(t, err)
+	assert.True(t, has)
+	assert.Len(t, containers, 1)
+	assert.NotContains(t, containers[0], "image")
+	assert.Equal(t, "demo", containers[0].(map[string]interface{})["name"])
+}
+
+func TestNormalizeInvalidJQPathExpression(t *testing.T) {
+	_, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:             "apps",
+		Kind:              "Deployment",
+		JQPathExpressions: []string{`.spec.template.spec.containers[`},
+	}}, nil)
+	assert.Error(t, err)
+
+	_, err = NewDiffNormalizer(nil, map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {IgnoreDifferences: `jqPathExpressions: ["spec"]`},
+	})
+	assert.Error(t, err)
+}
+
+const testManagedFieldsTargetYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:1.0
+`
+
+const testManagedFieldsLiveYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  annotations:
+    cert-manager.io/issuer: letsencrypt
+  managedFields:
+  - manager: argocd-application-controller
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"guestbook"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+  - manager: cert-manager
+    operation: Update
+    apiVersion: apps/v1
+    fields:
+      f:metadata:
+        f:annotations:
+          .: {}
+          f:cert-manager.io/issuer: {}
+  - manager: sidecar-injector
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"guestbook"}:
+                f:ports:
+                  k:{"containerPort":15090,"protocol":"TCP"}:
+                    .: {}
+                    f:containerPort: {}
+              k:{"name":"proxy"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:1.0
+        ports:
+        - containerPort: 15090
+          protocol: TCP
+      - name: proxy
+        image: proxy:1.0
+`
+
+func TestNormalizeManagedFieldsManagers(t *testing.T) {
+	var target, live unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(testManagedFieldsTargetYAML), &target))
+	assert.NoError(t, yaml.Unmarshal([]byte(testManagedFieldsLiveYAML), &live))
+	liveCopy := live.DeepCopy()
+
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, make(map[string]v1alpha1.ResourceOverride))
+	assert.NoError(t, err)
+	assert.True(t, diff.Diff(&target, &live, normalizer).Modified)
+
+	normalizer, err = NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:                 "apps",
+		Kind:                  "Deployment",
+		ManagedFieldsManagers: []string{"kube-controller-manager", "cert-manager", "sidecar-injector"},
+	}}, make(map[string]v1alpha1.ResourceOverride))
+	assert.NoError(t, err)
+	assert.False(t, diff.Diff(&target, &live, normalizer).Modified)
+	// the live resource (e.g. of the cluster cache) is not mutated
+	assert.Equal(t, liveCopy, &live)
+
+	liveStateNormalizer, ok := normalizer.(diff.LiveStateNormalizer)
+	if !assert.True(t, ok) {
+		return
+	}
+	normalizedLive := live.DeepCopy()
+	assert.NoError(t, liveStateNormalizer.NormalizeWithLiveState(normalizedLive, &live))
+	_, hasReplicas, err := unstructured.NestedFieldNoCopy(normalizedLive.Object, "spec", "replicas")
+	assert.NoError(t, err)
+	assert.False(t, hasReplicas)
+	assert.Empty(t, normalizedLive.GetAnnotations())
+	containers, _, err := unstructured.NestedSlice(normalizedLive.Object, "spec", "template", "spec", "containers")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "guestbook", "image": "guestbook:1.0", "ports": []interface{}{}}}, containers)
+
+	// the target state is normalized as well, e.g. so that changes of the replicas in Git are ignored
+	normalizedTarget := target.DeepCopy()
+	assert.NoError(t, liveStateNormalizer.NormalizeWithLiveState(normalizedTarget, &live))
+	_, hasReplicas, err = unstructured.NestedFieldNoCopy(normalizedTarget.Object, "spec", "replicas")
+	assert.NoError(t, err)
+	assert.False(t, hasReplicas)
+}
+
+func TestNormalizeManagedFieldsManagersOverride(t *testing.T) {
+	var target, live unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(testManagedFieldsTargetYAML), &target))
+	assert.NoError(t, yaml.Unmarshal([]byte(testManagedFieldsLiveYAML), &live))
+
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {
+			IgnoreDifferences: `managedFieldsManagers: [kube-controller-manager]`,
+		},
+	})
+	assert.NoError(t, err)
+	res := diff.Diff(&target, &live, normalizer)
+	assert.True(t, res.Modified)
+	assert.NotContains(t, res.ModifiedPaths(), []string{"spec", "replicas"})
+}
+
+func TestNormalizeManagedFieldsManagersWithoutManagedFields(t *testing.T) {
+	var target, live unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(testManagedFieldsTargetYAML), &target))
+	assert.NoError(t, yaml.Unmarshal([]byte(testManagedFieldsTargetYAML), &live))
+	unstructured.SetNestedField(live.Object, int64(3), "spec", "replicas")
+
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:                 "apps",
+		Kind:                  "Deployment",
+		ManagedFieldsManagers: []string{"kube-controller-manager"},
+	}}, make(map[string]v1alpha1.ResourceOverride))
+	assert.NoError(t, err)
+	assert.True(t, diff.Diff(&target, &live, normalizer).Modified)
+}
+
 const testCRDYAML = `
 apiVersion: apiextensions.k8s.io/v1beta1
 kind: CustomResourceDefinition
@@ -116,3 +313,157 @@ func TestNormalizeMissingJsonPointer(t *testing.T) {
 	err = normalizer.Normalize(&crd)
 	assert.NoError(t, err)
 }
+
+const testMinimalAppYAML = `
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: child
+spec:
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    path: guestbook
+    helm: {}
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: default
+  syncPolicy: {}`
+
+const testDefaultedAppYAML = `
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: child
+spec:
+  project: default
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    path: guestbook
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: default`
+
+func TestNormalizeApplicationDefaults(t *testing.T) {
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{
+		"argoproj.io/Application": {
+			IgnoreDifferences: `normalizeDefaults: true`,
+		},
+	})
+	assert.NoError(t, err)
+
+	var target, live unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(testMinimalAppYAML), &target))
+	assert.NoError(t, yaml.Unmarshal([]byte(testDefaultedAppYAML), &live))
+
+	res := diff.Diff(&target, &live, normalizer)
+	assert.False(t, res.Modified)
+
+	assert.NoError(t, normalizer.Normalize(&target))
+	project, _, err := unstructured.NestedString(target.Object, "spec", "project")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", project)
+}
+
+func TestNormalizeApplicationDefaultsDisabled(t *testing.T) {
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{
+		"argoproj.io/Application": {
+			IgnoreDifferences: `normalizeDefaults: false`,
+		},
+	})
+	assert.NoError(t, err)
+
+	var target, live unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(testMinimalAppYAML), &target))
+	assert.NoError(t, yaml.Unmarshal([]byte(testDefaultedAppYAML), &live))
+
+	res := diff.Diff(&target, &live, normalizer)
+	assert.True(t, res.Modified)
+}
+
+func TestNormalizeApplicationDefaultsSyncPolicy(t *testing.T) {
+	normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{
+		"argoproj.io/Application": {
+			IgnoreDifferences: `normalizeDefaults: true`,
+		},
+	})
+	assert.NoError(t, err)
+
+	for name, syncPolicy := range map[string]map[string]interface{}{
+		"Retry":       {"retry": map[string]interface{}{"limit": int64(5)}},
+		"SyncOptions": {"syncOptions": []interface{}{"CreateNamespace=true"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var target, live unstructured.Unstructured
+			assert.NoError(t, yaml.Unmarshal([]byte(testMinimalAppYAML), &target))
+			assert.NoError(t, yaml.Unmarshal([]byte(testDefaultedAppYAML), &live))
+			assert.NoError(t, unstructured.SetNestedMap(target.Object, syncPolicy, "spec", "syncPolicy"))
+
+			// a sync policy without automated sync is still compared
+			res := diff.Diff(&target, &live, normalizer)
+			assert.True(t, res.Modified)
+		})
+	}
+}
+
+func TestNormalizeApplicationDefaultsDestinationName(t *testing.T) {
+	overrides := map[string]v1alpha1.ResourceOverride{
+		"argoproj.io/Application": {
+			IgnoreDifferences: `normalizeDefaults: true`,
+		},
+	}
+	resolveServer := func(dest v1alpha1.ApplicationDestination) (string, error) {
+		return ResolveDestinationServer(dest, []v1alpha1.Cluster{{Name: "in-cluster", Server: "https://kubernetes.default.svc"}})
+	}
+	newApps := func(name string) (*unstructured.Unstructured, *unstructured.Unstructured) {
+		var target, live unstructured.Unstructured
+		assert.NoError(t, yaml.Unmarshal([]byte(testMinimalAppYAML), &target))
+		assert.NoError(t, yaml.Unmarshal([]byte(testDefaultedAppYAML), &live))
+		unstructured.RemoveNestedField(target.Object, "spec", "destination", "server")
+		assert.NoError(t, unstructured.SetNestedField(target.Object, name, "spec", "destination", "name"))
+		return &target, &live
+	}
+
+	normalizer, err := NewDiffNormalizerWithResolver([]v1alpha1.ResourceIgnoreDifferences{}, overrides, resolveServer)
+	assert.NoError(t, err)
+	target, live := newApps("in-cluster")
+	assert.False(t, diff.Diff(target, live, normalizer).Modified)
+
+	// unknown cluster names are compared as they are
+	target, live = newApps("unknown")
+	assert.True(t, diff.Diff(target, live, normalizer).Modified)
+
+	// the names are not resolved without resolver
+	normalizer, err = NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, overrides)
+	assert.NoError(t, err)
+	target, live = newApps("in-cluster")
+	assert.True(t, diff.Diff(target, live, normalizer).Modified)
+}
+
+func mustLoadFixture(t *testing.T, path string) *unstructured.Unstructured {
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	var un unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal(data, &un))
+	return &un
+}
+
+func TestNormalizeAggregatedClusterRole(t *testing.T) {
+	target := mustLoadFixture(t, "testdata/aggregated-clusterrole-config.yaml")
+	live := mustLoadFixture(t, "testdata/aggregated-clusterrole-live.yaml")
+	normalizeDefaults := func(enabled bool) diff.Normalizer {
+		normalizer, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{
+			"rbac.authorization.k8s.io/ClusterRole": {IgnoreDifferences: fmt.Sprintf("normalizeDefaults: %v", enabled)},
+		})
+		assert.NoError(t, err)
+		return normalizer
+	}
+
+	assert.True(t, diff.Diff(target, live, normalizeDefaults(false)).Modified)
+	assert.False(t, diff.Diff(target, live, normalizeDefaults(true)).Modified)
+
+	// rules of cluster roles without aggregation rule are compared
+	unstructured.RemoveNestedField(target.Object, "aggregationRule")
+	unstructured.RemoveNestedField(live.Object, "aggregationRule")
+	live.SetAnnotations(nil)
+	assert.True(t, diff.Diff(target, live, normalizeDefaults(true)).Modified)
+}
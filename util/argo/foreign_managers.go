@@ -0,0 +1,110 @@
+package argo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// ForeignManagers detects resources which carry the labels or annotations of a tool other than Argo CD managing them,
+// e.g. Flux or Helm. Two tools managing the same resource silently revert each other's changes.
+type ForeignManagers struct {
+	managers []foreignManager
+}
+
+type foreignManager struct {
+	name        string
+	labels      []metadataPattern
+	annotations []metadataPattern
+}
+
+// metadataPattern matches label or annotation keys and, if a value is set, their value
+type metadataPattern struct {
+	key   glob.Glob
+	value string
+}
+
+// NewForeignManagers creates ForeignManagers which detect the given tools
+func NewForeignManagers(managers []settings.ForeignManager) (*ForeignManagers, error) {
+	f := &ForeignManagers{}
+	for _, manager := range managers {
+		labels, err := compileMetadataPatterns(manager.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid labels of foreign manager %s: %v", manager.Name, err)
+		}
+		annotations, err := compileMetadataPatterns(manager.Annotations)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotations of foreign manager %s: %v", manager.Name, err)
+		}
+		f.managers = append(f.managers, foreignManager{name: manager.Name, labels: labels, annotations: annotations})
+	}
+	return f, nil
+}
+
+func compileMetadataPatterns(patterns []string) ([]metadataPattern, error) {
+	compiled := make([]metadataPattern, len(patterns))
+	for i, pattern := range patterns {
+		parts := strings.SplitN(pattern, "=", 2)
+		key, err := glob.Compile(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		compiled[i] = metadataPattern{key: key}
+		if len(parts) == 2 {
+			compiled[i].value = parts[1]
+		}
+	}
+	return compiled, nil
+}
+
+// Detect returns the name of the tool which manages the given live resource and the label or annotation it was detected
+// by. Labels and annotations which are also set by the target resource are part of the application manifests and
+// are ignored, e.g. the app.kubernetes.io/managed-by label rendered by Helm charts.
+func (f *ForeignManagers) Detect(live, target *unstructured.Unstructured) (string, string, bool) {
+	if f == nil || live == nil {
+		return "", "", false
+	}
+	var targetLabels, targetAnnotations map[string]string
+	if target != nil {
+		targetLabels, targetAnnotations = target.GetLabels(), target.GetAnnotations()
+	}
+	for _, manager := range f.managers {
+		if key, ok := matchMetadata(manager.labels, live.GetLabels(), targetLabels); ok {
+			return manager.name, fmt.Sprintf("label %s", key), true
+		}
+		if key, ok := matchMetadata(manager.annotations, live.GetAnnotations(), targetAnnotations); ok {
+			return manager.name, fmt.Sprintf("annotation %s", key), true
+		}
+	}
+	return "", "", false
+}
+
+// matchMetadata returns the first key of the live labels or annotations which matches one of the patterns and is not
+// set to the same value by the target
+func matchMetadata(patterns []metadataPattern, live, target map[string]string) (string, bool) {
+	if len(patterns) == 0 || len(live) == 0 {
+		return "", false
+	}
+	keys := make([]string, 0, len(live))
+	for key := range live {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := live[key]
+		if targetValue, ok := target[key]; ok && targetValue == value {
+			continue
+		}
+		for _, pattern := range patterns {
+			if pattern.key.Match(key) && (pattern.value == "" || pattern.value == value) {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
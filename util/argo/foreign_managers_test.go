@@ -0,0 +1,63 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func TestForeignManagers(t *testing.T) {
+	foreignManagers, err := NewForeignManagers([]settings.ForeignManager{
+		{Name: "Flux", Labels: []string{"kustomize.toolkit.fluxcd.io/*"}},
+		{Name: "Helm", Labels: []string{"app.kubernetes.io/managed-by=Helm"}, Annotations: []string{"meta.helm.sh/release-name"}},
+	})
+	assert.NoError(t, err)
+
+	t.Run("Unmanaged", func(t *testing.T) {
+		_, _, ok := foreignManagers.Detect(test.NewPod(), test.NewPod())
+		assert.False(t, ok)
+	})
+	t.Run("LabelKeyPattern", func(t *testing.T) {
+		live := test.NewPod()
+		live.SetLabels(map[string]string{"kustomize.toolkit.fluxcd.io/name": "apps"})
+		name, marker, ok := foreignManagers.Detect(live, test.NewPod())
+		assert.True(t, ok)
+		assert.Equal(t, "Flux", name)
+		assert.Equal(t, "label kustomize.toolkit.fluxcd.io/name", marker)
+	})
+	t.Run("LabelValue", func(t *testing.T) {
+		live := test.NewPod()
+		live.SetLabels(map[string]string{"app.kubernetes.io/managed-by": "Tiller"})
+		_, _, ok := foreignManagers.Detect(live, nil)
+		assert.False(t, ok)
+		live.SetLabels(map[string]string{"app.kubernetes.io/managed-by": "Helm"})
+		name, marker, ok := foreignManagers.Detect(live, nil)
+		assert.True(t, ok)
+		assert.Equal(t, "Helm", name)
+		assert.Equal(t, "label app.kubernetes.io/managed-by", marker)
+	})
+	t.Run("Annotation", func(t *testing.T) {
+		live := test.NewPod()
+		live.SetAnnotations(map[string]string{"meta.helm.sh/release-name": "my-release"})
+		name, marker, ok := foreignManagers.Detect(live, test.NewPod())
+		assert.True(t, ok)
+		assert.Equal(t, "Helm", name)
+		assert.Equal(t, "annotation meta.helm.sh/release-name", marker)
+	})
+	t.Run("SetByTarget", func(t *testing.T) {
+		live := test.NewPod()
+		live.SetLabels(map[string]string{"app.kubernetes.io/managed-by": "Helm"})
+		target := test.NewPod()
+		target.SetLabels(map[string]string{"app.kubernetes.io/managed-by": "Helm"})
+		_, _, ok := foreignManagers.Detect(live, target)
+		assert.False(t, ok)
+	})
+}
+
+func TestForeignManagers_InvalidPattern(t *testing.T) {
+	_, err := NewForeignManagers([]settings.ForeignManager{{Name: "Broken", Labels: []string{"["}}})
+	assert.Error(t, err)
+}
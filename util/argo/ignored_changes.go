@@ -0,0 +1,126 @@
+package argo
+
+import (
+	"strings"
+
+	"github.com/gobwas/glob"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// alwaysIgnoredPaths holds the paths of fields which are written by the API server, controllers or operators rather
+// than by the user, so changes of them never make a resource OutOfSync
+var alwaysIgnoredPaths = [][]string{
+	{"status"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+}
+
+// IgnoredChanges matches the changes of a resource which are caused by controllers and operators updating the live
+// resource rather than by a change of the desired state: status updates, server managed metadata and annotations or
+// labels with configured keys. A resource whose only changes are ignored is considered in sync.
+type IgnoredChanges struct {
+	metadataKeys     []glob.Glob
+	kindMetadataKeys map[schema.GroupKind][]glob.Glob
+	excluded         map[string]bool
+}
+
+// NewIgnoredChanges creates IgnoredChanges which ignore annotations and labels matching the given key patterns, and the
+// key patterns configured per group/kind in the `ignoredMetadataKeys` field of the ignoreDifferences of resource
+// overrides. Changes of the app instance labels are never ignored.
+func NewIgnoredChanges(metadataKeys []string, overrides map[string]v1alpha1.ResourceOverride, appLabelKeys ...string) (*IgnoredChanges, error) {
+	c := &IgnoredChanges{
+		kindMetadataKeys: make(map[schema.GroupKind][]glob.Glob),
+		excluded: map[string]bool{
+			common.LabelKeyAppInstance:      true,
+			common.AnnotationKeyAppInstance: true,
+		},
+	}
+	for _, key := range appLabelKeys {
+		c.excluded[key] = true
+	}
+	var err error
+	if c.metadataKeys, err = compileGlobs(metadataKeys); err != nil {
+		return nil, err
+	}
+	for key, override := range overrides {
+		parts := strings.Split(key, "/")
+		if len(parts) < 2 || override.IgnoreDifferences == "" {
+			continue
+		}
+		ignoreSettings := overrideIgnoreDiff{}
+		if err := yaml.Unmarshal([]byte(override.IgnoreDifferences), &ignoreSettings); err != nil {
+			return nil, err
+		}
+		if len(ignoreSettings.IgnoredMetadataKeys) == 0 {
+			continue
+		}
+		patterns, err := compileGlobs(ignoreSettings.IgnoredMetadataKeys)
+		if err != nil {
+			return nil, err
+		}
+		c.kindMetadataKeys[schema.GroupKind{Group: parts[0], Kind: parts[1]}] = patterns
+	}
+	return c, nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	compiled := make([]glob.Glob, len(patterns))
+	for i, pattern := range patterns {
+		var err error
+		if compiled[i], err = glob.Compile(pattern); err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
+
+// IsIgnored returns true if all the given changed paths of a resource of the given group/kind are ignored
+func (c *IgnoredChanges) IsIgnored(groupKind schema.GroupKind, paths [][]string) bool {
+	if c == nil || len(paths) == 0 {
+		return false
+	}
+	for _, path := range paths {
+		if !c.isIgnoredPath(groupKind, path) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *IgnoredChanges) isIgnoredPath(groupKind schema.GroupKind, path []string) bool {
+	for _, ignored := range alwaysIgnoredPaths {
+		if hasPathPrefix(path, ignored) {
+			return true
+		}
+	}
+	if len(path) < 3 || path[0] != "metadata" || path[1] != "annotations" && path[1] != "labels" || c.excluded[path[2]] {
+		return false
+	}
+	return matchAny(c.metadataKeys, path[2]) || matchAny(c.kindMetadataKeys[groupKind], path[2])
+}
+
+func hasPathPrefix(path []string, prefix []string) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(patterns []glob.Glob, key string) bool {
+	for _, pattern := range patterns {
+		if pattern.Match(key) {
+			return true
+		}
+	}
+	return false
+}
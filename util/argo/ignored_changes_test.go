@@ -0,0 +1,68 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+var widgetGroupKind = schema.GroupKind{Group: "example.com", Kind: "Widget"}
+
+func TestIgnoredChanges_AlwaysIgnoredPaths(t *testing.T) {
+	ignored, err := NewIgnoredChanges(nil, nil, common.LabelKeyAppInstance)
+	assert.NoError(t, err)
+
+	assert.True(t, ignored.IsIgnored(widgetGroupKind, [][]string{
+		{"status", "phase"},
+		{"metadata", "resourceVersion"},
+		{"metadata", "generation"},
+		{"metadata", "managedFields", "0", "manager"},
+	}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"status", "phase"}, {"spec", "replicas"}}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"metadata", "annotations", "example.com/owner"}}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, nil))
+
+	var nilIgnored *IgnoredChanges
+	assert.False(t, nilIgnored.IsIgnored(widgetGroupKind, [][]string{{"status"}}))
+}
+
+func TestIgnoredChanges_MetadataKeys(t *testing.T) {
+	ignored, err := NewIgnoredChanges([]string{"operator.example.com/*", "*"}, nil, "my-label", "my-legacy-label")
+	assert.NoError(t, err)
+
+	assert.True(t, ignored.IsIgnored(widgetGroupKind, [][]string{
+		{"metadata", "annotations", "operator.example.com/last-reconciled"},
+		{"metadata", "labels", "foo"},
+	}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"metadata", "labels", "my-label"}}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"metadata", "labels", "my-legacy-label"}}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"metadata", "labels", common.LabelKeyAppInstance}}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"metadata", "annotations", common.AnnotationKeyAppInstance}}))
+	assert.False(t, ignored.IsIgnored(widgetGroupKind, [][]string{{"metadata", "name"}}))
+}
+
+func TestIgnoredChanges_ResourceOverrides(t *testing.T) {
+	ignored, err := NewIgnoredChanges(nil, map[string]v1alpha1.ResourceOverride{
+		"example.com/Widget": {IgnoreDifferences: `ignoredMetadataKeys: ["operator.example.com/*"]`},
+		"apps/Deployment":    {IgnoreDifferences: `jsonPointers: ["/spec/replicas"]`},
+	}, common.LabelKeyAppInstance)
+	assert.NoError(t, err)
+
+	paths := [][]string{{"metadata", "annotations", "operator.example.com/last-reconciled"}}
+	assert.True(t, ignored.IsIgnored(widgetGroupKind, paths))
+	assert.False(t, ignored.IsIgnored(schema.GroupKind{Group: "apps", Kind: "Deployment"}, paths))
+}
+
+func TestIgnoredChanges_InvalidPattern(t *testing.T) {
+	_, err := NewIgnoredChanges([]string{"[foo"}, nil, common.LabelKeyAppInstance)
+	assert.Error(t, err)
+
+	_, err = NewIgnoredChanges(nil, map[string]v1alpha1.ResourceOverride{
+		"example.com/Widget": {IgnoreDifferences: `ignoredMetadataKeys: ["[foo"]`},
+	}, common.LabelKeyAppInstance)
+	assert.Error(t, err)
+}
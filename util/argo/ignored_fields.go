@@ -0,0 +1,90 @@
+package argo
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+// PreserveIgnoredFields returns a copy of the target resource in which fields ignored by the given normalizer hold the
+// values of the live resource. Fields are considered ignored if the normalizer removes them from the live resource.
+// Ignored fields which don't exist in the live resource keep the target value.
+func PreserveIgnoredFields(target, live *unstructured.Unstructured, normalizer diff.Normalizer) (*unstructured.Unstructured, error) {
+	if target == nil || live == nil || normalizer == nil {
+		return target, nil
+	}
+	normalizedLive := live.DeepCopy()
+	if err := normalizer.Normalize(normalizedLive); err != nil {
+		return nil, err
+	}
+	if liveStateNormalizer, ok := normalizer.(diff.LiveStateNormalizer); ok {
+		if err := liveStateNormalizer.NormalizeWithLiveState(normalizedLive, live); err != nil {
+			return nil, err
+		}
+	}
+	patchedTarget := target.DeepCopy()
+	preserveIgnoredMapFields(patchedTarget.Object, live.DeepCopy().Object, normalizedLive.Object)
+	return patchedTarget, nil
+}
+
+// preserveIgnoredMapFields copies the fields of live which were removed from normalizedLive into target
+func preserveIgnoredMapFields(target, live, normalizedLive map[string]interface{}) {
+	for key, liveVal := range live {
+		normalizedVal, ok := normalizedLive[key]
+		if !ok {
+			target[key] = liveVal
+			continue
+		}
+		targetVal, ok := target[key]
+		if !ok {
+			continue
+		}
+		if preserved, ok := preserveIgnoredValue(targetVal, liveVal, normalizedVal); ok {
+			target[key] = preserved
+		}
+	}
+}
+
+// preserveIgnoredValue returns the target value with ignored fields preserved. The second return value is false if the
+// target value should be kept unchanged.
+func preserveIgnoredValue(targetVal, liveVal, normalizedVal interface{}) (interface{}, bool) {
+	switch live := liveVal.(type) {
+	case map[string]interface{}:
+		normalized, ok := normalizedVal.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		target, ok := targetVal.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		preserveIgnoredMapFields(target, live, normalized)
+		return target, true
+	case []interface{}:
+		normalized, ok := normalizedVal.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		if len(normalized) != len(live) {
+			// items were removed from the array, so the whole live array is preserved since the remaining items can't
+			// be matched to the target items by index
+			return live, true
+		}
+		target, ok := targetVal.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		for i := range live {
+			if i >= len(target) || reflect.DeepEqual(live[i], normalized[i]) {
+				continue
+			}
+			if preserved, ok := preserveIgnoredValue(target[i], live[i], normalized[i]); ok {
+				target[i] = preserved
+			}
+		}
+		return target, true
+	}
+	return nil, false
+}
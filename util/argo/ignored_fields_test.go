@@ -0,0 +1,94 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+func newIgnoreDifferencesNormalizer(t *testing.T, jsonPointers ...string) diff.Normalizer {
+	n, err := NewDiffNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointers: jsonPointers,
+	}}, make(map[string]v1alpha1.ResourceOverride))
+	assert.NoError(t, err)
+	return n
+}
+
+func TestPreserveIgnoredFields_Scalar(t *testing.T) {
+	target := test.NewDeployment()
+	live := test.NewDeployment()
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(5), "spec", "replicas"))
+	assert.NoError(t, unstructured.SetNestedField(live.Object, "live", "spec", "template", "metadata", "labels", "app"))
+
+	patched, err := PreserveIgnoredFields(target, live, newIgnoreDifferencesNormalizer(t, "/spec/replicas"))
+	assert.NoError(t, err)
+
+	replicas, _, _ := unstructured.NestedInt64(patched.Object, "spec", "replicas")
+	assert.Equal(t, int64(5), replicas)
+	app, _, _ := unstructured.NestedString(patched.Object, "spec", "template", "metadata", "labels", "app")
+	assert.Equal(t, "nginx", app)
+	// the original target is not modified
+	replicas, _, _ = unstructured.NestedInt64(target.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+}
+
+func TestPreserveIgnoredFields_ArrayItemField(t *testing.T) {
+	target := test.NewDeployment()
+	live := test.NewDeployment()
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.17"
+	containers[0].(map[string]interface{})["ports"] = []interface{}{map[string]interface{}{"containerPort": int64(8080)}}
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers"))
+
+	patched, err := PreserveIgnoredFields(target, live, newIgnoreDifferencesNormalizer(t, "/spec/template/spec/containers/0/image"))
+	assert.NoError(t, err)
+
+	containers, _, _ = unstructured.NestedSlice(patched.Object, "spec", "template", "spec", "containers")
+	assert.Len(t, containers, 1)
+	container := containers[0].(map[string]interface{})
+	assert.Equal(t, "nginx:1.17", container["image"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"containerPort": int64(80)}}, container["ports"])
+}
+
+func TestPreserveIgnoredFields_Array(t *testing.T) {
+	target := test.NewDeployment()
+	live := test.NewDeployment()
+	liveContainers := []interface{}{
+		map[string]interface{}{"name": "nginx", "image": "nginx:1.17"},
+		map[string]interface{}{"name": "sidecar", "image": "envoy"},
+	}
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, liveContainers, "spec", "template", "spec", "containers"))
+
+	patched, err := PreserveIgnoredFields(target, live, newIgnoreDifferencesNormalizer(t, "/spec/template/spec/containers"))
+	assert.NoError(t, err)
+
+	containers, _, _ := unstructured.NestedSlice(patched.Object, "spec", "template", "spec", "containers")
+	assert.Equal(t, liveContainers, containers)
+}
+
+func TestPreserveIgnoredFields_MissingInLive(t *testing.T) {
+	target := test.NewDeployment()
+	live := test.NewDeployment()
+	unstructured.RemoveNestedField(live.Object, "spec", "replicas")
+
+	patched, err := PreserveIgnoredFields(target, live, newIgnoreDifferencesNormalizer(t, "/spec/replicas"))
+	assert.NoError(t, err)
+
+	replicas, _, _ := unstructured.NestedInt64(patched.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+}
+
+func TestPreserveIgnoredFields_NoLive(t *testing.T) {
+	target := test.NewDeployment()
+
+	patched, err := PreserveIgnoredFields(target, nil, newIgnoreDifferencesNormalizer(t, "/spec/replicas"))
+	assert.NoError(t, err)
+	assert.Equal(t, target, patched)
+}
@@ -0,0 +1,599 @@
+package argo
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jqPathExpression is a compiled jq path expression, e.g. `.spec.containers[] | select(.name == "istio-proxy") | .image`.
+// Only the subset of jq which selects paths is supported: field, index and iteration suffixes, pipes and select filters
+// with comparisons of paths and literals combined by `and` and `or`.
+type jqPathExpression struct {
+	terms []jqTerm
+}
+
+// jqTerm is a term of a pipe, which is either a path or a select filter
+type jqTerm struct {
+	steps  []jqStep
+	filter *jqCondition
+}
+
+type jqStepKind int
+
+const (
+	jqStepField jqStepKind = iota
+	jqStepIndex
+	jqStepIterate
+)
+
+type jqStep struct {
+	kind  jqStepKind
+	field string
+	index int
+}
+
+// jqCondition is the condition of a select filter. Logical operators combine the left and right conditions,
+// comparisons compare the operand to the other operand, and single operands are tested for truthiness.
+type jqCondition struct {
+	op          string
+	left, right *jqCondition
+	operand     *jqOperand
+	other       *jqOperand
+}
+
+// jqOperand is either a path relative to the filtered value or a literal
+type jqOperand struct {
+	path    []jqStep
+	literal interface{}
+	isPath  bool
+}
+
+// jqMatch is a path selected by an expression and the value at the path, which is nil if it does not exist
+type jqMatch struct {
+	path  []interface{}
+	value interface{}
+}
+
+// compileJQPathExpression parses the given jq path expression
+func compileJQPathExpression(source string) (*jqPathExpression, error) {
+	tokens, err := tokenizeJQ(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &jqParser{tokens: tokens}
+	terms, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != jqTokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos)
+	}
+	return &jqPathExpression{terms: terms}, nil
+}
+
+// paths returns the paths which the expression selects in the given document. Expressions which don't match the
+// structure of the document, e.g. which iterate over a missing field, select nothing rather than failing.
+func (e *jqPathExpression) paths(doc interface{}) [][]interface{} {
+	matches := []jqMatch{{path: []interface{}{}, value: doc}}
+	for _, term := range e.terms {
+		var next []jqMatch
+		for _, match := range matches {
+			if term.filter != nil {
+				if isJQTruthy(term.filter.eval(match.value)) {
+					next = append(next, match)
+				}
+				continue
+			}
+			next = append(next, evalJQSteps(match, term.steps)...)
+		}
+		matches = next
+	}
+	paths := make([][]interface{}, 0, len(matches))
+	for _, match := range matches {
+		paths = append(paths, match.path)
+	}
+	return paths
+}
+
+// deletePaths removes all the paths which the expression selects from the given object. The paths are removed in
+// descending order, so that removing an element of a list does not shift the indices of the other selected elements.
+func (e *jqPathExpression) deletePaths(obj map[string]interface{}) {
+	paths := e.paths(obj)
+	sort.Slice(paths, func(i, j int) bool {
+		return compareJQPaths(paths[i], paths[j]) > 0
+	})
+	for i, path := range paths {
+		if len(path) == 0 || i > 0 && compareJQPaths(path, paths[i-1]) == 0 {
+			continue
+		}
+		deleteJQPath(obj, path)
+	}
+}
+
+func evalJQSteps(match jqMatch, steps []jqStep) []jqMatch {
+	matches := []jqMatch{match}
+	for _, step := range steps {
+		var next []jqMatch
+		for _, m := range matches {
+			next = append(next, evalJQStep(m, step)...)
+		}
+		matches = next
+	}
+	return matches
+}
+
+func evalJQStep(match jqMatch, step jqStep) []jqMatch {
+	child := func(key interface{}, value interface{}) jqMatch {
+		path := make([]interface{}, len(match.path), len(match.path)+1)
+		copy(path, match.path)
+		return jqMatch{path: append(path, key), value: value}
+	}
+	switch step.kind {
+	case jqStepField:
+		switch value := match.value.(type) {
+		case map[string]interface{}:
+			return []jqMatch{child(step.field, value[step.field])}
+		case nil:
+			return []jqMatch{child(step.field, nil)}
+		}
+	case jqStepIndex:
+		if value, ok := match.value.([]interface{}); ok {
+			index := step.index
+			if index < 0 {
+				index += len(value)
+			}
+			if index >= 0 && index < len(value) {
+				return []jqMatch{child(index, value[index])}
+			}
+		}
+	case jqStepIterate:
+		switch value := match.value.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(value))
+			for key := range value {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			matches := make([]jqMatch, 0, len(keys))
+			for _, key := range keys {
+				matches = append(matches, child(key, value[key]))
+			}
+			return matches
+		case []interface{}:
+			matches := make([]jqMatch, 0, len(value))
+			for i := range value {
+				matches = append(matches, child(i, value[i]))
+			}
+			return matches
+		}
+	}
+	return nil
+}
+
+func (c *jqCondition) eval(value interface{}) interface{} {
+	switch c.op {
+	case "and":
+		return isJQTruthy(c.left.eval(value)) && isJQTruthy(c.right.eval(value))
+	case "or":
+		return isJQTruthy(c.left.eval(value)) || isJQTruthy(c.right.eval(value))
+	case "==":
+		return reflect.DeepEqual(normalizeJQValue(c.operand.eval(value)), normalizeJQValue(c.other.eval(value)))
+	case "!=":
+		return !reflect.DeepEqual(normalizeJQValue(c.operand.eval(value)), normalizeJQValue(c.other.eval(value)))
+	}
+	return c.operand.eval(value)
+}
+
+func (o *jqOperand) eval(value interface{}) interface{} {
+	if !o.isPath {
+		return o.literal
+	}
+	matches := evalJQSteps(jqMatch{value: value}, o.path)
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[0].value
+}
+
+// isJQTruthy returns whether jq considers the value true, which applies to all values except false and null
+func isJQTruthy(value interface{}) bool {
+	return value != nil && value != false
+}
+
+// normalizeJQValue converts numbers to float64, since unstructured objects hold integers as int64
+func normalizeJQValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			normalized[key] = normalizeJQValue(val)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i := range v {
+			normalized[i] = normalizeJQValue(v[i])
+		}
+		return normalized
+	}
+	return value
+}
+
+// compareJQPaths orders paths element by element, with indices before keys, and prefixes before longer paths
+func compareJQPaths(a, b []interface{}) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		switch x := a[i].(type) {
+		case int:
+			y, ok := b[i].(int)
+			if !ok {
+				return -1
+			}
+			if x != y {
+				if x < y {
+					return -1
+				}
+				return 1
+			}
+		case string:
+			y, ok := b[i].(string)
+			if !ok {
+				return 1
+			}
+			if c := strings.Compare(x, y); c != 0 {
+				return c
+			}
+		}
+	}
+	return len(a) - len(b)
+}
+
+// deleteJQPath removes the given path from the object, which is a no-op if the path does not exist
+func deleteJQPath(obj map[string]interface{}, path []interface{}) {
+	var deleteFrom func(value interface{}, path []interface{}) interface{}
+	deleteFrom = func(value interface{}, path []interface{}) interface{} {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			key, ok := path[0].(string)
+			if !ok {
+				return value
+			}
+			child, ok := v[key]
+			if !ok {
+				return value
+			}
+			if len(path) == 1 {
+				delete(v, key)
+			} else {
+				v[key] = deleteFrom(child, path[1:])
+			}
+		case []interface{}:
+			index, ok := path[0].(int)
+			if !ok || index < 0 || index >= len(v) {
+				return value
+			}
+			if len(path) == 1 {
+				return append(v[:index:index], v[index+1:]...)
+			}
+			v[index] = deleteFrom(v[index], path[1:])
+		}
+		return value
+	}
+	deleteFrom(obj, path)
+}
+
+type jqTokenKind int
+
+const (
+	jqTokenEOF jqTokenKind = iota
+	jqTokenDot
+	jqTokenIdent
+	jqTokenString
+	jqTokenNumber
+	jqTokenPunct
+)
+
+type jqToken struct {
+	kind  jqTokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+func (t jqToken) String() string {
+	if t.kind == jqTokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("'%s'", t.text)
+}
+
+func isJQIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isJQIdentPart(c byte) bool {
+	return isJQIdentStart(c) || c >= '0' && c <= '9'
+}
+
+func tokenizeJQ(source string) ([]jqToken, error) {
+	var tokens []jqToken
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '.':
+			if i+1 < len(source) && source[i+1] == '.' {
+				return nil, fmt.Errorf("recursive descent at position %d is not supported", i)
+			}
+			tokens = append(tokens, jqToken{kind: jqTokenDot, text: ".", pos: i})
+			i++
+		case isJQIdentStart(c):
+			start := i
+			for i < len(source) && isJQIdentPart(source[i]) {
+				i++
+			}
+			tokens = append(tokens, jqToken{kind: jqTokenIdent, text: source[start:i], pos: start})
+		case c == '"':
+			start := i
+			for i++; i < len(source) && source[i] != '"'; i++ {
+				if source[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(source) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			value, err := strconv.Unquote(source[start:i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %v", start, err)
+			}
+			tokens = append(tokens, jqToken{kind: jqTokenString, text: source[start:i], value: value, pos: start})
+		case c == '-' || c >= '0' && c <= '9':
+			start := i
+			for i++; i < len(source) && (source[i] >= '0' && source[i] <= '9' || source[i] == '.'); i++ {
+			}
+			value, err := strconv.ParseFloat(source[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number at position %d: %v", start, err)
+			}
+			tokens = append(tokens, jqToken{kind: jqTokenNumber, text: source[start:i], value: value, pos: start})
+		case c == '=' || c == '!':
+			if i+1 >= len(source) || source[i+1] != '=' {
+				return nil, fmt.Errorf("unexpected '%c' at position %d", c, i)
+			}
+			tokens = append(tokens, jqToken{kind: jqTokenPunct, text: source[i : i+2], pos: i})
+			i += 2
+		case strings.IndexByte("[]()|?", c) >= 0:
+			tokens = append(tokens, jqToken{kind: jqTokenPunct, text: string(c), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected '%c' at position %d", c, i)
+		}
+	}
+	return append(tokens, jqToken{kind: jqTokenEOF, pos: len(source)}), nil
+}
+
+type jqParser struct {
+	tokens []jqToken
+	pos    int
+}
+
+func (p *jqParser) peek() jqToken {
+	return p.tokens[p.pos]
+}
+
+func (p *jqParser) next() jqToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != jqTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *jqParser) isPunct(text string) bool {
+	tok := p.peek()
+	return tok.kind == jqTokenPunct && tok.text == text
+}
+
+func (p *jqParser) expectPunct(text string) error {
+	if tok := p.next(); tok.kind != jqTokenPunct || tok.text != text {
+		return fmt.Errorf("expected '%s' but got %s at position %d", text, tok, tok.pos)
+	}
+	return nil
+}
+
+func (p *jqParser) parsePipe() ([]jqTerm, error) {
+	var terms []jqTerm
+	for {
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if !p.isPunct("|") {
+			return terms, nil
+		}
+		p.next()
+	}
+}
+
+func (p *jqParser) parseTerm() (jqTerm, error) {
+	if tok := p.peek(); tok.kind == jqTokenIdent && tok.text == "select" {
+		p.next()
+		if err := p.expectPunct("("); err != nil {
+			return jqTerm{}, err
+		}
+		condition, err := p.parseOr()
+		if err != nil {
+			return jqTerm{}, err
+		}
+		if err := p.expectPunct(")"); err != nil {
+			return jqTerm{}, err
+		}
+		return jqTerm{filter: condition}, nil
+	}
+	steps, err := p.parsePath()
+	if err != nil {
+		return jqTerm{}, err
+	}
+	return jqTerm{steps: steps}, nil
+}
+
+// parsePath parses a path which starts with a dot, e.g. `.`, `.metadata.labels["app"]` or `.[0]`
+func (p *jqParser) parsePath() ([]jqStep, error) {
+	tok := p.next()
+	if tok.kind != jqTokenDot {
+		return nil, fmt.Errorf("expected a path but got %s at position %d", tok, tok.pos)
+	}
+	steps := make([]jqStep, 0)
+	switch tok := p.peek(); tok.kind {
+	case jqTokenIdent, jqTokenString:
+		p.next()
+		steps = append(steps, jqStep{kind: jqStepField, field: jqFieldName(tok)})
+	}
+	for {
+		switch tok := p.peek(); {
+		case tok.kind == jqTokenDot:
+			p.next()
+			field := p.next()
+			if field.kind != jqTokenIdent && field.kind != jqTokenString {
+				return nil, fmt.Errorf("expected a field name but got %s at position %d", field, field.pos)
+			}
+			steps = append(steps, jqStep{kind: jqStepField, field: jqFieldName(field)})
+		case p.isPunct("["):
+			p.next()
+			step, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+		case p.isPunct("?"):
+			// errors are always suppressed, since mismatching values select nothing
+			p.next()
+		default:
+			return steps, nil
+		}
+	}
+}
+
+func (p *jqParser) parseBracket() (jqStep, error) {
+	var step jqStep
+	switch tok := p.next(); {
+	case tok.kind == jqTokenPunct && tok.text == "]":
+		return jqStep{kind: jqStepIterate}, nil
+	case tok.kind == jqTokenString:
+		step = jqStep{kind: jqStepField, field: tok.value.(string)}
+	case tok.kind == jqTokenNumber:
+		index := tok.value.(float64)
+		if index != float64(int(index)) {
+			return jqStep{}, fmt.Errorf("index %s at position %d is not an integer", tok.text, tok.pos)
+		}
+		step = jqStep{kind: jqStepIndex, index: int(index)}
+	default:
+		return jqStep{}, fmt.Errorf("expected an index, a string or ']' but got %s at position %d", tok, tok.pos)
+	}
+	return step, p.expectPunct("]")
+}
+
+func jqFieldName(tok jqToken) string {
+	if tok.kind == jqTokenString {
+		return tok.value.(string)
+	}
+	return tok.text
+}
+
+func (p *jqParser) parseOr() (*jqCondition, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok.kind == jqTokenIdent && tok.text == "or"; tok = p.peek() {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqCondition{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseAnd() (*jqCondition, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok.kind == jqTokenIdent && tok.text == "and"; tok = p.peek() {
+		p.next()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqCondition{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseComparison() (*jqCondition, error) {
+	if p.isPunct("(") {
+		p.next()
+		condition, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return condition, p.expectPunct(")")
+	}
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isPunct("==") && !p.isPunct("!=") {
+		return &jqCondition{operand: left}, nil
+	}
+	op := p.next().text
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return &jqCondition{op: op, operand: left, other: right}, nil
+}
+
+func (p *jqParser) parseOperand() (*jqOperand, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case jqTokenDot:
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		return &jqOperand{path: path, isPath: true}, nil
+	case jqTokenString, jqTokenNumber:
+		p.next()
+		return &jqOperand{literal: tok.value}, nil
+	case jqTokenIdent:
+		switch tok.text {
+		case "true":
+			p.next()
+			return &jqOperand{literal: true}, nil
+		case "false":
+			p.next()
+			return &jqOperand{literal: false}, nil
+		case "null":
+			p.next()
+			return &jqOperand{literal: nil}, nil
+		}
+	}
+	return nil, fmt.Errorf("expected a path or a literal but got %s at position %d", tok, tok.pos)
+}
@@ -0,0 +1,130 @@
+package argo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func jqTestObject(t *testing.T) map[string]interface{} {
+	obj := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal([]byte(`{
+  "metadata": {"name": "my-pod", "labels": {"app.kubernetes.io/name": "my-app", "tier": "web"}},
+  "spec": {
+    "containers": [
+      {"name": "istio-proxy", "image": "istio/proxyv2", "ports": [{"containerPort": 15090}]},
+      {"name": "app", "image": "my-app:1.0", "ports": [{"containerPort": 8080}, {"containerPort": 15090}]},
+      {"name": "istio-init", "image": "istio/proxyv2"}
+    ]
+  }
+}`), &obj))
+	return obj
+}
+
+func deleteJQPaths(t *testing.T, obj map[string]interface{}, expression string) {
+	compiled, err := compileJQPathExpression(expression)
+	if assert.NoError(t, err, expression) {
+		compiled.deletePaths(obj)
+	}
+}
+
+func jqContainerNames(obj map[string]interface{}) []string {
+	var names []string
+	for _, container := range obj["spec"].(map[string]interface{})["containers"].([]interface{}) {
+		names = append(names, container.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestJQPathExpression_SelectListElement(t *testing.T) {
+	obj := jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[] | select(.name == "istio-proxy")`)
+	assert.Equal(t, []string{"app", "istio-init"}, jqContainerNames(obj))
+}
+
+func TestJQPathExpression_SelectFieldOfListElement(t *testing.T) {
+	obj := jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[] | select(.name == "app") | .image`)
+	containers := obj["spec"].(map[string]interface{})["containers"].([]interface{})
+	assert.NotContains(t, containers[1], "image")
+	assert.Equal(t, "istio/proxyv2", containers[0].(map[string]interface{})["image"])
+}
+
+func TestJQPathExpression_DeleteMultiplePaths(t *testing.T) {
+	obj := jqTestObject(t)
+	// both istio containers are removed, although removing the first one shifts the index of the second one
+	deleteJQPaths(t, obj, `.spec.containers[] | select(.image == "istio/proxyv2")`)
+	assert.Equal(t, []string{"app"}, jqContainerNames(obj))
+
+	obj = jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[].ports[] | select(.containerPort == 15090)`)
+	containers := obj["spec"].(map[string]interface{})["containers"].([]interface{})
+	assert.Equal(t, []interface{}{}, containers[0].(map[string]interface{})["ports"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"containerPort": float64(8080)}}, containers[1].(map[string]interface{})["ports"])
+
+	obj = jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[]`)
+	assert.Equal(t, []interface{}{}, obj["spec"].(map[string]interface{})["containers"])
+}
+
+func TestJQPathExpression_Conditions(t *testing.T) {
+	obj := jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[] | select(.name != "app" and (.image == "istio/proxyv2" or .name == "other"))`)
+	assert.Equal(t, []string{"app"}, jqContainerNames(obj))
+
+	obj = jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[] | select(.ports)`)
+	assert.Equal(t, []string{"istio-init"}, jqContainerNames(obj))
+}
+
+func TestJQPathExpression_FieldsAndIndices(t *testing.T) {
+	obj := jqTestObject(t)
+	deleteJQPaths(t, obj, `.metadata.labels."app.kubernetes.io/name"`)
+	assert.Equal(t, map[string]interface{}{"tier": "web"}, obj["metadata"].(map[string]interface{})["labels"])
+
+	obj = jqTestObject(t)
+	deleteJQPaths(t, obj, `.metadata["labels"]["tier"]`)
+	assert.Equal(t, map[string]interface{}{"app.kubernetes.io/name": "my-app"}, obj["metadata"].(map[string]interface{})["labels"])
+
+	obj = jqTestObject(t)
+	deleteJQPaths(t, obj, `.spec.containers[-1]`)
+	assert.Equal(t, []string{"istio-proxy", "app"}, jqContainerNames(obj))
+
+	obj = jqTestObject(t)
+	deleteJQPaths(t, obj, `.metadata.labels[]`)
+	assert.Equal(t, map[string]interface{}{}, obj["metadata"].(map[string]interface{})["labels"])
+}
+
+func TestJQPathExpression_NoMatch(t *testing.T) {
+	for _, expression := range []string{
+		`.`,
+		`.spec.initContainers[] | select(.name == "istio-proxy")`,
+		`.spec.containers[] | select(.name == "missing")`,
+		`.spec.containers[10]`,
+		`.metadata.name.first`,
+		`.metadata.name[]`,
+		`.spec.missing.field`,
+	} {
+		obj := jqTestObject(t)
+		deleteJQPaths(t, obj, expression)
+		assert.Equal(t, jqTestObject(t), obj, expression)
+	}
+}
+
+func TestJQPathExpression_Invalid(t *testing.T) {
+	for _, expression := range []string{
+		``,
+		`spec`,
+		`.spec.containers[`,
+		`.spec.containers[] | select(.name == )`,
+		`.spec.containers[] | select(.name = "app")`,
+		`.spec.containers[0.5]`,
+		`..`,
+		`.spec | length`,
+		`.metadata.labels."unterminated`,
+	} {
+		_, err := compileJQPathExpression(expression)
+		assert.Error(t, err, expression)
+	}
+}
@@ -0,0 +1,202 @@
+package argo
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+// podSpecPaths holds the paths of the pod spec in the core workload kinds
+var podSpecPaths = map[schema.GroupKind][]string{
+	{Group: "", Kind: "Pod"}:                   {"spec"},
+	{Group: "", Kind: "ReplicationController"}: {"spec", "template", "spec"},
+	{Group: "", Kind: "PodTemplate"}:           {"template", "spec"},
+	{Group: "apps", Kind: "Deployment"}:        {"spec", "template", "spec"},
+	{Group: "apps", Kind: "ReplicaSet"}:        {"spec", "template", "spec"},
+	{Group: "apps", Kind: "StatefulSet"}:       {"spec", "template", "spec"},
+	{Group: "apps", Kind: "DaemonSet"}:         {"spec", "template", "spec"},
+	{Group: "extensions", Kind: "Deployment"}:  {"spec", "template", "spec"},
+	{Group: "extensions", Kind: "ReplicaSet"}:  {"spec", "template", "spec"},
+	{Group: "extensions", Kind: "DaemonSet"}:   {"spec", "template", "spec"},
+	{Group: "batch", Kind: "Job"}:              {"spec", "template", "spec"},
+	{Group: "batch", Kind: "CronJob"}:          {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+type knownTypesNormalizer struct{}
+
+// NewKnownTypesNormalizer creates diff normalizer which canonicalizes the representation of the fields of core
+// resource kinds which have several equivalent representations, e.g. resource quantities (`1000m` and `1`) or
+// int-or-string ports (`"8080"` and `8080`). Only fields with known types are changed, so actual differences are not hidden.
+func NewKnownTypesNormalizer() diff.Normalizer {
+	return &knownTypesNormalizer{}
+}
+
+// Normalize canonicalizes the known fields of the supplied resource
+func (n *knownTypesNormalizer) Normalize(un *unstructured.Unstructured) error {
+	gk := un.GroupVersionKind().GroupKind()
+	if path := podSpecPaths[gk]; path != nil {
+		if podSpec, ok, err := unstructured.NestedFieldNoCopy(un.Object, path...); err == nil && ok {
+			if podSpec, ok := podSpec.(map[string]interface{}); ok {
+				normalizePodSpec(podSpec)
+			}
+		}
+	}
+	switch gk {
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		for _, claim := range nestedMaps(un.Object, "spec", "volumeClaimTemplates") {
+			normalizeResourceRequirements(nestedMap(claim, "spec", "resources"))
+		}
+	case schema.GroupKind{Group: "", Kind: "PersistentVolumeClaim"}:
+		normalizeResourceRequirements(nestedMap(un.Object, "spec", "resources"))
+	case schema.GroupKind{Group: "", Kind: "ResourceQuota"}:
+		normalizeQuantities(nestedMap(un.Object, "spec", "hard"))
+	case schema.GroupKind{Group: "", Kind: "LimitRange"}:
+		for _, limit := range nestedMaps(un.Object, "spec", "limits") {
+			for _, field := range []string{"max", "min", "default", "defaultRequest", "maxLimitRequestRatio"} {
+				normalizeQuantities(nestedMap(limit, field))
+			}
+		}
+	case schema.GroupKind{Group: "", Kind: "Service"}:
+		for _, port := range nestedMaps(un.Object, "spec", "ports") {
+			normalizeIntOrString(port, "targetPort")
+		}
+	case schema.GroupKind{Group: "policy", Kind: "PodDisruptionBudget"}:
+		spec := nestedMap(un.Object, "spec")
+		normalizeIntOrString(spec, "minAvailable")
+		normalizeIntOrString(spec, "maxUnavailable")
+	}
+	return nil
+}
+
+func normalizePodSpec(podSpec map[string]interface{}) {
+	normalizeInt(podSpec, "terminationGracePeriodSeconds")
+	normalizeInt(podSpec, "activeDeadlineSeconds")
+	normalizeQuantities(nestedMap(podSpec, "overhead"))
+	for _, field := range []string{"containers", "initContainers"} {
+		for _, container := range nestedMaps(podSpec, field) {
+			normalizeResourceRequirements(nestedMap(container, "resources"))
+			for _, env := range nestedMaps(container, "env") {
+				normalizeString(env, "value")
+			}
+			for _, port := range nestedMaps(container, "ports") {
+				normalizeInt(port, "containerPort")
+				normalizeInt(port, "hostPort")
+			}
+			for _, probeField := range []string{"livenessProbe", "readinessProbe", "startupProbe"} {
+				probe := nestedMap(container, probeField)
+				if probe == nil {
+					continue
+				}
+				normalizeIntOrString(nestedMap(probe, "httpGet"), "port")
+				normalizeIntOrString(nestedMap(probe, "tcpSocket"), "port")
+				for _, field := range []string{"initialDelaySeconds", "timeoutSeconds", "periodSeconds", "successThreshold", "failureThreshold"} {
+					normalizeInt(probe, field)
+				}
+			}
+		}
+	}
+}
+
+func normalizeResourceRequirements(requirements map[string]interface{}) {
+	normalizeQuantities(nestedMap(requirements, "requests"))
+	normalizeQuantities(nestedMap(requirements, "limits"))
+}
+
+// normalizeQuantities replaces the values of the given map of resource quantities with their canonical form
+func normalizeQuantities(quantities map[string]interface{}) {
+	for name, val := range quantities {
+		var str string
+		switch val := val.(type) {
+		case string:
+			str = val
+		case int64:
+			str = strconv.FormatInt(val, 10)
+		case float64:
+			str = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			continue
+		}
+		if q, err := resource.ParseQuantity(str); err == nil {
+			quantities[name] = q.String()
+		}
+	}
+}
+
+// normalizeIntOrString replaces a numeric string value of the given int-or-string field with an integer
+func normalizeIntOrString(obj map[string]interface{}, field string) {
+	if obj == nil {
+		return
+	}
+	if str, ok := obj[field].(string); ok {
+		if i, err := strconv.ParseInt(str, 10, 32); err == nil {
+			obj[field] = i
+		}
+		return
+	}
+	normalizeInt(obj, field)
+}
+
+// normalizeInt replaces an integral float or a numeric string value of the given integer field with an integer
+func normalizeInt(obj map[string]interface{}, field string) {
+	if obj == nil {
+		return
+	}
+	switch val := obj[field].(type) {
+	case float64:
+		if val == float64(int64(val)) {
+			obj[field] = int64(val)
+		}
+	case string:
+		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+			obj[field] = i
+		}
+	}
+}
+
+// normalizeString replaces a boolean or numeric value of the given string field with its string representation
+func normalizeString(obj map[string]interface{}, field string) {
+	switch val := obj[field].(type) {
+	case bool:
+		obj[field] = strconv.FormatBool(val)
+	case int64:
+		obj[field] = strconv.FormatInt(val, 10)
+	case float64:
+		obj[field] = strconv.FormatFloat(val, 'f', -1, 64)
+	}
+}
+
+// nestedMap returns the map at the given path or nil if there is no map
+func nestedMap(obj map[string]interface{}, fields ...string) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+	val, ok, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !ok {
+		return nil
+	}
+	res, _ := val.(map[string]interface{})
+	return res
+}
+
+// nestedMaps returns the maps of the slice at the given path
+func nestedMaps(obj map[string]interface{}, fields ...string) []map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+	val, ok, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !ok {
+		return nil
+	}
+	items, _ := val.([]interface{})
+	res := make([]map[string]interface{}, 0, len(items))
+	for i := range items {
+		if item, ok := items[i].(map[string]interface{}); ok {
+			res = append(res, item)
+		}
+	}
+	return res
+}
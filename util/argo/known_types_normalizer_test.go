@@ -0,0 +1,219 @@
+package argo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+func mustUnmarshalYAML(t *testing.T, data string) *unstructured.Unstructured {
+	var un unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(data), &un.Object)
+	assert.NoError(t, err)
+	return &un
+}
+
+const podSpecTemplate = `
+      initContainers:
+      - name: init
+        image: busybox
+        resources:
+          requests:
+            cpu: %s
+      containers:
+      - name: main
+        image: nginx
+        env:
+        - name: ENABLED
+          value: %s
+        ports:
+        - containerPort: 8080
+        readinessProbe:
+          httpGet:
+            port: %s
+        resources:
+          requests:
+            memory: %s
+          limits:
+            cpu: %s
+`
+
+func TestNormalizeKnownTypesContainers(t *testing.T) {
+	normalizer := NewKnownTypesNormalizer()
+	pod := mustUnmarshalYAML(t, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  initContainers:
+  - name: init
+    resources:
+      requests:
+        cpu: 1000m
+  containers:
+  - name: main
+    env:
+    - name: ENABLED
+      value: true
+    readinessProbe:
+      httpGet:
+        port: "8080"
+      tcpSocket:
+        port: http
+    resources:
+      requests:
+        memory: 1024Mi
+        cpu: 0.5
+      limits:
+        cpu: 2
+`)
+	err := normalizer.Normalize(pod)
+	assert.NoError(t, err)
+
+	initRequests, _, _ := unstructured.NestedMap(pod.Object["spec"].(map[string]interface{})["initContainers"].([]interface{})[0].(map[string]interface{}), "resources", "requests")
+	assert.Equal(t, map[string]interface{}{"cpu": "1"}, initRequests)
+
+	container := pod.Object["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+	requests, _, _ := unstructured.NestedMap(container, "resources", "requests")
+	assert.Equal(t, map[string]interface{}{"memory": "1Gi", "cpu": "500m"}, requests)
+	limits, _, _ := unstructured.NestedMap(container, "resources", "limits")
+	assert.Equal(t, map[string]interface{}{"cpu": "2"}, limits)
+	env, _, _ := unstructured.NestedSlice(container, "env")
+	assert.Equal(t, "true", env[0].(map[string]interface{})["value"])
+	httpPort, _, _ := unstructured.NestedFieldNoCopy(container, "readinessProbe", "httpGet", "port")
+	assert.Equal(t, int64(8080), httpPort)
+	// named ports are left unchanged
+	tcpPort, _, _ := unstructured.NestedFieldNoCopy(container, "readinessProbe", "tcpSocket", "port")
+	assert.Equal(t, "http", tcpPort)
+}
+
+func TestNormalizeKnownTypesPodTemplates(t *testing.T) {
+	for _, manifest := range []string{`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deploy
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        resources:
+          requests:
+            cpu: 1000m
+`, `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: my-sts
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        resources:
+          requests:
+            cpu: 1000m
+`, `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: my-cronjob
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: main
+            resources:
+              requests:
+                cpu: 1000m
+`} {
+		obj := mustUnmarshalYAML(t, manifest)
+		err := NewKnownTypesNormalizer().Normalize(obj)
+		assert.NoError(t, err)
+
+		path := []string{"spec", "template", "spec", "containers"}
+		if obj.GetKind() == "CronJob" {
+			path = []string{"spec", "jobTemplate", "spec", "template", "spec", "containers"}
+		}
+		containers, _, _ := unstructured.NestedSlice(obj.Object, path...)
+		cpu, _, _ := unstructured.NestedString(containers[0].(map[string]interface{}), "resources", "requests", "cpu")
+		assert.Equal(t, "1", cpu, obj.GetKind())
+	}
+}
+
+func TestNormalizeKnownTypesVolumeClaimTemplates(t *testing.T) {
+	sts := mustUnmarshalYAML(t, `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: my-sts
+spec:
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      resources:
+        requests:
+          storage: 1024Mi
+`)
+	err := NewKnownTypesNormalizer().Normalize(sts)
+	assert.NoError(t, err)
+
+	claims, _, _ := unstructured.NestedSlice(sts.Object, "spec", "volumeClaimTemplates")
+	storage, _, _ := unstructured.NestedString(claims[0].(map[string]interface{}), "spec", "resources", "requests", "storage")
+	assert.Equal(t, "1Gi", storage)
+}
+
+func TestNormalizeKnownTypesUnknownKind(t *testing.T) {
+	obj := mustUnmarshalYAML(t, `
+apiVersion: example.com/v1
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  containers:
+  - name: main
+    resources:
+      requests:
+        cpu: 1000m
+`)
+	err := NewKnownTypesNormalizer().Normalize(obj)
+	assert.NoError(t, err)
+
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "containers")
+	cpu, _, _ := unstructured.NestedString(containers[0].(map[string]interface{}), "resources", "requests", "cpu")
+	assert.Equal(t, "1000m", cpu)
+}
+
+func TestDiffKnownTypes(t *testing.T) {
+	newDeployment := func(cpu, env, port, memory, limit string) *unstructured.Unstructured {
+		return mustUnmarshalYAML(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deploy
+spec:
+  template:
+    spec:`+fmt.Sprintf(podSpecTemplate, cpu, env, port, memory, limit))
+	}
+	normalizer := NewKnownTypesNormalizer()
+
+	config := newDeployment("1000m", "true", `"8080"`, "1024Mi", "0.5")
+	live := newDeployment(`"1"`, `"true"`, "8080", "1Gi", "500m")
+	assert.False(t, diff.Diff(config, live, normalizer).Modified)
+
+	// actual differences are still detected
+	live = newDeployment(`"2"`, `"true"`, "8080", "1Gi", "500m")
+	assert.True(t, diff.Diff(config, live, normalizer).Modified)
+	live = newDeployment(`"1"`, `"false"`, "8080", "1Gi", "500m")
+	assert.True(t, diff.Diff(config, live, normalizer).Modified)
+}
@@ -0,0 +1,181 @@
+package argo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// managedFieldsSelf marks that the manager owns the field or list element itself and not only some of its children
+	managedFieldsSelf = "."
+	// managedFieldsFieldPrefix prefixes the name of a map field
+	managedFieldsFieldPrefix = "f:"
+	// managedFieldsKeyPrefix prefixes the JSON encoded key fields of a list element
+	managedFieldsKeyPrefix = "k:"
+	// managedFieldsValuePrefix prefixes the JSON encoded value of a list element of a set
+	managedFieldsValuePrefix = "v:"
+	// managedFieldsIndexPrefix prefixes the index of a list element of an atomic list
+	managedFieldsIndexPrefix = "i:"
+)
+
+// getManagedFieldSets returns the field sets of the managed fields entries of the given resource which belong to
+// one of the given managers. Both the "fieldsV1" and the older "fields" entry formats are supported.
+func getManagedFieldSets(un *unstructured.Unstructured, managers map[string]bool) ([]map[string]interface{}, error) {
+	entries, ok, err := unstructured.NestedSlice(un.Object, "metadata", "managedFields")
+	if err != nil || !ok {
+		return nil, err
+	}
+	fieldSets := make([]map[string]interface{}, 0)
+	for i := range entries {
+		entry, ok := entries[i].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("managed fields entry %d of %s/%s is not an object", i, un.GetNamespace(), un.GetName())
+		}
+		if manager, _ := entry["manager"].(string); !managers[manager] {
+			continue
+		}
+		fieldSet, ok := entry["fieldsV1"]
+		if !ok {
+			fieldSet, ok = entry["fields"]
+		}
+		if !ok {
+			continue
+		}
+		fields, ok := fieldSet.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("managed fields entry %d of %s/%s has invalid fields", i, un.GetNamespace(), un.GetName())
+		}
+		fieldSets = append(fieldSets, fields)
+	}
+	return fieldSets, nil
+}
+
+// isManagedFieldsLeaf returns whether the given field set owns the whole field rather than some of its children
+func isManagedFieldsLeaf(fieldSet map[string]interface{}) bool {
+	if _, ok := fieldSet[managedFieldsSelf]; ok {
+		return len(fieldSet) == 1
+	}
+	return len(fieldSet) == 0
+}
+
+// removeManagedFields removes the fields of the given field set from the given object
+func removeManagedFields(obj map[string]interface{}, fieldSet map[string]interface{}) {
+	for key, child := range fieldSet {
+		if !strings.HasPrefix(key, managedFieldsFieldPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, managedFieldsFieldPrefix)
+		value, ok := obj[name]
+		if !ok {
+			continue
+		}
+		childSet, _ := child.(map[string]interface{})
+		if isManagedFieldsLeaf(childSet) {
+			delete(obj, name)
+			continue
+		}
+		switch typedValue := value.(type) {
+		case map[string]interface{}:
+			removeManagedFields(typedValue, childSet)
+		case []interface{}:
+			obj[name] = removeManagedListElements(typedValue, childSet)
+		}
+	}
+}
+
+// removeManagedListElements removes the elements (or the fields of elements) of the given field set from the given
+// list and returns the resulting list
+func removeManagedListElements(list []interface{}, fieldSet map[string]interface{}) []interface{} {
+	removed := make(map[int]bool)
+	for key, child := range fieldSet {
+		index := findManagedListElement(list, key)
+		if index < 0 {
+			continue
+		}
+		childSet, _ := child.(map[string]interface{})
+		if _, ok := childSet[managedFieldsSelf]; ok || len(childSet) == 0 {
+			removed[index] = true
+			continue
+		}
+		if element, ok := list[index].(map[string]interface{}); ok {
+			removeManagedFields(element, childSet)
+		}
+	}
+	if len(removed) == 0 {
+		return list
+	}
+	result := make([]interface{}, 0, len(list)-len(removed))
+	for i := range list {
+		if !removed[i] {
+			result = append(result, list[i])
+		}
+	}
+	return result
+}
+
+// findManagedListElement returns the index of the list element which is identified by the given field set key, or -1
+// if the list has no such element
+func findManagedListElement(list []interface{}, key string) int {
+	switch {
+	case strings.HasPrefix(key, managedFieldsKeyPrefix):
+		var keyFields map[string]interface{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, managedFieldsKeyPrefix)), &keyFields); err != nil {
+			return -1
+		}
+		for i := range list {
+			element, ok := list[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matches := true
+			for name, value := range keyFields {
+				if !jsonValuesEqual(element[name], value) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return i
+			}
+		}
+	case strings.HasPrefix(key, managedFieldsValuePrefix):
+		var value interface{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, managedFieldsValuePrefix)), &value); err != nil {
+			return -1
+		}
+		for i := range list {
+			if jsonValuesEqual(list[i], value) {
+				return i
+			}
+		}
+	case strings.HasPrefix(key, managedFieldsIndexPrefix):
+		index, err := strconv.Atoi(strings.TrimPrefix(key, managedFieldsIndexPrefix))
+		if err == nil && index >= 0 && index < len(list) {
+			return index
+		}
+	}
+	return -1
+}
+
+// jsonValuesEqual compares the given values by their JSON representation, so that e.g. an int64 and a float64 holding
+// the same number are equal
+func jsonValuesEqual(a, b interface{}) bool {
+	aData, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bData, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	var aValue, bValue interface{}
+	if json.Unmarshal(aData, &aValue) != nil || json.Unmarshal(bData, &bValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
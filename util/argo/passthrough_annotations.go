@@ -0,0 +1,108 @@
+package argo
+
+import (
+	"strings"
+
+	"github.com/gobwas/glob"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+// argoCDAnnotationPrefix is the prefix of annotations which control Argo CD behavior and so cannot be passed through
+const argoCDAnnotationPrefix = "argocd.argoproj.io/"
+
+// PassthroughAnnotations matches the keys of annotations which are added to live resources out-of-band (e.g. by ops
+// tooling). Matching annotations are preserved when a resource is applied and ignored during comparison.
+type PassthroughAnnotations struct {
+	patterns []glob.Glob
+	excluded map[string]bool
+}
+
+// NewPassthroughAnnotations creates PassthroughAnnotations from the given key patterns. Keys of the app instance labels
+// and of annotations used for resource tracking are never passed through.
+func NewPassthroughAnnotations(patterns []string, appLabelKeys ...string) (*PassthroughAnnotations, error) {
+	p := &PassthroughAnnotations{
+		excluded: map[string]bool{
+			common.LabelKeyAppInstance:     true,
+			common.AnnotationKeyManagedBy:  true,
+			v1.LastAppliedConfigAnnotation: true,
+		},
+	}
+	for _, key := range appLabelKeys {
+		p.excluded[key] = true
+	}
+	for _, pattern := range patterns {
+		compiled, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		p.patterns = append(p.patterns, compiled)
+	}
+	return p, nil
+}
+
+// Match returns true if the annotation with the given key should be passed through
+func (p *PassthroughAnnotations) Match(key string) bool {
+	if p == nil || p.excluded[key] || strings.HasPrefix(key, argoCDAnnotationPrefix) {
+		return false
+	}
+	for _, pattern := range p.patterns {
+		if pattern.Match(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize removes passed through annotations from the given resource so that they are never reported as a difference
+func (p *PassthroughAnnotations) Normalize(un *unstructured.Unstructured) error {
+	if p == nil || len(p.patterns) == 0 {
+		return nil
+	}
+	annotations := un.GetAnnotations()
+	if len(annotations) == 0 {
+		return nil
+	}
+	changed := false
+	for key := range annotations {
+		if p.Match(key) {
+			delete(annotations, key)
+			changed = true
+		}
+	}
+	if changed {
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		un.SetAnnotations(annotations)
+	}
+	return nil
+}
+
+// Preserve copies passed through annotations of the live resource to the target resource, so that applying the target
+// does not remove them. Annotations explicitly defined in the target take precedence.
+func (p *PassthroughAnnotations) Preserve(target, live *unstructured.Unstructured) {
+	if p == nil || len(p.patterns) == 0 || target == nil || live == nil {
+		return
+	}
+	annotations := target.GetAnnotations()
+	changed := false
+	for key, value := range live.GetAnnotations() {
+		if !p.Match(key) {
+			continue
+		}
+		if _, ok := annotations[key]; ok {
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[key] = value
+		changed = true
+	}
+	if changed {
+		target.SetAnnotations(annotations)
+	}
+}
@@ -0,0 +1,69 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/test"
+)
+
+func TestPassthroughAnnotations_Match(t *testing.T) {
+	passthrough, err := NewPassthroughAnnotations([]string{"cluster-autoscaler.kubernetes.io/*", "*"}, "my-label")
+	assert.NoError(t, err)
+
+	assert.True(t, passthrough.Match("cluster-autoscaler.kubernetes.io/safe-to-evict"))
+	assert.True(t, passthrough.Match("foo"))
+	assert.False(t, passthrough.Match("my-label"))
+	assert.False(t, passthrough.Match(common.LabelKeyAppInstance))
+	assert.False(t, passthrough.Match(v1.LastAppliedConfigAnnotation))
+	assert.False(t, passthrough.Match(common.AnnotationSyncOptions))
+
+	var nilPassthrough *PassthroughAnnotations
+	assert.False(t, nilPassthrough.Match("foo"))
+}
+
+func TestPassthroughAnnotations_InvalidPattern(t *testing.T) {
+	_, err := NewPassthroughAnnotations([]string{"[foo"}, common.LabelKeyAppInstance)
+	assert.Error(t, err)
+}
+
+func TestPassthroughAnnotations_Normalize(t *testing.T) {
+	passthrough, err := NewPassthroughAnnotations([]string{"cluster-autoscaler.kubernetes.io/*"}, common.LabelKeyAppInstance)
+	assert.NoError(t, err)
+
+	pod := test.NewPod()
+	pod.SetAnnotations(map[string]string{
+		"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+		"foo": "bar",
+	})
+	assert.NoError(t, passthrough.Normalize(pod))
+	assert.Equal(t, map[string]string{"foo": "bar"}, pod.GetAnnotations())
+
+	pod.SetAnnotations(map[string]string{"cluster-autoscaler.kubernetes.io/safe-to-evict": "true"})
+	assert.NoError(t, passthrough.Normalize(pod))
+	assert.Empty(t, pod.GetAnnotations())
+}
+
+func TestPassthroughAnnotations_Preserve(t *testing.T) {
+	passthrough, err := NewPassthroughAnnotations([]string{"cluster-autoscaler.kubernetes.io/*"}, common.LabelKeyAppInstance)
+	assert.NoError(t, err)
+
+	live := test.NewPod()
+	live.SetAnnotations(map[string]string{
+		"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+		"cluster-autoscaler.kubernetes.io/scale-down":    "false",
+		"foo": "bar",
+	})
+	target := test.NewPod()
+	target.SetAnnotations(map[string]string{"cluster-autoscaler.kubernetes.io/scale-down": "true"})
+
+	passthrough.Preserve(target, live)
+
+	assert.Equal(t, map[string]string{
+		"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+		"cluster-autoscaler.kubernetes.io/scale-down":    "true",
+	}, target.GetAnnotations())
+}
@@ -75,3 +75,19 @@ func (c *Cache) GetAppResourcesTree(appName string, res *appv1.ApplicationTree)
 func (c *Cache) SetAppResourcesTree(appName string, resourcesTree *appv1.ApplicationTree) error {
 	return c.SetItem(appResourcesTreeKey(appName), resourcesTree, c.appStateCacheExpiration, resourcesTree == nil)
 }
+
+// ClusterConnectionStateKey returns the key of the connection state of the given cluster, which is shared by the
+// application controller and the API server
+func ClusterConnectionStateKey(server string) string {
+	return fmt.Sprintf("cluster|%s|connection-state", server)
+}
+
+func (c *Cache) GetClusterConnectionState(server string) (appv1.ConnectionState, error) {
+	res := appv1.ConnectionState{}
+	err := c.GetItem(ClusterConnectionStateKey(server), &res)
+	return res, err
+}
+
+func (c *Cache) SetClusterConnectionState(server string, state *appv1.ConnectionState) error {
+	return c.SetItem(ClusterConnectionStateKey(server), &state, c.appStateCacheExpiration, state == nil)
+}
@@ -63,3 +63,20 @@ func TestAddCacheFlagsToCmd(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1*time.Hour, cache.appStateCacheExpiration)
 }
+
+func TestCache_GetClusterConnectionState(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	_, err := cache.GetClusterConnectionState("my-server")
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetClusterConnectionState("my-server", &ConnectionState{Status: ConnectionStatusFailed, Message: "my-error"})
+	assert.NoError(t, err)
+	// cache miss
+	_, err = cache.GetClusterConnectionState("other-server")
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	value, err := cache.GetClusterConnectionState("my-server")
+	assert.NoError(t, err)
+	assert.Equal(t, ConnectionState{Status: ConnectionStatusFailed, Message: "my-error"}, value)
+}
@@ -261,6 +261,9 @@ func clusterToData(c *appv1.Cluster) map[string][]byte {
 		panic(err)
 	}
 	data["config"] = configBytes
+	if len(c.Namespaces) > 0 {
+		data["namespaces"] = []byte(strings.Join(c.Namespaces, ","))
+	}
 	return data
 }
 
@@ -276,5 +279,12 @@ func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
 		Name:   string(s.Data["name"]),
 		Config: config,
 	}
+	if namespaces := string(s.Data["namespaces"]); namespaces != "" {
+		for _, ns := range strings.Split(namespaces, ",") {
+			if ns = strings.TrimSpace(ns); ns != "" {
+				cluster.Namespaces = append(cluster.Namespaces, ns)
+			}
+		}
+	}
 	return &cluster
 }
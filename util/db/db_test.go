@@ -317,6 +317,29 @@ func TestGetClusterSuccessful(t *testing.T) {
 	assert.Equal(t, clusterURL, cluster.Server)
 }
 
+func TestGetNamespaceScopedCluster(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mycluster-443",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeCluster,
+			},
+		},
+		Data: map[string][]byte{
+			"server":     []byte(clusterURL),
+			"config":     []byte("{}"),
+			"namespaces": []byte("default, guestbook,"),
+		},
+	})
+
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	cluster, err := db.GetCluster(context.Background(), clusterURL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default", "guestbook"}, cluster.Namespaces)
+}
+
 func TestGetNonExistingCluster(t *testing.T) {
 	clusterURL := "https://mycluster"
 	clientset := getClientset(nil)
@@ -335,7 +358,8 @@ func TestCreateClusterSuccessful(t *testing.T) {
 	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
 
 	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
-		Server: clusterURL,
+		Server:     clusterURL,
+		Namespaces: []string{"default", "guestbook"},
 	})
 	assert.Nil(t, err)
 
@@ -343,6 +367,7 @@ func TestCreateClusterSuccessful(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, clusterURL, string(secret.Data["server"]))
+	assert.Equal(t, "default,guestbook", string(secret.Data["namespaces"]))
 	assert.Equal(t, common.AnnotationValueManagedByArgoCD, secret.Annotations[common.AnnotationKeyManagedBy])
 }
 
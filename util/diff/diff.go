@@ -9,9 +9,14 @@ import (
 	"os/exec"
 	"path"
 	"reflect"
+	goruntime "runtime"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/ghodss/yaml"
 	"github.com/google/shlex"
+	"github.com/pmezard/go-difflib/difflib"
 	log "github.com/sirupsen/logrus"
 	"github.com/yudai/gojsondiff"
 	"github.com/yudai/gojsondiff/formatter"
@@ -35,13 +40,25 @@ type DiffResultList struct {
 	Modified bool
 }
 
+// Normalizer removes or rewrites the fields of a resource which should not be compared. Implementations must be safe
+// for concurrent use, since the resources of an application are normalized by several goroutines at once.
 type Normalizer interface {
 	Normalize(un *unstructured.Unstructured) error
 }
 
+// LiveStateNormalizer is implemented by normalizers which additionally remove fields depending on the live state of
+// the resource, e.g. the fields which are owned by some managers of the live resource. The live state must not be
+// mutated.
+type LiveStateNormalizer interface {
+	NormalizeWithLiveState(un, live *unstructured.Unstructured) error
+}
+
 // Diff performs a diff on two unstructured objects. If the live object happens to have a
 // "kubectl.kubernetes.io/last-applied-configuration", then perform a three way diff.
 func Diff(config, live *unstructured.Unstructured, normalizer Normalizer) *DiffResult {
+	// remarshalling may drop metadata which the live state normalization depends on (e.g. the managed fields), so the
+	// live state is passed to it as it was supplied
+	liveState := live
 	if config != nil {
 		config = remarshal(config)
 		Normalize(config, normalizer)
@@ -51,6 +68,11 @@ func Diff(config, live *unstructured.Unstructured, normalizer Normalizer) *DiffR
 		Normalize(live, normalizer)
 	}
 	orig := GetLastAppliedConfigAnnotation(live)
+	if live != nil {
+		normalizeWithLiveState(config, liveState, normalizer)
+		normalizeWithLiveState(orig, liveState, normalizer)
+		normalizeWithLiveState(live, liveState, normalizer)
+	}
 	if orig != nil && config != nil {
 		Normalize(orig, normalizer)
 		dr, err := ThreeWayDiff(orig, config, live)
@@ -219,26 +241,163 @@ func GetLastAppliedConfigAnnotation(live *unstructured.Unstructured) *unstructur
 // DiffArray performs a diff on a list of unstructured objects. Objects are expected to match
 // environments
 func DiffArray(configArray, liveArray []*unstructured.Unstructured, normalizer Normalizer) (*DiffResultList, error) {
+	return DiffArrayParallel(configArray, liveArray, normalizer, 1)
+}
+
+// DiffArrayParallel performs the same diff as DiffArray using up to the given number of goroutines, or
+// runtime.NumCPU() goroutines if parallelism is less than 1. The diff of an object pair is stored at the index of the
+// pair, so the result is identical to the result of DiffArray. The normalizer must be safe for concurrent use.
+func DiffArrayParallel(configArray, liveArray []*unstructured.Unstructured, normalizer Normalizer, parallelism int) (*DiffResultList, error) {
 	numItems := len(configArray)
 	if len(liveArray) != numItems {
 		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
 	}
+	if parallelism < 1 {
+		parallelism = goruntime.NumCPU()
+	}
+	if parallelism > numItems {
+		parallelism = numItems
+	}
 
 	diffResultList := DiffResultList{
 		Diffs: make([]DiffResult, numItems),
 	}
-	for i := 0; i < numItems; i++ {
-		config := configArray[i]
-		live := liveArray[i]
-		diffRes := Diff(config, live, normalizer)
-		diffResultList.Diffs[i] = *diffRes
+	if parallelism <= 1 {
+		for i := 0; i < numItems; i++ {
+			diffResultList.Diffs[i] = *Diff(configArray[i], liveArray[i], normalizer)
+		}
+	} else {
+		indexes := make(chan int, numItems)
+		for i := 0; i < numItems; i++ {
+			indexes <- i
+		}
+		close(indexes)
+		var wg sync.WaitGroup
+		wg.Add(parallelism)
+		for w := 0; w < parallelism; w++ {
+			go func() {
+				defer wg.Done()
+				for i := range indexes {
+					diffResultList.Diffs[i] = *Diff(configArray[i], liveArray[i], normalizer)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+	for _, diffRes := range diffResultList.Diffs {
 		if diffRes.Modified {
 			diffResultList.Modified = true
+			break
 		}
 	}
 	return &diffResultList, nil
 }
 
+// ModifiedPaths returns the paths of the fields which differ between the compared objects. The fields of added or
+// removed objects are returned individually, so that the changed keys of maps such as annotations can be told apart.
+// Array indexes are returned in their decimal representation.
+func (d *DiffResult) ModifiedPaths() [][]string {
+	positionPaths := d.modifiedPositionPaths()
+	if positionPaths == nil {
+		return nil
+	}
+	paths := make([][]string, len(positionPaths))
+	for i, positions := range positionPaths {
+		paths[i] = make([]string, len(positions))
+		for j, position := range positions {
+			paths[i][j] = position.String()
+		}
+	}
+	return paths
+}
+
+// ModifiedFields returns the sorted paths of the fields which differ between the compared objects in a compact
+// notation, e.g. spec.template.spec.containers[0].image. Keys which contain dots or brackets are quoted, e.g.
+// metadata.annotations["example.com/owner"]. If more than limit paths differ, only the first limit paths are returned,
+// followed by a "+N more" entry which counts the omitted paths. Any limit less than 1 returns all paths.
+func (d *DiffResult) ModifiedFields(limit int) []string {
+	positionPaths := d.modifiedPositionPaths()
+	if len(positionPaths) == 0 {
+		return nil
+	}
+	fields := make([]string, len(positionPaths))
+	for i, positions := range positionPaths {
+		fields[i] = formatFieldPath(positions)
+	}
+	sort.Strings(fields)
+	if limit > 0 && len(fields) > limit {
+		fields = append(fields[:limit], fmt.Sprintf("+%d more", len(fields)-limit))
+	}
+	return fields
+}
+
+func (d *DiffResult) modifiedPositionPaths() [][]gojsondiff.Position {
+	if d.Diff == nil {
+		return nil
+	}
+	var paths [][]gojsondiff.Position
+	collectModifiedPaths(d.Diff.Deltas(), nil, &paths)
+	return paths
+}
+
+func collectModifiedPaths(deltas []gojsondiff.Delta, parent []gojsondiff.Position, paths *[][]gojsondiff.Position) {
+	for _, delta := range deltas {
+		switch d := delta.(type) {
+		case *gojsondiff.Object:
+			collectModifiedPaths(d.Deltas, appendPath(parent, d.PostPosition()), paths)
+		case *gojsondiff.Array:
+			collectModifiedPaths(d.Deltas, appendPath(parent, d.PostPosition()), paths)
+		case *gojsondiff.Added:
+			collectValuePaths(d.Value, appendPath(parent, d.PostPosition()), paths)
+		case *gojsondiff.Deleted:
+			collectValuePaths(d.Value, appendPath(parent, d.PrePosition()), paths)
+		case gojsondiff.PostDelta:
+			*paths = append(*paths, appendPath(parent, d.PostPosition()))
+		case gojsondiff.PreDelta:
+			*paths = append(*paths, appendPath(parent, d.PrePosition()))
+		}
+	}
+}
+
+// collectValuePaths adds the paths of the fields of an added or removed value
+func collectValuePaths(value interface{}, path []gojsondiff.Position, paths *[][]gojsondiff.Position) {
+	if obj, ok := value.(map[string]interface{}); ok && len(obj) > 0 {
+		for key, val := range obj {
+			collectValuePaths(val, appendPath(path, gojsondiff.Name(key)), paths)
+		}
+		return
+	}
+	*paths = append(*paths, path)
+}
+
+func appendPath(parent []gojsondiff.Position, position gojsondiff.Position) []gojsondiff.Position {
+	path := make([]gojsondiff.Position, len(parent), len(parent)+1)
+	copy(path, parent)
+	return append(path, position)
+}
+
+// formatFieldPath formats the path of a field in the notation of ModifiedFields
+func formatFieldPath(path []gojsondiff.Position) string {
+	var sb strings.Builder
+	for _, position := range path {
+		switch p := position.(type) {
+		case gojsondiff.Index:
+			sb.WriteString(fmt.Sprintf("[%d]", int(p)))
+		default:
+			name := position.String()
+			if strings.ContainsAny(name, ".[]") {
+				sb.WriteString(fmt.Sprintf("[%q]", name))
+			} else {
+				if sb.Len() > 0 {
+					sb.WriteString(".")
+				}
+				sb.WriteString(name)
+			}
+		}
+	}
+	return sb.String()
+}
+
 // ASCIIFormat returns the ASCII format of the diff
 func (d *DiffResult) ASCIIFormat(left *unstructured.Unstructured, formatOpts formatter.AsciiFormatterConfig) (string, error) {
 	if !d.Diff.Modified() {
@@ -274,6 +433,19 @@ func Normalize(un *unstructured.Unstructured, normalizer Normalizer) {
 	}
 }
 
+// normalizeWithLiveState removes the fields of the given resource which the normalizer ignores according to the live
+// state.
+func normalizeWithLiveState(un, live *unstructured.Unstructured, normalizer Normalizer) {
+	liveStateNormalizer, ok := normalizer.(LiveStateNormalizer)
+	if !ok || un == nil {
+		return
+	}
+	err := liveStateNormalizer.NormalizeWithLiveState(un, live)
+	if err != nil {
+		log.Warnf("Failed to normalize %s/%s/%s: %v", un.GroupVersionKind(), un.GetNamespace(), un.GetName(), err)
+	}
+}
+
 // NormalizeSecret mutates the supplied object and encodes stringData to data, and converts nils to
 // empty strings. If the object is not a secret, or is an invalid secret, then returns the same object.
 func NormalizeSecret(un *unstructured.Unstructured) {
@@ -530,3 +702,64 @@ func PrintDiff(name string, live *unstructured.Unstructured, target *unstructure
 	cmd.Stdout = os.Stdout
 	return cmd.Run()
 }
+
+// TextDiff returns a unified diff between the live and the target state of a resource as it is seen by the diffing:
+// both states are normalized, secret data is hidden and the target state only includes the differences which are
+// reported for the live state. The status and last-applied-configuration annotation are omitted.
+func TextDiff(name string, config, live *unstructured.Unstructured, normalizer Normalizer) (string, error) {
+	config, live, err := HideSecretData(config, live)
+	if err != nil {
+		return "", err
+	}
+	if live != nil {
+		live = remarshal(live)
+		Normalize(live, normalizer)
+	}
+	target := config
+	if config != nil && live != nil {
+		// the target state is the live state patched with the differences, so that fields which are not managed
+		// by the application are not reported
+		res := Diff(config, live, normalizer)
+		target = live.DeepCopy()
+		gojsondiff.New().ApplyPatch(target.Object, res.Diff)
+	} else if config != nil {
+		target = remarshal(config)
+		Normalize(target, normalizer)
+	}
+	liveData, err := textDiffData(live)
+	if err != nil {
+		return "", err
+	}
+	targetData, err := textDiffData(target)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveData),
+		B:        difflib.SplitLines(targetData),
+		FromFile: fmt.Sprintf("%s-live.yaml", name),
+		ToFile:   fmt.Sprintf("%s-target.yaml", name),
+		Context:  3,
+	})
+}
+
+// textDiffData returns the YAML representation of the given object without the fields which are not diffed
+func textDiffData(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		delete(annotations, core.LastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		obj.SetAnnotations(annotations)
+	}
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
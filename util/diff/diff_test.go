@@ -1,11 +1,15 @@
 package diff
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	goruntime "runtime"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ghodss/yaml"
@@ -140,8 +144,154 @@ func TestDiffArrayModification(t *testing.T) {
 	assert.True(t, diffResList.Modified)
 }
 
+// syntheticResources returns the given number of config and live deployment pairs, the pair at every third index has
+// an index specific modification
+func syntheticResources(count int) ([]*unstructured.Unstructured, []*unstructured.Unstructured) {
+	configArray := make([]*unstructured.Unstructured, count)
+	liveArray := make([]*unstructured.Unstructured, count)
+	for i := 0; i < count; i++ {
+		dep := test.DemoDeployment()
+		dep.Name = fmt.Sprintf("demo-%d", i)
+		liveArray[i] = mustToUnstructured(dep)
+		if i%3 == 0 {
+			dep.Spec.Template.Labels[fmt.Sprintf("label-%d", i)] = "config"
+		}
+		configArray[i] = mustToUnstructured(dep)
+	}
+	return configArray, liveArray
+}
+
+type countingNormalizer struct {
+	calls int64
+}
+
+func (n *countingNormalizer) Normalize(un *unstructured.Unstructured) error {
+	atomic.AddInt64(&n.calls, 1)
+	unstructured.RemoveNestedField(un.Object, "status")
+	return nil
+}
+
+func TestDiffArrayParallel(t *testing.T) {
+	configArray, liveArray := syntheticResources(300)
+	normalizer := &countingNormalizer{}
+
+	diffResList, err := DiffArrayParallel(configArray, liveArray, normalizer, 8)
+	assert.NoError(t, err)
+	assert.True(t, diffResList.Modified)
+	assert.Len(t, diffResList.Diffs, len(configArray))
+	assert.Equal(t, int64(2*len(configArray)), atomic.LoadInt64(&normalizer.calls))
+	for i, diffRes := range diffResList.Diffs {
+		if i%3 == 0 {
+			assert.True(t, diffRes.Modified, "resource %d", i)
+			assert.Contains(t, diffRes.ModifiedPaths(), []string{"spec", "template", "metadata", "labels", fmt.Sprintf("label-%d", i)}, "resource %d", i)
+		} else {
+			assert.False(t, diffRes.Modified, "resource %d", i)
+		}
+	}
+
+	serialResList, err := DiffArray(configArray, liveArray, normalizer)
+	assert.NoError(t, err)
+	for i := range serialResList.Diffs {
+		assert.Equal(t, serialResList.Diffs[i].ModifiedPaths(), diffResList.Diffs[i].ModifiedPaths(), "resource %d", i)
+	}
+}
+
+func TestDiffArrayParallelDefaults(t *testing.T) {
+	configArray, liveArray := syntheticResources(2)
+	liveArray[0] = configArray[0]
+
+	diffResList, err := DiffArrayParallel(configArray, liveArray, nil, 0)
+	assert.NoError(t, err)
+	assert.False(t, diffResList.Modified)
+
+	_, err = DiffArrayParallel(configArray, liveArray[:1], nil, 0)
+	assert.Error(t, err)
+
+	diffResList, err = DiffArrayParallel(nil, nil, nil, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, diffResList.Diffs)
+}
+
+func BenchmarkDiffArray(b *testing.B) {
+	configArray, liveArray := syntheticResources(5000)
+	for _, parallelism := range []int{1, 0} {
+		name := "Serial"
+		if parallelism != 1 {
+			name = fmt.Sprintf("Parallel-%d", goruntime.NumCPU())
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := DiffArrayParallel(configArray, liveArray, nil, parallelism)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 // TestThreeWayDiff will perform a diff when there is a kubectl.kubernetes.io/last-applied-configuration
 // present in the live object.
+func TestModifiedPaths(t *testing.T) {
+	config := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata": map[string]interface{}{
+			"name":        "my-widget",
+			"annotations": map[string]interface{}{"example.com/owner": "team-a"},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(2), "ports": []interface{}{int64(80), int64(443)}},
+		"status": map[string]interface{}{"phase": "Running"},
+	}}
+	live := config.DeepCopy()
+	unstructured.RemoveNestedField(live.Object, "metadata", "annotations")
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(1), "spec", "replicas"))
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, []interface{}{int64(80)}, "spec", "ports"))
+	assert.NoError(t, unstructured.SetNestedField(live.Object, "Pending", "status", "phase"))
+
+	dr := Diff(&config, live, nil)
+	assert.True(t, dr.Modified)
+	assert.ElementsMatch(t, [][]string{
+		{"metadata", "annotations", "example.com/owner"},
+		{"spec", "replicas"},
+		{"spec", "ports", "1"},
+		{"status", "phase"},
+	}, dr.ModifiedPaths())
+
+	assert.Empty(t, Diff(&config, config.DeepCopy(), nil).ModifiedPaths())
+}
+
+func TestModifiedFields(t *testing.T) {
+	config := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata": map[string]interface{}{
+			"name":        "my-widget",
+			"annotations": map[string]interface{}{"example.com/owner": "team-a"},
+		},
+		"spec": map[string]interface{}{
+			"replicas":   int64(2),
+			"containers": []interface{}{map[string]interface{}{"name": "main", "image": "nginx:1.15", "imagePullPolicy": "Always", "workingDir": "/srv"}},
+		},
+	}}
+	live := config.DeepCopy()
+	unstructured.RemoveNestedField(live.Object, "metadata", "annotations")
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(1), "spec", "replicas"))
+	liveContainers := []interface{}{map[string]interface{}{"name": "main", "image": "nginx:1.14", "imagePullPolicy": "Always", "workingDir": "/srv"}}
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, liveContainers, "spec", "containers"))
+
+	dr := Diff(&config, live, nil)
+	assert.Equal(t, []string{
+		`metadata.annotations["example.com/owner"]`,
+		"spec.containers[0].image",
+		"spec.replicas",
+	}, dr.ModifiedFields(0))
+	assert.Equal(t, []string{`metadata.annotations["example.com/owner"]`, "+2 more"}, dr.ModifiedFields(1))
+	assert.Len(t, dr.ModifiedFields(3), 3)
+
+	assert.Empty(t, Diff(&config, config.DeepCopy(), nil).ModifiedFields(0))
+}
+
 func TestThreeWayDiff(t *testing.T) {
 	// 1. get config and live to be the same. Both have a foo annotation.
 	configDep := test.DemoDeployment()
@@ -588,6 +738,50 @@ func TestHideSecretDataLastAppliedConfig(t *testing.T) {
 
 }
 
+func TestTextDiff(t *testing.T) {
+	target := test.NewPod()
+	target.SetNamespace("default")
+	live := target.DeepCopy()
+	live.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: string(test.PodManifest)})
+	assert.Nil(t, unstructured.SetNestedField(live.Object, "Running", "status", "phase"))
+	assert.Nil(t, unstructured.SetNestedField(live.Object, "default", "spec", "serviceAccountName"))
+	containers, _, _ := unstructured.NestedSlice(target.Object, "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "nginx:1.12"
+	assert.Nil(t, unstructured.SetNestedSlice(target.Object, containers, "spec", "containers"))
+
+	text, err := TextDiff("my-pod", target, live, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, text, "--- my-pod-live.yaml\n+++ my-pod-target.yaml\n")
+	assert.Contains(t, text, "\n-  - image: nginx:1.7.9\n+  - image: nginx:1.12\n")
+	// live fields which are not managed are not reported as differences
+	assert.NotContains(t, text, "-  serviceAccountName: default")
+	assert.NotContains(t, text, "status")
+	assert.NotContains(t, text, corev1.LastAppliedConfigAnnotation)
+
+	text, err = TextDiff("my-pod", target, target.DeepCopy(), nil)
+	assert.Nil(t, err)
+	assert.Empty(t, text)
+}
+
+func TestTextDiffSecret(t *testing.T) {
+	target := createSecret(map[string]string{"key1": "test", "key2": "new-value"})
+	target.SetName("my-secret")
+	live := createSecret(map[string]string{"key1": "test", "key2": "old-value"})
+	live.SetName("my-secret")
+
+	text, err := TextDiff("my-secret", target, live, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, text, "-  key2: "+replacement2+"\n+  key2: "+replacement1+"\n")
+	assert.Contains(t, text, "   key1: "+replacement1+"\n")
+	for _, val := range []string{"test", "new-value", "old-value"} {
+		assert.NotContains(t, text, base64.StdEncoding.EncodeToString([]byte(val)))
+	}
+
+	text, err = TextDiff("my-secret", target, nil, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, text, "+  key1: "+replacement1+"\n")
+}
+
 func TestRemarshal(t *testing.T) {
 	manifest := []byte(`
 apiVersion: v1
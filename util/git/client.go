@@ -37,6 +37,52 @@ type RevisionMetadata struct {
 	Message string
 }
 
+const (
+	SignatureValidityGood            = "Good"
+	SignatureValidityUnknownValidity = "UnknownValidity"
+	SignatureValidityBad             = "Bad"
+	SignatureValidityExpired         = "Expired"
+	SignatureValidityExpiredKey      = "ExpiredKey"
+	SignatureValidityRevokedKey      = "RevokedKey"
+	SignatureValidityMissingKey      = "MissingKey"
+	SignatureValidityUnsigned        = "Unsigned"
+)
+
+// SignatureInfo holds the result of the GPG signature verification of a commit
+type SignatureInfo struct {
+	Validity string
+	KeyID    string
+	Signer   string
+}
+
+// Valid returns whether the commit carries a good signature. Signatures of keys with unknown trust are considered
+// valid since it is up to the caller to decide which keys are allowed.
+func (s SignatureInfo) Valid() bool {
+	return s.Validity == SignatureValidityGood || s.Validity == SignatureValidityUnknownValidity
+}
+
+// signatureValidity converts the signature status reported by the %G? placeholder of git log
+func signatureValidity(status string) string {
+	switch status {
+	case "G":
+		return SignatureValidityGood
+	case "U":
+		return SignatureValidityUnknownValidity
+	case "B":
+		return SignatureValidityBad
+	case "X":
+		return SignatureValidityExpired
+	case "Y":
+		return SignatureValidityExpiredKey
+	case "R":
+		return SignatureValidityRevokedKey
+	case "E":
+		return SignatureValidityMissingKey
+	default:
+		return SignatureValidityUnsigned
+	}
+}
+
 // Client is a generic git client interface
 type Client interface {
 	Root() string
@@ -48,6 +94,7 @@ type Client interface {
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
+	VerifyCommitSignature(revision string) (*SignatureInfo, error)
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -427,6 +474,20 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
+// VerifyCommitSignature returns the result of the GPG signature verification of the given revision. The public keys
+// of the signers must be present in the GPG keyring of the process.
+func (m *nativeGitClient) VerifyCommitSignature(revision string) (*SignatureInfo, error) {
+	out, err := m.runCmd("show", "-s", "--format=%G?|%GK|%GS", revision)
+	if err != nil {
+		return nil, err
+	}
+	segments := strings.SplitN(strings.TrimSpace(out), "|", 3)
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("expected 3 segments, got %v", segments)
+	}
+	return &SignatureInfo{Validity: signatureValidity(segments[0]), KeyID: segments[1], Signer: segments[2]}, nil
+}
+
 // runCmd is a convenience function to run a command in a given directory and return its output
 func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 	cmd := exec.Command("git", args...)
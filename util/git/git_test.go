@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 
@@ -310,3 +311,37 @@ func TestNewFactory(t *testing.T) {
 		assert.Equal(t, commitSHA, commitSHA2)
 	}
 }
+
+func TestSignatureValidity(t *testing.T) {
+	assert.Equal(t, SignatureValidityGood, signatureValidity("G"))
+	assert.Equal(t, SignatureValidityUnknownValidity, signatureValidity("U"))
+	assert.Equal(t, SignatureValidityMissingKey, signatureValidity("E"))
+	assert.Equal(t, SignatureValidityUnsigned, signatureValidity("N"))
+	assert.True(t, SignatureInfo{Validity: SignatureValidityGood}.Valid())
+	assert.True(t, SignatureInfo{Validity: SignatureValidityUnknownValidity}.Valid())
+	assert.False(t, SignatureInfo{Validity: SignatureValidityBad}.Valid())
+	assert.False(t, SignatureInfo{Validity: SignatureValidityUnsigned}.Valid())
+}
+
+func TestVerifyCommitSignature_Unsigned(t *testing.T) {
+	dirName, err := ioutil.TempDir("", "git-client-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dirName) }()
+
+	for _, args := range [][]string{
+		{"init"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "--no-gpg-sign", "-m", "unsigned"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dirName
+		out, err := cmd.CombinedOutput()
+		if !assert.NoError(t, err, string(out)) {
+			return
+		}
+	}
+
+	client := &nativeGitClient{root: dirName, creds: NopCreds{}}
+	info, err := client.VerifyCommitSignature("HEAD")
+	assert.NoError(t, err)
+	assert.Equal(t, &SignatureInfo{Validity: SignatureValidityUnsigned}, info)
+}
@@ -179,3 +179,26 @@ func (_m *Client) Root() string {
 
 	return r0
 }
+
+// VerifyCommitSignature provides a mock function with given fields: revision
+func (_m *Client) VerifyCommitSignature(revision string) (*git.SignatureInfo, error) {
+	ret := _m.Called(revision)
+
+	var r0 *git.SignatureInfo
+	if rf, ok := ret.Get(0).(func(string) *git.SignatureInfo); ok {
+		r0 = rf(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.SignatureInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,138 @@
+package health
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// Gateway API condition types and statuses
+const (
+	gatewayConditionAccepted     = "Accepted"
+	gatewayConditionProgrammed   = "Programmed"
+	gatewayConditionReady        = "Ready"
+	gatewayConditionResolvedRefs = "ResolvedRefs"
+	gatewayReasonPending         = "Pending"
+	conditionStatusTrue          = "True"
+	conditionStatusFalse         = "False"
+)
+
+type gatewayAPICondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+}
+
+type gatewayAPIParentStatus struct {
+	ParentRef struct {
+		Namespace string `json:"namespace,omitempty"`
+		Name      string `json:"name"`
+	} `json:"parentRef"`
+	Conditions []gatewayAPICondition `json:"conditions,omitempty"`
+}
+
+// gatewayAPIStatus holds the status fields of Gateway API resources which are used to assess their health
+type gatewayAPIStatus struct {
+	Conditions []gatewayAPICondition    `json:"conditions,omitempty"`
+	Parents    []gatewayAPIParentStatus `json:"parents,omitempty"`
+}
+
+func getGatewayAPIStatus(obj *unstructured.Unstructured) (*gatewayAPIStatus, error) {
+	var status gatewayAPIStatus
+	statusObj, _, err := unstructured.NestedMap(obj.Object, "status")
+	if err == nil {
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(statusObj, &status)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of %s %s: %v", obj.GetKind(), obj.GetName(), err)
+	}
+	return &status, nil
+}
+
+// findGatewayAPICondition returns the first condition of the given types which was reported for the current generation
+// of the resource, or nil if there is none
+func findGatewayAPICondition(obj *unstructured.Unstructured, conditions []gatewayAPICondition, condTypes ...string) *gatewayAPICondition {
+	for _, condType := range condTypes {
+		for i := range conditions {
+			c := conditions[i]
+			if c.Type != condType {
+				continue
+			}
+			if c.ObservedGeneration != 0 && c.ObservedGeneration < obj.GetGeneration() {
+				// the condition is stale until the controller observes the current generation
+				return nil
+			}
+			return &c
+		}
+	}
+	return nil
+}
+
+func gatewayAPIConditionMessage(c *gatewayAPICondition) string {
+	if c.Message == "" {
+		return c.Reason
+	}
+	return fmt.Sprintf("%s: %s", c.Reason, c.Message)
+}
+
+// getGatewayHealth returns the health of a Gateway, which is healthy once it is accepted and programmed by its
+// controller. Gateways reported by older Gateway API versions are programmed once they are ready.
+func getGatewayHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	status, err := getGatewayAPIStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+	if accepted := findGatewayAPICondition(obj, status.Conditions, gatewayConditionAccepted); accepted != nil && accepted.Status == conditionStatusFalse {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, Message: gatewayAPIConditionMessage(accepted)}, nil
+	}
+	programmed := findGatewayAPICondition(obj, status.Conditions, gatewayConditionProgrammed, gatewayConditionReady)
+	switch {
+	case programmed == nil || programmed.Status != conditionStatusTrue && programmed.Status != conditionStatusFalse:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, Message: "Waiting for gateway to be programmed"}, nil
+	case programmed.Status == conditionStatusTrue:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy, Message: gatewayAPIConditionMessage(programmed)}, nil
+	case programmed.Reason == gatewayReasonPending:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, Message: gatewayAPIConditionMessage(programmed)}, nil
+	default:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusDegraded, Message: gatewayAPIConditionMessage(programmed)}, nil
+	}
+}
+
+// getHTTPRouteHealth returns the health of an HTTPRoute, which is healthy once all its parent gateways have accepted it
+// and resolved its references
+func getHTTPRouteHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	status, err := getGatewayAPIStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(status.Parents) == 0 {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusProgressing, Message: "Waiting for route to be accepted by its parent gateways"}, nil
+	}
+	var progressing *appv1.HealthStatus
+	for _, parent := range status.Parents {
+		for _, condType := range []string{gatewayConditionAccepted, gatewayConditionResolvedRefs} {
+			c := findGatewayAPICondition(obj, parent.Conditions, condType)
+			if c != nil && c.Status == conditionStatusFalse {
+				return &appv1.HealthStatus{
+					Status:  appv1.HealthStatusDegraded,
+					Message: fmt.Sprintf("Parent %s: %s", parent.ParentRef.Name, gatewayAPIConditionMessage(c)),
+				}, nil
+			}
+			if condType == gatewayConditionAccepted && (c == nil || c.Status != conditionStatusTrue) && progressing == nil {
+				progressing = &appv1.HealthStatus{
+					Status:  appv1.HealthStatusProgressing,
+					Message: fmt.Sprintf("Waiting for route to be accepted by parent %s", parent.ParentRef.Name),
+				}
+			}
+		}
+	}
+	if progressing != nil {
+		return progressing, nil
+	}
+	return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}, nil
+}
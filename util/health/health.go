@@ -10,7 +10,6 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	coreV1 "k8s.io/api/core/v1"
-	extv1beta1 "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
@@ -18,17 +17,20 @@ import (
 	"k8s.io/kubernetes/pkg/apis/apps"
 	"k8s.io/kubernetes/pkg/kubectl/scheme"
 
+	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	hookutil "github.com/argoproj/argo-cd/util/hook"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/lua"
+	"github.com/argoproj/argo-cd/util/resource"
 	"github.com/argoproj/argo-cd/util/resource/ignore"
 )
 
-// SetApplicationHealth updates the health statuses of all resources performed in the comparison
-func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride, filter func(obj *unstructured.Unstructured) bool) (*appv1.HealthStatus, error) {
+// SetApplicationHealth updates the health statuses of all resources performed in the comparison and aggregates them
+// into the application health according to the given rollup policy (worst health wins if the policy is nil)
+func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride, rollupPolicy *appv1.HealthRollupPolicy, filter func(obj *unstructured.Unstructured) bool) (*appv1.HealthStatus, error) {
 	var savedErr error
-	appHealth := appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
+	var resources []rollupResource
 	for i, liveObj := range liveObjs {
 		var resHealth *appv1.HealthStatus
 		var err error
@@ -45,12 +47,19 @@ func SetApplicationHealth(resStatuses []appv1.ResourceStatus, liveObjs []*unstru
 		if resHealth != nil {
 			resStatuses[i].Health = resHealth
 			ignore := ignoreLiveObjectHealth(liveObj, *resHealth)
-			if !ignore && IsWorse(appHealth.Status, resHealth.Status) {
-				appHealth.Status = resHealth.Status
+			if !ignore {
+				obj := liveObj
+				if obj == nil {
+					obj = &unstructured.Unstructured{}
+					obj.SetGroupVersionKind(resStatuses[i].GroupVersionKind())
+					obj.SetNamespace(resStatuses[i].Namespace)
+					obj.SetName(resStatuses[i].Name)
+				}
+				resources = append(resources, rollupResource{obj: obj, health: resHealth.Status})
 			}
 		}
 	}
-	return &appHealth, savedErr
+	return &appv1.HealthStatus{Status: rollupHealth(rollupPolicy, resources)}, savedErr
 }
 
 // ignoreLiveObjectHealth determines if we should not allow the live object to affect the overall
@@ -64,6 +73,9 @@ func ignoreLiveObjectHealth(liveObj *unstructured.Unstructured, resHealth appv1.
 		if ignore.Ignore(liveObj) {
 			return true
 		}
+		if IsHealthCheckIgnored(liveObj) {
+			return true
+		}
 		gvk := liveObj.GroupVersionKind()
 		if gvk.Group == "argoproj.io" && gvk.Kind == "Application" && resHealth.Status == appv1.HealthStatusMissing {
 			// Covers the app-of-apps corner case where child app is deployed but that app itself
@@ -75,6 +87,11 @@ func ignoreLiveObjectHealth(liveObj *unstructured.Unstructured, resHealth appv1.
 	return false
 }
 
+// IsHealthCheckIgnored returns true if the resource is annotated to be excluded from the application health
+func IsHealthCheckIgnored(obj *unstructured.Unstructured) bool {
+	return obj != nil && obj.GetAnnotations()[common.AnnotationIgnoreHealthCheck] == "true"
+}
+
 // GetResourceHealth returns the health of a k8s resource
 func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[string]appv1.ResourceOverride) (*appv1.HealthStatus, error) {
 
@@ -117,6 +134,18 @@ func GetResourceHealth(obj *unstructured.Unstructured, resourceOverrides map[str
 		case "Application":
 			health, err = getApplicationHealth(obj)
 		}
+	case "networking.k8s.io":
+		switch gvk.Kind {
+		case kube.IngressKind:
+			health, err = getIngressHealth(obj)
+		}
+	case "gateway.networking.k8s.io":
+		switch gvk.Kind {
+		case "Gateway":
+			health, err = getGatewayHealth(obj)
+		case "HTTPRoute":
+			health, err = getHTTPRouteHealth(obj)
+		}
 	case "apiregistration.k8s.io":
 		switch gvk.Kind {
 		case kube.APIServiceKind:
@@ -207,19 +236,24 @@ func getPVCHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
 	}
 }
 
+// getIngressHealth returns the health of an extensions/v1beta1, networking.k8s.io/v1beta1 or networking.k8s.io/v1
+// ingress, which is progressing until the ingress controller has assigned a load balancer address, unless the check is
+// skipped using the SkipLoadBalancerCheck health option.
 func getIngressHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
-	ingress := &extv1beta1.Ingress{}
-	err := scheme.Scheme.Convert(obj, ingress, nil)
+	if resource.HasAnnotationOption(obj, common.AnnotationHealthOptions, "SkipLoadBalancerCheck") {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}, nil
+	}
+	ingress, _, err := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, ingress, err)
+		return nil, fmt.Errorf("failed to get status.loadBalancer.ingress of %s %s: %v", obj.GetKind(), obj.GetName(), err)
 	}
-	health := appv1.HealthStatus{}
-	if len(ingress.Status.LoadBalancer.Ingress) > 0 {
-		health.Status = appv1.HealthStatusHealthy
-	} else {
-		health.Status = appv1.HealthStatusProgressing
+	if len(ingress) > 0 {
+		return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}, nil
 	}
-	return &health, nil
+	return &appv1.HealthStatus{
+		Status:  appv1.HealthStatusProgressing,
+		Message: "Waiting for the ingress controller to assign a load balancer address",
+	}, nil
 }
 
 func getServiceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
@@ -245,6 +279,10 @@ func getDeploymentHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, e
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, deployment, err)
 	}
+	counts, err := kube.GetReplicaCounts(obj)
+	if err != nil {
+		return nil, err
+	}
 	if deployment.Spec.Paused {
 		return &appv1.HealthStatus{
 			Status:  appv1.HealthStatusSuspended,
@@ -255,24 +293,28 @@ func getDeploymentHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, e
 	if deployment.Generation <= deployment.Status.ObservedGeneration {
 		cond := getDeploymentCondition(deployment.Status, v1.DeploymentProgressing)
 		if cond != nil && cond.Reason == "ProgressDeadlineExceeded" {
+			message := fmt.Sprintf("Deployment %q exceeded its progress deadline (%s)", obj.GetName(), cond.Reason)
+			if cond.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, cond.Message)
+			}
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusDegraded,
-				Message: fmt.Sprintf("Deployment %q exceeded its progress deadline", obj.GetName()),
+				Message: message,
 			}, nil
-		} else if deployment.Spec.Replicas != nil && deployment.Status.UpdatedReplicas < *deployment.Spec.Replicas {
+		} else if deployment.Spec.Replicas != nil && counts.Updated < counts.Desired {
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusProgressing,
-				Message: fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", deployment.Status.UpdatedReplicas, *deployment.Spec.Replicas),
+				Message: fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", counts.Updated, counts.Desired),
 			}, nil
-		} else if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+		} else if counts.Current > counts.Updated {
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusProgressing,
-				Message: fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...", deployment.Status.Replicas-deployment.Status.UpdatedReplicas),
+				Message: fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...", counts.Current-counts.Updated),
 			}, nil
-		} else if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+		} else if counts.Available < counts.Updated {
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusProgressing,
-				Message: fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...", deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas),
+				Message: fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...", counts.Available, counts.Updated),
 			}, nil
 		}
 	} else {
@@ -309,18 +351,22 @@ func getDaemonSetHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, er
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, daemon, err)
 	}
+	counts, err := kube.GetReplicaCounts(obj)
+	if err != nil {
+		return nil, err
+	}
 	// Borrowed at kubernetes/kubectl/rollout_status.go https://github.com/kubernetes/kubernetes/blob/5232ad4a00ec93942d0b2c6359ee6cd1201b46bc/pkg/kubectl/rollout_status.go#L110
 	if daemon.Generation <= daemon.Status.ObservedGeneration {
-		if daemon.Status.UpdatedNumberScheduled < daemon.Status.DesiredNumberScheduled {
+		if counts.Updated < counts.Desired {
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusProgressing,
-				Message: fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d out of %d new pods have been updated...", daemon.Name, daemon.Status.UpdatedNumberScheduled, daemon.Status.DesiredNumberScheduled),
+				Message: fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d out of %d new pods have been updated...", daemon.Name, counts.Updated, counts.Desired),
 			}, nil
 		}
-		if daemon.Status.NumberAvailable < daemon.Status.DesiredNumberScheduled {
+		if counts.Available < counts.Desired {
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusProgressing,
-				Message: fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d of %d updated pods are available...", daemon.Name, daemon.Status.NumberAvailable, daemon.Status.DesiredNumberScheduled),
+				Message: fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d of %d updated pods are available...", daemon.Name, counts.Available, counts.Desired),
 			}, nil
 		}
 
@@ -348,10 +394,14 @@ func getStatefulSetHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus,
 			Message: "Waiting for statefulset spec update to be observed...",
 		}, nil
 	}
-	if sts.Spec.Replicas != nil && sts.Status.ReadyReplicas < *sts.Spec.Replicas {
+	counts, err := kube.GetReplicaCounts(obj)
+	if err != nil {
+		return nil, err
+	}
+	if sts.Spec.Replicas != nil && counts.Ready < counts.Desired {
 		return &appv1.HealthStatus{
 			Status:  appv1.HealthStatusProgressing,
-			Message: fmt.Sprintf("Waiting for %d pods to be ready...", *sts.Spec.Replicas-sts.Status.ReadyReplicas),
+			Message: fmt.Sprintf("Waiting for %d pods to be ready...", counts.Desired-counts.Ready),
 		}, nil
 	}
 	if sts.Spec.UpdateStrategy.Type == apps.RollingUpdateStatefulSetStrategyType && sts.Spec.UpdateStrategy.RollingUpdate != nil {
@@ -387,6 +437,10 @@ func getReplicaSetHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, e
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert %T to %T: %v", obj, replicaSet, err)
 	}
+	counts, err := kube.GetReplicaCounts(obj)
+	if err != nil {
+		return nil, err
+	}
 	if replicaSet.Generation <= replicaSet.Status.ObservedGeneration {
 		cond := getReplicaSetCondition(replicaSet.Status, v1.ReplicaSetReplicaFailure)
 		if cond != nil && cond.Status == coreV1.ConditionTrue {
@@ -394,10 +448,10 @@ func getReplicaSetHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, e
 				Status:  appv1.HealthStatusDegraded,
 				Message: cond.Message,
 			}, nil
-		} else if replicaSet.Spec.Replicas != nil && replicaSet.Status.AvailableReplicas < *replicaSet.Spec.Replicas {
+		} else if replicaSet.Spec.Replicas != nil && counts.Available < counts.Desired {
 			return &appv1.HealthStatus{
 				Status:  appv1.HealthStatusProgressing,
-				Message: fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas are available...", replicaSet.Status.AvailableReplicas, *replicaSet.Spec.Replicas),
+				Message: fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas are available...", counts.Available, counts.Desired),
 			}, nil
 		}
 	} else {
@@ -571,12 +625,19 @@ func getAPIServiceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, e
 	for _, c := range apiservice.Status.Conditions {
 		switch c.Type {
 		case apiregistrationv1.Available:
-			if c.Status == apiregistrationv1.ConditionTrue {
+			switch c.Status {
+			case apiregistrationv1.ConditionTrue:
 				return &appv1.HealthStatus{
 					Status:  appv1.HealthStatusHealthy,
 					Message: fmt.Sprintf("%s: %s", c.Reason, c.Message),
 				}, nil
-			} else {
+			case apiregistrationv1.ConditionFalse:
+				// the aggregated API is unavailable, which breaks the discovery of the APIs of the cluster
+				return &appv1.HealthStatus{
+					Status:  appv1.HealthStatusDegraded,
+					Message: fmt.Sprintf("%s: %s", c.Reason, c.Message),
+				}, nil
+			default:
 				return &appv1.HealthStatus{
 					Status:  appv1.HealthStatusProgressing,
 					Message: fmt.Sprintf("%s: %s", c.Reason, c.Message),
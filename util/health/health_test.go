@@ -38,8 +38,48 @@ func TestDeploymentHealth(t *testing.T) {
 	assertAppHealth(t, "./testdata/deployment-degraded.yaml", appv1.HealthStatusDegraded)
 }
 
+func TestDeploymentHealth_ProgressDeadlineExceeded(t *testing.T) {
+	health := getHealthStatus("./testdata/deployment-degraded.yaml", t)
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+	assert.Equal(t, `Deployment "guestbook-ui" exceeded its progress deadline (ProgressDeadlineExceeded): ReplicaSet "guestbook-ui-75dd4d49d5" has timed out progressing.`, health.Message)
+
+	// the condition is stale until the deployment controller observes the new generation of a rollout
+	yamlBytes, err := ioutil.ReadFile("./testdata/deployment-degraded.yaml")
+	assert.Nil(t, err)
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	assert.Nil(t, err)
+	obj.SetGeneration(5)
+	health, err = GetResourceHealth(&obj, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+}
+
+func TestDeploymentHealth_Paused(t *testing.T) {
+	health := getHealthStatus("./testdata/deployment-suspended.yaml", t)
+	assert.Equal(t, appv1.HealthStatusSuspended, health.Status)
+	assert.Equal(t, "Deployment is paused", health.Message)
+}
+
 func TestStatefulSetHealth(t *testing.T) {
 	assertAppHealth(t, "./testdata/statefulset.yaml", appv1.HealthStatusHealthy)
+	health := getHealthStatus("../kube/testdata/statefulset-rollout.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Waiting for 1 pods to be ready...", health.Message)
+}
+
+func TestWorkloadRolloutHealth(t *testing.T) {
+	health := getHealthStatus("../kube/testdata/deployment-rollout.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Waiting for rollout to finish: 1 out of 3 new replicas have been updated...", health.Message)
+
+	health = getHealthStatus("../kube/testdata/replicaset-rollout.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Waiting for rollout to finish: 1 out of 3 new replicas are available...", health.Message)
+
+	health = getHealthStatus("../kube/testdata/daemonset-rollout.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, `Waiting for daemon set "fluentd" rollout to finish: 2 out of 5 new pods have been updated...`, health.Message)
 }
 
 func TestPVCHealth(t *testing.T) {
@@ -58,6 +98,64 @@ func TestIngressHealth(t *testing.T) {
 	assertAppHealth(t, "./testdata/ingress.yaml", appv1.HealthStatusHealthy)
 	assertAppHealth(t, "./testdata/ingress-unassigned.yaml", appv1.HealthStatusProgressing)
 	assertAppHealth(t, "./testdata/ingress-nonemptylist.yaml", appv1.HealthStatusHealthy)
+	assertAppHealth(t, "./testdata/ingress-networking-v1beta1.yaml", appv1.HealthStatusHealthy)
+	assertAppHealth(t, "./testdata/ingress-networking-v1.yaml", appv1.HealthStatusHealthy)
+
+	health := getHealthStatus("./testdata/ingress-networking-v1-unassigned.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Waiting for the ingress controller to assign a load balancer address", health.Message)
+}
+
+func TestIngressHealth_SkipLoadBalancerCheck(t *testing.T) {
+	for _, path := range []string{"./testdata/ingress-unassigned.yaml", "./testdata/ingress-networking-v1-unassigned.yaml"} {
+		yamlBytes, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		var obj unstructured.Unstructured
+		err = yaml.Unmarshal(yamlBytes, &obj)
+		assert.Nil(t, err)
+		obj.SetAnnotations(map[string]string{common.AnnotationHealthOptions: "SkipLoadBalancerCheck"})
+		health, err := GetResourceHealth(&obj, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, appv1.HealthStatusHealthy, health.Status)
+	}
+}
+
+func TestGatewayHealth(t *testing.T) {
+	health := getHealthStatus("./testdata/gateway-programmed.yaml", t)
+	assert.Equal(t, appv1.HealthStatusHealthy, health.Status)
+	assert.Equal(t, "Programmed: Gateway is programmed", health.Message)
+
+	health = getHealthStatus("./testdata/gateway-pending.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Pending: Waiting for an address to be assigned", health.Message)
+
+	health = getHealthStatus("./testdata/gateway-not-accepted.yaml", t)
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+	assert.Equal(t, "UnsupportedValue: Listener protocol UDP is not supported", health.Message)
+
+	// the conditions are stale until the gateway controller observes the new generation
+	yamlBytes, err := ioutil.ReadFile("./testdata/gateway-not-accepted.yaml")
+	assert.Nil(t, err)
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	assert.Nil(t, err)
+	obj.SetGeneration(2)
+	health, err = GetResourceHealth(&obj, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Waiting for gateway to be programmed", health.Message)
+}
+
+func TestHTTPRouteHealth(t *testing.T) {
+	assertAppHealth(t, "./testdata/httproute-accepted.yaml", appv1.HealthStatusHealthy)
+
+	health := getHealthStatus("./testdata/httproute-unresolved-refs.yaml", t)
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+	assert.Equal(t, "Parent guestbook-gateway: BackendNotFound: Service default/guestbook-missing not found", health.Message)
+
+	health = getHealthStatus("./testdata/httproute-pending.yaml", t)
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Equal(t, "Waiting for route to be accepted by its parent gateways", health.Message)
 }
 
 func TestCRD(t *testing.T) {
@@ -122,7 +220,7 @@ func TestAppOfAppsHealth(t *testing.T) {
 	{
 		missingAndHealthyStatuses := []appv1.ResourceStatus{missingStatus, healthyStatus}
 		missingAndHealthyLiveObjects := []*unstructured.Unstructured{missingApp, healthyApp}
-		healthStatus, err := SetApplicationHealth(missingAndHealthyStatuses, missingAndHealthyLiveObjects, nil, noFilter)
+		healthStatus, err := SetApplicationHealth(missingAndHealthyStatuses, missingAndHealthyLiveObjects, nil, nil, noFilter)
 		assert.NoError(t, err)
 		assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
 	}
@@ -131,7 +229,7 @@ func TestAppOfAppsHealth(t *testing.T) {
 	{
 		degradedAndHealthyStatuses := []appv1.ResourceStatus{degradedStatus, healthyStatus}
 		degradedAndHealthyLiveObjects := []*unstructured.Unstructured{degradedApp, healthyApp}
-		healthStatus, err := SetApplicationHealth(degradedAndHealthyStatuses, degradedAndHealthyLiveObjects, nil, noFilter)
+		healthStatus, err := SetApplicationHealth(degradedAndHealthyStatuses, degradedAndHealthyLiveObjects, nil, nil, noFilter)
 		assert.NoError(t, err)
 		assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
 	}
@@ -169,22 +267,76 @@ func TestSetApplicationHealth(t *testing.T) {
 		&runningPod,
 		&failedJob,
 	}
-	healthStatus, err := SetApplicationHealth(resources, liveObjs, nil, noFilter)
+	healthStatus, err := SetApplicationHealth(resources, liveObjs, nil, nil, noFilter)
 	assert.NoError(t, err)
 	assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{common.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = SetApplicationHealth(resources, liveObjs, nil, noFilter)
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, nil, nil, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
+}
+
+func TestSetApplicationHealth_IgnoreHealthCheck(t *testing.T) {
+	yamlBytes, err := ioutil.ReadFile("./testdata/job-failed.yaml")
+	assert.Nil(t, err)
+	var failedJob unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &failedJob)
+	assert.Nil(t, err)
+	failedJob.SetAnnotations(map[string]string{common.AnnotationIgnoreHealthCheck: "true"})
+
+	yamlBytes, err = ioutil.ReadFile("./testdata/pod-running-restart-always.yaml")
+	assert.Nil(t, err)
+	var runningPod unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &runningPod)
+	assert.Nil(t, err)
+
+	resources := []appv1.ResourceStatus{
+		{Group: "", Version: "v1", Kind: "Pod", Name: runningPod.GetName()},
+		{Group: "batch", Version: "v1", Kind: "Job", Name: failedJob.GetName()},
+	}
+	liveObjs := []*unstructured.Unstructured{&runningPod, &failedJob}
+	healthStatus, err := SetApplicationHealth(resources, liveObjs, nil, nil, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
+	assert.Equal(t, appv1.HealthStatusDegraded, resources[1].Health.Status)
+
+	// any other value than "true" does not ignore the health
+	failedJob.SetAnnotations(map[string]string{common.AnnotationIgnoreHealthCheck: "false"})
+	healthStatus, err = SetApplicationHealth(resources, liveObjs, nil, nil, noFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, appv1.HealthStatusDegraded, healthStatus.Status)
+}
+
+func TestSetApplicationHealth_RollupPolicy(t *testing.T) {
+	yamlBytes, err := ioutil.ReadFile("./testdata/job-failed.yaml")
+	assert.Nil(t, err)
+	var failedJob unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &failedJob)
+	assert.Nil(t, err)
+
+	resources := []appv1.ResourceStatus{
+		{Group: "batch", Version: "v1", Kind: "Job", Name: failedJob.GetName()},
+		{Group: "", Version: "v1", Kind: "ConfigMap", Name: "my-config"},
+	}
+	liveObjs := []*unstructured.Unstructured{&failedJob, nil}
+	policy := &appv1.HealthRollupPolicy{Rules: []appv1.HealthRollupRule{
+		{Group: "batch", Kind: "Job", Informational: true},
+		{Kind: "ConfigMap", Name: "my-*", Informational: true},
+	}}
+	healthStatus, err := SetApplicationHealth(resources, liveObjs, nil, policy, noFilter)
 	assert.NoError(t, err)
 	assert.Equal(t, appv1.HealthStatusHealthy, healthStatus.Status)
+	assert.Equal(t, appv1.HealthStatusDegraded, resources[0].Health.Status)
+	assert.Equal(t, appv1.HealthStatusMissing, resources[1].Health.Status)
 }
 
 func TestAPIService(t *testing.T) {
 	assertAppHealth(t, "./testdata/apiservice-v1-true.yaml", appv1.HealthStatusHealthy)
-	assertAppHealth(t, "./testdata/apiservice-v1-false.yaml", appv1.HealthStatusProgressing)
+	assertAppHealth(t, "./testdata/apiservice-v1-false.yaml", appv1.HealthStatusDegraded)
 	assertAppHealth(t, "./testdata/apiservice-v1beta1-true.yaml", appv1.HealthStatusHealthy)
-	assertAppHealth(t, "./testdata/apiservice-v1beta1-false.yaml", appv1.HealthStatusProgressing)
+	assertAppHealth(t, "./testdata/apiservice-v1beta1-false.yaml", appv1.HealthStatusDegraded)
 }
 
 func TestGetStatusFromArgoWorkflow(t *testing.T) {
@@ -0,0 +1,83 @@
+package health
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// rollupResource is a resource which contributes to the application health
+type rollupResource struct {
+	obj    *unstructured.Unstructured
+	health appv1.HealthStatusCode
+	weight int64
+}
+
+// rollupHealth aggregates the health of the given resources into the application health according to the policy
+func rollupHealth(policy *appv1.HealthRollupPolicy, resources []rollupResource) appv1.HealthStatusCode {
+	if policy == nil {
+		return worstHealth(resources)
+	}
+	var contributing []rollupResource
+	for _, res := range resources {
+		res.weight = 1
+		if rule := matchingRollupRule(policy.Rules, res.obj); rule != nil {
+			if rule.Informational {
+				continue
+			}
+			res.weight = rule.GetWeight()
+		}
+		contributing = append(contributing, res)
+	}
+
+	switch policy.Mode {
+	case appv1.HealthRollupWeightedThreshold:
+		var total, degraded int64
+		var remaining []rollupResource
+		for _, res := range contributing {
+			total += res.weight
+			if res.health == appv1.HealthStatusDegraded {
+				degraded += res.weight
+			} else {
+				remaining = append(remaining, res)
+			}
+		}
+		if total > 0 && degraded*100 > policy.DegradedThreshold*total {
+			return appv1.HealthStatusDegraded
+		}
+		return worstHealth(remaining)
+	case appv1.HealthRollupKindPriority:
+		for _, kind := range policy.KindPriority {
+			var selected []rollupResource
+			for _, res := range contributing {
+				if res.obj.GetKind() == kind {
+					selected = append(selected, res)
+				}
+			}
+			if len(selected) > 0 {
+				return worstHealth(selected)
+			}
+		}
+	}
+	return worstHealth(contributing)
+}
+
+// matchingRollupRule returns the first rule which selects the given resource
+func matchingRollupRule(rules []appv1.HealthRollupRule, obj *unstructured.Unstructured) *appv1.HealthRollupRule {
+	for i := range rules {
+		if rules[i].Matches(obj) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func worstHealth(resources []rollupResource) appv1.HealthStatusCode {
+	health := appv1.HealthStatusHealthy
+	for _, res := range resources {
+		if IsWorse(health, res.health) {
+			health = res.health
+		}
+	}
+	return health
+}
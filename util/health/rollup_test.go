@@ -0,0 +1,104 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newRollupResource(kind, name string, health appv1.HealthStatusCode) rollupResource {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{"tier": name})
+	return rollupResource{obj: obj, health: health}
+}
+
+func TestRollupHealth_Default(t *testing.T) {
+	resources := []rollupResource{
+		newRollupResource("Deployment", "web", appv1.HealthStatusHealthy),
+		newRollupResource("CronJob", "cleanup", appv1.HealthStatusDegraded),
+	}
+	assert.Equal(t, appv1.HealthStatusDegraded, rollupHealth(nil, resources))
+	assert.Equal(t, appv1.HealthStatusHealthy, rollupHealth(nil, nil))
+}
+
+func TestRollupHealth_WorstOf(t *testing.T) {
+	resources := []rollupResource{
+		newRollupResource("Deployment", "web", appv1.HealthStatusProgressing),
+		newRollupResource("CronJob", "cleanup", appv1.HealthStatusDegraded),
+	}
+	policy := &appv1.HealthRollupPolicy{Rules: []appv1.HealthRollupRule{{Kind: "Cron*", Informational: true}}}
+	assert.Equal(t, appv1.HealthStatusProgressing, rollupHealth(policy, resources))
+
+	policy = &appv1.HealthRollupPolicy{Rules: []appv1.HealthRollupRule{{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+		Informational: true,
+	}}}
+	assert.Equal(t, appv1.HealthStatusDegraded, rollupHealth(policy, resources))
+}
+
+func TestRollupHealth_WeightedThreshold(t *testing.T) {
+	resources := []rollupResource{
+		newRollupResource("Deployment", "web", appv1.HealthStatusHealthy),
+		newRollupResource("Deployment", "api", appv1.HealthStatusProgressing),
+		newRollupResource("CronJob", "cleanup", appv1.HealthStatusDegraded),
+		newRollupResource("ConfigMap", "config", appv1.HealthStatusMissing),
+	}
+	policy := &appv1.HealthRollupPolicy{
+		Mode:              appv1.HealthRollupWeightedThreshold,
+		DegradedThreshold: 20,
+		Rules: []appv1.HealthRollupRule{
+			{Kind: "ConfigMap", Informational: true},
+			{Kind: "Deployment", Weight: 5},
+		},
+	}
+	// 1 of 11 weighted resources is degraded
+	assert.Equal(t, appv1.HealthStatusProgressing, rollupHealth(policy, resources))
+
+	// 6 of 11 weighted resources are degraded
+	resources[0].health = appv1.HealthStatusDegraded
+	assert.Equal(t, appv1.HealthStatusDegraded, rollupHealth(policy, resources))
+
+	// 6 of 11 weighted resources are degraded, which does not exceed the threshold
+	policy.DegradedThreshold = 60
+	assert.Equal(t, appv1.HealthStatusProgressing, rollupHealth(policy, resources))
+}
+
+func TestRollupHealth_KindPriority(t *testing.T) {
+	resources := []rollupResource{
+		newRollupResource("Deployment", "web", appv1.HealthStatusHealthy),
+		newRollupResource("StatefulSet", "db", appv1.HealthStatusProgressing),
+		newRollupResource("CronJob", "cleanup", appv1.HealthStatusDegraded),
+	}
+	policy := &appv1.HealthRollupPolicy{
+		Mode:         appv1.HealthRollupKindPriority,
+		KindPriority: []string{"Rollout", "Deployment", "StatefulSet"},
+	}
+	assert.Equal(t, appv1.HealthStatusHealthy, rollupHealth(policy, resources))
+
+	// informational resources are not considered
+	policy.Rules = []appv1.HealthRollupRule{{Kind: "Deployment", Informational: true}}
+	assert.Equal(t, appv1.HealthStatusProgressing, rollupHealth(policy, resources))
+
+	// fallback to the worst health if none of the kinds exist
+	policy.KindPriority = []string{"Rollout"}
+	assert.Equal(t, appv1.HealthStatusDegraded, rollupHealth(policy, resources))
+}
+
+func TestHealthRollupPolicy_Effective(t *testing.T) {
+	var policy *appv1.HealthRollupPolicy
+	assert.Nil(t, policy.Effective())
+
+	policy = &appv1.HealthRollupPolicy{Rules: []appv1.HealthRollupRule{{Kind: "Deployment"}, {Kind: "CronJob", Informational: true}}}
+	effective := policy.Effective()
+	assert.Equal(t, appv1.HealthRollupWorstOf, effective.Mode)
+	assert.Equal(t, int64(1), effective.Rules[0].Weight)
+	assert.Equal(t, int64(0), effective.Rules[1].Weight)
+	assert.Equal(t, "", policy.Mode)
+}
@@ -165,6 +165,8 @@ type TemplateOpts struct {
 	Name        string
 	Namespace   string
 	KubeVersion string
+	// APIVersions holds the group/versions available as .Capabilities.APIVersions to the templates
+	APIVersions []string
 	Set         map[string]string
 	SetString   map[string]string
 	Values      []string
@@ -187,6 +189,9 @@ func (c *Cmd) template(chart string, opts *TemplateOpts) (string, error) {
 	if opts.KubeVersion != "" {
 		args = append(args, "--kube-version", opts.KubeVersion)
 	}
+	for _, apiVersion := range opts.APIVersions {
+		args = append(args, "--api-versions", apiVersion)
+	}
 	for key, val := range opts.Set {
 		args = append(args, "--set", key+"="+cleanSetParameters(val))
 	}
@@ -42,7 +42,49 @@ func NewHelmApp(workDir string, repos []HelmRepository) (Helm, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &helm{repos: repos, cmd: *cmd}, nil
+	return &helm{repos: filterDependencyRepos(workDir, repos), cmd: *cmd}, nil
+}
+
+type requirements struct {
+	Dependencies []struct {
+		Repository string `json:"repository"`
+	} `json:"dependencies"`
+}
+
+// filterDependencyRepos returns the repositories referenced by the dependencies in the requirements.yaml file of the
+// chart, either by URL or by name (@name or alias:name). All repositories are returned if the dependencies of the
+// chart can't be determined.
+func filterDependencyRepos(workDir string, repos []HelmRepository) []HelmRepository {
+	if len(repos) == 0 {
+		return repos
+	}
+	data, err := ioutil.ReadFile(path.Join(workDir, "requirements.yaml"))
+	if err != nil {
+		return repos
+	}
+	var reqs requirements
+	if err := yaml.Unmarshal(data, &reqs); err != nil {
+		return repos
+	}
+	urls := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, dep := range reqs.Dependencies {
+		switch {
+		case strings.HasPrefix(dep.Repository, "@"):
+			names[strings.TrimPrefix(dep.Repository, "@")] = true
+		case strings.HasPrefix(dep.Repository, "alias:"):
+			names[strings.TrimPrefix(dep.Repository, "alias:")] = true
+		default:
+			urls[strings.TrimSuffix(dep.Repository, "/")] = true
+		}
+	}
+	filtered := make([]HelmRepository, 0)
+	for _, repo := range repos {
+		if names[repo.Name] || urls[strings.TrimSuffix(repo.Repo, "/")] {
+			filtered = append(filtered, repo)
+		}
+	}
+	return filtered
 }
 
 type helm struct {
@@ -1,7 +1,9 @@
 package helm
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -110,6 +112,30 @@ func TestHelmDependencyBuild(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFilterDependencyRepos(t *testing.T) {
+	stable := HelmRepository{Name: "stable", Repo: "https://kubernetes-charts.storage.googleapis.com"}
+	other := HelmRepository{Name: "other", Repo: "https://charts.example.com/"}
+
+	// wordpress depends on the stable repository
+	assert.Equal(t, []HelmRepository{stable}, filterDependencyRepos("./testdata/wordpress", []HelmRepository{stable, other}))
+	// redis doesn't have requirements.yaml so dependencies are unknown
+	assert.Equal(t, []HelmRepository{stable, other}, filterDependencyRepos("./testdata/redis", []HelmRepository{stable, other}))
+	assert.Nil(t, filterDependencyRepos("./testdata/wordpress", nil))
+
+	dir, err := ioutil.TempDir("", "helm-requirements-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	err = ioutil.WriteFile(filepath.Join(dir, "requirements.yaml"), []byte(`
+dependencies:
+- name: foo
+  repository: "@other"
+- name: bar
+  repository: file://../bar
+`), 0644)
+	assert.NoError(t, err)
+	assert.Equal(t, []HelmRepository{other}, filterDependencyRepos(dir, []HelmRepository{stable, other}))
+}
+
 func TestHelmTemplateReleaseNameOverwrite(t *testing.T) {
 	h, err := NewHelmApp("./testdata/redis", nil)
 	assert.NoError(t, err)
@@ -2,12 +2,14 @@ package kube
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os/exec"
 	"regexp"
+	"sort"
 	"strings"
 
 	argoexec "github.com/argoproj/pkg/exec"
@@ -27,16 +29,40 @@ import (
 )
 
 type Kubectl interface {
-	ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error)
+	// ApplyResource applies the resource with kubectl, the kubectl process is killed if the given context is done
+	ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy DryRunStrategy, force, validate bool) (string, error)
 	ConvertToVersion(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
 	DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error
 	GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
 	PatchResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte) (*unstructured.Unstructured, error)
+	// ServerSideDryRunApply performs a server-side apply of the resource without persisting it and returns the resource
+	// as the API server would persist it, i.e. defaulted and mutated by admission webhooks
+	ServerSideDryRunApply(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error)
+	// GetAPIResources returns the APIs of the cluster which can be listed and watched. If some group/versions could not
+	// be discovered, the discovered APIs are returned along with a *PartialDiscoveryError.
 	GetAPIResources(config *rest.Config, resourceFilter ResourceFilter) ([]APIResourceInfo, error)
 	GetServerVersion(config *rest.Config) (string, error)
+	GetAPIVersions(config *rest.Config) ([]string, error)
+	GetOpenAPISchema(config *rest.Config) (*OpenAPISchema, error)
 	SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error))
 }
 
+// DryRunStrategy controls if and how a resource is applied without persisting it
+type DryRunStrategy int
+
+const (
+	// DryRunNone applies the resource
+	DryRunNone DryRunStrategy = iota
+	// DryRunClient performs a client-side dry-run (`kubectl apply --dry-run`)
+	DryRunClient
+	// DryRunServer submits the resource to the API server, which runs admission webhooks and validation without
+	// persisting it (`kubectl apply --server-dry-run`)
+	DryRunServer
+)
+
+// ServerSideDryRunFieldManager is the field manager of the server-side dry-run applies
+const ServerSideDryRunFieldManager = "argocd-controller"
+
 type KubectlCmd struct {
 	OnKubectlRun func(command string) (util.Closer, error)
 }
@@ -47,6 +73,30 @@ type APIResourceInfo struct {
 	Interface dynamic.ResourceInterface
 }
 
+// PartialDiscoveryError is returned along with the discovered APIs if some group/versions of the cluster could not be
+// discovered, e.g. because the server of an aggregated APIService is down
+type PartialDiscoveryError struct {
+	// FailedGroupVersions holds the error of each group/version which could not be discovered
+	FailedGroupVersions map[schema.GroupVersion]error
+}
+
+func (e *PartialDiscoveryError) Error() string {
+	groupVersions := make([]string, 0, len(e.FailedGroupVersions))
+	for gv, err := range e.FailedGroupVersions {
+		groupVersions = append(groupVersions, fmt.Sprintf("%s: %v", gv.String(), err))
+	}
+	sort.Strings(groupVersions)
+	return fmt.Sprintf("unable to discover some APIs of the cluster: %s", strings.Join(groupVersions, ", "))
+}
+
+// APIServiceName returns the name of the APIService which serves the given group/version, e.g. v1beta1.metrics.k8s.io
+func APIServiceName(gv schema.GroupVersion) string {
+	if gv.Group == "" {
+		return gv.Version
+	}
+	return fmt.Sprintf("%s.%s", gv.Version, gv.Group)
+}
+
 type filterFunc func(apiResource *metav1.APIResource) bool
 
 func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filter filterFunc, namespace string) ([]APIResourceInfo, error) {
@@ -59,12 +109,21 @@ func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filt
 	if err != nil {
 		return nil, err
 	}
+	return discoverAPIResources(disco, dynamicIf, config.Host, resourceFilter, filter, namespace)
+}
 
-	serverResources, err := disco.ServerPreferredResources()
+// discoverAPIResources returns the preferred APIs of the cluster which pass the filters. The APIs of the group/versions
+// which have been discovered successfully are returned even if others failed, along with a *PartialDiscoveryError.
+func discoverAPIResources(disco discovery.DiscoveryInterface, dynamicIf dynamic.Interface, cluster string, resourceFilter ResourceFilter, filter filterFunc, namespace string) ([]APIResourceInfo, error) {
+	var partialErr *PartialDiscoveryError
+	serverResources, err := discovery.ServerPreferredResources(disco)
 	if err != nil {
 		if len(serverResources) == 0 {
 			return nil, err
 		}
+		if failedErr, ok := err.(*discovery.ErrGroupDiscoveryFailed); ok {
+			partialErr = &PartialDiscoveryError{FailedGroupVersions: failedErr.Groups}
+		}
 		log.Warnf("Partial success when performing preferred resource discovery: %v", err)
 	}
 	apiResIfs := make([]APIResourceInfo, 0)
@@ -75,7 +134,7 @@ func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filt
 		}
 		for _, apiResource := range apiResourcesList.APIResources {
 
-			if resourceFilter.IsExcludedResource(gv.Group, apiResource.Kind, config.Host) {
+			if resourceFilter.IsExcludedResource(gv.Group, apiResource.Kind, cluster) {
 				continue
 			}
 
@@ -95,6 +154,9 @@ func filterAPIResources(config *rest.Config, resourceFilter ResourceFilter, filt
 			}
 		}
 	}
+	if partialErr != nil {
+		return apiResIfs, partialErr
+	}
 	return apiResIfs, nil
 }
 
@@ -109,13 +171,9 @@ func isSupportedVerb(apiResource *metav1.APIResource, verb string) bool {
 }
 
 func (k KubectlCmd) GetAPIResources(config *rest.Config, resourceFilter ResourceFilter) ([]APIResourceInfo, error) {
-	apiResIfs, err := filterAPIResources(config, resourceFilter, func(apiResource *metav1.APIResource) bool {
+	return filterAPIResources(config, resourceFilter, func(apiResource *metav1.APIResource) bool {
 		return isSupportedVerb(apiResource, listVerb) && isSupportedVerb(apiResource, watchVerb)
 	}, "")
-	if err != nil {
-		return nil, err
-	}
-	return apiResIfs, err
 }
 
 // GetResource returns resource
@@ -156,6 +214,39 @@ func (k KubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVersionKi
 	return resourceIf.Patch(name, patchType, patchBytes, metav1.PatchOptions{})
 }
 
+// ServerSideDryRunApply performs a server-side apply of the resource with dry-run enabled
+func (k KubectlCmd) ServerSideDryRunApply(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	gvk := obj.GroupVersionKind()
+	apiResource, err := ServerResourceForGroupVersionKind(disco, gvk)
+	if err != nil {
+		return nil, err
+	}
+	if !apiResource.Namespaced {
+		namespace = ""
+	}
+	manifestBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	resource := gvk.GroupVersion().WithResource(apiResource.Name)
+	resourceIf := ToResourceInterface(dynamicIf, apiResource, resource, namespace)
+	// the dry-run apply forces the ownership of conflicting fields, like the apply of a sync overwrites them
+	force := true
+	return resourceIf.Patch(obj.GetName(), types.ApplyPatchType, manifestBytes, metav1.PatchOptions{
+		DryRun:       []string{metav1.DryRunAll},
+		Force:        &force,
+		FieldManager: ServerSideDryRunFieldManager,
+	})
+}
+
 // DeleteResource deletes resource
 func (k KubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error {
 	dynamicIf, err := dynamic.NewForConfig(config)
@@ -184,7 +275,7 @@ func (k KubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionK
 }
 
 // ApplyResource performs an apply of a unstructured resource
-func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+func (k KubectlCmd) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy DryRunStrategy, force, validate bool) (string, error) {
 	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
 	f, err := ioutil.TempFile(util.TempDir, "")
 	if err != nil {
@@ -210,7 +301,7 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 		// See: https://github.com/kubernetes/kubernetes/issues/71185. This is behavior which we do
 		// not want. We need to check if the namespace exists, before know if it is safe to run this
 		// command. Skip this for dryRuns.
-		if !dryRun && namespace != "" {
+		if dryRunStrategy == DryRunNone && namespace != "" {
 			kubeClient, err := kubernetes.NewForConfig(config)
 			if err != nil {
 				return "", err
@@ -220,7 +311,12 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 				return "", err
 			}
 		}
-		outReconcile, err := k.runKubectl(f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, dryRun)
+		// `kubectl auth reconcile` does not support server-side dry-run
+		reconcileDryRunStrategy := dryRunStrategy
+		if reconcileDryRunStrategy == DryRunServer {
+			reconcileDryRunStrategy = DryRunClient
+		}
+		outReconcile, err := k.runKubectl(ctx, f.Name(), namespace, []string{"auth", "reconcile"}, manifestBytes, reconcileDryRunStrategy)
 		if err != nil {
 			return "", err
 		}
@@ -237,7 +333,7 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	if !validate {
 		applyArgs = append(applyArgs, "--validate=false")
 	}
-	outApply, err := k.runKubectl(f.Name(), namespace, applyArgs, manifestBytes, dryRun)
+	outApply, err := k.runKubectl(ctx, f.Name(), namespace, applyArgs, manifestBytes, dryRunStrategy)
 	if err != nil {
 		return "", err
 	}
@@ -245,16 +341,16 @@ func (k KubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstruc
 	return strings.Join(out, ". "), nil
 }
 
-func convertKubectlError(err error) error {
-	errorStr := err.Error()
-	if cmdErr, ok := err.(*argoexec.CmdError); ok {
-		parts := []string{fmt.Sprintf("kubectl failed %s", cmdErr.Cause.Error())}
-		if cmdErr.Stderr != "" {
-			parts = append(parts, cleanKubectlOutput(cmdErr.Stderr))
-		}
-		errorStr = strings.Join(parts, ": ")
-	}
-	return fmt.Errorf(errorStr)
+// IsDryRunUnsupportedError returns true if the error indicates that the API server cannot perform a server-side
+// dry-run of the resource (e.g. the dry-run feature is disabled or an admission webhook has side effects)
+func IsDryRunUnsupportedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "does not support dry run") ||
+		strings.Contains(message, "dryrun alpha api disabled") ||
+		strings.Contains(message, "dry run is not supported")
 }
 
 func (k *KubectlCmd) processKubectlRun(args []string) (util.Closer, error) {
@@ -271,7 +367,7 @@ func (k *KubectlCmd) processKubectlRun(args []string) (util.Closer, error) {
 	}), nil
 }
 
-func (k *KubectlCmd) runKubectl(kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRun bool) (string, error) {
+func (k *KubectlCmd) runKubectl(ctx context.Context, kubeconfigPath string, namespace string, args []string, manifestBytes []byte, dryRunStrategy DryRunStrategy) (string, error) {
 	closer, err := k.processKubectlRun(args)
 	if err != nil {
 		return "", err
@@ -282,10 +378,13 @@ func (k *KubectlCmd) runKubectl(kubeconfigPath string, namespace string, args []
 	if namespace != "" {
 		cmdArgs = append(cmdArgs, "-n", namespace)
 	}
-	if dryRun {
+	switch dryRunStrategy {
+	case DryRunClient:
 		cmdArgs = append(cmdArgs, "--dry-run")
+	case DryRunServer:
+		cmdArgs = append(cmdArgs, "--server-dry-run")
 	}
-	cmd := exec.Command("kubectl", cmdArgs...)
+	cmd := exec.CommandContext(ctx, "kubectl", cmdArgs...)
 	if log.IsLevelEnabled(log.DebugLevel) {
 		var obj unstructured.Unstructured
 		err := json.Unmarshal(manifestBytes, &obj)
@@ -387,6 +486,44 @@ func (k KubectlCmd) GetServerVersion(config *rest.Config) (string, error) {
 	return fmt.Sprintf("%s.%s", v.Major, v.Minor), nil
 }
 
+// GetAPIVersions returns the sorted group/versions served by the cluster, e.g. "v1" and "networking.k8s.io/v1beta1"
+func (k KubectlCmd) GetAPIVersions(config *rest.Config) ([]string, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	return APIGroupsToVersions(groups.Groups), nil
+}
+
+// APIGroupsToVersions flattens the given API groups into the sorted list of their group/versions
+func APIGroupsToVersions(groups []metav1.APIGroup) []string {
+	var apiVersions []string
+	for _, group := range groups {
+		for _, version := range group.Versions {
+			apiVersions = append(apiVersions, version.GroupVersion)
+		}
+	}
+	sort.Strings(apiVersions)
+	return apiVersions
+}
+
+// GetOpenAPISchema retrieves and parses the OpenAPI schema published by the cluster
+func (k KubectlCmd) GetOpenAPISchema(config *rest.Config) (*OpenAPISchema, error) {
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := client.OpenAPISchema()
+	if err != nil {
+		return nil, err
+	}
+	return NewOpenAPISchema(doc)
+}
+
 func (k KubectlCmd) SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error)) {
 	k.OnKubectlRun = onKubectlRun
 }
@@ -1,6 +1,8 @@
 package kube
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"regexp"
 	"testing"
@@ -9,7 +11,13 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedisco "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	testcore "k8s.io/client-go/testing"
 )
 
 func TestConvertToVersion(t *testing.T) {
@@ -61,7 +69,7 @@ func TestRunKubectl(t *testing.T) {
 		},
 	}
 
-	_, _ = kubectl.runKubectl("/dev/null", "default", []string{"command-name"}, nil, false)
+	_, _ = kubectl.runKubectl(context.Background(), "/dev/null", "default", []string{"command-name"}, nil, DryRunNone)
 	assert.True(t, callbackExecuted)
 	assert.True(t, closerExecuted)
 }
@@ -73,3 +81,70 @@ func TestVersion(t *testing.T) {
 	re := regexp.MustCompile(SemverRegexValidation)
 	assert.True(t, re.MatchString(ver))
 }
+
+func TestIsDryRunUnsupportedError(t *testing.T) {
+	assert.False(t, IsDryRunUnsupportedError(nil))
+	assert.False(t, IsDryRunUnsupportedError(fmt.Errorf(`admission webhook "example.com" denied the request`)))
+	assert.True(t, IsDryRunUnsupportedError(fmt.Errorf(`admission webhook "example.com" does not support dry run`)))
+	assert.True(t, IsDryRunUnsupportedError(fmt.Errorf("the server rejected our request: DryRun alpha API disabled")))
+}
+
+func TestAPIGroupsToVersions(t *testing.T) {
+	apiVersions := APIGroupsToVersions([]metav1.APIGroup{{
+		Name:     "networking.k8s.io",
+		Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "networking.k8s.io/v1"}, {GroupVersion: "networking.k8s.io/v1beta1"}},
+	}, {
+		Name:     "",
+		Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "v1"}},
+	}, {
+		Name:     "apps",
+		Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1"}},
+	}})
+	assert.Equal(t, []string{"apps/v1", "networking.k8s.io/v1", "networking.k8s.io/v1beta1", "v1"}, apiVersions)
+}
+
+// partialDiscovery fails the discovery of the resources of the given group/version
+type partialDiscovery struct {
+	*fakedisco.FakeDiscovery
+	failedGroupVersion string
+}
+
+func (d *partialDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	if groupVersion == d.failedGroupVersion {
+		return nil, fmt.Errorf("the server is currently unable to handle the request")
+	}
+	return d.FakeDiscovery.ServerResourcesForGroupVersion(groupVersion)
+}
+
+type noResourceFilter struct{}
+
+func (noResourceFilter) IsExcludedResource(group, kind, cluster string) bool {
+	return false
+}
+
+func TestDiscoverAPIResourcesPartialFailure(t *testing.T) {
+	disco := &partialDiscovery{FakeDiscovery: &fakedisco.FakeDiscovery{Fake: &testcore.Fake{}}, failedGroupVersion: "metrics.k8s.io/v1beta1"}
+	disco.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list", "watch"}}},
+	}, {
+		GroupVersion: "metrics.k8s.io/v1beta1",
+		APIResources: []metav1.APIResource{{Name: "pods", Kind: "PodMetrics", Namespaced: true, Verbs: []string{"list", "watch"}}},
+	}}
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+
+	apis, err := discoverAPIResources(disco, dynamicIf, "https://cluster", noResourceFilter{}, func(apiResource *metav1.APIResource) bool {
+		return true
+	}, "")
+
+	if assert.Len(t, apis, 1) {
+		assert.Equal(t, "Pod", apis[0].GroupKind.Kind)
+	}
+	partialErr, ok := err.(*PartialDiscoveryError)
+	if assert.True(t, ok) {
+		failedGV := schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}
+		assert.Len(t, partialErr.FailedGroupVersions, 1)
+		assert.EqualError(t, partialErr.FailedGroupVersions[failedGV], "the server is currently unable to handle the request")
+		assert.Equal(t, "v1beta1.metrics.k8s.io", APIServiceName(failedGV))
+	}
+}
@@ -0,0 +1,135 @@
+package kube
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	argoexec "github.com/argoproj/pkg/exec"
+)
+
+// KubectlErrorType is the class of an error reported by kubectl
+type KubectlErrorType string
+
+const (
+	// ErrUnknown is the type of errors which could not be classified
+	ErrUnknown KubectlErrorType = "Unknown"
+	// ErrImmutableField is the type of errors caused by an update of an immutable field, the resource has to be re-created
+	ErrImmutableField KubectlErrorType = "ImmutableField"
+	// ErrWebhookDenied is the type of errors caused by an admission webhook which denied the request
+	ErrWebhookDenied KubectlErrorType = "WebhookDenied"
+	// ErrConflict is the type of errors caused by a concurrent modification of the resource
+	ErrConflict KubectlErrorType = "Conflict"
+	// ErrAlreadyExists is the type of errors caused by the creation of a resource which already exists
+	ErrAlreadyExists KubectlErrorType = "AlreadyExists"
+	// ErrNotFound is the type of errors caused by a missing resource, namespace or resource type
+	ErrNotFound KubectlErrorType = "NotFound"
+	// ErrForbidden is the type of errors caused by missing permissions of the credentials used by kubectl
+	ErrForbidden KubectlErrorType = "Forbidden"
+	// ErrTransient is the type of errors caused by an unavailable or overloaded API server which are likely to go away on retry
+	ErrTransient KubectlErrorType = "Transient"
+)
+
+// KubectlError is a classified error reported by kubectl
+type KubectlError struct {
+	Type KubectlErrorType
+	// Cause is the reason of the kubectl failure, e.g. exit status 1
+	Cause string
+	// Message is the kubectl output cleaned up for display
+	Message string
+	// Resource is the kind or the resource type of the affected object as reported by kubectl, empty if it could not be parsed
+	Resource string
+	// Name is the name of the affected object, empty if it could not be parsed
+	Name string
+}
+
+func (e *KubectlError) Error() string {
+	parts := []string{fmt.Sprintf("kubectl failed %s", e.Cause)}
+	if e.Message != "" {
+		parts = append(parts, e.Message)
+	}
+	return strings.Join(parts, ": ")
+}
+
+// IsRetryable returns true if the failed kubectl command might succeed if it is retried
+func (e *KubectlError) IsRetryable() bool {
+	return e.Type == ErrTransient || e.Type == ErrConflict
+}
+
+// GetKubectlErrorType returns the type of the given error or ErrUnknown if it is not a kubectl error
+func GetKubectlErrorType(err error) KubectlErrorType {
+	if kubectlErr, ok := err.(*KubectlError); ok {
+		return kubectlErr.Type
+	}
+	return ErrUnknown
+}
+
+// kubectlOutputNoise are the parts of the kubectl output which are useless for users
+var kubectlOutputNoise = []string{
+	": error validating \"STDIN\"",
+	": unable to recognize \"STDIN\"",
+	": error when creating \"STDIN\"",
+	"; if you choose to ignore these errors, turn validation off with --validate=false",
+}
+
+type kubectlErrorPattern struct {
+	errorType KubectlErrorType
+	pattern   *regexp.Regexp
+}
+
+// kubectlErrorPatterns are matched in order against the kubectl output, so more specific patterns go first
+var kubectlErrorPatterns = []kubectlErrorPattern{
+	{ErrWebhookDenied, regexp.MustCompile(`admission webhook "[^"]*" denied the request`)},
+	{ErrImmutableField, regexp.MustCompile(`field is immutable|cannot change roleRef|updates to statefulset spec for fields other than`)},
+	{ErrAlreadyExists, regexp.MustCompile(`\(AlreadyExists\)|" already exists`)},
+	{ErrConflict, regexp.MustCompile(`\(Conflict\)|the object has been modified; please apply your changes to the latest version`)},
+	{ErrTransient, regexp.MustCompile(`Unable to connect to the server|connection to the server .* was refused|connection refused|connection reset by peer|i/o timeout|TLS handshake timeout|unexpected EOF|\((ServiceUnavailable|Timeout|ServerTimeout|TooManyRequests)\)|the server is currently unable to handle the request|etcdserver: request timed out|failed calling webhook`)},
+	{ErrForbidden, regexp.MustCompile(`\(Forbidden\)|is forbidden: User "[^"]*" cannot`)},
+	{ErrNotFound, regexp.MustCompile(`\(NotFound\)|no matches for kind|the server could not find the requested resource|" not found`)},
+}
+
+// kubectlResourcePatterns extract the resource kind or type and the name of the affected object
+var kubectlResourcePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`The ([\w.]+) "([^"]+)" is invalid`),
+	regexp.MustCompile(`([\w.]+) "([^"]+)" is invalid`),
+	regexp.MustCompile(`Operation cannot be fulfilled on ([\w.]+) "([^"]+)"`),
+	regexp.MustCompile(`([\w.]+) "([^"]+)" not found`),
+	regexp.MustCompile(`no matches for kind "([^"]+)"()`),
+	regexp.MustCompile(`error when creating "STDIN": ([\w.]+) "([^"]+)"`),
+}
+
+// ParseKubectlError classifies the error output of kubectl
+func ParseKubectlError(cause string, output string) *KubectlError {
+	res := &KubectlError{Type: ErrUnknown, Cause: cause, Message: strings.TrimSpace(output)}
+	for _, noise := range kubectlOutputNoise {
+		res.Message = strings.Replace(res.Message, noise, "", -1)
+	}
+	res.Message = strings.Replace(res.Message, "error: error", "error", -1)
+
+	for _, p := range kubectlErrorPatterns {
+		if p.pattern.MatchString(output) {
+			res.Type = p.errorType
+			break
+		}
+	}
+	for _, p := range kubectlResourcePatterns {
+		if match := p.FindStringSubmatch(output); match != nil {
+			res.Resource = match[1]
+			res.Name = match[2]
+			break
+		}
+	}
+	return res
+}
+
+// cleanKubectlOutput makes the error output of kubectl a little better to read
+func cleanKubectlOutput(s string) string {
+	return ParseKubectlError("", s).Message
+}
+
+func convertKubectlError(err error) error {
+	if cmdErr, ok := err.(*argoexec.CmdError); ok {
+		return ParseKubectlError(cmdErr.Cause.Error(), cmdErr.Stderr)
+	}
+	return fmt.Errorf(err.Error())
+}
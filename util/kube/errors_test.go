@@ -0,0 +1,146 @@
+package kube
+
+import (
+	"errors"
+	"testing"
+
+	argoexec "github.com/argoproj/pkg/exec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKubectlError(t *testing.T) {
+	tests := []struct {
+		name         string
+		output       string
+		expectedType KubectlErrorType
+		resource     string
+		objName      string
+	}{{
+		name:         "ImmutableServiceClusterIP",
+		output:       `The Service "my-service" is invalid: spec.clusterIP: Invalid value: "10.96.0.12": field is immutable`,
+		expectedType: ErrImmutableField,
+		resource:     "Service",
+		objName:      "my-service",
+	}, {
+		name:         "ImmutableDeploymentSelector_1.11",
+		output:       `The Deployment "guestbook-ui" is invalid: spec.selector: Invalid value: v1.LabelSelector{MatchLabels:map[string]string{"app":"guestbook-ui-2"}, MatchExpressions:[]v1.LabelSelectorRequirement(nil)}: field is immutable`,
+		expectedType: ErrImmutableField,
+		resource:     "Deployment",
+		objName:      "guestbook-ui",
+	}, {
+		name:         "ImmutableJobTemplate_1.14",
+		output:       `Error from server (Invalid): error when applying patch:\n{"spec":{"template":{"spec":{"containers":[{"image":"busybox:1.31","name":"main"}]}}}}\nto:\nResource: "batch/v1, Resource=jobs", GroupVersionKind: "batch/v1, Kind=Job"\nName: "migrate", Namespace: "default"\nfor: "STDIN": Job.batch "migrate" is invalid: spec.template: Invalid value: core.PodTemplateSpec{}: field is immutable`,
+		expectedType: ErrImmutableField,
+		resource:     "Job.batch",
+		objName:      "migrate",
+	}, {
+		name:         "ImmutableRoleRef",
+		output:       `The RoleBinding "my-binding" is invalid: roleRef: Invalid value: rbac.RoleRef{APIGroup:"rbac.authorization.k8s.io", Kind:"Role", Name:"other"}: cannot change roleRef`,
+		expectedType: ErrImmutableField,
+		resource:     "RoleBinding",
+		objName:      "my-binding",
+	}, {
+		name:         "ImmutableStatefulSetSpec",
+		output:       `The StatefulSet "redis" is invalid: spec: Forbidden: updates to statefulset spec for fields other than 'replicas', 'template', and 'updateStrategy' are forbidden`,
+		expectedType: ErrImmutableField,
+		resource:     "StatefulSet",
+		objName:      "redis",
+	}, {
+		name:         "WebhookDenied_1.14",
+		output:       `Error from server ([denied by k8srequiredlabels] you must provide labels: {"owner"}): error when creating "STDIN": admission webhook "validation.gatekeeper.sh" denied the request: [denied by k8srequiredlabels] you must provide labels: {"owner"}`,
+		expectedType: ErrWebhookDenied,
+	}, {
+		name:         "WebhookDenied_1.11",
+		output:       `Error from server (Forbidden): error when creating "STDIN": admission webhook "validate.example.com" denied the request: replicas must be less than 10`,
+		expectedType: ErrWebhookDenied,
+	}, {
+		name:         "WebhookUnavailable",
+		output:       `Error from server (InternalError): error when creating "STDIN": Internal error occurred: failed calling webhook "validate.nginx.ingress.kubernetes.io": Post https://ingress-nginx-controller-admission.ingress-nginx.svc:443/extensions/v1beta1/ingresses?timeout=30s: dial tcp 10.0.0.1:443: connect: connection refused`,
+		expectedType: ErrTransient,
+	}, {
+		name:         "Conflict",
+		output:       `Error from server (Conflict): error when applying patch:\n{"metadata":{"labels":{"app":"guestbook"}}}\nto:\nResource: "apps/v1, Resource=deployments", GroupVersionKind: "apps/v1, Kind=Deployment"\nName: "guestbook-ui", Namespace: "default"\nfor: "STDIN": Operation cannot be fulfilled on deployments.apps "guestbook-ui": the object has been modified; please apply your changes to the latest version and try again`,
+		expectedType: ErrConflict,
+		resource:     "deployments.apps",
+		objName:      "guestbook-ui",
+	}, {
+		name:         "AlreadyExists",
+		output:       `Error from server (AlreadyExists): error when creating "STDIN": jobs.batch "my-hook" already exists`,
+		expectedType: ErrAlreadyExists,
+		resource:     "jobs.batch",
+		objName:      "my-hook",
+	}, {
+		name:         "NamespaceNotFound",
+		output:       `Error from server (NotFound): error when creating "STDIN": namespaces "does-not-exist" not found`,
+		expectedType: ErrNotFound,
+		resource:     "namespaces",
+		objName:      "does-not-exist",
+	}, {
+		name:         "KindNotFound_1.14",
+		output:       `error: unable to recognize "STDIN": no matches for kind "Rollout" in version "argoproj.io/v1alpha1"`,
+		expectedType: ErrNotFound,
+		resource:     "Rollout",
+	}, {
+		name:         "KindNotFound_1.10",
+		output:       `error: error when retrieving current configuration of:\nResource: "argoproj.io/v1alpha1, Resource=rollouts"\nfrom server for: "STDIN": the server could not find the requested resource`,
+		expectedType: ErrNotFound,
+	}, {
+		name:         "Forbidden",
+		output:       `Error from server (Forbidden): error when retrieving current configuration of:\nResource: "/v1, Resource=configmaps", GroupVersionKind: "/v1, Kind=ConfigMap"\nName: "my-config", Namespace: "kube-system"\nfrom server for: "STDIN": configmaps "my-config" is forbidden: User "system:serviceaccount:team-a:deployer" cannot get resource "configmaps" in API group "" in the namespace "kube-system"`,
+		expectedType: ErrForbidden,
+	}, {
+		name:         "ConnectionRefused",
+		output:       `The connection to the server 10.0.0.1:6443 was refused - did you specify the right host or port?`,
+		expectedType: ErrTransient,
+	}, {
+		name:         "Timeout",
+		output:       `Unable to connect to the server: dial tcp 35.1.2.3:443: i/o timeout`,
+		expectedType: ErrTransient,
+	}, {
+		name:         "TLSHandshakeTimeout",
+		output:       `Unable to connect to the server: net/http: TLS handshake timeout`,
+		expectedType: ErrTransient,
+	}, {
+		name:         "ServiceUnavailable",
+		output:       `Error from server (ServiceUnavailable): error when retrieving current configuration of:\nResource: "apps/v1, Resource=deployments"\nfrom server for: "STDIN": the server is currently unable to handle the request`,
+		expectedType: ErrTransient,
+	}, {
+		name:         "EtcdTimeout",
+		output:       `Error from server (InternalError): error when creating "STDIN": Internal error occurred: etcdserver: request timed out`,
+		expectedType: ErrTransient,
+	}, {
+		name:         "ValidationError",
+		output:       `error: error validating "STDIN": error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec; if you choose to ignore these errors, turn validation off with --validate=false`,
+		expectedType: ErrUnknown,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ParseKubectlError("exit status 1", test.output)
+			assert.Equal(t, test.expectedType, err.Type)
+			assert.Equal(t, test.resource, err.Resource)
+			assert.Equal(t, test.objName, err.Name)
+			assert.Equal(t, test.expectedType == ErrTransient || test.expectedType == ErrConflict, err.IsRetryable())
+		})
+	}
+}
+
+func TestParseKubectlErrorMessage(t *testing.T) {
+	err := ParseKubectlError("exit status 1", `error: error validating "STDIN": error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec; if you choose to ignore these errors, turn validation off with --validate=false`)
+	assert.Equal(t, `kubectl failed exit status 1: error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec`, err.Error())
+
+	err = ParseKubectlError("exit status 1", "")
+	assert.Equal(t, "kubectl failed exit status 1", err.Error())
+}
+
+func TestConvertKubectlError(t *testing.T) {
+	err := convertKubectlError(&argoexec.CmdError{
+		Args:   "kubectl apply",
+		Stderr: `The Service "my-service" is invalid: spec.clusterIP: Invalid value: "10.96.0.12": field is immutable`,
+		Cause:  errors.New("exit status 1"),
+	})
+	assert.Equal(t, ErrImmutableField, GetKubectlErrorType(err))
+	assert.Equal(t, `kubectl failed exit status 1: The Service "my-service" is invalid: spec.clusterIP: Invalid value: "10.96.0.12": field is immutable`, err.Error())
+
+	err = convertKubectlError(errors.New("executable file not found in $PATH"))
+	assert.Equal(t, ErrUnknown, GetKubectlErrorType(err))
+}
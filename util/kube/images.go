@@ -0,0 +1,66 @@
+package kube
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// containerFields are the fields of a pod spec which hold containers
+var containerFields = []string{"initContainers", "containers", "ephemeralContainers"}
+
+// getPodSpecPath returns the path of the pod spec of a pod or workload resource, or nil if the resource has no pod spec
+func getPodSpecPath(un *unstructured.Unstructured) []string {
+	gvk := un.GroupVersionKind()
+	switch {
+	case gvk.Group == "" && gvk.Kind == PodKind:
+		return []string{"spec"}
+	case gvk.Group == "argoproj.io" && gvk.Kind == "Rollout":
+		return []string{"spec", "template", "spec"}
+	case gvk.Group == "batch" && gvk.Kind == CronJobKind:
+		return []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	case gvk.Group == "batch" && gvk.Kind == JobKind:
+		return []string{"spec", "template", "spec"}
+	case gvk.Group == "apps" || gvk.Group == "extensions":
+		switch gvk.Kind {
+		case DeploymentKind, ReplicaSetKind, StatefulSetKind, DaemonSetKind:
+			return []string{"spec", "template", "spec"}
+		}
+	}
+	return nil
+}
+
+// GetImages returns the sorted and deduplicated images of the init, regular and ephemeral containers of a pod or of the
+// pod template of a workload resource. Malformed container lists are ignored.
+func GetImages(un *unstructured.Unstructured) []string {
+	path := getPodSpecPath(un)
+	if path == nil {
+		return nil
+	}
+	podSpec, ok, err := unstructured.NestedMap(un.Object, path...)
+	if !ok || err != nil {
+		return nil
+	}
+	imagesSet := make(map[string]bool)
+	for _, field := range containerFields {
+		containers, ok := podSpec[field].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if image, ok := container["image"].(string); ok && image != "" {
+				imagesSet[image] = true
+			}
+		}
+	}
+	var images []string
+	for image := range imagesSet {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	return images
+}
@@ -0,0 +1,126 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func unmarshalObj(t *testing.T, text string) *unstructured.Unstructured {
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(text), &obj))
+	return &obj
+}
+
+func TestGetImages(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []string
+	}{{
+		path:     "testdata/nginx.yaml",
+		expected: []string{"nginx:1.7.9"},
+	}, {
+		path:     "testdata/statefulset-rollout.yaml",
+		expected: []string{"redis:5.0.5"},
+	}, {
+		path:     "testdata/daemonset-rollout.yaml",
+		expected: []string{"fluent/fluentd:v1.7"},
+	}, {
+		path:     "testdata/job.yaml",
+		expected: []string{"perl"},
+	}, {
+		path:     "testdata/svc.yaml",
+		expected: nil,
+	}}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, GetImages(loadTestdata(t, test.path)))
+		})
+	}
+}
+
+func TestGetImagesInitAndEphemeralContainers(t *testing.T) {
+	pod := unmarshalObj(t, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  initContainers:
+  - name: init
+    image: busybox:1.31
+  containers:
+  - name: main
+    image: nginx:1.17
+  - name: sidecar
+    image: busybox:1.31
+  ephemeralContainers:
+  - name: debugger
+    image: alpine:3.10
+`)
+	assert.Equal(t, []string{"alpine:3.10", "busybox:1.31", "nginx:1.17"}, GetImages(pod))
+}
+
+func TestGetImagesCronJobAndRollout(t *testing.T) {
+	cronJob := unmarshalObj(t, `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: my-cron
+spec:
+  schedule: "*/1 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: hello
+            image: busybox:1.31
+`)
+	assert.Equal(t, []string{"busybox:1.31"}, GetImages(cronJob))
+
+	rollout := unmarshalObj(t, `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: my-rollout
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: gcr.io/heptio-images/ks-guestbook-demo:0.2
+`)
+	assert.Equal(t, []string{"gcr.io/heptio-images/ks-guestbook-demo:0.2"}, GetImages(rollout))
+}
+
+func TestGetImagesMalformed(t *testing.T) {
+	deploy := unmarshalObj(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deploy
+spec:
+  template:
+    spec:
+      initContainers: invalid
+      containers:
+      - invalid
+      - name: no-image
+      - name: main
+        image: nginx:1.17
+`)
+	assert.Equal(t, []string{"nginx:1.17"}, GetImages(deploy))
+
+	noTemplate := unmarshalObj(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deploy
+spec:
+  template: invalid
+`)
+	assert.Nil(t, GetImages(noTemplate))
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -13,6 +12,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -38,12 +38,14 @@ const (
 	ServiceKind                  = "Service"
 	ServiceAccountKind           = "ServiceAccount"
 	EndpointsKind                = "Endpoints"
+	NamespaceKind                = "Namespace"
 	DeploymentKind               = "Deployment"
 	ReplicaSetKind               = "ReplicaSet"
 	StatefulSetKind              = "StatefulSet"
 	DaemonSetKind                = "DaemonSet"
 	IngressKind                  = "Ingress"
 	JobKind                      = "Job"
+	CronJobKind                  = "CronJob"
 	PersistentVolumeClaimKind    = "PersistentVolumeClaim"
 	CustomResourceDefinitionKind = "CustomResourceDefinition"
 	PodKind                      = "Pod"
@@ -84,17 +86,58 @@ func GetObjectRef(obj *unstructured.Unstructured) v1.ObjectReference {
 	}
 }
 
+// testConfigTimeout is the maximum duration of the check of the REST config performed by TestConfig
+const testConfigTimeout = 30 * time.Second
+
 // TestConfig tests to make sure the REST config is usable
 func TestConfig(config *rest.Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), testConfigTimeout)
+	defer cancel()
+	_, err := TestConfigWithContext(ctx, config, nil)
+	return err
+}
+
+// TestConfigWithContext tests to make sure the REST config is usable before the given context is done. If the preflight
+// options are provided, it also checks whether the credentials grant the permissions required by the application
+// controller and reports the missing permissions.
+func TestConfigWithContext(ctx context.Context, config *rest.Config, preflight *PreflightOptions) (*PreflightReport, error) {
+	config = rest.CopyConfig(config)
+	if deadline, ok := ctx.Deadline(); ok {
+		// the requests are not cancellable, so make sure they don't outlive the context
+		config.Timeout = time.Until(deadline)
+	}
 	kubeclientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
-		return fmt.Errorf("REST config invalid: %s", err)
+		return nil, fmt.Errorf("REST config invalid: %s", err)
 	}
-	_, err = kubeclientset.ServerVersion()
+	var report *PreflightReport
+	err = runWithContext(ctx, func() error {
+		if _, err := kubeclientset.ServerVersion(); err != nil {
+			return err
+		}
+		if preflight != nil {
+			report, err = checkPermissions(kubeclientset, preflight)
+		}
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("REST config invalid: %s", err)
+		return nil, fmt.Errorf("REST config invalid: %s", err)
+	}
+	return report, nil
+}
+
+// runWithContext runs the given action and returns its result or the context error if the context is done first
+func runWithContext(ctx context.Context, action func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- action()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	return nil
 }
 
 // ToUnstructured converts a concrete K8s API type to a un unstructured object
@@ -115,10 +158,13 @@ func MustToUnstructured(obj interface{}) *unstructured.Unstructured {
 	return uObj
 }
 
-// GetAppInstanceLabel returns the application instance name from labels
-func GetAppInstanceLabel(un *unstructured.Unstructured, key string) string {
-	if labels := un.GetLabels(); labels != nil {
-		return labels[key]
+// GetAppInstanceLabel returns the application instance name from the first of the given label keys which is set
+func GetAppInstanceLabel(un *unstructured.Unstructured, keys ...string) string {
+	labels := un.GetLabels()
+	for _, key := range keys {
+		if val := labels[key]; val != "" {
+			return val
+		}
 	}
 	return ""
 }
@@ -137,6 +183,100 @@ func UnsetLabel(target *unstructured.Unstructured, key string) {
 	}
 }
 
+// UnsetAnnotation removes an annotation from an unstructured object
+func UnsetAnnotation(target *unstructured.Unstructured, key string) {
+	if annotations := target.GetAnnotations(); annotations != nil {
+		if _, ok := annotations[key]; ok {
+			delete(annotations, key)
+			if len(annotations) == 0 {
+				unstructured.RemoveNestedField(target.Object, "metadata", "annotations")
+			} else {
+				target.SetAnnotations(annotations)
+			}
+		}
+	}
+}
+
+// GetPodTemplatePaths returns the paths of the nested templates of the standard workload kinds, starting from the outermost one.
+// CronJobs have two nested templates: the job template and the pod template of the job.
+func GetPodTemplatePaths(gvk schema.GroupVersionKind) [][]string {
+	switch gvk.Group {
+	case "":
+		if gvk.Kind == "ReplicationController" {
+			return [][]string{{"spec", "template"}}
+		}
+	case "apps", "extensions":
+		switch gvk.Kind {
+		case DeploymentKind, ReplicaSetKind, StatefulSetKind, DaemonSetKind:
+			return [][]string{{"spec", "template"}}
+		}
+	case "batch":
+		switch gvk.Kind {
+		case JobKind:
+			return [][]string{{"spec", "template"}}
+		case CronJobKind:
+			return [][]string{{"spec", "jobTemplate"}, {"spec", "jobTemplate", "spec", "template"}}
+		}
+	}
+	return nil
+}
+
+// RemoveLabelRecursive removes a label from an object and from the metadata of its nested templates. The templates are found at
+// the given paths or, if no path is given, at the template paths of the standard workload kinds. Selectors are never modified:
+// a template keeps the label if the selector next to it references the label, since the pods would no longer match the selector otherwise.
+func RemoveLabelRecursive(target *unstructured.Unstructured, key string, paths ...[]string) error {
+	UnsetLabel(target, key)
+	if len(paths) == 0 {
+		paths = GetPodTemplatePaths(target.GroupVersionKind())
+	}
+	for _, path := range paths {
+		if len(path) == 0 {
+			continue
+		}
+		selectorPath := append(append([]string{}, path[:len(path)-1]...), "selector")
+		selector, _, err := unstructured.NestedMap(target.Object, selectorPath...)
+		if err != nil {
+			return err
+		}
+		if selectorReferencesLabel(selector, key) {
+			continue
+		}
+		labelsPath := append(append([]string{}, path...), "metadata", "labels")
+		labels, ok, err := unstructured.NestedMap(target.Object, labelsPath...)
+		if err != nil {
+			return err
+		}
+		if _, exists := labels[key]; !ok || !exists {
+			continue
+		}
+		delete(labels, key)
+		if len(labels) == 0 {
+			unstructured.RemoveNestedField(target.Object, labelsPath...)
+		} else if err = unstructured.SetNestedMap(target.Object, labels, labelsPath...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectorReferencesLabel returns true if a label selector or a replication controller's plain label map selects by the given key
+func selectorReferencesLabel(selector map[string]interface{}, key string) bool {
+	if matchLabels, ok := selector["matchLabels"].(map[string]interface{}); ok {
+		if _, ok := matchLabels[key]; ok {
+			return true
+		}
+	}
+	if expressions, ok := selector["matchExpressions"].([]interface{}); ok {
+		for _, item := range expressions {
+			if expression, ok := item.(map[string]interface{}); ok && expression["key"] == key {
+				return true
+			}
+		}
+	}
+	_, ok := selector[key].(string)
+	return ok
+}
+
 // SetAppInstanceLabel the recommended app.kubernetes.io/instance label against an unstructured object
 // Uses the legacy labeling if environment variable is set
 func SetAppInstanceLabel(target *unstructured.Unstructured, key, val string) error {
@@ -234,6 +374,12 @@ func IsCRD(obj *unstructured.Unstructured) bool {
 	return IsCRDGroupVersionKind(obj.GroupVersionKind())
 }
 
+// IsAPIService returns true if the object is an APIService, which registers the server of an aggregated API
+func IsAPIService(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Kind == APIServiceKind && gvk.Group == "apiregistration.k8s.io"
+}
+
 // See: https://github.com/ksonnet/ksonnet/blob/master/utils/client.go
 func ServerResourceForGroupVersionKind(disco discovery.DiscoveryInterface, gvk schema.GroupVersionKind) (*metav1.APIResource, error) {
 	resources, err := disco.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
@@ -249,24 +395,14 @@ func ServerResourceForGroupVersionKind(disco discovery.DiscoveryInterface, gvk s
 	return nil, apierr.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, "")
 }
 
-// cleanKubectlOutput makes the error output of kubectl a little better to read
-func cleanKubectlOutput(s string) string {
-	s = strings.TrimSpace(s)
-	s = strings.Replace(s, ": error validating \"STDIN\"", "", -1)
-	s = strings.Replace(s, ": unable to recognize \"STDIN\"", "", -1)
-	s = strings.Replace(s, ": error when creating \"STDIN\"", "", -1)
-	s = strings.Replace(s, "; if you choose to ignore these errors, turn validation off with --validate=false", "", -1)
-	s = strings.Replace(s, "error: error", "error", -1)
-	return s
-}
-
 // WriteKubeConfig takes a rest.Config and writes it as a kubeconfig at the specified path
 func WriteKubeConfig(restConfig *rest.Config, namespace, filename string) error {
 	kubeConfig := NewKubeConfig(restConfig, namespace)
 	return clientcmd.WriteToFile(*kubeConfig, filename)
 }
 
-// NewKubeConfig converts a clientcmdapi.Config (kubeconfig) from a rest.Config
+// NewKubeConfig converts a clientcmdapi.Config (kubeconfig) from a rest.Config. Note that the TLS server name of the
+// rest.Config is not preserved since it cannot be expressed in the kubeconfig format supported by the client library.
 func NewKubeConfig(restConfig *rest.Config, namespace string) *clientcmdapi.Config {
 	return &clientcmdapi.Config{
 		CurrentContext: restConfig.Host,
@@ -328,6 +464,16 @@ func newAuthInfo(restConfig *rest.Config) *clientcmdapi.AuthInfo {
 		authInfo.Exec = restConfig.ExecProvider
 		haveCredentials = true
 	}
+	// impersonation is applied on top of the credentials, so it does not count as credentials
+	if restConfig.Impersonate.UserName != "" {
+		authInfo.Impersonate = restConfig.Impersonate.UserName
+	}
+	if len(restConfig.Impersonate.Groups) > 0 {
+		authInfo.ImpersonateGroups = restConfig.Impersonate.Groups
+	}
+	if len(restConfig.Impersonate.Extra) > 0 {
+		authInfo.ImpersonateUserExtra = restConfig.Impersonate.Extra
+	}
 	if restConfig.ExecProvider == nil && !haveCredentials {
 		// If no credentials were set (or there was no exec provider), we assume in-cluster config.
 		// In-cluster configs from the go-client will no longer set bearer tokens, so we set the
@@ -371,6 +517,118 @@ func SplitYAML(out string) ([]*unstructured.Unstructured, error) {
 	return objs, firstErr
 }
 
+// watchRetryDelay is the delay before a watch which has been closed by the API server is restarted
+var watchRetryDelay = time.Second
+
+// listAndWatch lists the objects using lister, if set, and dispatches an Added event for each of them. It then watches
+// changes from the resource version of the list, or of the last received event, and restarts the watch after
+// watchRetryDelay if it is closed. If the resource version has expired, the objects are listed again. It returns the
+// first list, watch or handler error, or nil once the context is done.
+func listAndWatch(ctx context.Context, lister func() (runtime.Object, string, error), watcher func(resourceVersion string) (watch.Interface, error), handler func(watch.Event) error) error {
+	list := func() (string, error) {
+		obj, resourceVersion, err := lister()
+		if err != nil {
+			return "", err
+		}
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return "", err
+		}
+		for _, item := range items {
+			if err := handler(watch.Event{Type: watch.Added, Object: item}); err != nil {
+				return "", err
+			}
+		}
+		return resourceVersion, nil
+	}
+
+	resourceVersion := ""
+	relist := lister != nil
+	for {
+		if relist {
+			var err error
+			if resourceVersion, err = list(); err != nil {
+				return err
+			}
+			relist = false
+		}
+		w, err := watcher(resourceVersion)
+		if err != nil {
+			if lister != nil && (apierr.IsResourceExpired(err) || apierr.IsGone(err)) {
+				relist = true
+				continue
+			}
+			return err
+		}
+		closed, err := func() (bool, error) {
+			defer w.Stop()
+			for {
+				select {
+				case event, ok := <-w.ResultChan():
+					if !ok {
+						return true, nil
+					}
+					if event.Type == watch.Error && lister != nil {
+						if err := apierr.FromObject(event.Object); apierr.IsResourceExpired(err) || apierr.IsGone(err) {
+							relist = true
+							return true, nil
+						}
+					} else if event.Type != watch.Error {
+						if accessor, err := meta.Accessor(event.Object); err == nil {
+							resourceVersion = accessor.GetResourceVersion()
+						}
+					}
+					if err := handler(event); err != nil {
+						return false, err
+					}
+				case <-ctx.Done():
+					return false, nil
+				}
+			}
+		}()
+		if !closed || err != nil {
+			return err
+		}
+		if !relist {
+			select {
+			case <-time.After(watchRetryDelay):
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// ListAndWatch lists the objects returned by lister and dispatches a synthetic Added event for each of them to handler,
+// then watches changes from the resource version returned by lister. The watch is restarted from the last received
+// resource version if it is closed, and the objects are listed and dispatched again if the resource version has
+// expired (410 Gone), so handlers must treat Added events of known objects as updates. The returned channel receives
+// the first list, watch or handler error and is closed once watching stopped, either because of the error or because
+// the context is done.
+func ListAndWatch(ctx context.Context, lister func() (runtime.Object, string, error), watcher func(resourceVersion string) (watch.Interface, error), handler func(watch.EventType, *unstructured.Unstructured) error) <-chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+		err := listAndWatch(ctx, lister, watcher, func(event watch.Event) error {
+			if event.Type == watch.Error {
+				return apierr.FromObject(event.Object)
+			}
+			un, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				var err error
+				if un, err = ToUnstructured(event.Object); err != nil {
+					return fmt.Errorf("failed to convert %T to unstructured: %v", event.Object, err)
+				}
+			}
+			return handler(event.Type, un)
+		})
+		if err != nil {
+			errCh <- err
+		}
+	}()
+	return errCh
+}
+
 // WatchWithRetry returns channel of watch events or errors of failed to call watch API.
 func WatchWithRetry(ctx context.Context, getWatch func() (watch.Interface, error)) chan struct {
 	*watch.Event
@@ -380,43 +638,26 @@ func WatchWithRetry(ctx context.Context, getWatch func() (watch.Interface, error
 		*watch.Event
 		Error error
 	})
-	execute := func() (bool, error) {
-		w, err := getWatch()
-		if err != nil {
-			return false, err
-		}
-		defer w.Stop()
-
-		for {
-			select {
-			case event, ok := <-w.ResultChan():
-				if ok {
-					ch <- struct {
-						*watch.Event
-						Error error
-					}{Event: &event, Error: nil}
-				} else {
-					return true, nil
-				}
-			case <-ctx.Done():
-				return false, nil
-			}
-		}
-	}
 	go func() {
 		defer close(ch)
-		for {
-			retry, err := execute()
-			if err != nil {
-				ch <- struct {
-					*watch.Event
-					Error error
-				}{Error: err}
-			}
-			if !retry {
-				return
+		err := listAndWatch(ctx, nil, func(string) (watch.Interface, error) {
+			return getWatch()
+		}, func(event watch.Event) error {
+			select {
+			case ch <- struct {
+				*watch.Event
+				Error error
+			}{Event: &event}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
 			}
-			time.Sleep(time.Second)
+		})
+		if err != nil && ctx.Err() == nil {
+			ch <- struct {
+				*watch.Event
+				Error error
+			}{Error: err}
 		}
 	}()
 	return ch
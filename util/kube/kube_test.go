@@ -1,17 +1,26 @@
 package kube
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/argoproj/argo-cd/common"
@@ -57,6 +66,142 @@ func TestUnsetLabels(t *testing.T) {
 
 }
 
+const depWithTemplateLabels = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx-deployment
+  labels:
+    app: nginx
+    app.kubernetes.io/instance: my-app
+spec:
+  selector:
+    matchLabels:
+      app: nginx
+    matchExpressions:
+    - key: tier
+      operator: In
+      values: [frontend]
+  template:
+    metadata:
+      labels:
+        app: nginx
+        tier: frontend
+        app.kubernetes.io/instance: my-app
+    spec:
+      containers:
+      - image: nginx:1.7.9
+        name: nginx
+`
+
+func TestRemoveLabelRecursiveDeployment(t *testing.T) {
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(depWithTemplateLabels), &obj))
+
+	assert.NoError(t, RemoveLabelRecursive(&obj, "app.kubernetes.io/instance"))
+	assert.Equal(t, map[string]string{"app": "nginx"}, obj.GetLabels())
+	templateLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "nginx", "tier": "frontend"}, templateLabels)
+
+	// labels referenced by the selector are kept in the template
+	assert.NoError(t, RemoveLabelRecursive(&obj, "app"))
+	assert.NoError(t, RemoveLabelRecursive(&obj, "tier"))
+	assert.Nil(t, obj.GetLabels())
+	templateLabels, _, _ = unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "nginx", "tier": "frontend"}, templateLabels)
+	matchLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{"app": "nginx"}, matchLabels)
+}
+
+const cronJobWithLabels = `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: hello
+  labels:
+    app.kubernetes.io/instance: my-app
+spec:
+  schedule: "*/1 * * * *"
+  jobTemplate:
+    metadata:
+      labels:
+        app.kubernetes.io/instance: my-app
+    spec:
+      template:
+        metadata:
+          labels:
+            app: hello
+            app.kubernetes.io/instance: my-app
+        spec:
+          containers:
+          - name: hello
+            image: busybox
+          restartPolicy: OnFailure
+`
+
+func TestRemoveLabelRecursiveCronJob(t *testing.T) {
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(cronJobWithLabels), &obj))
+
+	assert.NoError(t, RemoveLabelRecursive(&obj, "app.kubernetes.io/instance"))
+	assert.Nil(t, obj.GetLabels())
+	_, ok, _ := unstructured.NestedMap(obj.Object, "spec", "jobTemplate", "metadata", "labels")
+	assert.False(t, ok)
+	templateLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "jobTemplate", "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "hello"}, templateLabels)
+}
+
+const configMapWithMetadata = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+  labels:
+    app.kubernetes.io/instance: my-app
+  annotations:
+    foo: bar
+    kubectl.kubernetes.io/last-applied-configuration: "{}"
+data:
+  template: |
+    metadata:
+      labels:
+        app.kubernetes.io/instance: my-app
+`
+
+func TestUnsetAnnotationAndRemoveLabelRecursiveConfigMap(t *testing.T) {
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(configMapWithMetadata), &obj))
+
+	UnsetAnnotation(&obj, "kubectl.kubernetes.io/last-applied-configuration")
+	assert.Equal(t, map[string]string{"foo": "bar"}, obj.GetAnnotations())
+	UnsetAnnotation(&obj, "does-not-exist")
+	assert.Equal(t, map[string]string{"foo": "bar"}, obj.GetAnnotations())
+	UnsetAnnotation(&obj, "foo")
+	_, ok, _ := unstructured.NestedMap(obj.Object, "metadata", "annotations")
+	assert.False(t, ok)
+
+	assert.NoError(t, RemoveLabelRecursive(&obj, "app.kubernetes.io/instance"))
+	_, ok, _ = unstructured.NestedMap(obj.Object, "metadata", "labels")
+	assert.False(t, ok)
+	data, _, _ := unstructured.NestedString(obj.Object, "data", "template")
+	assert.Contains(t, data, "app.kubernetes.io/instance: my-app")
+}
+
+func TestRemoveLabelRecursiveCustomPaths(t *testing.T) {
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal([]byte(depWithTemplateLabels), &obj))
+	obj.SetAPIVersion("argoproj.io/v1alpha1")
+	obj.SetKind("Rollout")
+
+	assert.NoError(t, RemoveLabelRecursive(&obj, "app.kubernetes.io/instance"))
+	templateLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+	assert.Contains(t, templateLabels, "app.kubernetes.io/instance")
+
+	assert.NoError(t, RemoveLabelRecursive(&obj, "app.kubernetes.io/instance", []string{"spec", "template"}))
+	templateLabels, _, _ = unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "nginx", "tier": "frontend"}, templateLabels)
+}
+
 const depWithoutSelector = `
 apiVersion: extensions/v1beta1
 kind: Deployment
@@ -125,6 +270,19 @@ func TestSetLabels(t *testing.T) {
 	}
 }
 
+func TestGetAppInstanceLabel(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	assert.Equal(t, "", GetAppInstanceLabel(obj, common.LabelKeyAppInstance))
+
+	obj.SetLabels(map[string]string{common.LabelKeyAppInstance: "legacy-app"})
+	assert.Equal(t, "legacy-app", GetAppInstanceLabel(obj, "mycompany.com/appname", common.LabelKeyAppInstance))
+	assert.Equal(t, "", GetAppInstanceLabel(obj, "mycompany.com/appname"))
+
+	obj.SetLabels(map[string]string{common.LabelKeyAppInstance: "legacy-app", "mycompany.com/appname": "my-app"})
+	assert.Equal(t, "my-app", GetAppInstanceLabel(obj, "mycompany.com/appname", common.LabelKeyAppInstance))
+	assert.Equal(t, "legacy-app", GetAppInstanceLabel(obj, common.LabelKeyAppInstance, "mycompany.com/appname"))
+}
+
 func TestSetLegacyLabels(t *testing.T) {
 	for _, yamlStr := range []string{depWithoutSelector, depWithSelector} {
 		var obj unstructured.Unstructured
@@ -223,6 +381,74 @@ func TestInClusterKubeConfig(t *testing.T) {
 	assert.Empty(t, kubeConfig.AuthInfos[kubeConfig.CurrentContext].TokenFile)
 }
 
+// roundTripRESTConfig builds a rest config from the kubeconfig generated from the given rest config
+func roundTripRESTConfig(t *testing.T, restConfig *rest.Config) *rest.Config {
+	kubeConfig := NewKubeConfig(restConfig, "my-namespace")
+	loaded, err := clientcmd.NewDefaultClientConfig(*kubeConfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	assert.NoError(t, err)
+	return loaded
+}
+
+func TestKubeConfigRoundTrip(t *testing.T) {
+	restConfig := &rest.Config{
+		Host:        "https://my-cluster:6443",
+		BearerToken: "my-token",
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   []byte("ca-data"),
+			CertData: []byte("cert-data"),
+			KeyData:  []byte("key-data"),
+		},
+		Impersonate: rest.ImpersonationConfig{
+			UserName: "system:serviceaccount:argocd:deployer",
+			Groups:   []string{"system:serviceaccounts", "deployers"},
+			Extra:    map[string][]string{"scopes": {"view", "edit"}},
+		},
+	}
+	loaded := roundTripRESTConfig(t, restConfig)
+	assert.Equal(t, restConfig.Host, loaded.Host)
+	assert.Equal(t, restConfig.BearerToken, loaded.BearerToken)
+	assert.Equal(t, restConfig.TLSClientConfig.CAData, loaded.TLSClientConfig.CAData)
+	assert.Equal(t, restConfig.TLSClientConfig.CertData, loaded.TLSClientConfig.CertData)
+	assert.Equal(t, restConfig.TLSClientConfig.KeyData, loaded.TLSClientConfig.KeyData)
+	assert.Equal(t, restConfig.TLSClientConfig.Insecure, loaded.TLSClientConfig.Insecure)
+	assert.Equal(t, restConfig.Impersonate, loaded.Impersonate)
+
+	restConfig = &rest.Config{
+		Host:            "https://my-cluster:6443",
+		Username:        "admin",
+		Password:        "secret",
+		TLSClientConfig: rest.TLSClientConfig{Insecure: true},
+	}
+	loaded = roundTripRESTConfig(t, restConfig)
+	assert.Equal(t, restConfig.Username, loaded.Username)
+	assert.Equal(t, restConfig.Password, loaded.Password)
+	assert.True(t, loaded.TLSClientConfig.Insecure)
+	assert.Empty(t, loaded.Impersonate.UserName)
+}
+
+func TestKubeConfigRoundTripExecProvider(t *testing.T) {
+	restConfig := &rest.Config{
+		Host: "https://my-cluster:6443",
+		ExecProvider: &clientcmdapi.ExecConfig{
+			APIVersion: "client.authentication.k8s.io/v1alpha1",
+			Command:    "aws-iam-authenticator",
+			Args:       []string{"token", "-i", "my-cluster"},
+			Env:        []clientcmdapi.ExecEnvVar{{Name: "AWS_PROFILE", Value: "deployer"}},
+		},
+		Impersonate: rest.ImpersonationConfig{UserName: "deployer"},
+	}
+	loaded := roundTripRESTConfig(t, restConfig)
+	assert.Equal(t, restConfig.ExecProvider, loaded.ExecProvider)
+	assert.Equal(t, restConfig.Impersonate.UserName, loaded.Impersonate.UserName)
+	assert.Empty(t, loaded.Impersonate.Groups)
+
+	// impersonation alone is not a credential, so the in-cluster token is still used
+	kubeConfig := NewKubeConfig(&rest.Config{Impersonate: rest.ImpersonationConfig{UserName: "deployer"}}, "")
+	authInfo := kubeConfig.AuthInfos[kubeConfig.CurrentContext]
+	assert.NotEmpty(t, authInfo.TokenFile)
+	assert.Equal(t, "deployer", authInfo.Impersonate)
+}
+
 func TestGetDeploymentReplicas(t *testing.T) {
 	manifest := []byte(`
 apiVersion: apps/v1
@@ -270,3 +496,144 @@ spec:
 	assert.NoError(t, err)
 	assert.Nil(t, GetDeploymentReplicas(&noDeployment))
 }
+
+func newWatchTestConfigMap(name, resourceVersion string) *apiv1.ConfigMap {
+	return &apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion}}
+}
+
+func TestListAndWatch(t *testing.T) {
+	defer func(delay time.Duration) { watchRetryDelay = delay }(watchRetryDelay)
+	watchRetryDelay = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	firstWatch, secondWatch := watch.NewFake(), watch.NewFake()
+	watchers := []*watch.FakeWatcher{firstWatch, secondWatch}
+	versions := make(chan string, 10)
+	events := make(chan string, 10)
+	errCh := ListAndWatch(ctx, func() (runtime.Object, string, error) {
+		return &apiv1.ConfigMapList{Items: []apiv1.ConfigMap{*newWatchTestConfigMap("a", "1"), *newWatchTestConfigMap("b", "2")}}, "10", nil
+	}, func(resourceVersion string) (watch.Interface, error) {
+		w := watchers[0]
+		watchers = watchers[1:]
+		versions <- resourceVersion
+		return w, nil
+	}, func(eventType watch.EventType, un *unstructured.Unstructured) error {
+		events <- fmt.Sprintf("%s %s", eventType, un.GetName())
+		return nil
+	})
+
+	assert.Equal(t, "ADDED a", <-events)
+	assert.Equal(t, "ADDED b", <-events)
+	assert.Equal(t, "10", <-versions)
+
+	firstWatch.Modify(newWatchTestConfigMap("a", "11"))
+	assert.Equal(t, "MODIFIED a", <-events)
+
+	// the watch is restarted from the last received resource version once it is closed
+	firstWatch.Stop()
+	assert.Equal(t, "11", <-versions)
+	secondWatch.Delete(newWatchTestConfigMap("b", "12"))
+	assert.Equal(t, "DELETED b", <-events)
+
+	cancel()
+	assert.NoError(t, <-errCh)
+}
+
+func TestListAndWatch_ResourceVersionExpired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lists := make(chan string, 10)
+	fakeWatch := watch.NewFake()
+	listCalls := 0
+	watchCalls := 0
+	errCh := ListAndWatch(ctx, func() (runtime.Object, string, error) {
+		listCalls++
+		resourceVersion := fmt.Sprintf("%d", listCalls)
+		lists <- resourceVersion
+		return &apiv1.ConfigMapList{Items: []apiv1.ConfigMap{*newWatchTestConfigMap("a", resourceVersion)}}, resourceVersion, nil
+	}, func(resourceVersion string) (watch.Interface, error) {
+		watchCalls++
+		if watchCalls == 1 {
+			return nil, apierr.NewResourceExpired("too old resource version")
+		}
+		return fakeWatch, nil
+	}, func(eventType watch.EventType, un *unstructured.Unstructured) error {
+		return nil
+	})
+
+	// the expired watch call and the expired watch event trigger a new list
+	assert.Equal(t, "1", <-lists)
+	assert.Equal(t, "2", <-lists)
+	fakeWatch.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired})
+	assert.Equal(t, "3", <-lists)
+
+	cancel()
+	assert.NoError(t, <-errCh)
+}
+
+func TestListAndWatch_Errors(t *testing.T) {
+	listErr := fmt.Errorf("list failed")
+	errCh := ListAndWatch(context.Background(), func() (runtime.Object, string, error) {
+		return nil, "", listErr
+	}, func(resourceVersion string) (watch.Interface, error) {
+		return watch.NewFake(), nil
+	}, func(eventType watch.EventType, un *unstructured.Unstructured) error {
+		return nil
+	})
+	assert.Equal(t, listErr, <-errCh)
+	_, ok := <-errCh
+	assert.False(t, ok)
+
+	handlerErr := fmt.Errorf("handler failed")
+	errCh = ListAndWatch(context.Background(), func() (runtime.Object, string, error) {
+		return &apiv1.ConfigMapList{Items: []apiv1.ConfigMap{*newWatchTestConfigMap("a", "1")}}, "1", nil
+	}, func(resourceVersion string) (watch.Interface, error) {
+		return watch.NewFake(), nil
+	}, func(eventType watch.EventType, un *unstructured.Unstructured) error {
+		return handlerErr
+	})
+	assert.Equal(t, handlerErr, <-errCh)
+
+	fakeWatch := watch.NewFake()
+	errCh = ListAndWatch(context.Background(), func() (runtime.Object, string, error) {
+		return &apiv1.ConfigMapList{}, "1", nil
+	}, func(resourceVersion string) (watch.Interface, error) {
+		return fakeWatch, nil
+	}, func(eventType watch.EventType, un *unstructured.Unstructured) error {
+		return nil
+	})
+	fakeWatch.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusForbidden, Reason: metav1.StatusReasonForbidden, Message: "forbidden"})
+	assert.True(t, apierr.IsForbidden(<-errCh))
+}
+
+func TestWatchWithRetry(t *testing.T) {
+	defer func(delay time.Duration) { watchRetryDelay = delay }(watchRetryDelay)
+	watchRetryDelay = 0
+
+	watchErr := fmt.Errorf("watch failed")
+	fakeWatch := watch.NewFake()
+	watchCalls := 0
+	ch := WatchWithRetry(context.Background(), func() (watch.Interface, error) {
+		watchCalls++
+		if watchCalls == 1 {
+			return fakeWatch, nil
+		}
+		return nil, watchErr
+	})
+	go func() {
+		fakeWatch.Add(newWatchTestConfigMap("a", "1"))
+		fakeWatch.Stop()
+	}()
+
+	next := <-ch
+	if assert.NotNil(t, next.Event) {
+		assert.Equal(t, watch.Added, next.Type)
+		assert.Equal(t, "a", next.Object.(*apiv1.ConfigMap).Name)
+	}
+	// the watch is restarted once it is closed and the error of the failed watch call is returned
+	next = <-ch
+	assert.Equal(t, watchErr, next.Error)
+	_, ok := <-ch
+	assert.False(t, ok)
+}
@@ -1,6 +1,8 @@
 package kubetest
 
 import (
+	"context"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,17 +19,33 @@ type KubectlOutput struct {
 }
 
 type MockKubectlCmd struct {
-	APIResources []kube.APIResourceInfo
-	Commands     map[string]KubectlOutput
-	Events       chan watch.Event
-	LastValidate bool
+	APIResources       []kube.APIResourceInfo
+	APIResourcesError  error
+	Resources          []*unstructured.Unstructured
+	Commands           map[string]KubectlOutput
+	Events             chan watch.Event
+	LastValidate       bool
+	LastApplied        *unstructured.Unstructured
+	LastDryRunStrategy kube.DryRunStrategy
+	OpenAPISchema      *kube.OpenAPISchema
+	APIVersions        []string
+	// ServerSideDryRunResults holds the results of the server-side dry-run applies by resource name, the applied
+	// resource is returned if there is no result for its name
+	ServerSideDryRunResults map[string]*unstructured.Unstructured
+	// ServerSideDryRunErrors holds the errors of the server-side dry-run applies by resource name
+	ServerSideDryRunErrors map[string]error
 }
 
 func (k *MockKubectlCmd) GetAPIResources(config *rest.Config, resourceFilter kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
-	return k.APIResources, nil
+	return k.APIResources, k.APIResourcesError
 }
 
 func (k *MockKubectlCmd) GetResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	for _, obj := range k.Resources {
+		if obj.GroupVersionKind() == gvk && obj.GetName() == name && obj.GetNamespace() == namespace {
+			return obj, nil
+		}
+	}
 	return nil, nil
 }
 
@@ -35,6 +53,16 @@ func (k *MockKubectlCmd) PatchResource(config *rest.Config, gvk schema.GroupVers
 	return nil, nil
 }
 
+func (k *MockKubectlCmd) ServerSideDryRunApply(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	if err, ok := k.ServerSideDryRunErrors[obj.GetName()]; ok {
+		return nil, err
+	}
+	if result, ok := k.ServerSideDryRunResults[obj.GetName()]; ok {
+		return result.DeepCopy(), nil
+	}
+	return obj.DeepCopy(), nil
+}
+
 func (k *MockKubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, forceDelete bool) error {
 	command, ok := k.Commands[name]
 	if !ok {
@@ -43,8 +71,10 @@ func (k *MockKubectlCmd) DeleteResource(config *rest.Config, gvk schema.GroupVer
 	return command.Err
 }
 
-func (k *MockKubectlCmd) ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force, validate bool) (string, error) {
+func (k *MockKubectlCmd) ApplyResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRunStrategy kube.DryRunStrategy, force, validate bool) (string, error) {
 	k.LastValidate = validate
+	k.LastApplied = obj
+	k.LastDryRunStrategy = dryRunStrategy
 	command, ok := k.Commands[obj.GetName()]
 	if !ok {
 		return "", nil
@@ -61,5 +91,13 @@ func (k *MockKubectlCmd) GetServerVersion(config *rest.Config) (string, error) {
 	return "", nil
 }
 
+func (k *MockKubectlCmd) GetAPIVersions(config *rest.Config) ([]string, error) {
+	return k.APIVersions, nil
+}
+
+func (k *MockKubectlCmd) GetOpenAPISchema(config *rest.Config) (*kube.OpenAPISchema, error) {
+	return k.OpenAPISchema, nil
+}
+
 func (k *MockKubectlCmd) SetOnKubectlRun(onKubectlRun func(command string) (util.Closer, error)) {
 }
@@ -0,0 +1,81 @@
+package kube
+
+import (
+	"fmt"
+
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"k8s.io/kube-openapi/pkg/util/proto/validation"
+)
+
+// groupVersionKindExtensionKey is the vendor extension which lists the kinds of the top level models
+const groupVersionKindExtensionKey = "x-kubernetes-group-version-kind"
+
+// OpenAPISchema holds the OpenAPI models of the resource kinds served by a cluster
+type OpenAPISchema struct {
+	models proto.Models
+	// kindModels holds the model names of the resource kinds
+	kindModels map[schema.GroupVersionKind]string
+}
+
+// NewOpenAPISchema parses the OpenAPI document published by a cluster
+func NewOpenAPISchema(doc *openapi_v2.Document) (*OpenAPISchema, error) {
+	models, err := proto.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, err
+	}
+	kindModels := make(map[schema.GroupVersionKind]string)
+	for _, name := range models.ListModels() {
+		model := models.LookupModel(name)
+		if model == nil {
+			continue
+		}
+		for _, gvk := range parseGroupVersionKinds(model) {
+			kindModels[gvk] = name
+		}
+	}
+	return &OpenAPISchema{models: models, kindModels: kindModels}, nil
+}
+
+// parseGroupVersionKinds returns the kinds listed in the group version kind extension of the given model
+func parseGroupVersionKinds(model proto.Schema) []schema.GroupVersionKind {
+	extension, ok := model.GetExtensions()[groupVersionKindExtensionKey].([]interface{})
+	if !ok {
+		return nil
+	}
+	var gvks []schema.GroupVersionKind
+	for _, item := range extension {
+		fields := make(map[string]string)
+		switch gvk := item.(type) {
+		case map[interface{}]interface{}:
+			for k, v := range gvk {
+				fields[fmt.Sprint(k)] = fmt.Sprint(v)
+			}
+		case map[string]interface{}:
+			for k, v := range gvk {
+				fields[k] = fmt.Sprint(v)
+			}
+		default:
+			continue
+		}
+		gvks = append(gvks, schema.GroupVersionKind{Group: fields["group"], Version: fields["version"], Kind: fields["kind"]})
+	}
+	return gvks
+}
+
+// ValidateObject validates the given object against the model of its kind and returns the field path and reason of
+// every violation. The second return value is false if the schema has no model of the kind, e.g. because the kind is a
+// custom resource whose CRD publishes no schema.
+func (s *OpenAPISchema) ValidateObject(obj *unstructured.Unstructured) ([]error, bool) {
+	name, ok := s.kindModels[obj.GroupVersionKind()]
+	if !ok {
+		return nil, false
+	}
+	model := s.models.LookupModel(name)
+	if model == nil {
+		return nil, false
+	}
+	return validation.ValidateModel(obj.Object, model, obj.GetKind()), true
+}
@@ -0,0 +1,77 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/test"
+)
+
+func TestOpenAPISchema_ValidateObject(t *testing.T) {
+	openAPISchema, err := NewOpenAPISchema(test.NewOpenAPIDocument())
+	assert.NoError(t, err)
+
+	errs, ok := openAPISchema.ValidateObject(test.NewPod())
+	assert.True(t, ok)
+	assert.Empty(t, errs)
+
+	pod := unmarshalObj(t, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+  creationTimestamp: null
+spec:
+  restartPolicy:
+    always: true
+  containers:
+  - name: nginx
+    imag: nginx:1.17
+`)
+	errs, ok = openAPISchema.ValidateObject(pod)
+	assert.True(t, ok)
+	if assert.Len(t, errs, 2) {
+		assert.Contains(t, errs[0].Error(), `Pod.spec.containers[0]): unknown field "imag"`)
+		assert.Contains(t, errs[1].Error(), `Pod.spec.restartPolicy): invalid type for io.k8s.api.core.v1.PodSpec.restartPolicy: got "map", expected "string"`)
+	}
+
+	noContainers := unmarshalObj(t, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+spec: {}
+`)
+	errs, ok = openAPISchema.ValidateObject(noContainers)
+	assert.True(t, ok)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), `missing required field "containers"`)
+	}
+}
+
+func TestOpenAPISchema_ValidateObjectUnknownKind(t *testing.T) {
+	openAPISchema, err := NewOpenAPISchema(test.NewOpenAPIDocument())
+	assert.NoError(t, err)
+
+	// kinds of other versions and custom resources without a published schema are not validated
+	for _, obj := range []string{`
+apiVersion: v2
+kind: Pod
+metadata:
+  name: my-pod
+spec:
+  unknown: true
+`, `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: my-rollout
+spec:
+  unknown: true
+`} {
+		errs, ok := openAPISchema.ValidateObject(unmarshalObj(t, obj))
+		assert.False(t, ok)
+		assert.Empty(t, errs)
+	}
+}
@@ -0,0 +1,94 @@
+package kube
+
+import (
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PreflightOptions configures the check of the permissions required by the application controller
+type PreflightOptions struct {
+	// Namespaces are the namespaces managed by Argo CD. All namespaces are managed if empty.
+	Namespaces []string
+}
+
+// MissingPermission is a permission required by the application controller which is not granted to the credentials
+type MissingPermission struct {
+	Verb     string
+	Group    string
+	Resource string
+	// Namespace is empty if the permission is required cluster-wide
+	Namespace string
+}
+
+func (p MissingPermission) String() string {
+	resource := p.Resource
+	if resource == "*" {
+		resource = "all resources"
+	} else if p.Group != "" && p.Group != "*" {
+		resource = fmt.Sprintf("%s.%s", p.Resource, p.Group)
+	}
+	scope := "cluster-wide"
+	if p.Namespace != "" {
+		scope = fmt.Sprintf("in namespace %s", p.Namespace)
+	}
+	return fmt.Sprintf("this credential cannot %s %s %s", p.Verb, resource, scope)
+}
+
+// PreflightReport lists the permissions required by the application controller which are not granted to the credentials
+type PreflightReport struct {
+	Missing []MissingPermission
+}
+
+// requiredPermission is a permission which the application controller needs in every managed namespace
+type requiredPermission struct {
+	verb     string
+	group    string
+	resource string
+}
+
+// requiredPermissions are the permissions required to cache the cluster state and to sync and prune resources
+var requiredPermissions = []requiredPermission{
+	{verb: "list", group: "*", resource: "*"},
+	{verb: "watch", group: "*", resource: "*"},
+	{verb: "list", group: "", resource: "secrets"},
+	{verb: "watch", group: "", resource: "secrets"},
+	{verb: "create", group: "*", resource: "*"},
+	{verb: "delete", group: "*", resource: "*"},
+}
+
+// checkPermissions issues a SelfSubjectAccessReview for every permission required in every managed namespace
+func checkPermissions(kubeclientset kubernetes.Interface, preflight *PreflightOptions) (*PreflightReport, error) {
+	namespaces := preflight.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	report := &PreflightReport{}
+	for _, namespace := range namespaces {
+		for _, permission := range requiredPermissions {
+			review, err := kubeclientset.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Namespace: namespace,
+						Verb:      permission.verb,
+						Group:     permission.group,
+						Resource:  permission.resource,
+					},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !review.Status.Allowed {
+				report.Missing = append(report.Missing, MissingPermission{
+					Verb:      permission.verb,
+					Group:     permission.group,
+					Resource:  permission.resource,
+					Namespace: namespace,
+				})
+			}
+		}
+	}
+	return report, nil
+}
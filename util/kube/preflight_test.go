@@ -0,0 +1,84 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
+)
+
+// newFakeAPIServer returns an API server which serves the version and allows the access reviews accepted by the given function
+func newFakeAPIServer(t *testing.T, allowed func(attrs *authorizationv1.ResourceAttributes) bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/version":
+			_, _ = w.Write([]byte(`{"major": "1", "minor": "14", "gitVersion": "v1.14.0"}`))
+		case "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews":
+			var review authorizationv1.SelfSubjectAccessReview
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&review))
+			review.Status.Allowed = allowed(review.Spec.ResourceAttributes)
+			w.WriteHeader(http.StatusCreated)
+			assert.NoError(t, json.NewEncoder(w).Encode(review))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestTestConfigWithContext(t *testing.T) {
+	server := newFakeAPIServer(t, func(attrs *authorizationv1.ResourceAttributes) bool { return true })
+	defer server.Close()
+
+	report, err := TestConfigWithContext(context.Background(), &rest.Config{Host: server.URL}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+
+	report, err = TestConfigWithContext(context.Background(), &rest.Config{Host: server.URL}, &PreflightOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, report.Missing)
+
+	assert.NoError(t, TestConfig(&rest.Config{Host: server.URL}))
+}
+
+func TestTestConfigWithContextTimeout(t *testing.T) {
+	unblock := make(chan bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := TestConfigWithContext(ctx, &rest.Config{Host: server.URL}, nil)
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestTestConfigWithContextMissingPermissions(t *testing.T) {
+	server := newFakeAPIServer(t, func(attrs *authorizationv1.ResourceAttributes) bool {
+		return attrs.Resource != "secrets" && !(attrs.Verb == "delete" && attrs.Namespace == "kube-system")
+	})
+	defer server.Close()
+
+	report, err := TestConfigWithContext(context.Background(), &rest.Config{Host: server.URL}, &PreflightOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []MissingPermission{
+		{Verb: "list", Group: "", Resource: "secrets"},
+		{Verb: "watch", Group: "", Resource: "secrets"},
+	}, report.Missing)
+	assert.Equal(t, "this credential cannot watch secrets cluster-wide", report.Missing[1].String())
+
+	report, err = TestConfigWithContext(context.Background(), &rest.Config{Host: server.URL}, &PreflightOptions{Namespaces: []string{"default", "kube-system"}})
+	assert.NoError(t, err)
+	assert.Len(t, report.Missing, 5)
+	assert.Equal(t, "this credential cannot delete all resources in namespace kube-system", report.Missing[4].String())
+}
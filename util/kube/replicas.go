@@ -0,0 +1,103 @@
+package kube
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ReplicaCounts are the replica counts of a workload resource
+type ReplicaCounts struct {
+	// Desired is the number of replicas requested by the spec, or the number of nodes which should run the pod for daemon sets
+	Desired int64
+	// Current is the number of replicas which currently exist
+	Current int64
+	// Ready is the number of replicas which are ready
+	Ready int64
+	// Updated is the number of replicas which run the latest pod template
+	Updated int64
+	// Available is the number of replicas which are available for at least minReadySeconds
+	Available int64
+	// HasStatus is false if the controller has not reported any status yet, in which case all counts except Desired are zero
+	HasStatus bool
+}
+
+// UnsupportedKindError is returned for resources which don't manage replicas
+type UnsupportedKindError struct {
+	GroupKind schema.GroupKind
+}
+
+func (e *UnsupportedKindError) Error() string {
+	return fmt.Sprintf("replica counts are not supported for %s", e.GroupKind.String())
+}
+
+// replicaStatusFields are the status fields of the Current, Ready, Updated and Available counts
+type replicaStatusFields struct {
+	current   string
+	ready     string
+	updated   string
+	available string
+}
+
+var (
+	replicaSetStatusFields = replicaStatusFields{current: "replicas", ready: "readyReplicas", updated: "updatedReplicas", available: "availableReplicas"}
+	daemonSetStatusFields  = replicaStatusFields{current: "currentNumberScheduled", ready: "numberReady", updated: "updatedNumberScheduled", available: "numberAvailable"}
+	// stateful sets don't report available replicas, pods are counted as available once they are ready
+	statefulSetStatusFields = replicaStatusFields{current: "replicas", ready: "readyReplicas", updated: "updatedReplicas", available: "readyReplicas"}
+)
+
+// GetReplicaCounts returns the replica counts of a Deployment, ReplicaSet, StatefulSet or DaemonSet
+func GetReplicaCounts(un *unstructured.Unstructured) (*ReplicaCounts, error) {
+	gvk := un.GroupVersionKind()
+	if gvk.Group != "apps" && gvk.Group != "extensions" {
+		return nil, &UnsupportedKindError{GroupKind: gvk.GroupKind()}
+	}
+	var counts ReplicaCounts
+	var fields replicaStatusFields
+	switch gvk.Kind {
+	case DeploymentKind, ReplicaSetKind:
+		fields = replicaSetStatusFields
+	case StatefulSetKind:
+		if gvk.Group != "apps" {
+			return nil, &UnsupportedKindError{GroupKind: gvk.GroupKind()}
+		}
+		fields = statefulSetStatusFields
+	case DaemonSetKind:
+		fields = daemonSetStatusFields
+	default:
+		return nil, &UnsupportedKindError{GroupKind: gvk.GroupKind()}
+	}
+
+	status, ok, err := unstructured.NestedMap(un.Object, "status")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of %s %s: %v", gvk.Kind, un.GetName(), err)
+	}
+	counts.HasStatus = ok && len(status) > 0
+	for _, item := range []struct {
+		field string
+		count *int64
+	}{
+		{fields.current, &counts.Current},
+		{fields.ready, &counts.Ready},
+		{fields.updated, &counts.Updated},
+		{fields.available, &counts.Available},
+	} {
+		if *item.count, _, err = unstructured.NestedInt64(status, item.field); err != nil {
+			return nil, fmt.Errorf("failed to get status.%s of %s %s: %v", item.field, gvk.Kind, un.GetName(), err)
+		}
+	}
+
+	if gvk.Kind == DaemonSetKind {
+		counts.Desired, _, err = unstructured.NestedInt64(status, "desiredNumberScheduled")
+	} else if replicas := GetDeploymentReplicas(un); replicas != nil {
+		counts.Desired = *replicas
+	} else {
+		// replicas default to one
+		counts.Desired = 1
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status.desiredNumberScheduled of %s %s: %v", gvk.Kind, un.GetName(), err)
+	}
+	return &counts, nil
+}
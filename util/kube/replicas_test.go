@@ -0,0 +1,71 @@
+package kube
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func loadTestdata(t *testing.T, path string) *unstructured.Unstructured {
+	yamlBytes, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	var obj unstructured.Unstructured
+	assert.NoError(t, yaml.Unmarshal(yamlBytes, &obj))
+	return &obj
+}
+
+func TestGetReplicaCounts(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected ReplicaCounts
+	}{{
+		path:     "testdata/deployment-rollout.yaml",
+		expected: ReplicaCounts{Desired: 3, Current: 4, Ready: 3, Updated: 1, Available: 3, HasStatus: true},
+	}, {
+		path:     "testdata/replicaset-rollout.yaml",
+		expected: ReplicaCounts{Desired: 3, Current: 3, Ready: 2, Updated: 0, Available: 1, HasStatus: true},
+	}, {
+		path:     "testdata/statefulset-rollout.yaml",
+		expected: ReplicaCounts{Desired: 3, Current: 3, Ready: 2, Updated: 1, Available: 2, HasStatus: true},
+	}, {
+		path:     "testdata/daemonset-rollout.yaml",
+		expected: ReplicaCounts{Desired: 5, Current: 5, Ready: 4, Updated: 2, Available: 4, HasStatus: true},
+	}, {
+		path:     "testdata/deployment-nostatus.yaml",
+		expected: ReplicaCounts{Desired: 1, HasStatus: false},
+	}, {
+		path:     "testdata/nginx.yaml",
+		expected: ReplicaCounts{Desired: 1, Current: 1, Ready: 1, Updated: 1, Available: 1, HasStatus: true},
+	}}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			counts, err := GetReplicaCounts(loadTestdata(t, test.path))
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, *counts)
+		})
+	}
+}
+
+func TestGetReplicaCountsUnsupportedKind(t *testing.T) {
+	for _, path := range []string{"testdata/svc.yaml", "testdata/job.yaml"} {
+		un := loadTestdata(t, path)
+		_, err := GetReplicaCounts(un)
+		if assert.IsType(t, &UnsupportedKindError{}, err) {
+			assert.Equal(t, un.GroupVersionKind().GroupKind(), err.(*UnsupportedKindError).GroupKind)
+		}
+	}
+
+	_, err := GetReplicaCounts(&unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "extensions/v1beta1", "kind": "StatefulSet"}})
+	assert.Equal(t, &UnsupportedKindError{GroupKind: schema.GroupKind{Group: "extensions", Kind: "StatefulSet"}}, err)
+}
+
+func TestGetReplicaCountsInvalidStatus(t *testing.T) {
+	un := loadTestdata(t, "testdata/deployment-rollout.yaml")
+	assert.NoError(t, unstructured.SetNestedField(un.Object, "three", "status", "readyReplicas"))
+	_, err := GetReplicaCounts(un)
+	assert.Error(t, err)
+}
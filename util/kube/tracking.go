@@ -0,0 +1,130 @@
+package kube
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+// TrackingMethod is the method by which the resources of an application are associated with it
+type TrackingMethod string
+
+const (
+	// TrackingMethodLabel tracks resources by the app instance label, which is the default
+	TrackingMethodLabel TrackingMethod = "label"
+	// TrackingMethodAnnotation tracks resources by the tracking annotation, which unlike the app instance label is not
+	// overwritten by tools such as Helm and is not limited to 63 characters
+	TrackingMethodAnnotation TrackingMethod = "annotation"
+	// TrackingMethodAnnotationAndLabel tracks resources by the tracking annotation, but still sets the app instance label
+	// for tools which select resources by it
+	TrackingMethodAnnotationAndLabel TrackingMethod = "annotation+label"
+)
+
+// ParseTrackingMethod parses a resource tracking method. An empty string is the label tracking method.
+func ParseTrackingMethod(val string) (TrackingMethod, error) {
+	switch method := TrackingMethod(val); method {
+	case "":
+		return TrackingMethodLabel, nil
+	case TrackingMethodLabel, TrackingMethodAnnotation, TrackingMethodAnnotationAndLabel:
+		return method, nil
+	}
+	return "", fmt.Errorf("unknown resource tracking method '%s', expected one of %s, %s or %s",
+		val, TrackingMethodLabel, TrackingMethodAnnotation, TrackingMethodAnnotationAndLabel)
+}
+
+// UsesAnnotation returns whether resources are tracked by the tracking annotation
+func (m TrackingMethod) UsesAnnotation() bool {
+	return m == TrackingMethodAnnotation || m == TrackingMethodAnnotationAndLabel
+}
+
+// SetsLabel returns whether the app instance label is set on resources. Unknown methods behave like the label method.
+func (m TrackingMethod) SetsLabel() bool {
+	return m != TrackingMethodAnnotation
+}
+
+// GetAppInstanceAnnotation returns the application instance name from the tracking annotation
+func GetAppInstanceAnnotation(un *unstructured.Unstructured) string {
+	return un.GetAnnotations()[common.AnnotationKeyAppInstance]
+}
+
+// SetAppInstanceAnnotation sets the tracking annotation of an unstructured object
+func SetAppInstanceAnnotation(target *unstructured.Unstructured, val string) {
+	annotations := target.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.AnnotationKeyAppInstance] = val
+	target.SetAnnotations(annotations)
+}
+
+// UnsetAppInstanceAnnotation removes the tracking annotation from an unstructured object
+func UnsetAppInstanceAnnotation(target *unstructured.Unstructured) {
+	UnsetAnnotation(target, common.AnnotationKeyAppInstance)
+}
+
+// GetAppInstanceName returns the name of the application which tracks a resource using the given tracking method.
+// Tracking methods which use the annotation fall back to the given label keys if the annotation is not set, so that
+// resources which were synced before switching to the annotation are still tracked.
+func GetAppInstanceName(un *unstructured.Unstructured, method TrackingMethod, labelKeys ...string) string {
+	if method.UsesAnnotation() {
+		if val := GetAppInstanceAnnotation(un); val != "" {
+			return val
+		}
+	}
+	return GetAppInstanceLabel(un, labelKeys...)
+}
+
+// SetAppInstance marks a resource as part of an application using the given tracking method
+func SetAppInstance(target *unstructured.Unstructured, method TrackingMethod, labelKey, val string) error {
+	if method.UsesAnnotation() {
+		SetAppInstanceAnnotation(target, val)
+	}
+	if method.SetsLabel() {
+		return SetAppInstanceLabel(target, labelKey, val)
+	}
+	return nil
+}
+
+// TrackingValue formats the value by which the resources of applications are tracked, i.e. the value of the app
+// instance label or the tracking annotation. The application name is prefixed with a value which identifies the Argo CD
+// installation, so that applications with the same name in different installations which manage the same cluster do
+// not claim each other's resources.
+type TrackingValue struct {
+	// Prefix is prepended to the application name, it is empty if resources are tracked by the bare application name
+	Prefix string
+}
+
+// NamespaceTrackingValue returns the tracking value format `<namespace>_<name>` of the installation in the given namespace
+func NamespaceTrackingValue(namespace string) TrackingValue {
+	return TrackingValue{Prefix: namespace + "_"}
+}
+
+// InstanceIDTrackingValue returns the tracking value format `<instance-id>:<name>` of the installation with the given ID
+func InstanceIDTrackingValue(instanceID string) TrackingValue {
+	return TrackingValue{Prefix: instanceID + ":"}
+}
+
+// Format returns the tracking value of the resources of the given application
+func (v TrackingValue) Format(appName string) string {
+	return v.Prefix + appName
+}
+
+// Parse returns the application name of the given tracking value. Bare application names, which resources were
+// tracked by before the prefix was configured, are still recognized, in which case legacy is true. The name is empty if
+// the value has the prefix of another installation.
+func (v TrackingValue) Parse(val string) (appName string, legacy bool) {
+	if v.Prefix == "" || val == "" {
+		return val, false
+	}
+	if strings.HasPrefix(val, v.Prefix) {
+		return strings.TrimPrefix(val, v.Prefix), false
+	}
+	// application names are DNS subdomain names, a value with a separator is formatted by another installation
+	if strings.ContainsAny(val, "_:") {
+		return "", false
+	}
+	return val, true
+}
@@ -0,0 +1,90 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+func TestParseTrackingMethod(t *testing.T) {
+	for val, expected := range map[string]TrackingMethod{
+		"":                 TrackingMethodLabel,
+		"label":            TrackingMethodLabel,
+		"annotation":       TrackingMethodAnnotation,
+		"annotation+label": TrackingMethodAnnotationAndLabel,
+	} {
+		method, err := ParseTrackingMethod(val)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, method)
+	}
+
+	_, err := ParseTrackingMethod("name")
+	assert.EqualError(t, err, "unknown resource tracking method 'name', expected one of label, annotation or annotation+label")
+}
+
+func TestGetAppInstanceName(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	obj.SetLabels(map[string]string{common.LabelKeyAppInstance: "helm-release"})
+	assert.Equal(t, "helm-release", GetAppInstanceName(obj, TrackingMethodAnnotation, common.LabelKeyAppInstance))
+
+	obj.SetAnnotations(map[string]string{common.AnnotationKeyAppInstance: "my-app"})
+	assert.Equal(t, "my-app", GetAppInstanceName(obj, TrackingMethodAnnotation, common.LabelKeyAppInstance))
+	assert.Equal(t, "my-app", GetAppInstanceName(obj, TrackingMethodAnnotationAndLabel, common.LabelKeyAppInstance))
+	assert.Equal(t, "helm-release", GetAppInstanceName(obj, TrackingMethodLabel, common.LabelKeyAppInstance))
+	assert.Equal(t, "helm-release", GetAppInstanceName(obj, "", common.LabelKeyAppInstance))
+
+	UnsetAppInstanceAnnotation(obj)
+	assert.Nil(t, obj.GetAnnotations())
+}
+
+func TestSetAppInstance(t *testing.T) {
+	for method, expected := range map[TrackingMethod]struct {
+		label      string
+		annotation string
+	}{
+		TrackingMethodLabel:              {label: "my-app"},
+		TrackingMethodAnnotation:         {annotation: "my-app"},
+		TrackingMethodAnnotationAndLabel: {label: "my-app", annotation: "my-app"},
+	} {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		assert.NoError(t, SetAppInstance(obj, method, common.LabelKeyAppInstance, "my-app"))
+		assert.Equal(t, expected.label, obj.GetLabels()[common.LabelKeyAppInstance], method)
+		assert.Equal(t, expected.annotation, GetAppInstanceAnnotation(obj), method)
+	}
+}
+
+func TestTrackingValue(t *testing.T) {
+	assert.Equal(t, "my-app", TrackingValue{}.Format("my-app"))
+	name, legacy := TrackingValue{}.Parse("argocd_my-app")
+	assert.Equal(t, "argocd_my-app", name)
+	assert.False(t, legacy)
+
+	for _, value := range []TrackingValue{NamespaceTrackingValue("argocd"), InstanceIDTrackingValue("argocd")} {
+		val := value.Format("my-app")
+		name, legacy := value.Parse(val)
+		assert.Equal(t, "my-app", name, val)
+		assert.False(t, legacy, val)
+
+		// resources labeled with the bare name are still tracked
+		name, legacy = value.Parse("my-app")
+		assert.Equal(t, "my-app", name)
+		assert.True(t, legacy)
+
+		// resources of other installations are not
+		for _, other := range []string{"other_my-app", "other:my-app"} {
+			name, legacy = value.Parse(other)
+			assert.Empty(t, name, other)
+			assert.False(t, legacy, other)
+		}
+
+		name, _ = value.Parse("")
+		assert.Empty(t, name)
+	}
+	assert.Equal(t, "argocd_my-app", NamespaceTrackingValue("argocd").Format("my-app"))
+	assert.Equal(t, "argocd:my-app", InstanceIDTrackingValue("argocd").Format("my-app"))
+}
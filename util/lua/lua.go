@@ -114,6 +114,14 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, error) {
 	return vm.getPredefinedLuaScripts(key, healthScriptFile)
 }
 
+// ValidateScript verifies that the given Lua script compiles without executing it
+func ValidateScript(script string) error {
+	l := lua.NewState(lua.Options{SkipOpenLibs: true})
+	defer l.Close()
+	_, err := l.LoadString(script)
+	return err
+}
+
 func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) (*unstructured.Unstructured, error) {
 	l, err := vm.runLua(obj, script)
 	if err != nil {
@@ -110,6 +110,13 @@ func TestHandleInfiniteLoop(t *testing.T) {
 	assert.IsType(t, &lua.ApiError{}, err)
 }
 
+func TestValidateScript(t *testing.T) {
+	assert.NoError(t, ValidateScript(newHealthStatusFunction))
+	// the script is only compiled, so infinite loops are not detected
+	assert.NoError(t, ValidateScript(infiniteLoop))
+	assert.Error(t, ValidateScript(`hs = {`))
+}
+
 func TestGetHealthScriptWithOverride(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{
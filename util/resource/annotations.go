@@ -22,6 +22,18 @@ func GetAnnotationCSVs(obj *unstructured.Unstructured, key string) []string {
 	return values
 }
 
+// GetAnnotationOptionValue returns the value of the option with the given name, which is listed as "<name>=<value>" in
+// the given annotation
+func GetAnnotationOptionValue(obj *unstructured.Unstructured, key, name string) (string, bool) {
+	prefix := name + "="
+	for _, item := range GetAnnotationCSVs(obj, key) {
+		if strings.HasPrefix(item, prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(item, prefix)), true
+		}
+	}
+	return "", false
+}
+
 func HasAnnotationOption(obj *unstructured.Unstructured, key, val string) bool {
 	for _, item := range GetAnnotationCSVs(obj, key) {
 		if item == val {
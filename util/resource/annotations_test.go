@@ -36,6 +36,18 @@ func TestHasAnnotationOption(t *testing.T) {
 	}
 }
 
+func TestGetAnnotationOptionValue(t *testing.T) {
+	val, ok := GetAnnotationOptionValue(example("bar,baz=120s"), "foo", "baz")
+	assert.True(t, ok)
+	assert.Equal(t, "120s", val)
+
+	_, ok = GetAnnotationOptionValue(example("bar,baz"), "foo", "baz")
+	assert.False(t, ok)
+
+	_, ok = GetAnnotationOptionValue(test.NewPod(), "foo", "baz")
+	assert.False(t, ok)
+}
+
 func example(val string) *unstructured.Unstructured {
 	return test.Annotate(test.NewPod(), "foo", val)
 }
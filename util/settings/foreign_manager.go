@@ -0,0 +1,21 @@
+package settings
+
+// ForeignManager describes the labels and annotations which mark resources as managed by a tool other than Argo CD
+type ForeignManager struct {
+	// Name is the name of the tool which is reported, e.g. Flux
+	Name string `json:"name"`
+	// Labels holds the label patterns in the form <key pattern> or <key pattern>=<value>
+	Labels []string `json:"labels,omitempty"`
+	// Annotations holds the annotation patterns in the form <key pattern> or <key pattern>=<value>
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// defaultForeignManagers holds the tools which are detected out of the box
+var defaultForeignManagers = []ForeignManager{{
+	Name:   "Flux",
+	Labels: []string{"kustomize.toolkit.fluxcd.io/*", "helm.toolkit.fluxcd.io/*"},
+}, {
+	Name:        "Helm",
+	Labels:      []string{"app.kubernetes.io/managed-by=Helm"},
+	Annotations: []string{"meta.helm.sh/release-name"},
+}}
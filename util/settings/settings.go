@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/server/settings/oidc"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/password"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
 )
@@ -197,14 +199,49 @@ const (
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
 	resourceInclusionsKey = "resource.inclusions"
+	// resourcePassthroughAnnotationsKey is the key to the list of annotation key patterns which are preserved on sync
+	resourcePassthroughAnnotationsKey = "resource.passthroughAnnotations"
 	// configManagementPluginsKey is the key to the list of config management plugins
 	configManagementPluginsKey = "configManagementPlugins"
 	// kustomizeBuildOptions is a string of kustomize build parameters
 	kustomizeBuildOptions = "kustomize.buildOptions"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
+	// resourceIgnoredMetadataKeysKey is the key to the list of annotation and label key patterns whose changes don't make
+	// resources OutOfSync
+	resourceIgnoredMetadataKeysKey = "resource.ignoredMetadataKeys"
+	// resourceForeignManagersKey is the key to the list of labels and annotations which mark resources as managed by
+	// other tools
+	resourceForeignManagersKey = "resource.foreignManagers"
+	// secretRedactionDisabledKey is the key which disables the redaction of Secret data in comparison results
+	secretRedactionDisabledKey = "resource.secretRedaction.disabled"
+	// appRefreshIntervalMinKey is the key to the lowest refresh interval which can be configured for an application
+	appRefreshIntervalMinKey = "application.refreshInterval.min"
+	// appRefreshIntervalMaxKey is the key to the highest refresh interval which can be configured for an application
+	appRefreshIntervalMaxKey = "application.refreshInterval.max"
+	// appMaxResourcesKey is the key to the maximum number of resources of an application
+	appMaxResourcesKey = "application.maxResources"
+	// appResourceTrackingMethodKey is the key to the method by which the resources of applications are tracked
+	appResourceTrackingMethodKey = "application.resourceTrackingMethod"
+	// appResourceTrackingValueFormatKey is the key to the format of the value by which the resources of applications are
+	// tracked
+	appResourceTrackingValueFormatKey = "application.resourceTrackingValueFormat"
+	// appInstanceIDKey is the key to the ID of the installation, which is part of the tracking value if the instanceID
+	// tracking value format is configured
+	appInstanceIDKey = "application.instanceID"
 )
 
+// defaultResourceOverrides holds the resource overrides which are configured out of the box. Their settings are merged
+// into the override of the user for the same key unless the user sets them, e.g. `ignoreDifferences: "normalizeDefaults: false"`
+// disables the built-in normalization
+var defaultResourceOverrides = map[string]v1alpha1.ResourceOverride{
+	// Applications are diffed with the spec defaulting applied, so that app-of-apps parents do not show minimal child
+	// Application manifests as OutOfSync
+	"argoproj.io/Application": {IgnoreDifferences: "normalizeDefaults: true"},
+	// The rules of aggregated ClusterRoles are populated by the controller manager
+	"rbac.authorization.k8s.io/ClusterRole": {IgnoreDifferences: "normalizeDefaults: true"},
+}
+
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
 type SettingsManager struct {
 	ctx        context.Context
@@ -291,16 +328,181 @@ func (mgr *SettingsManager) GetResourcesFilter() (*ResourcesFilter, error) {
 	return rf, nil
 }
 
-func (mgr *SettingsManager) GetAppInstanceLabelKey() (string, error) {
+// GetPassthroughAnnotations loads the list of annotation key patterns which are preserved on sync from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetPassthroughAnnotations() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	patterns := make([]string, 0)
+	if value, ok := argoCDCM.Data[resourcePassthroughAnnotationsKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &patterns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return patterns, nil
+}
+
+// GetIgnoredMetadataKeys loads the list of annotation and label key patterns whose changes don't make resources
+// OutOfSync from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetIgnoredMetadataKeys() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	patterns := make([]string, 0)
+	if value, ok := argoCDCM.Data[resourceIgnoredMetadataKeysKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &patterns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return patterns, nil
+}
+
+// GetForeignManagers loads the labels and annotations which mark resources as managed by other tools from argocd-cm
+// ConfigMap. The configured tools are added to the default ones, a configured tool replaces the default tool of the
+// same name.
+func (mgr *SettingsManager) GetForeignManagers() ([]ForeignManager, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	configured := make([]ForeignManager, 0)
+	if value, ok := argoCDCM.Data[resourceForeignManagersKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &configured)
+		if err != nil {
+			return nil, err
+		}
+	}
+	names := make(map[string]bool)
+	for _, manager := range configured {
+		names[manager.Name] = true
+	}
+	managers := make([]ForeignManager, 0, len(defaultForeignManagers)+len(configured))
+	for _, manager := range defaultForeignManagers {
+		if !names[manager.Name] {
+			managers = append(managers, manager)
+		}
+	}
+	return append(managers, configured...), nil
+}
+
+// GetSecretRedactionDisabled returns true if the data of Secrets should not be redacted in comparison results.
+// Intended for break-glass debugging only.
+func (mgr *SettingsManager) GetSecretRedactionDisabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	return argoCDCM.Data[secretRedactionDisabledKey] == "true", nil
+}
+
+// GetAppRefreshIntervalLimits returns the lowest and highest refresh interval which can be configured for an
+// application. Zero means there is no limit.
+func (mgr *SettingsManager) GetAppRefreshIntervalLimits() (time.Duration, time.Duration, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, 0, err
+	}
+	limits := make([]time.Duration, 2)
+	for i, key := range []string{appRefreshIntervalMinKey, appRefreshIntervalMaxKey} {
+		if value, ok := argoCDCM.Data[key]; ok {
+			if limits[i], err = time.ParseDuration(value); err != nil {
+				return 0, 0, fmt.Errorf("invalid value of %s: %v", key, err)
+			}
+		}
+	}
+	return limits[0], limits[1], nil
+}
+
+// GetAppMaxResources returns the maximum number of resources of an application, which projects can override. Zero means
+// there is no limit.
+func (mgr *SettingsManager) GetAppMaxResources() (int64, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, err
+	}
+	value, ok := argoCDCM.Data[appMaxResourcesKey]
+	if !ok {
+		return 0, nil
+	}
+	maxResources, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of %s: %v", appMaxResourcesKey, err)
+	}
+	if maxResources < 0 {
+		return 0, fmt.Errorf("invalid value of %s: %d is negative", appMaxResourcesKey, maxResources)
+	}
+	return maxResources, nil
+}
+
+// GetAppResourceTrackingMethod returns the method by which the resources of applications are tracked, which defaults to
+// the app instance label
+func (mgr *SettingsManager) GetAppResourceTrackingMethod() (kube.TrackingMethod, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
 		return "", err
 	}
-	label := argoCDCM.Data[settingsApplicationInstanceLabelKey]
-	if label == "" {
-		return common.LabelKeyAppInstance, nil
+	method, err := kube.ParseTrackingMethod(strings.TrimSpace(argoCDCM.Data[appResourceTrackingMethodKey]))
+	if err != nil {
+		return "", fmt.Errorf("invalid value of %s: %v", appResourceTrackingMethodKey, err)
+	}
+	return method, nil
+}
+
+// GetAppResourceTrackingValue returns the format of the value by which the resources of applications are tracked, which
+// defaults to the bare application name. The `namespace` format prefixes the name with the namespace of the
+// installation and the `instanceID` format with the configured instance ID.
+func (mgr *SettingsManager) GetAppResourceTrackingValue() (kube.TrackingValue, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return kube.TrackingValue{}, err
+	}
+	switch format := strings.TrimSpace(argoCDCM.Data[appResourceTrackingValueFormatKey]); format {
+	case "", "name":
+		return kube.TrackingValue{}, nil
+	case "namespace":
+		return kube.NamespaceTrackingValue(mgr.namespace), nil
+	case "instanceID":
+		instanceID := strings.TrimSpace(argoCDCM.Data[appInstanceIDKey])
+		if instanceID == "" {
+			return kube.TrackingValue{}, fmt.Errorf("%s must be set if %s is instanceID", appInstanceIDKey, appResourceTrackingValueFormatKey)
+		}
+		return kube.InstanceIDTrackingValue(instanceID), nil
+	default:
+		return kube.TrackingValue{}, fmt.Errorf("invalid value of %s: unknown format '%s', expected one of name, namespace or instanceID", appResourceTrackingValueFormatKey, format)
 	}
-	return label, nil
+}
+
+// GetAppInstanceLabelKey returns the primary app instance label key, which is injected into the resources of applications
+func (mgr *SettingsManager) GetAppInstanceLabelKey() (string, error) {
+	keys, err := mgr.GetAppInstanceLabelKeys()
+	if err != nil {
+		return "", err
+	}
+	return keys[0], nil
+}
+
+// GetAppInstanceLabelKeys returns the ordered list of app instance label keys. The first key is the primary key, which
+// is injected into the resources of applications, and the following keys are legacy keys which are still considered
+// when determining the application of a resource, e.g. while migrating resources to a new key.
+func (mgr *SettingsManager) GetAppInstanceLabelKeys() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, key := range strings.Split(argoCDCM.Data[settingsApplicationInstanceLabelKey], ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return []string{common.LabelKeyAppInstance}, nil
+	}
+	return keys, nil
 }
 
 func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManagementPlugin, error) {
@@ -332,9 +534,51 @@ func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.Resource
 		}
 	}
 
+	// Built-in overrides are merged into the differences handling the user has configured for the same kind, the
+	// settings of the user take precedence
+	for key, override := range defaultResourceOverrides {
+		userOverride := resourceOverrides[key]
+		userOverride.IgnoreDifferences = mergeIgnoreDifferences(userOverride.IgnoreDifferences, override.IgnoreDifferences)
+		resourceOverrides[key] = userOverride
+	}
+
 	return resourceOverrides, nil
 }
 
+// mergeIgnoreDifferences adds the settings of the built-in ignoreDifferences which are not set by the given
+// ignoreDifferences of the user. The user settings are returned unchanged if they cannot be parsed, so that the error is
+// reported by the normalizer.
+func mergeIgnoreDifferences(user string, builtin string) string {
+	if user == "" {
+		return builtin
+	}
+	var userSettings, builtinSettings map[string]interface{}
+	if err := yaml.Unmarshal([]byte(user), &userSettings); err != nil {
+		return user
+	}
+	if err := yaml.Unmarshal([]byte(builtin), &builtinSettings); err != nil {
+		return user
+	}
+	if userSettings == nil {
+		userSettings = make(map[string]interface{})
+	}
+	merged := false
+	for k, v := range builtinSettings {
+		if _, ok := userSettings[k]; !ok {
+			userSettings[k] = v
+			merged = true
+		}
+	}
+	if !merged {
+		return user
+	}
+	data, err := yaml.Marshal(userSettings)
+	if err != nil {
+		return user
+	}
+	return string(data)
+}
+
 // GetKustomizeBuildOptions loads the kustomize build options from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetKustomizeBuildOptions() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
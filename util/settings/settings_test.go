@@ -3,9 +3,11 @@ package settings
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/kube"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -97,6 +99,72 @@ func TestGetAppInstanceLabelKey(t *testing.T) {
 	assert.Equal(t, "testLabel", label)
 }
 
+func TestGetAppInstanceLabelKeys(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"application.instanceLabelKey": "mycompany.com/appname, app.kubernetes.io/instance,",
+	})
+	keys, err := settingsManager.GetAppInstanceLabelKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mycompany.com/appname", "app.kubernetes.io/instance"}, keys)
+	label, err := settingsManager.GetAppInstanceLabelKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "mycompany.com/appname", label)
+
+	_, settingsManager = fixtures(nil)
+	keys, err = settingsManager.GetAppInstanceLabelKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{common.LabelKeyAppInstance}, keys)
+}
+
+func TestGetPassthroughAnnotations(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.passthroughAnnotations": `
+- cluster-autoscaler.kubernetes.io/*
+- example.com/owner`,
+	})
+	patterns, err := settingsManager.GetPassthroughAnnotations()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cluster-autoscaler.kubernetes.io/*", "example.com/owner"}, patterns)
+}
+
+func TestGetIgnoredMetadataKeys(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.ignoredMetadataKeys": `
+- operator.example.com/*
+- example.com/last-reconciled`,
+	})
+	patterns, err := settingsManager.GetIgnoredMetadataKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"operator.example.com/*", "example.com/last-reconciled"}, patterns)
+
+	_, settingsManager = fixtures(nil)
+	patterns, err = settingsManager.GetIgnoredMetadataKeys()
+	assert.NoError(t, err)
+	assert.Empty(t, patterns)
+}
+
+func TestGetForeignManagers(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	managers, err := settingsManager.GetForeignManagers()
+	assert.NoError(t, err)
+	assert.Equal(t, defaultForeignManagers, managers)
+
+	_, settingsManager = fixtures(map[string]string{
+		"resource.foreignManagers": `
+- name: Helm
+  annotations: [meta.helm.sh/release-name]
+- name: Operator
+  labels: [operator.example.com/owner]`,
+	})
+	managers, err = settingsManager.GetForeignManagers()
+	assert.NoError(t, err)
+	assert.Equal(t, []ForeignManager{
+		defaultForeignManagers[0],
+		{Name: "Helm", Annotations: []string{"meta.helm.sh/release-name"}},
+		{Name: "Operator", Labels: []string{"operator.example.com/owner"}},
+	}, managers)
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"resource.customizations": `
@@ -114,6 +182,134 @@ func TestGetResourceOverrides(t *testing.T) {
 	assert.Equal(t, v1alpha1.ResourceOverride{
 		IgnoreDifferences: "jsonPointers:\n- /webhooks/0/clientConfig/caBundle",
 	}, webHookOverrides)
+
+	assert.Equal(t, v1alpha1.ResourceOverride{
+		IgnoreDifferences: "normalizeDefaults: true",
+	}, overrides["argoproj.io/Application"])
+
+	assert.Equal(t, v1alpha1.ResourceOverride{
+		IgnoreDifferences: "normalizeDefaults: true",
+	}, overrides["rbac.authorization.k8s.io/ClusterRole"])
+}
+
+func TestGetResourceOverrides_DisableDefault(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.customizations": `
+    argoproj.io/Application:
+      ignoreDifferences: "normalizeDefaults: false"`,
+	})
+	overrides, err := settingsManager.GetResourceOverrides()
+	assert.NoError(t, err)
+
+	assert.Equal(t, v1alpha1.ResourceOverride{
+		IgnoreDifferences: "normalizeDefaults: false",
+	}, overrides["argoproj.io/Application"])
+}
+
+func TestGetResourceOverrides_MergeDefault(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.customizations": `
+    argoproj.io/Application:
+      ignoreDifferences: |
+        jsonPointers:
+        - /spec/source/targetRevision
+    rbac.authorization.k8s.io/ClusterRole:
+      ignoreDifferences: |
+        jsonPointers:
+        - /metadata/labels
+        normalizeDefaults: false`,
+	})
+	overrides, err := settingsManager.GetResourceOverrides()
+	assert.NoError(t, err)
+
+	// the built-in normalization is kept unless the user configures it
+	assert.Equal(t, v1alpha1.ResourceOverride{
+		IgnoreDifferences: "jsonPointers:\n- /spec/source/targetRevision\nnormalizeDefaults: true\n",
+	}, overrides["argoproj.io/Application"])
+	assert.Equal(t, v1alpha1.ResourceOverride{
+		IgnoreDifferences: "jsonPointers:\n- /metadata/labels\nnormalizeDefaults: false",
+	}, overrides["rbac.authorization.k8s.io/ClusterRole"])
+}
+
+func TestGetAppRefreshIntervalLimits(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	minInterval, maxInterval, err := settingsManager.GetAppRefreshIntervalLimits()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), minInterval)
+	assert.Equal(t, time.Duration(0), maxInterval)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.refreshInterval.min": "30s",
+		"application.refreshInterval.max": "1h",
+	})
+	minInterval, maxInterval, err = settingsManager.GetAppRefreshIntervalLimits()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, minInterval)
+	assert.Equal(t, time.Hour, maxInterval)
+
+	_, settingsManager = fixtures(map[string]string{"application.refreshInterval.min": "invalid"})
+	_, _, err = settingsManager.GetAppRefreshIntervalLimits()
+	assert.Error(t, err)
+}
+
+func TestGetAppMaxResources(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	maxResources, err := settingsManager.GetAppMaxResources()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), maxResources)
+
+	_, settingsManager = fixtures(map[string]string{"application.maxResources": "5000"})
+	maxResources, err = settingsManager.GetAppMaxResources()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5000), maxResources)
+
+	for _, value := range []string{"invalid", "-1"} {
+		_, settingsManager = fixtures(map[string]string{"application.maxResources": value})
+		_, err = settingsManager.GetAppMaxResources()
+		assert.Error(t, err)
+	}
+}
+
+func TestGetAppResourceTrackingMethod(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	method, err := settingsManager.GetAppResourceTrackingMethod()
+	assert.NoError(t, err)
+	assert.Equal(t, kube.TrackingMethodLabel, method)
+
+	_, settingsManager = fixtures(map[string]string{"application.resourceTrackingMethod": "annotation+label"})
+	method, err = settingsManager.GetAppResourceTrackingMethod()
+	assert.NoError(t, err)
+	assert.Equal(t, kube.TrackingMethodAnnotationAndLabel, method)
+
+	_, settingsManager = fixtures(map[string]string{"application.resourceTrackingMethod": "invalid"})
+	_, err = settingsManager.GetAppResourceTrackingMethod()
+	assert.Error(t, err)
+}
+
+func TestGetAppResourceTrackingValue(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	value, err := settingsManager.GetAppResourceTrackingValue()
+	assert.NoError(t, err)
+	assert.Equal(t, "my-app", value.Format("my-app"))
+
+	_, settingsManager = fixtures(map[string]string{"application.resourceTrackingValueFormat": "namespace"})
+	value, err = settingsManager.GetAppResourceTrackingValue()
+	assert.NoError(t, err)
+	assert.Equal(t, "default_my-app", value.Format("my-app"))
+
+	_, settingsManager = fixtures(map[string]string{"application.resourceTrackingValueFormat": "instanceID", "application.instanceID": "prod"})
+	value, err = settingsManager.GetAppResourceTrackingValue()
+	assert.NoError(t, err)
+	assert.Equal(t, "prod:my-app", value.Format("my-app"))
+
+	for _, data := range []map[string]string{
+		{"application.resourceTrackingValueFormat": "instanceID"},
+		{"application.resourceTrackingValueFormat": "invalid"},
+	} {
+		_, settingsManager = fixtures(data)
+		_, err = settingsManager.GetAppResourceTrackingValue()
+		assert.Error(t, err)
+	}
 }
 
 func TestSettingsManager_GetKustomizeBuildOptions(t *testing.T) {